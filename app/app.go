@@ -322,12 +322,14 @@ func NewSifAppWithBlacklist(
 		addrs[addr.String()] = true
 	}
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	bankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey],
 		app.AccountKeeper,
 		app.GetSubspace(banktypes.ModuleName),
 		addrs,
 	)
+	// pool shares sent through the bank keeper carry the clp liquidity provider units they back
+	app.BankKeeper = clpkeeper.NewPoolShareBankKeeper(bankKeeper, func() clpkeeper.Keeper { return app.ClpKeeper })
 	app.AuthzKeeper = authzkeeper.NewKeeper(
 		keys[authzkeeper.StoreKey],
 		appCodec,
@@ -381,7 +383,7 @@ func NewSifAppWithBlacklist(
 	app.ClpKeeper = clpkeeper.NewKeeper(
 		appCodec,
 		keys[clptypes.StoreKey],
-		app.BankKeeper,
+		bankKeeper,
 		app.AccountKeeper,
 		app.TokenRegistryKeeper,
 		app.AdminKeeper,
@@ -445,7 +447,6 @@ func NewSifAppWithBlacklist(
 		appCodec,
 		keys[oracletypes.StoreKey],
 		app.StakingKeeper,
-		oracletypes.DefaultConsensusNeeded,
 	)
 
 	app.EthbridgeKeeper = ethbridgekeeper.NewKeeper(
@@ -454,6 +455,7 @@ func NewSifAppWithBlacklist(
 		app.OracleKeeper,
 		app.AccountKeeper,
 		app.AdminKeeper,
+		app.TokenRegistryKeeper,
 		keys[ethbridgetypes.StoreKey],
 	)
 
@@ -493,7 +495,7 @@ func NewSifAppWithBlacklist(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newBankModule(appCodec, app.BankKeeper, bankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
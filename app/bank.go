@@ -0,0 +1,34 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankModule serves the bank messages through the app bank keeper, which wraps the base keeper, while the bank
+// store migrations keep running on the base keeper
+type bankModule struct {
+	bank.AppModule
+	keeper     bankkeeper.Keeper
+	baseKeeper bankkeeper.BaseKeeper
+}
+
+func newBankModule(cdc codec.Codec, keeper bankkeeper.Keeper, baseKeeper bankkeeper.BaseKeeper, accountKeeper banktypes.AccountKeeper) bankModule {
+	return bankModule{
+		AppModule:  bank.NewAppModule(cdc, keeper, accountKeeper),
+		keeper:     keeper,
+		baseKeeper: baseKeeper,
+	}
+}
+
+// RegisterServices registers module services.
+func (am bankModule) RegisterServices(cfg module.Configurator) {
+	banktypes.RegisterMsgServer(cfg.MsgServer(), bankkeeper.NewMsgServerImpl(am.keeper))
+	banktypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := bankkeeper.NewMigrator(am.baseKeeper)
+	cfg.RegisterMigration(banktypes.ModuleName, 1, m.Migrate1to2)
+}
@@ -15,13 +15,16 @@ import (
 
 	sifapp "github.com/Sifchain/sifnode/app"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/contract"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/metrics"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/relayer"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/server"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
@@ -80,6 +83,36 @@ func buildRootCmd() *cobra.Command {
 		"./relayerdb",
 		"Path to the relayerdb directory",
 	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumSigner,
+		txs.SignerBackendEnv,
+		"Where the Ethereum key is kept (env|keystore|keyring|remote); env reads ETHEREUM_PRIVATE_KEY",
+	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumKeystoreFile,
+		"",
+		"Path to the encrypted go-ethereum keystore JSON file, for the keystore signer",
+	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumPasswordFile,
+		"",
+		"Path to a file containing the keystore passphrase, for the keystore signer",
+	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumKeyName,
+		"",
+		"Name of the secp256k1 key in the keyring selected by --keyring-backend, for the keyring signer",
+	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumRemoteSignerURL,
+		"",
+		"URL the remote signer accepts signing requests on, for the remote signer",
+	)
+	rootCmd.PersistentFlags().String(
+		ebrelayertypes.FlagEthereumAddress,
+		"",
+		"Ethereum address the remote signer signs with, for the remote signer",
+	)
 	// Construct Root Command
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
@@ -97,14 +130,24 @@ func buildRootCmd() *cobra.Command {
 func initRelayerCmd() *cobra.Command {
 	//nolint:lll
 	initRelayerCmd := &cobra.Command{
-		Use:     "init [tendermintNode] [web3Provider] [bridgeRegistryContractAddress] [validatorMoniker] [validatorMnemonic]",
+		Use:     "init [tendermintNode] [web3Provider] [bridgeRegistryContractAddress] [validatorMoniker]",
 		Short:   "Validate credentials and initialize subscriptions to both chains",
-		Args:    cobra.ExactArgs(5),
-		Example: "ebrelayer init tcp://localhost:26657 ws://localhost:7545/ 0x30753E4A8aad7F8597332E813735Def5dD395028 validator mnemonic --chain-id=peggy",
+		Args:    cobra.RangeArgs(4, 5),
+		Example: "ebrelayer init tcp://localhost:26657 ws://localhost:7545/ 0x30753E4A8aad7F8597332E813735Def5dD395028 validator --chain-id=peggy --ethereum-signer=keystore --ethereum-keystore-file=relayer.json --ethereum-password-file=relayer.pass",
 		RunE:    RunInitRelayerCmd,
 	}
 	//flags.AddQueryFlagsToCmd(initRelayerCmd)
 	flags.AddTxFlagsToCmd(initRelayerCmd)
+	initRelayerCmd.Flags().String(
+		ebrelayertypes.FlagMetricsListenAddr,
+		"",
+		"Address to serve Prometheus metrics on under /metrics (e.g. :9090), disabled if empty",
+	)
+	initRelayerCmd.Flags().Uint64(
+		ebrelayertypes.FlagEthereumConfirmations,
+		relayer.DefaultEthereumConfirmations,
+		"Number of blocks an Ethereum block must be buried under before its events are relayed",
+	)
 
 	return initRelayerCmd
 }
@@ -177,6 +220,9 @@ func RunInitRelayerCmd(cmd *cobra.Command, args []string) error {
 		return errors.Errorf("invalid [validator-moniker]: %s", args[3])
 	}
 	validatorMoniker := args[3]
+	if len(args) == 5 {
+		log.Println("ignoring the deprecated [validatorMnemonic] argument, keys are loaded from --keyring-backend and --ethereum-signer")
+	}
 
 	logConfig := zap.NewDevelopmentConfig()
 	logConfig.Sampling = nil
@@ -199,6 +245,19 @@ func RunInitRelayerCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	metricsListenAddr, err := cmd.Flags().GetString(ebrelayertypes.FlagMetricsListenAddr)
+	if err != nil {
+		return err
+	}
+	if metricsListenAddr != "" {
+		go metrics.Serve(metricsListenAddr, sugaredLogger)
+	}
+
+	confirmations, err := cmd.Flags().GetUint64(ebrelayertypes.FlagEthereumConfirmations)
+	if err != nil {
+		return err
+	}
+
 	// Initialize new Ethereum event listener
 	ethSub := relayer.NewEthereumSub(
 		cliContext,
@@ -207,23 +266,24 @@ func RunInitRelayerCmd(cmd *cobra.Command, args []string) error {
 		web3Provider,
 		contractAddress,
 		nil,
+		confirmations,
 		db,
 		sugaredLogger,
 	)
 
-	key, err := txs.LoadPrivateKey()
+	signer, err := buildEthereumSigner(cmd)
 	if err != nil {
-		log.Fatalf("failed to load ETHEREUM_PRIVATE_KEY")
+		log.Fatalf("failed to load ethereum signer: %s", err.Error())
 	}
 
 	// Initialize new Cosmos event listener
-	cosmosSub := relayer.NewCosmosSub(tendermintNode, web3Provider, contractAddress, key, db, sugaredLogger)
+	cosmosSub := relayer.NewCosmosSub(cliContext, tendermintNode, web3Provider, contractAddress, validatorMoniker, signer, db, sugaredLogger)
 
 	waitForAll := sync.WaitGroup{}
 	waitForAll.Add(2)
 	txFactory := tx.NewFactoryCLI(cliContext, cmd.Flags())
 	go ethSub.Start(txFactory, &waitForAll, symbolTranslator)
-	go cosmosSub.Start(&waitForAll, symbolTranslator)
+	go cosmosSub.Start(txFactory, &waitForAll, symbolTranslator)
 	waitForAll.Wait()
 
 	return nil
@@ -247,10 +307,10 @@ func RunGenerateBindingsCmd(_ *cobra.Command, _ []string) error {
 func replayEthereumCmd() *cobra.Command {
 	//nolint:lll
 	replayEthereumCmd := &cobra.Command{
-		Use:     "replayEthereum [tendermintNode] [web3Provider] [bridgeRegistryContractAddress] [validatorMoniker] [validatorMnemonic] [fromBlock] [toBlock] [sifFromBlock] [sifEndBlock]",
+		Use:     "replayEthereum [tendermintNode] [web3Provider] [bridgeRegistryContractAddress] [validatorMoniker] [fromBlock] [toBlock] [sifFromBlock] [sifEndBlock]",
 		Short:   "replay missed ethereum events",
-		Args:    cobra.ExactArgs(9),
-		Example: "replayEthereum tcp://localhost:26657 ws://localhost:7545/ 0x30753E4A8aad7F8597332E813735Def5dD395028 validator mnemonic 100 200 100 200 --chain-id=peggy",
+		Args:    cobra.RangeArgs(8, 9),
+		Example: "replayEthereum tcp://localhost:26657 ws://localhost:7545/ 0x30753E4A8aad7F8597332E813735Def5dD395028 validator 100 200 100 200 --chain-id=peggy --from=validator",
 		RunE:    RunReplayEthereumCmd,
 	}
 
@@ -302,6 +362,54 @@ func buildSymbolTranslator(flags *flag.FlagSet) (*symbol_translator.SymbolTransl
 	return symbolTranslator, nil
 }
 
+// buildEthereumSigner loads the relayer's Ethereum signer selected by FlagEthereumSigner
+func buildEthereumSigner(cmd *cobra.Command) (txs.Signer, error) {
+	backend, err := cmd.Flags().GetString(ebrelayertypes.FlagEthereumSigner)
+	if err != nil {
+		return nil, err
+	}
+	switch backend {
+	case txs.SignerBackendEnv:
+		return txs.NewEnvSigner()
+	case txs.SignerBackendKeystore:
+		keystoreFile, _ := cmd.Flags().GetString(ebrelayertypes.FlagEthereumKeystoreFile)
+		passwordFile, _ := cmd.Flags().GetString(ebrelayertypes.FlagEthereumPasswordFile)
+		if keystoreFile == "" || passwordFile == "" {
+			return nil, errors.Errorf("--%s and --%s are required by the keystore signer",
+				ebrelayertypes.FlagEthereumKeystoreFile, ebrelayertypes.FlagEthereumPasswordFile)
+		}
+		return txs.NewKeystoreSigner(keystoreFile, passwordFile)
+	case txs.SignerBackendKeyring:
+		keyName, _ := cmd.Flags().GetString(ebrelayertypes.FlagEthereumKeyName)
+		if keyName == "" {
+			return nil, errors.Errorf("--%s is required by the keyring signer", ebrelayertypes.FlagEthereumKeyName)
+		}
+		keyringBackend, _ := cmd.Flags().GetString(flags.FlagKeyringBackend)
+		if keyringBackend == "" {
+			keyringBackend = flags.DefaultKeyringBackend
+		}
+		keyringDir, _ := cmd.Flags().GetString(flags.FlagKeyringDir)
+		if keyringDir == "" {
+			keyringDir = client.GetClientContextFromCmd(cmd).HomeDir
+		}
+		kr, err := keyring.New(sdk.KeyringServiceName(), keyringBackend, keyringDir, cmd.InOrStdin())
+		if err != nil {
+			return nil, err
+		}
+		return txs.NewKeyringSigner(kr, keyName)
+	case txs.SignerBackendRemote:
+		url, _ := cmd.Flags().GetString(ebrelayertypes.FlagEthereumRemoteSignerURL)
+		address, _ := cmd.Flags().GetString(ebrelayertypes.FlagEthereumAddress)
+		if url == "" || !common.IsHexAddress(address) {
+			return nil, errors.Errorf("--%s and a valid --%s are required by the remote signer",
+				ebrelayertypes.FlagEthereumRemoteSignerURL, ebrelayertypes.FlagEthereumAddress)
+		}
+		return txs.NewRemoteSigner(url, common.HexToAddress(address)), nil
+	default:
+		return nil, errors.Errorf("unknown ethereum signer %s", backend)
+	}
+}
+
 func main() {
 	if err := svrcmd.Execute(buildRootCmd(), sifapp.DefaultNodeHome); err != nil {
 		switch e := err.(type) {
@@ -0,0 +1,100 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const (
+	namespace = "ebrelayer"
+
+	// ChainEthereum labels metrics about the Ethereum side of the relayer
+	ChainEthereum = "ethereum"
+	// ChainCosmos labels metrics about the Sifchain side of the relayer
+	ChainCosmos = "cosmos"
+)
+
+var (
+	registry = prometheus.NewRegistry()
+	factory  = promauto.With(registry)
+
+	// LastProcessedBlock is the last processed block persisted in the relayer db, per chain
+	LastProcessedBlock = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_processed_block",
+		Help:      "Last processed block stored in the relayer db",
+	}, []string{"chain"})
+
+	// EventsSeen counts the bridge events witnessed, per chain and event type
+	EventsSeen = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_seen_total",
+		Help:      "Bridge events witnessed",
+	}, []string{"chain", "event"})
+
+	// EventsRelayed counts the bridge events relayed to the opposite chain, per source chain and event type
+	EventsRelayed = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "events_relayed_total",
+		Help:      "Bridge events relayed to the opposite chain",
+	}, []string{"chain", "event"})
+
+	// ClaimSubmissionFailures counts the failed attempts to submit a claim, per source chain and event type
+	ClaimSubmissionFailures = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "claim_submission_failures_total",
+		Help:      "Failed attempts to submit a claim to the opposite chain",
+	}, []string{"chain", "event"})
+
+	// WebsocketReconnects counts the restarts of a chain subscription
+	WebsocketReconnects = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "websocket_reconnects_total",
+		Help:      "Restarts of a chain subscription after a websocket failure",
+	}, []string{"chain"})
+
+	// EthereumReorgs counts the Ethereum reorgs that replaced blocks the relayer already processed
+	EthereumReorgs = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ethereum_reorgs_total",
+		Help:      "Ethereum reorgs that replaced already processed blocks",
+	})
+
+	// EthereumGasUsed counts the gas used by prophecy claims relayed to Ethereum
+	EthereumGasUsed = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ethereum_gas_used_total",
+		Help:      "Gas used by prophecy claims relayed to Ethereum",
+	})
+
+	// EthereumGasSpentWei counts the wei paid for the gas of prophecy claims relayed to Ethereum
+	EthereumGasSpentWei = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "ethereum_gas_spent_wei_total",
+		Help:      "Wei paid for gas by prophecy claims relayed to Ethereum",
+	})
+)
+
+func init() {
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+}
+
+// Handler serves the relayer metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Serve exposes the relayer metrics on addr under /metrics, it blocks until the server fails
+func Serve(addr string, sugaredLogger *zap.SugaredLogger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	sugaredLogger.Infow("serving metrics.", "address", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		sugaredLogger.Errorw("metrics server stopped.", "errorMessage", err.Error())
+	}
+}
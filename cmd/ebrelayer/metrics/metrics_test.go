@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	LastProcessedBlock.WithLabelValues(ChainEthereum).Set(120)
+	EventsSeen.WithLabelValues(ChainCosmos, "lock").Inc()
+	EventsSeen.WithLabelValues(ChainCosmos, "lock").Inc()
+	EthereumGasUsed.Add(21000)
+	require.Equal(t, float64(2), testutil.ToFloat64(EventsSeen.WithLabelValues(ChainCosmos, "lock")))
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(recorder.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `ebrelayer_last_processed_block{chain="ethereum"} 120`)
+	require.Contains(t, string(body), `ebrelayer_events_seen_total{chain="cosmos",event="lock"} 2`)
+	require.Contains(t, string(body), `ebrelayer_ethereum_gas_used_total 21000`)
+}
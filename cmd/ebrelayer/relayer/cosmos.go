@@ -5,7 +5,6 @@ package relayer
 import (
 	"bytes"
 	"context"
-	"crypto/ecdsa"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -22,8 +21,11 @@ import (
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/contract"
 	cosmosbridge "github.com/Sifchain/sifnode/cmd/ebrelayer/contract/generated/bindings/cosmosbridge"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/metrics"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
@@ -46,44 +48,50 @@ type CosmosSub struct {
 	TmProvider              string
 	EthProvider             string
 	RegistryContractAddress common.Address
-	PrivateKey              *ecdsa.PrivateKey
+	ValidatorName           string
+	CliCtx                  client.Context
+	Signer                  txs.Signer
 	DB                      *leveldb.DB
 	SugaredLogger           *zap.SugaredLogger
 }
 
 // NewCosmosSub initializes a new CosmosSub
-func NewCosmosSub(tmProvider, ethProvider string, registryContractAddress common.Address,
-	key *ecdsa.PrivateKey,
+func NewCosmosSub(cliCtx client.Context, tmProvider, ethProvider string, registryContractAddress common.Address,
+	validatorMoniker string, signer txs.Signer,
 	db *leveldb.DB, sugaredLogger *zap.SugaredLogger) CosmosSub {
 
 	return CosmosSub{
 		TmProvider:              tmProvider,
 		EthProvider:             ethProvider,
 		RegistryContractAddress: registryContractAddress,
-		PrivateKey:              key,
+		ValidatorName:           validatorMoniker,
+		CliCtx:                  cliCtx,
+		Signer:                  signer,
 		DB:                      db,
 		SugaredLogger:           sugaredLogger,
 	}
 }
 
 // Start a Cosmos chain subscription
-func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *symbol_translator.SymbolTranslator) {
+func (sub CosmosSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGroup, symbolTranslator *symbol_translator.SymbolTranslator) {
 	defer completionEvent.Done()
 	time.Sleep(time.Second)
 	client, err := tmClient.New(sub.TmProvider, "/websocket")
 	if err != nil {
 		sub.SugaredLogger.Errorw("failed to initialize a sifchain client.",
 			errorMessageKey, err.Error())
+		metrics.WebsocketReconnects.WithLabelValues(metrics.ChainCosmos).Inc()
 		completionEvent.Add(1)
-		go sub.Start(completionEvent, symbolTranslator)
+		go sub.Start(txFactory, completionEvent, symbolTranslator)
 		return
 	}
 
 	if err := client.Start(); err != nil {
 		sub.SugaredLogger.Errorw("failed to start a sifchain client.",
 			errorMessageKey, err.Error())
+		metrics.WebsocketReconnects.WithLabelValues(metrics.ChainCosmos).Inc()
 		completionEvent.Add(1)
-		go sub.Start(completionEvent, symbolTranslator)
+		go sub.Start(txFactory, completionEvent, symbolTranslator)
 		return
 	}
 
@@ -96,8 +104,9 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 		sub.SugaredLogger.Errorw("sifchain client failed to subscribe to query.",
 			errorMessageKey, err.Error(),
 			"query", query)
+		metrics.WebsocketReconnects.WithLabelValues(metrics.ChainCosmos).Inc()
 		completionEvent.Add(1)
-		go sub.Start(completionEvent, symbolTranslator)
+		go sub.Start(txFactory, completionEvent, symbolTranslator)
 		return
 	}
 
@@ -120,6 +129,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 		lastProcessedBlock = 0
 	} else {
 		lastProcessedBlock = new(big.Int).SetBytes(data).Int64()
+		metrics.LastProcessedBlock.WithLabelValues(metrics.ChainCosmos).Set(float64(lastProcessedBlock))
 	}
 
 	for {
@@ -167,6 +177,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 
 						switch claimType {
 						case types.MsgBurn, types.MsgLock:
+							metrics.EventsSeen.WithLabelValues(metrics.ChainCosmos, claimType.String()).Inc()
 							cosmosMsg, err := txs.BurnLockEventToCosmosMsg(claimType, event.GetAttributes(), symbolTranslator, sub.SugaredLogger)
 							if err != nil {
 								sub.SugaredLogger.Errorw("sifchain client failed in get message from event.",
@@ -179,7 +190,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 								"msg", cosmosMsg,
 							)
 
-							sub.handleBurnLockMsg(cosmosMsg, claimType)
+							sub.relayOutboundTransfer(txFactory, client, cosmosMsg, claimType, symbolTranslator)
 						}
 					}
 				}
@@ -190,6 +201,7 @@ func (sub CosmosSub) Start(completionEvent *sync.WaitGroup, symbolTranslator *sy
 					// if you can't write to leveldb, then error out as something is seriously amiss
 					log.Fatalf("Error saving lastProcessedBlock to leveldb: %v", err)
 				}
+				metrics.LastProcessedBlock.WithLabelValues(metrics.ChainCosmos).Set(float64(lastProcessedBlock))
 				blockNumber++
 			}
 		}
@@ -308,11 +320,7 @@ func (sub CosmosSub) Replay(symbolTranslator *symbol_translator.SymbolTranslator
 	log.Printf("clientChainID is %d \n", clientChainID)
 
 	// Load the validator's ethereum address
-	mySender, err := txs.LoadSender()
-	if err != nil {
-		log.Println(err)
-		return
-	}
+	mySender := sub.Signer.Address()
 
 	ProphecyClaims := GetAllProphecyClaim(ethClient, mySender, ethFromBlock, ethToBlock)
 
@@ -362,7 +370,9 @@ func (sub CosmosSub) Replay(symbolTranslator *symbol_translator.SymbolTranslator
 					log.Printf("found out a lock burn message%s\n", cosmosMsg.String())
 
 					if !MessageProcessed(cosmosMsg, ProphecyClaims) {
-						sub.handleBurnLockMsg(cosmosMsg, claimType)
+						if err := sub.handleBurnLockMsg(cosmosMsg, claimType); err != nil {
+							log.Println(err)
+						}
 					} else {
 						log.Println("lock burn message already processed by me")
 					}
@@ -393,7 +403,7 @@ func tryInitRelayConfig(sub CosmosSub, claimType types.Event) (*ethclient.Client
 			sub.EthProvider,
 			sub.RegistryContractAddress,
 			claimType,
-			sub.PrivateKey,
+			sub.Signer,
 			sub.SugaredLogger,
 		)
 
@@ -412,7 +422,7 @@ func tryInitRelayConfig(sub CosmosSub, claimType types.Event) (*ethclient.Client
 func (sub CosmosSub) handleBurnLockMsg(
 	cosmosMsg types.CosmosMsg,
 	claimType types.Event,
-) {
+) error {
 	sub.SugaredLogger.Infow("handle burn lock message.",
 		"cosmosMessage", cosmosMsg.String())
 
@@ -425,7 +435,8 @@ func (sub CosmosSub) handleBurnLockMsg(
 	if err != nil {
 		sub.SugaredLogger.Errorw("failed in init relay config.",
 			errorMessageKey, err.Error())
-		return
+		metrics.ClaimSubmissionFailures.WithLabelValues(metrics.ChainCosmos, claimType.String()).Inc()
+		return err
 	}
 
 	// Initialize CosmosBridge instance
@@ -433,7 +444,8 @@ func (sub CosmosSub) handleBurnLockMsg(
 	if err != nil {
 		sub.SugaredLogger.Errorw("failed to get cosmosBridge instance.",
 			errorMessageKey, err.Error())
-		return
+		metrics.ClaimSubmissionFailures.WithLabelValues(metrics.ChainCosmos, claimType.String()).Inc()
+		return err
 	}
 
 	maxRetries := 5
@@ -453,7 +465,9 @@ func (sub CosmosSub) handleBurnLockMsg(
 				"failed to send new prophecyclaim to ethereum",
 				errorMessageKey, err.Error(),
 			)
+			metrics.ClaimSubmissionFailures.WithLabelValues(metrics.ChainCosmos, claimType.String()).Inc()
 		} else {
+			metrics.EventsRelayed.WithLabelValues(metrics.ChainCosmos, claimType.String()).Inc()
 			break
 		}
 		i++
@@ -464,5 +478,7 @@ func (sub CosmosSub) handleBurnLockMsg(
 		if err != nil {
 			sub.SugaredLogger.Errorw("error for failed broadcast is", errorMessageKey, err)
 		}
+		return err
 	}
+	return nil
 }
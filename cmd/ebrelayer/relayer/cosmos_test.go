@@ -1,13 +1,13 @@
 package relayer
 
 import (
-	"crypto/ecdsa"
 	"log"
 	"math/big"
 	"testing"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -30,9 +30,9 @@ func TestNewCosmosSub(t *testing.T) {
 
 	sugaredLogger := logger.Sugar()
 	registryContractAddress := common.HexToAddress(contractAddress)
-	var key *ecdsa.PrivateKey // this isn't actually used
-	sub := NewCosmosSub(tmProvider, ethProvider, registryContractAddress,
-		key, db, sugaredLogger)
+	var signer txs.Signer // this isn't actually used
+	sub := NewCosmosSub(client.Context{}, tmProvider, ethProvider, registryContractAddress,
+		"", signer, db, sugaredLogger)
 	require.NotEqual(t, sub, nil)
 }
 
@@ -65,3 +65,31 @@ func TestMyDecode(t *testing.T) {
 	_, err := MyDecode(wrongData)
 	require.Error(t, err)
 }
+
+func TestLastOutboundNonce(t *testing.T) {
+	db, err := leveldb.OpenFile(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+	sub := NewCosmosSub(client.Context{}, tmProvider, ethProvider, common.HexToAddress(contractAddress),
+		"", nil, db, zap.NewNop().Sugar())
+
+	require.Equal(t, uint64(0), sub.getLastOutboundNonce())
+	sub.setLastOutboundNonce(300)
+	require.Equal(t, uint64(300), sub.getLastOutboundNonce())
+}
+
+func TestFailedOutboundNonces(t *testing.T) {
+	db, err := leveldb.OpenFile(t.TempDir(), nil)
+	require.NoError(t, err)
+	defer db.Close()
+	sub := NewCosmosSub(client.Context{}, tmProvider, ethProvider, common.HexToAddress(contractAddress),
+		"", nil, db, zap.NewNop().Sugar())
+
+	require.Empty(t, sub.getFailedOutboundNonces())
+	sub.addFailedOutboundNonce(300)
+	sub.addFailedOutboundNonce(2)
+	sub.setLastOutboundNonce(300)
+	require.Equal(t, []uint64{2, 300}, sub.getFailedOutboundNonces())
+	sub.removeFailedOutboundNonce(2)
+	require.Equal(t, []uint64{300}, sub.getFailedOutboundNonces())
+}
@@ -31,6 +31,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/contract"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/metrics"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
 	ethbridge "github.com/Sifchain/sifnode/x/ethbridge/types"
@@ -38,8 +39,10 @@ import (
 
 const (
 	transactionInterval = 10 * time.Second
-	trailingBlocks      = 50
 	ethLevelDBKey       = "ethereumLastProcessedBlock"
+
+	// DefaultEthereumConfirmations is how many blocks an Ethereum block must be buried under before its events are relayed
+	DefaultEthereumConfirmations = 50
 )
 
 // EthereumSub is an Ethereum listener that can relay txs to Cosmos and Ethereum
@@ -49,6 +52,7 @@ type EthereumSub struct {
 	RegistryContractAddress common.Address
 	ValidatorName           string
 	ValidatorAddress        sdk.ValAddress
+	Confirmations           uint64
 	CliCtx                  client.Context
 	PrivateKey              *ecdsa.PrivateKey
 	DB                      *leveldb.DB
@@ -75,6 +79,7 @@ func NewEthereumSub(
 	ethProvider string,
 	registryContractAddress common.Address,
 	validatorAddress sdk.ValAddress,
+	confirmations uint64,
 	db *leveldb.DB,
 	sugaredLogger *zap.SugaredLogger,
 ) EthereumSub {
@@ -85,6 +90,7 @@ func NewEthereumSub(
 		RegistryContractAddress: registryContractAddress,
 		ValidatorName:           validatorMoniker,
 		ValidatorAddress:        validatorAddress,
+		Confirmations:           confirmations,
 		CliCtx:                  cliCtx,
 		DB:                      db,
 		SugaredLogger:           sugaredLogger,
@@ -100,6 +106,7 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 		sub.SugaredLogger.Errorw("SetupWebsocketEthClient failed.",
 			errorMessageKey, err.Error())
 
+		metrics.WebsocketReconnects.WithLabelValues(metrics.ChainEthereum).Inc()
 		completionEvent.Add(1)
 		go sub.Start(txFactory, completionEvent, symbolTranslator)
 		return
@@ -112,6 +119,7 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 	if err != nil {
 		sub.SugaredLogger.Errorw("failed to get network ID.",
 			errorMessageKey, err.Error())
+		metrics.WebsocketReconnects.WithLabelValues(metrics.ChainEthereum).Inc()
 		completionEvent.Add(1)
 		go sub.Start(txFactory, completionEvent, symbolTranslator)
 		return
@@ -140,15 +148,13 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 	}
 	defer subHead.Unsubscribe()
 
-	var lastProcessedBlock *big.Int
-
-	data, err := sub.DB.Get([]byte(ethLevelDBKey), nil)
+	store := newEthereumStore(sub.DB)
+	lastProcessedBlock, err := store.LastProcessedBlock()
 	if err != nil {
 		sub.SugaredLogger.Errorw("failed to get the last ethereum block from level db.",
 			errorMessageKey, err.Error())
-		lastProcessedBlock = big.NewInt(0)
 	} else {
-		lastProcessedBlock = new(big.Int).SetBytes(data)
+		metrics.LastProcessedBlock.WithLabelValues(metrics.ChainEthereum).Set(float64(lastProcessedBlock.Int64()))
 	}
 
 	for {
@@ -159,6 +165,7 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 		case err := <-subHead.Err():
 			sub.SugaredLogger.Errorw("failed to subscribe ethereum header.",
 				errorMessageKey, err.Error())
+			metrics.WebsocketReconnects.WithLabelValues(metrics.ChainEthereum).Inc()
 			completionEvent.Add(1)
 			go sub.Start(txFactory, completionEvent, symbolTranslator)
 			return
@@ -167,10 +174,33 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 				"ethereum block number", newHead.Number,
 				"ethereum block hash", newHead.Hash())
 
-			startingBigInt := newHead.Number
-			endingBlock := startingBigInt.Sub(startingBigInt, big.NewInt(trailingBlocks))
+			// Blocks already processed may have been replaced by a reorg deeper than the confirmation depth
+			resumeFrom, reorged, err := store.FindReorgStart(func(blockNumber uint64) (common.Hash, error) {
+				header, err := ethClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+				if err != nil {
+					return common.Hash{}, err
+				}
+				return header.Hash(), nil
+			})
+			if err != nil {
+				sub.SugaredLogger.Errorw("failed to check processed blocks for a reorg.",
+					errorMessageKey, err.Error())
+				continue
+			}
+			if reorged {
+				sub.SugaredLogger.Errorw("ethereum reorg replaced processed blocks, reprocessing them.",
+					"resumeFromBlock", resumeFrom,
+					"lastProcessedBlock", lastProcessedBlock)
+				metrics.EthereumReorgs.Inc()
+				if err := store.ResetBlockHashesFrom(resumeFrom); err != nil {
+					log.Fatalf("Error saving lastProcessedBlock to leveldb: %v", err)
+				}
+				lastProcessedBlock = new(big.Int).SetUint64(resumeFrom)
+			}
+
+			endingBlock := new(big.Int).Sub(newHead.Number, new(big.Int).SetUint64(sub.Confirmations))
 
-			// if the current block number - trailing blocks is negative, don't bother
+			// if the current block number - confirmations is negative, don't bother
 			// going deeper into the function.
 			if endingBlock.Cmp(big.NewInt(0)) == -1 {
 				sub.SugaredLogger.Infow("Ending block index negative. Cancelling run.")
@@ -182,6 +212,9 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 			if lastProcessedBlock.Cmp(big.NewInt(0)) == 0 {
 				lastProcessedBlock = endingBlock
 			}
+			if lastProcessedBlock.Cmp(endingBlock) == 1 {
+				continue
+			}
 
 			sub.SugaredLogger.Infow("Processing events from blocks.",
 				"lastProcessedBlock", lastProcessedBlock,
@@ -202,16 +235,38 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 				// the current last processed block so we keep retrying
 				continue
 			}
+			// the hash of the ending block lets the next run detect a reorg of this range
+			endingHeader, err := ethClient.HeaderByNumber(context.Background(), endingBlock)
+			if err != nil {
+				sub.SugaredLogger.Errorw("failed to get the ending block header.",
+					errorMessageKey, err.Error(),
+					"block number", endingBlock)
+				continue
+			}
 			// Assumption here is that we will repeat a failing block because we return if there is an error retrieving logs
 			sub.SugaredLogger.Infow("received events from bridgebank.",
 				"lastProcessedBlock", lastProcessedBlock,
 				"endingBlock", endingBlock)
 
 			var events []types.EthereumEvent
+			var nonces []*big.Int
+			var processedLogs []ctypes.Log
 
 			// loop over ethlogs, and build an array of burn/lock events
 			for _, ethLog := range ethLogs {
 				log.Printf("Processed events from block %v", ethLog.BlockNumber)
+				// FilterLogs only returns the logs of the canonical chain, reorgs of blocks that were already
+				// processed are found by FindReorgStart. A node may still flag a log as removed, never relay it
+				if ethLog.Removed {
+					sub.handleRemovedLog(store, ethLog)
+					continue
+				}
+				if store.IsLogProcessed(ethLog) {
+					sub.SugaredLogger.Infow("log already processed, continue events.",
+						"blockHash", ethLog.BlockHash, "logIndex", ethLog.Index)
+					continue
+				}
+				processedLogs = append(processedLogs, ethLog)
 				event, isBurnLock, err := sub.logToEvent(clientChainID, bridgeBankAddress, bridgeBankContractABI, ethLog)
 				if err != nil {
 					sub.SugaredLogger.Errorw("failed to transform from log to event.",
@@ -222,29 +277,65 @@ func (sub EthereumSub) Start(txFactory tx.Factory, completionEvent *sync.WaitGro
 					sub.SugaredLogger.Infow("not burn or lock event, continue events.")
 					continue
 				}
+				// the same event may come back in another block after a reorg, its claim was already submitted
+				if store.IsNonceSubmitted(bridgeBankAddress, event.Nonce) {
+					sub.SugaredLogger.Infow("claim already submitted for event, continue events.",
+						"nonce", event.Nonce)
+					continue
+				}
 				events = append(events, event)
+				nonces = append(nonces, event.Nonce)
 			}
 
 			if len(events) > 0 {
-				if err := sub.handleEthereumEvent(txFactory, events, symbolTranslator); err != nil {
+				// the claims are recorded before they are broadcast so a restart never submits them twice
+				var broadcastErr error
+				err := store.SubmitNonces(bridgeBankAddress, endingBlock.Uint64(), nonces, func() error {
+					broadcastErr = sub.handleEthereumEvent(txFactory, events, symbolTranslator)
+					return broadcastErr
+				})
+				if broadcastErr != nil {
 					sub.SugaredLogger.Errorw("failed to handle ethereum event.",
-						errorMessageKey, err.Error())
+						errorMessageKey, broadcastErr.Error())
+				}
+				if err != nil && err != broadcastErr {
+					// if you can't write to leveldb, then error out as something is seriously amiss
+					log.Fatalf("Error saving submitted nonces to leveldb: %v", err)
 				}
 				time.Sleep(transactionInterval)
 			}
-			// add 1 to the current block so we don't reprocess it
-			endingBlock = endingBlock.Add(endingBlock, big.NewInt(1))
-			// save the current ending block + 1 to the lastprocessed block to ensure we keep reading blocks sequentially and don't repeat blocks
-			err = sub.DB.Put([]byte(ethLevelDBKey), endingBlock.Bytes(), nil)
+			// save the processed logs and the current ending block + 1 as the next block to process,
+			// to ensure we keep reading blocks sequentially and don't repeat blocks
+			err = store.SaveProcessedRange(endingBlock.Uint64(), endingHeader.Hash(), processedLogs)
 			if err != nil {
 				// if you can't write to leveldb, then error out as something is seriously amiss
 				log.Fatalf("Error saving lastProcessedBlock to leveldb: %v", err)
 			}
-			lastProcessedBlock = endingBlock
+			// add 1 to the current block so we don't reprocess it
+			lastProcessedBlock = new(big.Int).Add(endingBlock, big.NewInt(1))
+			metrics.LastProcessedBlock.WithLabelValues(metrics.ChainEthereum).Set(float64(lastProcessedBlock.Int64()))
 		}
 	}
 }
 
+// handleRemovedLog handles a log the node reports as removed by a reorg. A claim may already
+// have been submitted for it, which the relayer cannot take back
+func (sub EthereumSub) handleRemovedLog(store ethereumStore, ethLog ctypes.Log) {
+	if !store.IsLogProcessed(ethLog) {
+		sub.SugaredLogger.Infow("ignoring removed log that was never processed.",
+			"blockHash", ethLog.BlockHash, "logIndex", ethLog.Index)
+		return
+	}
+	sub.SugaredLogger.Errorw("reorg removed an already processed log.",
+		"blockHash", ethLog.BlockHash,
+		"logIndex", ethLog.Index,
+		"txHash", ethLog.TxHash)
+	if err := store.RemoveProcessedLog(ethLog); err != nil {
+		sub.SugaredLogger.Errorw("failed to remove processed log from level db.",
+			errorMessageKey, err.Error())
+	}
+}
+
 func (sub EthereumSub) getAllClaims(fromBlock int64, toBlock int64) []types.EthereumBridgeClaim {
 	log.Printf("Replay get all ethereum bridge claim from block %d to block %d\n", fromBlock, toBlock)
 
@@ -404,6 +495,7 @@ func (sub EthereumSub) logToEvent(clientChainID *big.Int, contractAddress common
 	}
 	sub.SugaredLogger.Infow("receive an event.",
 		"event", event)
+	metrics.EventsSeen.WithLabelValues(metrics.ChainEthereum, eventName).Inc()
 
 	// Add the event to the record
 	types.NewEventWrite(cLog.TxHash.Hex(), event)
@@ -421,6 +513,7 @@ func GetValAddressFromKeyring(k keyring.Keyring, keyname string) (sdk.ValAddress
 // handleEthereumEvent unpacks an Ethereum event, converts it to a ProphecyClaim, and relays a tx to Cosmos
 func (sub EthereumSub) handleEthereumEvent(txFactory tx.Factory, events []types.EthereumEvent, symbolTranslator *symbol_translator.SymbolTranslator) error {
 	var prophecyClaims []*ethbridge.EthBridgeClaim
+	var eventNames []string
 	valAddr, err := GetValAddressFromKeyring(txFactory.Keybase(), sub.ValidatorName)
 	if err != nil {
 		return err
@@ -432,6 +525,7 @@ func (sub EthereumSub) handleEthereumEvent(txFactory tx.Factory, events []types.
 				errorMessageKey, err.Error())
 		} else {
 			prophecyClaims = append(prophecyClaims, &prophecyClaim)
+			eventNames = append(eventNames, ethereumEventName(event))
 		}
 	}
 	sub.SugaredLogger.Infow("relay prophecy claims to cosmos.",
@@ -441,5 +535,21 @@ func (sub EthereumSub) handleEthereumEvent(txFactory tx.Factory, events []types.
 		return nil
 	}
 
-	return txs.RelayToCosmos(txFactory, prophecyClaims, sub.CliCtx, sub.SugaredLogger)
+	err = txs.RelayToCosmos(txFactory, prophecyClaims, sub.CliCtx, sub.SugaredLogger)
+	for _, eventName := range eventNames {
+		if err != nil {
+			metrics.ClaimSubmissionFailures.WithLabelValues(metrics.ChainEthereum, eventName).Inc()
+		} else {
+			metrics.EventsRelayed.WithLabelValues(metrics.ChainEthereum, eventName).Inc()
+		}
+	}
+	return err
+}
+
+// ethereumEventName returns the name of the bridgebank event an Ethereum event was unpacked from
+func ethereumEventName(event types.EthereumEvent) string {
+	if event.ClaimType == ethbridge.ClaimType_CLAIM_TYPE_BURN {
+		return types.LogBurn.String()
+	}
+	return types.LogLock.String()
 }
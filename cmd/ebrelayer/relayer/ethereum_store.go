@@ -0,0 +1,214 @@
+package relayer
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ctypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var (
+	// ethBlockHashPrefix maps a processed block number to the hash it had when it was processed
+	ethBlockHashPrefix = []byte("ethereumBlockHash/")
+	// ethProcessedLogPrefix marks a (block number, block hash, log index) triple as processed
+	ethProcessedLogPrefix = []byte("ethereumProcessedLog/")
+	// ethSubmittedNoncePrefix marks the bridgebank nonce of an event whose claim was submitted
+	ethSubmittedNoncePrefix = []byte("ethereumSubmittedNonce/")
+	// ethSubmittedNonceByBlockPrefix indexes submitted nonces by the block they were submitted at, for pruning
+	ethSubmittedNonceByBlockPrefix = []byte("ethereumSubmittedNonceByBlock/")
+)
+
+// finalizedBlockDepth is how far behind the last processed block a block is considered final. Reorgs are
+// only looked for above it, so the block hashes, processed logs and submitted nonces below it are pruned
+const finalizedBlockDepth = 1000
+
+// ethereumStore persists the Ethereum processing state of the relayer in leveldb
+type ethereumStore struct {
+	db *leveldb.DB
+}
+
+func newEthereumStore(db *leveldb.DB) ethereumStore {
+	return ethereumStore{db: db}
+}
+
+func getBlockHashKey(blockNumber uint64) []byte {
+	return append(append([]byte{}, ethBlockHashPrefix...), uint64ToBigEndian(blockNumber)...)
+}
+
+func getProcessedLogKey(blockNumber uint64, blockHash common.Hash, logIndex uint) []byte {
+	key := append(append([]byte{}, ethProcessedLogPrefix...), uint64ToBigEndian(blockNumber)...)
+	key = append(key, blockHash.Bytes()...)
+	return append(key, uint64ToBigEndian(uint64(logIndex))...)
+}
+
+func getNonceSuffix(bridgeBank common.Address, nonce *big.Int) []byte {
+	return append(append([]byte{}, bridgeBank.Bytes()...), common.LeftPadBytes(nonce.Bytes(), 32)...)
+}
+
+func getSubmittedNonceKey(bridgeBank common.Address, nonce *big.Int) []byte {
+	return append(append([]byte{}, ethSubmittedNoncePrefix...), getNonceSuffix(bridgeBank, nonce)...)
+}
+
+func getSubmittedNonceByBlockKey(blockNumber uint64, bridgeBank common.Address, nonce *big.Int) []byte {
+	key := append(append([]byte{}, ethSubmittedNonceByBlockPrefix...), uint64ToBigEndian(blockNumber)...)
+	return append(key, getNonceSuffix(bridgeBank, nonce)...)
+}
+
+func uint64ToBigEndian(i uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, i)
+	return b
+}
+
+// LastProcessedBlock returns the next block to process, 0 if the relayer never processed a block
+func (s ethereumStore) LastProcessedBlock() (*big.Int, error) {
+	data, err := s.db.Get([]byte(ethLevelDBKey), nil)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// IsLogProcessed returns true if the log was processed
+func (s ethereumStore) IsLogProcessed(ethLog ctypes.Log) bool {
+	has, err := s.db.Has(getProcessedLogKey(ethLog.BlockNumber, ethLog.BlockHash, ethLog.Index), nil)
+	return err == nil && has
+}
+
+// IsNonceSubmitted returns true if a claim was submitted for the bridgebank event with nonce
+func (s ethereumStore) IsNonceSubmitted(bridgeBank common.Address, nonce *big.Int) bool {
+	has, err := s.db.Has(getSubmittedNonceKey(bridgeBank, nonce), nil)
+	return err == nil && has
+}
+
+// MarkNoncesSubmitted records that claims for the given bridgebank nonces were broadcast at blockNumber
+func (s ethereumStore) MarkNoncesSubmitted(bridgeBank common.Address, blockNumber uint64, nonces []*big.Int) error {
+	batch := new(leveldb.Batch)
+	for _, nonce := range nonces {
+		batch.Put(getSubmittedNonceKey(bridgeBank, nonce), []byte{})
+		batch.Put(getSubmittedNonceByBlockKey(blockNumber, bridgeBank, nonce), []byte{})
+	}
+	return s.db.Write(batch, nil)
+}
+
+// UnmarkNoncesSubmitted removes the record of claims for the given bridgebank nonces submitted at blockNumber
+func (s ethereumStore) UnmarkNoncesSubmitted(bridgeBank common.Address, blockNumber uint64, nonces []*big.Int) error {
+	batch := new(leveldb.Batch)
+	for _, nonce := range nonces {
+		batch.Delete(getSubmittedNonceKey(bridgeBank, nonce))
+		batch.Delete(getSubmittedNonceByBlockKey(blockNumber, bridgeBank, nonce))
+	}
+	return s.db.Write(batch, nil)
+}
+
+// SubmitNonces records the claims for nonces as submitted before calling broadcast, so a crash after the
+// broadcast never submits them twice on restart, and removes the record again if broadcast fails so they
+// are retried. A crash between the record and the broadcast drops the claims instead, Replay recovers them
+func (s ethereumStore) SubmitNonces(bridgeBank common.Address, blockNumber uint64, nonces []*big.Int, broadcast func() error) error {
+	if err := s.MarkNoncesSubmitted(bridgeBank, blockNumber, nonces); err != nil {
+		return err
+	}
+	if broadcastErr := broadcast(); broadcastErr != nil {
+		if err := s.UnmarkNoncesSubmitted(bridgeBank, blockNumber, nonces); err != nil {
+			return err
+		}
+		return broadcastErr
+	}
+	return nil
+}
+
+// RemoveProcessedLog forgets that the log was processed
+func (s ethereumStore) RemoveProcessedLog(ethLog ctypes.Log) error {
+	return s.db.Delete(getProcessedLogKey(ethLog.BlockNumber, ethLog.BlockHash, ethLog.Index), nil)
+}
+
+// SaveProcessedRange atomically records the processed logs of a block range, the hash of its last
+// block and the next block to process, pruning what was recorded for blocks that are now final
+func (s ethereumStore) SaveProcessedRange(endingBlock uint64, endingBlockHash common.Hash, processedLogs []ctypes.Log) error {
+	batch := new(leveldb.Batch)
+	for _, ethLog := range processedLogs {
+		batch.Put(getProcessedLogKey(ethLog.BlockNumber, ethLog.BlockHash, ethLog.Index), []byte{})
+	}
+	batch.Put(getBlockHashKey(endingBlock), endingBlockHash.Bytes())
+	if endingBlock > finalizedBlockDepth {
+		finalizedBlock := endingBlock - finalizedBlockDepth
+		s.pruneBelow(batch, ethBlockHashPrefix, finalizedBlock, nil)
+		s.pruneBelow(batch, ethProcessedLogPrefix, finalizedBlock, nil)
+		s.pruneBelow(batch, ethSubmittedNonceByBlockPrefix, finalizedBlock, func(key []byte) {
+			nonceSuffix := key[len(ethSubmittedNonceByBlockPrefix)+8:]
+			batch.Delete(append(append([]byte{}, ethSubmittedNoncePrefix...), nonceSuffix...))
+		})
+	}
+	batch.Put([]byte(ethLevelDBKey), new(big.Int).SetUint64(endingBlock+1).Bytes())
+	return s.db.Write(batch, nil)
+}
+
+// pruneBelow deletes the keys under prefix that start with a block number below blockNumber,
+// calling onDelete for each of them
+func (s ethereumStore) pruneBelow(batch *leveldb.Batch, prefix []byte, blockNumber uint64, onDelete func(key []byte)) {
+	iterator := s.db.NewIterator(&util.Range{
+		Start: append(append([]byte{}, prefix...), uint64ToBigEndian(0)...),
+		Limit: append(append([]byte{}, prefix...), uint64ToBigEndian(blockNumber)...),
+	}, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		key := append([]byte{}, iterator.Key()...)
+		batch.Delete(key)
+		if onDelete != nil {
+			onDelete(key)
+		}
+	}
+}
+
+// FindReorgStart compares the stored block hashes, most recent first, with the hashes returned by
+// canonicalHash. It returns true and the block to resume processing from if a reorg replaced blocks
+// the relayer already processed. Processing resumes after the last stored block that is still canonical,
+// or from the first block that is not final if none is, since a stored block only ends a processed range
+func (s ethereumStore) FindReorgStart(canonicalHash func(blockNumber uint64) (common.Hash, error)) (uint64, bool, error) {
+	iterator := s.db.NewIterator(util.BytesPrefix(ethBlockHashPrefix), nil)
+	defer iterator.Release()
+	reorged := false
+	for ok := iterator.Last(); ok; ok = iterator.Prev() {
+		blockNumber := binary.BigEndian.Uint64(iterator.Key()[len(ethBlockHashPrefix):])
+		hash, err := canonicalHash(blockNumber)
+		if err != nil {
+			return 0, false, err
+		}
+		if hash == common.BytesToHash(iterator.Value()) {
+			return blockNumber + 1, reorged, nil
+		}
+		reorged = true
+	}
+	if err := iterator.Error(); err != nil || !reorged {
+		return 0, false, err
+	}
+	lastProcessedBlock, err := s.LastProcessedBlock()
+	if err != nil {
+		return 0, false, err
+	}
+	// the hashes below the last finalized block are pruned, no reorg reaches them
+	resumeFrom := uint64(1)
+	if endingBlock := lastProcessedBlock.Uint64() - 1; endingBlock > finalizedBlockDepth+1 {
+		resumeFrom = endingBlock - finalizedBlockDepth
+	}
+	return resumeFrom, true, nil
+}
+
+// ResetBlockHashesFrom drops the stored block hashes from blockNumber on after a reorg replaced them,
+// and makes blockNumber the next block to process
+func (s ethereumStore) ResetBlockHashesFrom(blockNumber uint64) error {
+	batch := new(leveldb.Batch)
+	iterator := s.db.NewIterator(&util.Range{
+		Start: getBlockHashKey(blockNumber),
+		Limit: util.BytesPrefix(ethBlockHashPrefix).Limit,
+	}, nil)
+	for iterator.Next() {
+		batch.Delete(append([]byte{}, iterator.Key()...))
+	}
+	iterator.Release()
+	batch.Put([]byte(ethLevelDBKey), new(big.Int).SetUint64(blockNumber).Bytes())
+	return s.db.Write(batch, nil)
+}
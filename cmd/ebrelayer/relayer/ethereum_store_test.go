@@ -0,0 +1,166 @@
+package relayer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ctypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func newTestEthereumStore(t *testing.T) ethereumStore {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return newEthereumStore(db)
+}
+
+func TestEthereumStore_ProcessedLogsAndNonces(t *testing.T) {
+	store := newTestEthereumStore(t)
+	bridgeBank := common.HexToAddress("0xd88159878c50e4B2b03BB701DD436e4A98D6fBe2")
+	blockHash := common.HexToHash("0x01")
+
+	lastProcessedBlock, err := store.LastProcessedBlock()
+	require.Error(t, err)
+	require.Equal(t, int64(0), lastProcessedBlock.Int64())
+
+	processedLogs := []ctypes.Log{
+		{BlockNumber: 10, BlockHash: blockHash, Index: 0},
+		{BlockNumber: 10, BlockHash: blockHash, Index: 2},
+	}
+	require.NoError(t, store.SaveProcessedRange(10, blockHash, processedLogs))
+	lastProcessedBlock, err = store.LastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), lastProcessedBlock.Int64())
+	require.True(t, store.IsLogProcessed(ctypes.Log{BlockNumber: 10, BlockHash: blockHash, Index: 2}))
+	require.False(t, store.IsLogProcessed(ctypes.Log{BlockNumber: 10, BlockHash: blockHash, Index: 1}))
+	require.False(t, store.IsLogProcessed(ctypes.Log{BlockNumber: 10, BlockHash: common.HexToHash("0x02"), Index: 2}))
+
+	require.NoError(t, store.MarkNoncesSubmitted(bridgeBank, 10, []*big.Int{big.NewInt(1), big.NewInt(2)}))
+	require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(2)))
+	require.False(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(3)))
+}
+
+func TestEthereumStore_FindReorgStart(t *testing.T) {
+	store := newTestEthereumStore(t)
+	canonical := map[uint64]common.Hash{
+		10: common.HexToHash("0x0a"),
+		20: common.HexToHash("0x14"),
+		30: common.HexToHash("0x1e"),
+	}
+	canonicalHash := func(blockNumber uint64) (common.Hash, error) {
+		return canonical[blockNumber], nil
+	}
+	for _, blockNumber := range []uint64{10, 20, 30} {
+		require.NoError(t, store.SaveProcessedRange(blockNumber, canonical[blockNumber], nil))
+	}
+
+	resumeFrom, reorged, err := store.FindReorgStart(canonicalHash)
+	require.NoError(t, err)
+	require.False(t, reorged)
+	require.Equal(t, uint64(31), resumeFrom)
+
+	// a reorg replaces blocks 20 and 30, processing resumes after the last block still canonical
+	canonical[20] = common.HexToHash("0xff")
+	canonical[30] = common.HexToHash("0xfe")
+	resumeFrom, reorged, err = store.FindReorgStart(canonicalHash)
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(11), resumeFrom)
+
+	require.NoError(t, store.ResetBlockHashesFrom(resumeFrom))
+	lastProcessedBlock, err := store.LastProcessedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), lastProcessedBlock.Int64())
+	resumeFrom, reorged, err = store.FindReorgStart(canonicalHash)
+	require.NoError(t, err)
+	require.False(t, reorged)
+	require.Equal(t, uint64(11), resumeFrom)
+
+	// a reorg replaces every stored block, the range ending at block 10 is reprocessed from its start
+	canonical[10] = common.HexToHash("0xfd")
+	resumeFrom, reorged, err = store.FindReorgStart(canonicalHash)
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(1), resumeFrom)
+}
+
+func TestEthereumStore_FindReorgStartBelowStoredBlocks(t *testing.T) {
+	store := newTestEthereumStore(t)
+	endingBlocks := []uint64{1500, 2500, 3000}
+	for _, blockNumber := range endingBlocks {
+		require.NoError(t, store.SaveProcessedRange(blockNumber, common.HexToHash("0x01"), nil))
+	}
+
+	// every stored block was replaced, processing resumes from the first block that is not final rather
+	// than from the end of the oldest stored range
+	resumeFrom, reorged, err := store.FindReorgStart(func(blockNumber uint64) (common.Hash, error) {
+		return common.HexToHash("0x02"), nil
+	})
+	require.NoError(t, err)
+	require.True(t, reorged)
+	require.Equal(t, uint64(3000-finalizedBlockDepth), resumeFrom)
+}
+
+func TestEthereumStore_PrunesFinalizedBlocks(t *testing.T) {
+	store := newTestEthereumStore(t)
+	bridgeBank := common.HexToAddress("0xd88159878c50e4B2b03BB701DD436e4A98D6fBe2")
+	oldLog := ctypes.Log{BlockNumber: 10, BlockHash: common.HexToHash("0x0a"), Index: 1}
+	recentLog := ctypes.Log{BlockNumber: 20, BlockHash: common.HexToHash("0x14"), Index: 1}
+	require.NoError(t, store.SaveProcessedRange(10, common.HexToHash("0x0a"), []ctypes.Log{oldLog}))
+	require.NoError(t, store.MarkNoncesSubmitted(bridgeBank, 10, []*big.Int{big.NewInt(1)}))
+	require.NoError(t, store.SaveProcessedRange(20, common.HexToHash("0x14"), []ctypes.Log{recentLog}))
+	require.NoError(t, store.MarkNoncesSubmitted(bridgeBank, 20, []*big.Int{big.NewInt(2)}))
+	require.NoError(t, store.SaveProcessedRange(10+finalizedBlockDepth+1, common.HexToHash("0x0b"), nil))
+
+	require.False(t, store.IsLogProcessed(oldLog))
+	require.False(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(1)))
+	require.True(t, store.IsLogProcessed(recentLog))
+	require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(2)))
+
+	checked := 0
+	_, _, err := store.FindReorgStart(func(blockNumber uint64) (common.Hash, error) {
+		checked++
+		return common.Hash{}, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, checked)
+}
+
+func TestEthereumStore_SubmitNonces(t *testing.T) {
+	store := newTestEthereumStore(t)
+	bridgeBank := common.HexToAddress("0xd88159878c50e4B2b03BB701DD436e4A98D6fBe2")
+	nonces := []*big.Int{big.NewInt(1), big.NewInt(2)}
+
+	// the nonces are already recorded while the claims are broadcast
+	err := store.SubmitNonces(bridgeBank, 10, nonces, func() error {
+		require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(1)))
+		require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(2)))
+		return nil
+	})
+	require.NoError(t, err)
+	require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(1)))
+
+	// a failed broadcast removes the record so the claims are retried
+	broadcastErr := errors.New("broadcast failed")
+	err = store.SubmitNonces(bridgeBank, 11, []*big.Int{big.NewInt(3)}, func() error {
+		return broadcastErr
+	})
+	require.ErrorIs(t, err, broadcastErr)
+	require.False(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(3)))
+	require.True(t, store.IsNonceSubmitted(bridgeBank, big.NewInt(2)))
+}
+
+func TestEthereumStore_RemoveProcessedLog(t *testing.T) {
+	store := newTestEthereumStore(t)
+	ethLog := ctypes.Log{BlockNumber: 10, BlockHash: common.HexToHash("0x0a"), Index: 1}
+	require.NoError(t, store.SaveProcessedRange(10, ethLog.BlockHash, []ctypes.Log{ethLog}))
+	require.True(t, store.IsLogProcessed(ethLog))
+
+	require.NoError(t, store.RemoveProcessedLog(ethLog))
+	require.False(t, store.IsLogProcessed(ethLog))
+}
@@ -0,0 +1,192 @@
+package relayer
+
+// DONTCOVER
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math/big"
+
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/internal/symbol_translator"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
+	ethbridge "github.com/Sifchain/sifnode/x/ethbridge/types"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	tmClient "github.com/tendermint/tendermint/rpc/client/http"
+)
+
+const (
+	cosmosOutboundNonceLevelDBKey = "cosmosLastOutboundNonce"
+	outboundTransferQueryPath     = "/sifnode.ethbridge.v1.Query/GetOutboundTransfer"
+	maxConfirmRetries             = 5
+)
+
+// cosmosFailedOutboundNoncePrefix marks the nonces of outbound transfers that failed to relay
+var cosmosFailedOutboundNoncePrefix = []byte("cosmosFailedOutboundNonce/")
+
+// relayOutboundTransfer relays a lock or burn to Ethereum. Outbound transfers are numbered from a single
+// sequence on Sifchain, so when the nonce of the event skips ahead of the last relayed nonce the transfers
+// in between were missed and are queried from Sifchain and relayed first. Transfers that fail to relay are
+// recorded and retried with the next outbound transfer.
+func (sub CosmosSub) relayOutboundTransfer(txFactory tx.Factory, client *tmClient.HTTP, cosmosMsg types.CosmosMsg,
+	claimType types.Event, symbolTranslator *symbol_translator.SymbolTranslator) {
+	// Events emitted before outbound transfers were recorded carry no nonce
+	if cosmosMsg.OutboundNonce == 0 {
+		_ = sub.relayAndConfirm(txFactory, cosmosMsg, claimType)
+		return
+	}
+
+	lastNonce := sub.getLastOutboundNonce()
+	if cosmosMsg.OutboundNonce <= lastNonce {
+		sub.SugaredLogger.Infow("outbound transfer already relayed.",
+			"outboundNonce", cosmosMsg.OutboundNonce, "lastOutboundNonce", lastNonce)
+		return
+	}
+
+	for _, nonce := range sub.getFailedOutboundNonces() {
+		if sub.relayOutboundTransferByNonce(txFactory, client, nonce, symbolTranslator) {
+			sub.removeFailedOutboundNonce(nonce)
+		}
+	}
+
+	// Just start from the current nonce if never relayed any outbound transfer before
+	for nonce := lastNonce + 1; lastNonce != 0 && nonce < cosmosMsg.OutboundNonce; nonce++ {
+		if !sub.relayOutboundTransferByNonce(txFactory, client, nonce, symbolTranslator) {
+			sub.addFailedOutboundNonce(nonce)
+		}
+	}
+
+	if err := sub.relayAndConfirm(txFactory, cosmosMsg, claimType); err != nil {
+		sub.addFailedOutboundNonce(cosmosMsg.OutboundNonce)
+	}
+	sub.setLastOutboundNonce(cosmosMsg.OutboundNonce)
+}
+
+// relayOutboundTransferByNonce queries a missed or failed outbound transfer from Sifchain and relays it
+// if it is still pending. It returns false if the transfer could not be relayed and should be retried
+func (sub CosmosSub) relayOutboundTransferByNonce(txFactory tx.Factory, client *tmClient.HTTP, nonce uint64,
+	symbolTranslator *symbol_translator.SymbolTranslator) bool {
+	transfer, found, err := queryOutboundTransfer(client, nonce)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to query outbound transfer.",
+			errorMessageKey, err.Error(), "outboundNonce", nonce)
+		return false
+	}
+	if !found || transfer.Status == ethbridge.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED {
+		// completed transfers were relayed by the other validators, and are pruned from Sifchain later on
+		return true
+	}
+	missedMsg, err := txs.OutboundTransferToCosmosMsg(transfer, symbolTranslator)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to get message from outbound transfer.",
+			errorMessageKey, err.Error(), "outboundNonce", nonce)
+		return false
+	}
+	sub.SugaredLogger.Infow("relaying missed outbound transfer.", "msg", missedMsg)
+	return sub.relayAndConfirm(txFactory, missedMsg, missedMsg.ClaimType) == nil
+}
+
+// relayAndConfirm relays a lock or burn to Ethereum and confirms its outbound transfer on Sifchain. It only
+// returns an error if the relay to Ethereum failed, failing to confirm is logged
+func (sub CosmosSub) relayAndConfirm(txFactory tx.Factory, cosmosMsg types.CosmosMsg, claimType types.Event) error {
+	if err := sub.handleBurnLockMsg(cosmosMsg, claimType); err != nil {
+		return err
+	}
+	if cosmosMsg.OutboundNonce == 0 {
+		return nil
+	}
+
+	valAddr, err := GetValAddressFromKeyring(txFactory.Keybase(), sub.ValidatorName)
+	if err != nil {
+		sub.SugaredLogger.Errorw("failed to get validator address from keyring.",
+			errorMessageKey, err.Error())
+		return nil
+	}
+	for i := 0; i < maxConfirmRetries; i++ {
+		err = txs.ConfirmOutboundTransfer(txFactory, valAddr, cosmosMsg.OutboundNonce, sub.CliCtx, sub.SugaredLogger)
+		if err == nil {
+			return nil
+		}
+	}
+	sub.SugaredLogger.Errorw("failed to confirm outbound transfer.",
+		errorMessageKey, err.Error(), "outboundNonce", cosmosMsg.OutboundNonce)
+	return nil
+}
+
+// queryOutboundTransfer queries the outbound transfer with the given nonce from Sifchain
+func queryOutboundTransfer(client *tmClient.HTTP, nonce uint64) (ethbridge.OutboundTransfer, bool, error) {
+	req := ethbridge.QueryOutboundTransferRequest{Nonce: nonce}
+	bz, err := req.Marshal()
+	if err != nil {
+		return ethbridge.OutboundTransfer{}, false, err
+	}
+	result, err := client.ABCIQuery(context.Background(), outboundTransferQueryPath, bz)
+	if err != nil {
+		return ethbridge.OutboundTransfer{}, false, err
+	}
+	if !result.Response.IsOK() {
+		if result.Response.Codespace == ethbridge.ModuleName &&
+			result.Response.Code == ethbridge.ErrOutboundTransferNotFound.ABCICode() {
+			return ethbridge.OutboundTransfer{}, false, nil
+		}
+		return ethbridge.OutboundTransfer{}, false, errors.New(result.Response.Log)
+	}
+	var res ethbridge.QueryOutboundTransferResponse
+	if err := res.Unmarshal(result.Response.Value); err != nil {
+		return ethbridge.OutboundTransfer{}, false, err
+	}
+	if res.Transfer == nil {
+		return ethbridge.OutboundTransfer{}, false, nil
+	}
+	return *res.Transfer, true, nil
+}
+
+// getLastOutboundNonce returns the nonce of the last outbound transfer relayed, 0 if there is none yet
+func (sub CosmosSub) getLastOutboundNonce() uint64 {
+	data, err := sub.DB.Get([]byte(cosmosOutboundNonceLevelDBKey), nil)
+	if err != nil {
+		return 0
+	}
+	return new(big.Int).SetBytes(data).Uint64()
+}
+
+func (sub CosmosSub) setLastOutboundNonce(nonce uint64) {
+	err := sub.DB.Put([]byte(cosmosOutboundNonceLevelDBKey), new(big.Int).SetUint64(nonce).Bytes(), nil)
+	if err != nil {
+		// if you can't write to leveldb, then error out as something is seriously amiss
+		log.Fatalf("Error saving lastOutboundNonce to leveldb: %v", err)
+	}
+}
+
+func getFailedOutboundNonceKey(nonce uint64) []byte {
+	return append(append([]byte{}, cosmosFailedOutboundNoncePrefix...), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// getFailedOutboundNonces returns the nonces of the outbound transfers that failed to relay, in order
+func (sub CosmosSub) getFailedOutboundNonces() []uint64 {
+	var nonces []uint64
+	iterator := sub.DB.NewIterator(util.BytesPrefix(cosmosFailedOutboundNoncePrefix), nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		nonces = append(nonces, sdk.BigEndianToUint64(iterator.Key()[len(cosmosFailedOutboundNoncePrefix):]))
+	}
+	return nonces
+}
+
+func (sub CosmosSub) addFailedOutboundNonce(nonce uint64) {
+	sub.SugaredLogger.Errorw("failed to relay outbound transfer, retrying with the next one.", "outboundNonce", nonce)
+	if err := sub.DB.Put(getFailedOutboundNonceKey(nonce), []byte{}, nil); err != nil {
+		// if you can't write to leveldb, then error out as something is seriously amiss
+		log.Fatalf("Error saving failed outbound nonce to leveldb: %v", err)
+	}
+}
+
+func (sub CosmosSub) removeFailedOutboundNonce(nonce uint64) {
+	if err := sub.DB.Delete(getFailedOutboundNonceKey(nonce), nil); err != nil {
+		// if you can't write to leveldb, then error out as something is seriously amiss
+		log.Fatalf("Error removing failed outbound nonce from leveldb: %v", err)
+	}
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"github.com/Sifchain/sifnode/cmd/ebrelayer/txs"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"log"
@@ -41,26 +40,30 @@ func RunReplayEthereumCmd(cmd *cobra.Command, args []string) error {
 		return errors.Errorf("invalid [validator-moniker]: %s", args[2])
 	}
 	validatorMoniker := args[3]
-	//mnemonic := args[4]
+	blockArgs := args[4:]
+	if len(args) == 9 {
+		log.Println("ignoring the deprecated [validatorMnemonic] argument, keys are loaded from --keyring-backend")
+		blockArgs = args[5:]
+	}
 
-	fromBlock, err := strconv.ParseInt(args[5], 10, 64)
+	fromBlock, err := strconv.ParseInt(blockArgs[0], 10, 64)
 	if err != nil {
-		return errors.Errorf("invalid [from-block]: %s", args[5])
+		return errors.Errorf("invalid [from-block]: %s", blockArgs[0])
 	}
 
-	toBlock, err := strconv.ParseInt(args[6], 10, 64)
+	toBlock, err := strconv.ParseInt(blockArgs[1], 10, 64)
 	if err != nil {
-		return errors.Errorf("invalid [to-block]: %s", args[6])
+		return errors.Errorf("invalid [to-block]: %s", blockArgs[1])
 	}
 
-	cosmosFromBlock, err := strconv.ParseInt(args[7], 10, 64)
+	cosmosFromBlock, err := strconv.ParseInt(blockArgs[2], 10, 64)
 	if err != nil {
-		return errors.Errorf("invalid [from-block]: %s", args[7])
+		return errors.Errorf("invalid [from-block]: %s", blockArgs[2])
 	}
 
-	cosmosToBlock, err := strconv.ParseInt(args[8], 10, 64)
+	cosmosToBlock, err := strconv.ParseInt(blockArgs[3], 10, 64)
 	if err != nil {
-		return errors.Errorf("invalid [to-block]: %s", args[8])
+		return errors.Errorf("invalid [to-block]: %s", blockArgs[3])
 	}
 
 	logger, err := zap.NewProduction()
@@ -75,7 +78,7 @@ func RunReplayEthereumCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	ethSub := relayer.NewEthereumSub(cliContext, tendermintNode, validatorMoniker, web3Provider,
-		contractAddress, nil, nil, sugaredLogger)
+		contractAddress, nil, relayer.DefaultEthereumConfirmations, nil, sugaredLogger)
 
 	txFactory := tx.NewFactoryCLI(cliContext, cmd.Flags())
 	ethSub.Replay(txFactory, fromBlock, toBlock, cosmosFromBlock, cosmosToBlock, symbolTranslator)
@@ -132,13 +135,13 @@ func RunReplayCosmosCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	key, err := txs.LoadPrivateKey()
+	signer, err := buildEthereumSigner(cmd)
 	if err != nil {
-		log.Fatalf("failed to load ETHEREUM_PRIVATE_KEY")
+		log.Fatalf("failed to load ethereum signer: %s", err.Error())
 	}
 
 	// Initialize new Cosmos event listener
-	cosmosSub := relayer.NewCosmosSub(tendermintNode, web3Provider, contractAddress, key, nil, sugaredLogger)
+	cosmosSub := relayer.NewCosmosSub(client.Context{}, tendermintNode, web3Provider, contractAddress, "", signer, nil, sugaredLogger)
 
 	cosmosSub.Replay(symbolTranslator, fromBlock, toBlock, ethFromBlock, ethToBlock)
 
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 	"log"
 	"math/big"
+	"strconv"
 	"strings"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
@@ -87,6 +88,7 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 	var ethereumReceiver common.Address
 	var symbol string
 	var amount sdk.Int
+	var outboundNonce uint64
 
 	attributeNumber := 0
 
@@ -120,18 +122,12 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 			ethereumReceiver = common.HexToAddress(val)
 		case types.Symbol.String():
 			attributeNumber++
-			switch claimType {
-			case types.MsgLock:
-				symbol = symbolTranslator.SifchainToEthereum(val)
-			case types.MsgBurn:
-				if !strings.Contains(val, defaultSifchainPrefix) {
-					// log.Printf("Can only relay burns of '%v' prefixed coins", defaultSifchainPrefix)
-					sugaredLogger.Errorw("only relay burns prefixed coins", "coin symbol", val)
-					return types.CosmosMsg{}, errors.New("can only relay burns of '%v' prefixed coins" + defaultSifchainPrefix)
-				}
-				res := strings.SplitAfter(val, defaultSifchainPrefix)
-				symbol = strings.Join(res[1:], "")
+			tempSymbol, err := cosmosSymbolToEthereum(claimType, val, symbolTranslator)
+			if err != nil {
+				sugaredLogger.Errorw("only relay burns prefixed coins", "coin symbol", val)
+				return types.CosmosMsg{}, err
 			}
+			symbol = tempSymbol
 		case types.Amount.String():
 			attributeNumber++
 			tempAmount, ok := sdk.NewIntFromString(val)
@@ -142,6 +138,13 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 				return types.CosmosMsg{}, errors.New("invalid amount:" + val)
 			}
 			amount = tempAmount
+		case types.OutboundNonce.String():
+			tempNonce, err := strconv.ParseUint(val, 10, 64)
+			if err != nil {
+				sugaredLogger.Errorw("Invalid outbound nonce", "outbound nonce", val)
+				return types.CosmosMsg{}, errors.New("invalid outbound nonce: " + val)
+			}
+			outboundNonce = tempNonce
 		}
 	}
 
@@ -158,7 +161,45 @@ func BurnLockEventToCosmosMsg(claimType types.Event, attributes []abci.EventAttr
 		sugaredLogger.Errorw("message not complete", "attributeNumber", attributeNumber)
 		return types.CosmosMsg{}, errors.New("message not complete")
 	}
-	return types.NewCosmosMsg(claimType, cosmosSender, cosmosSenderSequence, ethereumReceiver, symbol, amount), nil
+	cosmosMsg := types.NewCosmosMsg(claimType, cosmosSender, cosmosSenderSequence, ethereumReceiver, symbol, amount)
+	cosmosMsg.OutboundNonce = outboundNonce
+	return cosmosMsg, nil
+}
+
+// OutboundTransferToCosmosMsg packages an outbound transfer queried from Sifchain into a CosmosMsg struct
+func OutboundTransferToCosmosMsg(transfer ethbridge.OutboundTransfer, symbolTranslator *symbol_translator.SymbolTranslator) (types.CosmosMsg, error) {
+	var claimType types.Event
+	switch transfer.ClaimType {
+	case ethbridge.ClaimType_CLAIM_TYPE_LOCK:
+		claimType = types.MsgLock
+	case ethbridge.ClaimType_CLAIM_TYPE_BURN:
+		claimType = types.MsgBurn
+	default:
+		return types.CosmosMsg{}, errors.New("invalid claim type: " + transfer.ClaimType.String())
+	}
+	if !common.IsHexAddress(transfer.EthereumReceiver) {
+		return types.CosmosMsg{}, errors.New("invalid recipient address: " + transfer.EthereumReceiver)
+	}
+	symbol, err := cosmosSymbolToEthereum(claimType, transfer.Symbol, symbolTranslator)
+	if err != nil {
+		return types.CosmosMsg{}, err
+	}
+	cosmosMsg := types.NewCosmosMsg(claimType, []byte(transfer.CosmosSender), new(big.Int).SetUint64(transfer.CosmosSenderSequence),
+		common.HexToAddress(transfer.EthereumReceiver), symbol, transfer.Amount)
+	cosmosMsg.OutboundNonce = transfer.Nonce
+	return cosmosMsg, nil
+}
+
+// cosmosSymbolToEthereum returns the ethereum symbol of a coin locked or burned on Sifchain
+func cosmosSymbolToEthereum(claimType types.Event, symbol string, symbolTranslator *symbol_translator.SymbolTranslator) (string, error) {
+	if claimType == types.MsgLock {
+		return symbolTranslator.SifchainToEthereum(symbol), nil
+	}
+	if !strings.Contains(symbol, defaultSifchainPrefix) {
+		return "", errors.New("can only relay burns of '%v' prefixed coins" + defaultSifchainPrefix)
+	}
+	res := strings.SplitAfter(symbol, defaultSifchainPrefix)
+	return strings.Join(res[1:], ""), nil
 }
 
 // AttributesToEthereumBridgeClaim parses data from event to EthereumBridgeClaim
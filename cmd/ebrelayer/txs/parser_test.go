@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"go.uber.org/zap"
 
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
@@ -91,6 +92,43 @@ func TestFailedLockEventToCosmosMsg(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestOutboundNonceEventToCosmosMsg(t *testing.T) {
+	expectedMsgBurn := CreateTestCosmosMsg(t, types.MsgBurn)
+	expectedMsgBurn.OutboundNonce = 7
+
+	cosmosMsgAttributes := CreateCosmosMsgAttributes(t, types.MsgBurn)
+	cosmosMsgAttributes = append(cosmosMsgAttributes, abci.EventAttribute{
+		Key:   []byte(types.OutboundNonce.String()),
+		Value: []byte("7"),
+	})
+	msgBurn, err := BurnLockEventToCosmosMsg(types.MsgBurn, cosmosMsgAttributes, symbol_translator.NewSymbolTranslator(), sugaredLogger)
+
+	require.NoError(t, err)
+	require.Equal(t, expectedMsgBurn, msgBurn)
+}
+
+func TestOutboundTransferToCosmosMsg(t *testing.T) {
+	expectedMsgBurn := CreateTestCosmosMsg(t, types.MsgBurn)
+	expectedMsgBurn.OutboundNonce = 7
+
+	transfer := ethbridge.OutboundTransfer{
+		Nonce:                7,
+		ClaimType:            ethbridge.ClaimType_CLAIM_TYPE_BURN,
+		CosmosSender:         TestCosmosAddress1,
+		EthereumReceiver:     TestEthereumAddress1,
+		Symbol:               strings.ToLower(TestSymbol),
+		Amount:               testSDKAmount,
+		CosmosSenderSequence: TestCosmosAddressSequence,
+	}
+	msgBurn, err := OutboundTransferToCosmosMsg(transfer, symbol_translator.NewSymbolTranslator())
+	require.NoError(t, err)
+	require.Equal(t, expectedMsgBurn, msgBurn)
+
+	transfer.ClaimType = ethbridge.ClaimType_CLAIM_TYPE_UNSPECIFIED
+	_, err = OutboundTransferToCosmosMsg(transfer, symbol_translator.NewSymbolTranslator())
+	require.Error(t, err)
+}
+
 func TestIsZeroAddress(t *testing.T) {
 	falseRes := isZeroAddress(common.HexToAddress(TestOtherAddress))
 	require.False(t, falseRes)
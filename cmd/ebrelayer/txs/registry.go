@@ -39,13 +39,6 @@ func (d ContractRegistry) String() string {
 // GetAddressFromBridgeRegistry queries the requested contract address from the BridgeRegistry contract
 func GetAddressFromBridgeRegistry(client *ethclient.Client, registry common.Address, target ContractRegistry,
 	sugaredLogger *zap.SugaredLogger) (common.Address, error) {
-	sender, err := LoadSender()
-	if err != nil {
-		// log.Println(err)
-		sugaredLogger.Errorw("failed to get sender", errorMessageKey, err.Error())
-		return common.Address{}, err
-	}
-
 	header, err := client.HeaderByNumber(context.Background(), nil)
 	if err != nil {
 		// log.Println(err)
@@ -57,7 +50,6 @@ func GetAddressFromBridgeRegistry(client *ethclient.Client, registry common.Addr
 	// Set up CallOpts auth
 	auth := bind.CallOpts{
 		Pending:     true,
-		From:        sender,
 		BlockNumber: header.Number,
 		Context:     context.Background(),
 	}
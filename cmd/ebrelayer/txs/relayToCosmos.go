@@ -71,3 +71,30 @@ func RelayToCosmos(factory tx.Factory, claims []*types.EthBridgeClaim, cliCtx cl
 
 	return nil
 }
+
+// ConfirmOutboundTransfer signs and broadcasts the validator's confirmation that the outbound transfer
+// with the given nonce has been relayed to Ethereum
+func ConfirmOutboundTransfer(factory tx.Factory, valAddr sdk.ValAddress, nonce uint64, cliCtx client.Context, sugaredLogger *zap.SugaredLogger) error {
+	msg := types.NewMsgConfirmOutboundTransfer(valAddr, nonce)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	sugaredLogger.Infow("ConfirmOutboundTransfer building, signing, and broadcasting", "message", msg)
+	err := tx.BroadcastTx(
+		cliCtx,
+		factory.
+			WithGas(1000000000000000000).
+			WithFees("500000000000000000rowan"),
+		&msg,
+	)
+	if err != nil {
+		sugaredLogger.Errorw(
+			"failed to broadcast outbound transfer confirmation to sifchain.",
+			errorMessageKey, err.Error(),
+		)
+		return err
+	}
+
+	return nil
+}
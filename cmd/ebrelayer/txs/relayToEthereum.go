@@ -4,12 +4,12 @@ package txs
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"errors"
 	"math/big"
 	"time"
 
 	cosmosbridge "github.com/Sifchain/sifnode/cmd/ebrelayer/contract/generated/bindings/cosmosbridge"
+	"github.com/Sifchain/sifnode/cmd/ebrelayer/metrics"
 	"github.com/Sifchain/sifnode/cmd/ebrelayer/types"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -92,6 +92,9 @@ func RelayProphecyClaimToEthereum(
 		"Successfully received transaction receipt after retry",
 		"txReceipt", receipt,
 	)
+	metrics.EthereumGasUsed.Add(float64(receipt.GasUsed))
+	gasSpent, _ := new(big.Float).SetInt(new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), tx.GasPrice())).Float64()
+	metrics.EthereumGasSpentWei.Add(gasSpent)
 
 	return nil
 }
@@ -101,7 +104,7 @@ func InitRelayConfig(
 	provider string,
 	registry common.Address,
 	event types.Event,
-	key *ecdsa.PrivateKey,
+	signer Signer,
 	sugaredLogger *zap.SugaredLogger,
 ) (
 	*ethclient.Client,
@@ -118,12 +121,7 @@ func InitRelayConfig(
 	}
 
 	// Load the validator's address
-	sender, err := LoadSender()
-	if err != nil {
-		sugaredLogger.Errorw("failed to load validator address.",
-			errorMessageKey, err.Error())
-		return nil, nil, common.Address{}, err
-	}
+	sender := signer.Address()
 
 	nonce, err := client.PendingNonceAt(context.Background(), sender)
 	sugaredLogger.Infow("Current eth operator at pending nonce.", "pendingNonce", nonce)
@@ -148,12 +146,7 @@ func InitRelayConfig(
 		return nil, nil, common.Address{}, err
 	}
 
-	transactOptsAuth, err := bind.NewKeyedTransactorWithChainID(key, chainID)
-	if err != nil {
-		sugaredLogger.Errorw("New Keystore Transaction failure",
-			errorMessageKey, err.Error())
-		return nil, nil, common.Address{}, err
-	}
+	transactOptsAuth := NewTransactOpts(signer, chainID)
 
 	sugaredLogger.Infow("ethereum tx current nonce from client api.",
 		"nonce", nonce,
@@ -0,0 +1,183 @@
+package txs
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ctypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignerBackendEnv loads the key from the ETHEREUM_PRIVATE_KEY environment variable
+	SignerBackendEnv = "env"
+	// SignerBackendKeystore loads the key from a go-ethereum encrypted keystore file
+	SignerBackendKeystore = "keystore"
+	// SignerBackendKeyring loads the key from a Cosmos SDK keyring
+	SignerBackendKeyring = "keyring"
+	// SignerBackendRemote sends hashes to a remote signer over HTTP
+	SignerBackendRemote = "remote"
+
+	remoteSignerTimeout = 10 * time.Second
+)
+
+// Signer signs on behalf of the relayer's Ethereum account without exposing how its key is kept
+type Signer interface {
+	// Address returns the Ethereum address of the signing account
+	Address() common.Address
+	// SignHash signs a 32 byte hash and returns the 65 byte [R || S || V] signature
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// NewTransactOpts returns transaction options that sign transactions for the given chain with signer
+func NewTransactOpts(signer Signer, chainID *big.Int) *bind.TransactOpts {
+	txSigner := ctypes.LatestSignerForChainID(chainID)
+	return &bind.TransactOpts{
+		From: signer.Address(),
+		Signer: func(address common.Address, tx *ctypes.Transaction) (*ctypes.Transaction, error) {
+			if address != signer.Address() {
+				return nil, bind.ErrNotAuthorized
+			}
+			signature, err := signer.SignHash(txSigner.Hash(tx).Bytes())
+			if err != nil {
+				return nil, err
+			}
+			return tx.WithSignature(txSigner, signature)
+		},
+	}
+}
+
+// privateKeySigner signs with a private key held in memory
+type privateKeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewPrivateKeySigner returns a Signer for a private key
+func NewPrivateKeySigner(key *ecdsa.PrivateKey) Signer {
+	return privateKeySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s privateKeySigner) Address() common.Address {
+	return s.address
+}
+
+func (s privateKeySigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
+
+// NewEnvSigner returns a Signer for the private key in the ETHEREUM_PRIVATE_KEY environment variable
+func NewEnvSigner() (Signer, error) {
+	key, err := LoadPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return NewPrivateKeySigner(key), nil
+}
+
+// NewKeystoreSigner decrypts a go-ethereum keystore JSON file with the passphrase in passwordFile
+func NewKeystoreSigner(keystoreFile, passwordFile string) (Signer, error) {
+	keyJSON, err := ioutil.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read keystore file")
+	}
+	password, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read keystore password file")
+	}
+	key, err := keystore.DecryptKey(keyJSON, strings.TrimRight(string(password), "\r\n"))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt keystore file")
+	}
+	return NewPrivateKeySigner(key.PrivateKey), nil
+}
+
+// NewKeyringSigner loads the secp256k1 key stored under keyName in a Cosmos SDK keyring,
+// typically opened with the file or os backend
+func NewKeyringSigner(kr keyring.Keyring, keyName string) (Signer, error) {
+	rawKey, err := keyring.NewUnsafe(kr).UnsafeExportPrivKeyHex(keyName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load key %s from keyring", keyName)
+	}
+	key, err := crypto.HexToECDSA(rawKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "key %s is not a secp256k1 key", keyName)
+	}
+	return NewPrivateKeySigner(key), nil
+}
+
+// remoteSigner asks a signing service to sign hashes, the key never reaches the relayer
+type remoteSigner struct {
+	url     string
+	address common.Address
+	client  *http.Client
+}
+
+// RemoteSignRequest is the body posted to a remote signer
+type RemoteSignRequest struct {
+	Address string `json:"address"`
+	Hash    string `json:"hash"`
+}
+
+// RemoteSignResponse is the body a remote signer answers with
+type RemoteSignResponse struct {
+	Signature string `json:"signature"`
+}
+
+// NewRemoteSigner returns a Signer that posts hashes to be signed by address to url
+func NewRemoteSigner(url string, address common.Address) Signer {
+	return remoteSigner{
+		url:     url,
+		address: address,
+		client:  &http.Client{Timeout: remoteSignerTimeout},
+	}
+}
+
+func (s remoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignHash posts the hash to the remote signer and checks that the signature recovers to its address
+func (s remoteSigner) SignHash(hash []byte) ([]byte, error) {
+	body, err := json.Marshal(RemoteSignRequest{Address: s.address.Hex(), Hash: hexutil.Encode(hash)})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "remote signer request failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("remote signer returned status %d", resp.StatusCode)
+	}
+	var signResponse RemoteSignResponse
+	if err := json.NewDecoder(resp.Body).Decode(&signResponse); err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer response")
+	}
+	signature, err := hexutil.Decode(signResponse.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer signature")
+	}
+	pubKey, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote signer signature")
+	}
+	if crypto.PubkeyToAddress(*pubKey) != s.address {
+		return nil, errors.New("remote signer signed with a different address")
+	}
+	return signature, nil
+}
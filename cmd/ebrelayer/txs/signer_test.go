@@ -0,0 +1,115 @@
+package txs
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkcrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ctypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// requireSignsAs checks that signatures made by signer recover to the test address
+func requireSignsAs(t *testing.T, signer Signer) {
+	require.Equal(t, common.HexToAddress(TestAddrHex), signer.Address())
+	hash := crypto.Keccak256([]byte("claim"))
+	signature, err := signer.SignHash(hash)
+	require.NoError(t, err)
+	pubKey, err := crypto.SigToPub(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestEnvSigner(t *testing.T) {
+	os.Setenv(EthereumPrivateKey, TestPrivHex)
+	signer, err := NewEnvSigner()
+	require.NoError(t, err)
+	requireSignsAs(t, signer)
+}
+
+func TestKeystoreSigner(t *testing.T) {
+	dir := t.TempDir()
+	key, err := crypto.HexToECDSA(TestPrivHex)
+	require.NoError(t, err)
+	account, err := keystore.NewKeyStore(dir, keystore.LightScryptN, keystore.LightScryptP).ImportECDSA(key, "secret")
+	require.NoError(t, err)
+	passwordFile := filepath.Join(dir, "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("secret\n"), 0600))
+
+	signer, err := NewKeystoreSigner(account.URL.Path, passwordFile)
+	require.NoError(t, err)
+	requireSignsAs(t, signer)
+
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("wrong"), 0600))
+	_, err = NewKeystoreSigner(account.URL.Path, passwordFile)
+	require.Error(t, err)
+}
+
+func TestKeyringSigner(t *testing.T) {
+	rawKey, err := hex.DecodeString(TestPrivHex)
+	require.NoError(t, err)
+	armor := sdkcrypto.EncryptArmorPrivKey(&secp256k1.PrivKey{Key: rawKey}, "secret", string(hd.Secp256k1Type))
+	kr := keyring.NewInMemory()
+	require.NoError(t, kr.ImportPrivKey("relayer", armor, "secret"))
+
+	signer, err := NewKeyringSigner(kr, "relayer")
+	require.NoError(t, err)
+	requireSignsAs(t, signer)
+
+	_, err = NewKeyringSigner(kr, "missing")
+	require.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, err := crypto.HexToECDSA(TestPrivHex)
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request RemoteSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		hash, err := hexutil.Decode(request.Hash)
+		require.NoError(t, err)
+		signature, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(RemoteSignResponse{Signature: hexutil.Encode(signature)}))
+	}))
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, common.HexToAddress(TestAddrHex))
+	requireSignsAs(t, signer)
+
+	// a signature that does not recover to the configured address is rejected
+	_, err = NewRemoteSigner(server.URL, common.HexToAddress(TestOtherAddress)).SignHash(crypto.Keccak256([]byte("claim")))
+	require.Error(t, err)
+}
+
+func TestNewTransactOpts(t *testing.T) {
+	key, err := crypto.HexToECDSA(TestPrivHex)
+	require.NoError(t, err)
+	signer := NewPrivateKeySigner(key)
+	chainID := big.NewInt(TestEthereumChainID)
+	opts := NewTransactOpts(signer, chainID)
+
+	tx := ctypes.NewTransaction(1, common.HexToAddress(TestOtherAddress), big.NewInt(0), GasLimit, big.NewInt(1), nil)
+	signedTx, err := opts.Signer(signer.Address(), tx)
+	require.NoError(t, err)
+	sender, err := ctypes.Sender(ctypes.LatestSignerForChainID(chainID), signedTx)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address(), sender)
+
+	_, err = opts.Signer(common.HexToAddress(TestOtherAddress), tx)
+	require.Error(t, err)
+}
@@ -36,6 +36,16 @@ const (
 const (
 	FlagSymbolTranslatorFile = "symbol-translator-file"
 	FlagRelayerDbPath        = "relayerdb-path"
+
+	FlagEthereumSigner          = "ethereum-signer"
+	FlagEthereumKeystoreFile    = "ethereum-keystore-file"
+	FlagEthereumPasswordFile    = "ethereum-password-file"
+	FlagEthereumKeyName         = "ethereum-key-name"
+	FlagEthereumRemoteSignerURL = "ethereum-remote-signer-url"
+	FlagEthereumAddress         = "ethereum-address"
+
+	FlagMetricsListenAddr     = "metrics-listen-addr"
+	FlagEthereumConfirmations = "ethereum-confirmations"
 )
 
 // String returns the event type as a string
@@ -121,6 +131,8 @@ type CosmosMsg struct {
 	Amount               sdk.Int
 	EthereumReceiver     common.Address
 	ClaimType            Event
+	// OutboundNonce is the nonce of the outbound transfer recorded on Sifchain, 0 if the event carried none
+	OutboundNonce uint64
 }
 
 // NewCosmosMsg creates a new CosmosMsg
@@ -168,11 +180,13 @@ const (
 	EthereumSender
 	// EthereumSenderNonce is ethereum sender nonce
 	EthereumSenderNonce
+	// OutboundNonce is the nonce of the outbound transfer on Sifchain
+	OutboundNonce
 )
 
 // String returns the event type as a string
 func (d CosmosMsgAttributeKey) String() string {
-	return [...]string{"unsupported", "cosmos_sender", "cosmos_sender_sequence", "ethereum_receiver", "amount", "symbol", "ethereum_sender", "ethereum_sender_nonce"}[d]
+	return [...]string{"unsupported", "cosmos_sender", "cosmos_sender_sequence", "ethereum_receiver", "amount", "symbol", "ethereum_sender", "ethereum_sender_nonce", "outbound_nonce"}[d]
 }
 
 // EthereumBridgeClaim for store the EventTypeCreateClaim from cosmos
@@ -84,10 +84,9 @@ def test_transfer_eth_to_ceth_using_replay_blocks(
     ews = test_utilities.get_required_env_var("ETHEREUM_WEBSOCKET_ADDRESS")
     bra = test_utilities.get_required_env_var("BRIDGE_REGISTRY_ADDRESS")
     mon = test_utilities.get_required_env_var("MONIKER")
-    mn = test_utilities.get_required_env_var("MNEMONIC")
     cn = test_utilities.get_required_env_var("CHAINNET")
     ending_block = test_utilities.current_ethereum_block_number(smart_contracts_dir) + 1
-    cmd = f"""yes | ebrelayer replayEthereum tcp://0.0.0.0:26657 {ews} {bra} {mon} '{mn}' {starting_block} {ending_block} 1 2 --chain-id {cn} --gas 5000000000000 \
+    cmd = f"""yes | ebrelayer replayEthereum tcp://0.0.0.0:26657 {ews} {bra} {mon} {starting_block} {ending_block} 1 2 --chain-id {cn} --gas 5000000000000 \
  --keyring-backend test --node tcp://0.0.0.0:26657 --from {mon}  --symbol-translator-file {integration_dir}/config/symbol_translator.json"""
     test_utilities.get_shell_output(cmd)
     time.sleep(5)
@@ -19,6 +19,8 @@ func EndBlocker(ctx sdk.Context, keeper kpr.Keeper) []abci.ValidatorUpdate {
 		keeper.ProviderDistributionPolicyRun(ctx)
 	}
 
+	keeper.ProcessLimitOrders(ctx)
+
 	params := keeper.GetRewardsParams(ctx)
 	pools := keeper.GetPools(ctx)
 	currentPeriod := keeper.GetCurrentRewardPeriod(ctx, params)
@@ -149,6 +151,7 @@ func BeginBlocker(ctx sdk.Context, k kpr.Keeper) {
 		ctx.Logger().Error(fmt.Sprintf("error in running policy | Error Message : %s ", err.Error()))
 	}
 
+	k.RecordPriceAccumulators(ctx)
 }
 
 var blockTime *time.Time
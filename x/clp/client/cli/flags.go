@@ -9,6 +9,9 @@ const (
 	FlagUnits                           = "units"
 	FlagSentAssetSymbol                 = "sentSymbol"
 	FlagReceivedAssetSymbol             = "receivedSymbol"
+	FlagSwapRoute                       = "route"
+	FlagTargetPrice                     = "targetPrice"
+	FlagExpiryHeight                    = "expiryHeight"
 	FlagNativeAssetAmount               = "nativeAmount"
 	FlagExternalAssetAmount             = "externalAmount"
 	FlagWBasisPoints                    = "wBasis"
@@ -37,6 +40,9 @@ const (
 	FlagLiquidityProtectionEpochLength  = "epochLength"
 	FlagCurrentRowanLiquidityThreshold  = "currentRowanLiquidityThreshold"
 	FlagLiquidityProtectionIsActive     = "isActive"
+	FlagTwapWindow                      = "twapWindow"
+	FlagStartHeight                     = "startHeight"
+	FlagEndHeight                       = "endHeight"
 	FlagProviderDistributionPeriods     = "path"
 	FlagSwapFeeParams                   = "path"
 )
@@ -52,6 +58,9 @@ var (
 	FsWithdrawUnits                   = flag.NewFlagSet("", flag.ContinueOnError)
 	FsSentAssetSymbol                 = flag.NewFlagSet("", flag.ContinueOnError)
 	FsReceivedAssetSymbol             = flag.NewFlagSet("", flag.ContinueOnError)
+	FsSwapRoute                       = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTargetPrice                     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsExpiryHeight                    = flag.NewFlagSet("", flag.ContinueOnError)
 	FsAmount                          = flag.NewFlagSet("", flag.ContinueOnError)
 	FsMinReceivingAmount              = flag.NewFlagSet("", flag.ContinueOnError)
 	FsLiquidityRemovalLockPeriod      = flag.NewFlagSet("", flag.ContinueOnError)
@@ -75,6 +84,9 @@ var (
 	FsLiquidityThresholdIsActive      = flag.NewFlagSet("", flag.ContinueOnError)
 	FsLiquidityProtectionEpochLength  = flag.NewFlagSet("", flag.ContinueOnError)
 	FsCurrentRowanLiquidityThreshold  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsTwapWindow                      = flag.NewFlagSet("", flag.ContinueOnError)
+	FsStartHeight                     = flag.NewFlagSet("", flag.ContinueOnError)
+	FsEndHeight                       = flag.NewFlagSet("", flag.ContinueOnError)
 	FsFlagProviderDistributionPeriods = flag.NewFlagSet("", flag.ContinueOnError)
 	FsFlagSwapFeeParams               = flag.NewFlagSet("", flag.ContinueOnError)
 )
@@ -90,6 +102,9 @@ func init() {
 	FsWithdrawUnits.String(FlagWithdrawUnits, "", "Withdraw Units ")
 	FsSentAssetSymbol.String(FlagSentAssetSymbol, "", "Symbol for Sent Asset")
 	FsReceivedAssetSymbol.String(FlagReceivedAssetSymbol, "", "Symbol for Received Asset")
+	FsTargetPrice.String(FlagTargetPrice, "", "Price of the sent asset, in the received asset, at which the order executes")
+	FsExpiryHeight.Int64(FlagExpiryHeight, 0, "Block height after which an unfilled order is refunded")
+	FsSwapRoute.StringSlice(FlagSwapRoute, []string{}, "Comma separated asset symbols to swap through, starting with the sent asset")
 	FsAmount.String(FlagAmount, "", "Sent amount")
 	FsMinReceivingAmount.String(FlagMinimumReceivingAmount, "", "Min threshold for receiving amount")
 	FsBlockRate.String(FlagBlockRate, "", "Flag to modify Block rate")
@@ -113,6 +128,9 @@ func init() {
 	FsLiquidityProtectionEpochLength.String(FlagLiquidityProtectionEpochLength, "", "Set liquidity protection epoch length")
 	FsLiquidityThresholdIsActive.String(FlagLiquidityProtectionIsActive, "", "Set liquidity protection isActive")
 	FsCurrentRowanLiquidityThreshold.String(FlagCurrentRowanLiquidityThreshold, "", "Set current rowan liquidity threshold value")
+	FsTwapWindow.Uint64(FlagTwapWindow, 0, "Number of blocks to average the native price over (0 to use the spot price)")
+	FsStartHeight.Int64(FlagStartHeight, 0, "First block height of the window")
+	FsEndHeight.Int64(FlagEndHeight, 0, "Block height the window ends at, exclusive (defaults to the current height)")
 	FsFlagProviderDistributionPeriods.String(FlagProviderDistributionPeriods, "", "Path to Json File containing LP provider distribution periods")
 	FsFlagSwapFeeParams.String(FlagProviderDistributionPeriods, "", "Path to Json File containing swap fee params")
 }
@@ -40,6 +40,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdProviderDistributionParams(queryRoute),
 		GetCmdSwapFeeParams(queryRoute),
 		GetCmdPoolShareEstimate(queryRoute),
+		GetCmdSwapEstimate(queryRoute),
+		GetCmdLimitOrdersByAddress(queryRoute),
+		GetCmdLimitOrdersByPool(queryRoute),
+		GetCmdTwap(queryRoute),
+		GetCmdRemovalQueue(queryRoute),
+		GetCmdRemovalRequestsByPool(queryRoute),
+		GetCmdRemovalRequestsByAddress(queryRoute),
+		GetCmdRemovalQueueUnits(queryRoute),
 	)
 	return clpQueryCmd
 }
@@ -449,3 +457,286 @@ func GetCmdPoolShareEstimate(queryRoute string) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdSwapEstimate(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap",
+		Short: "Estimate the result of a swap",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sentAsset := types.NewAsset(viper.GetString(FlagSentAssetSymbol))
+			receivedAsset := types.NewAsset(viper.GetString(FlagReceivedAssetSymbol))
+			sentAmount := viper.GetString(FlagAmount)
+
+			result, err := queryClient.GetSwapEstimate(context.Background(), &types.SwapEstimateReq{
+				SentAsset:     &sentAsset,
+				ReceivedAsset: &receivedAsset,
+				SentAmount:    sdk.NewUintFromString(sentAmount),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSentAssetSymbol)
+	cmd.Flags().AddFlagSet(FsReceivedAssetSymbol)
+	cmd.Flags().AddFlagSet(FsAmount)
+	if err := cmd.MarkFlagRequired(FlagSentAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagReceivedAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdLimitOrdersByAddress(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-address [address]",
+		Short: "Get the open limit orders placed by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			result, err := queryClient.GetLimitOrdersByAddress(context.Background(), &types.LimitOrdersByAddressReq{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-address")
+
+	return cmd
+}
+
+func GetCmdLimitOrdersByPool(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders-by-pool [symbol]",
+		Short: "Get the open limit orders for a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			result, err := queryClient.GetLimitOrdersByPool(context.Background(), &types.LimitOrdersByPoolReq{
+				Symbol:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "limit-orders-by-pool")
+
+	return cmd
+}
+
+func GetCmdTwap(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [symbol]",
+		Short: "Get the time weighted average prices of a pool over a block window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.GetTwap(context.Background(), &types.TwapReq{
+				Symbol:      args[0],
+				StartHeight: viper.GetInt64(FlagStartHeight),
+				EndHeight:   viper.GetInt64(FlagEndHeight),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsStartHeight)
+	cmd.Flags().AddFlagSet(FsEndHeight)
+	if err := cmd.MarkFlagRequired(FlagStartHeight); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRemovalQueue(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "removal-queue [symbol]",
+		Short: "Get the summary of the liquidity removal queue of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.GetRemovalQueue(context.Background(), &types.RemovalQueueReq{
+				Symbol: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdRemovalRequestsByPool(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "removal-requests-by-pool [symbol]",
+		Short: "Get the removal requests waiting in the removal queue of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			result, err := queryClient.GetRemovalRequestsByPool(context.Background(), &types.RemovalRequestsByPoolReq{
+				Symbol:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "removal-requests-by-pool")
+
+	return cmd
+}
+
+func GetCmdRemovalRequestsByAddress(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "removal-requests-by-address [address]",
+		Short: "Get the removal requests of an address waiting in the removal queues",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			result, err := queryClient.GetRemovalRequestsByAddress(context.Background(), &types.RemovalRequestsByAddressReq{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "removal-requests-by-address")
+
+	return cmd
+}
+
+func GetCmdRemovalQueueUnits(queryRoute string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "removal-queue-units [symbol] [lpAddress]",
+		Short: "Get the units of a liquidity provider still waiting in the removal queue of a pool",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.GetRemovalQueueUnits(context.Background(), &types.RemovalQueueUnitsReq{
+				Symbol:    args[0],
+				LpAddress: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"strconv"
 
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
@@ -35,6 +36,12 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRemoveLiquidity(),
 		GetCmdRemoveLiquidityUnits(),
 		GetCmdSwap(),
+		GetCmdSwapRoute(),
+		GetCmdPlaceLimitOrder(),
+		GetCmdCancelLimitOrder(),
+		GetCmdClaimRewards(),
+		GetCmdTransferLiquidity(),
+		GetCmdCancelRemovalRequest(),
 		GetCmdDecommissionPool(),
 		GetCmdUnlockLiquidity(),
 		GetCmdCancelUnlockLiquidity(),
@@ -602,6 +609,59 @@ func GetCmdSwap() *cobra.Command {
 	return cmd
 }
 
+func GetCmdSwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route",
+		Short: "Swap tokens through an explicit route of liquidity pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			symbols, err := cmd.Flags().GetStringSlice(FlagSwapRoute)
+			if err != nil {
+				return err
+			}
+			route := make([]*types.Asset, len(symbols))
+			for i, symbol := range symbols {
+				asset := types.NewAsset(symbol)
+				route[i] = &asset
+			}
+
+			sentAmount := viper.GetString(FlagAmount)
+			minReceivingAmount := viper.GetString(FlagMinimumReceivingAmount)
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgSwapRoute(signer, route, sdk.NewUintFromString(sentAmount), sdk.NewUintFromString(minReceivingAmount))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSwapRoute)
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsMinReceivingAmount)
+
+	if err := cmd.MarkFlagRequired(FlagSwapRoute); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagAmount); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagMinimumReceivingAmount); err != nil {
+		log.Println("MarkFlagRequired failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdUnlockLiquidity() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "unbond-liquidity",
@@ -698,6 +758,7 @@ func GetCmdUpdateLiquidityProtectionParams() *cobra.Command {
 				MaxRowanLiquidityThresholdAsset: viper.GetString(FlagMaxRowanLiquidityThresholdAsset),
 				EpochLength:                     viper.GetUint64(FlagLiquidityProtectionEpochLength),
 				IsActive:                        viper.GetBool(FlagLiquidityProtectionIsActive),
+				TwapWindow:                      viper.GetUint64(FlagTwapWindow),
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -709,6 +770,7 @@ func GetCmdUpdateLiquidityProtectionParams() *cobra.Command {
 	cmd.Flags().AddFlagSet(FsMaxRowanLiquidityThresholdAsset)
 	cmd.Flags().AddFlagSet(FsPmtpPeriodEpochLength)
 	cmd.Flags().AddFlagSet(FsLiquidityThresholdIsActive)
+	cmd.Flags().AddFlagSet(FsTwapWindow)
 	if err := cmd.MarkFlagRequired(FlagPmtpPeriodEpochLength); err != nil {
 		log.Println("MarkFlagRequired  failed: ", err.Error())
 	}
@@ -797,3 +859,185 @@ func GetCmdSetProviderDistributionPeriods() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdPlaceLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order",
+		Short: "Place a limit order that swaps once the swap pays at least the target price",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sentAsset := types.NewAsset(viper.GetString(FlagSentAssetSymbol))
+			receivedAsset := types.NewAsset(viper.GetString(FlagReceivedAssetSymbol))
+			sentAmount := viper.GetString(FlagAmount)
+			targetPrice, err := sdk.NewDecFromStr(viper.GetString(FlagTargetPrice))
+			if err != nil {
+				return err
+			}
+			expiryHeight, err := cmd.Flags().GetInt64(FlagExpiryHeight)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgPlaceLimitOrder(signer, sentAsset, receivedAsset, sdk.NewUintFromString(sentAmount), targetPrice, expiryHeight)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FsSentAssetSymbol)
+	cmd.Flags().AddFlagSet(FsReceivedAssetSymbol)
+	cmd.Flags().AddFlagSet(FsAmount)
+	cmd.Flags().AddFlagSet(FsTargetPrice)
+	cmd.Flags().AddFlagSet(FsExpiryHeight)
+
+	for _, flag := range []string{FlagSentAssetSymbol, FlagReceivedAssetSymbol, FlagAmount, FlagTargetPrice, FlagExpiryHeight} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			log.Println("MarkFlagRequired failed: ", err.Error())
+		}
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [id]",
+		Short: "Cancel an open limit order and refund the escrowed amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelLimitOrder(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Short: "Claim the rowan rewards accrued by your liquidity in a pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress(), externalAsset)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdTransferLiquidity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-liquidity [receiver]",
+		Short: "Transfer units of your liquidity in a pool, and the unbond requests they back, to another address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			units := sdk.NewUintFromString(viper.GetString(FlagUnits))
+			msg := types.NewMsgTransferLiquidity(clientCtx.GetFromAddress(), externalAsset, receiver, units)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	cmd.Flags().AddFlagSet(FsUnits)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+	if err := cmd.MarkFlagRequired(FlagUnits); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelRemovalRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-removal-request [id]",
+		Short: "Cancel one of your removal requests waiting in the removal queue of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			externalAsset := types.NewAsset(viper.GetString(FlagAssetSymbol))
+			msg := types.NewMsgCancelRemovalRequest(clientCtx.GetFromAddress(), externalAsset, id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FsAssetSymbol)
+	if err := cmd.MarkFlagRequired(FlagAssetSymbol); err != nil {
+		log.Println("MarkFlagRequired  failed: ", err.Error())
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
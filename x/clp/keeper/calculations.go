@@ -250,6 +250,56 @@ func CalcSwapResult(toRowan bool,
 	return y, percentFee
 }
 
+// CalcSwapSentAmount returns the least amount that has to be sent into the pool for CalcSwapResult to pay at least
+// y, and false if the pool cannot pay y at any price
+func CalcSwapSentAmount(toRowan bool,
+	X, y, Y sdk.Uint,
+	pmtpCurrentRunningRate, swapFeeRate sdk.Dec) (sdk.Uint, bool) {
+
+	if y.IsZero() {
+		return sdk.ZeroUint(), true
+	}
+	if X.IsZero() || Y.IsZero() {
+		return sdk.ZeroUint(), false
+	}
+
+	// undo the swap fee and pmtp adjustment to find the raw xyk output, then solve y = (x * Y) / (X + x) for x
+	var rawR, oneMinusFeeR big.Rat
+	swapFeeRateR := DecToRat(&swapFeeRate)
+	oneMinusFeeR.Sub(big.NewRat(1, 1), &swapFeeRateR)
+	if oneMinusFeeR.Sign() <= 0 {
+		return sdk.ZeroUint(), false
+	}
+	rawR.SetInt(y.BigInt())
+	rawR.Quo(&rawR, &oneMinusFeeR)
+	pmtpFac := calcPmtpFactor(pmtpCurrentRunningRate)
+	if toRowan {
+		rawR.Mul(&rawR, &pmtpFac)
+	} else {
+		rawR.Quo(&rawR, &pmtpFac)
+	}
+	var YR, XR, denominator, xR big.Rat
+	YR.SetInt(Y.BigInt())
+	XR.SetInt(X.BigInt())
+	denominator.Sub(&YR, &rawR)
+	if denominator.Sign() <= 0 {
+		return sdk.ZeroUint(), false
+	}
+	xR.Mul(&rawR, &XR)
+	xR.Quo(&xR, &denominator)
+	sentAmount := sdk.NewUintFromBigInt(RatIntQuo(&xR))
+
+	// CalcSwapResult rounds down, step up from the rounded down estimate until it pays y
+	for i := 0; i < 4; i++ {
+		received, _ := CalcSwapResult(toRowan, X, sentAmount, Y, pmtpCurrentRunningRate, swapFeeRate)
+		if received.GTE(y) {
+			return sentAmount, true
+		}
+		sentAmount = sentAmount.Add(sdk.OneUint())
+	}
+	return sdk.ZeroUint(), false
+}
+
 func calcRawXYK(x, X, Y *big.Int) big.Rat {
 	var numerator, denominator, xR, XR, YR, y big.Rat
 
@@ -542,6 +592,12 @@ func ConvWBasisPointsToUnits(total sdk.Uint, wbasis sdk.Int) sdk.Uint {
 	return total.Quo(sdk.NewUint(10000).Quo(wbasisUint))
 }
 
+// ConvWBasisPointsToExactUnits returns the units wbasis basis points of total stand for, rounded down
+func ConvWBasisPointsToExactUnits(total sdk.Uint, wbasis sdk.Int) sdk.Uint {
+	wbasisUint := sdk.NewUintFromString(wbasis.String())
+	return total.Mul(wbasisUint).QuoUint64(10000)
+}
+
 func CalculateWithdrawalRowanValue(
 	sentAmount sdk.Uint,
 	to types.Asset,
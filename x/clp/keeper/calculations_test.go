@@ -411,6 +411,38 @@ func getFirstArg(a *big.Int, b bool) *big.Int {
 	return a
 }
 
+func TestKeeper_CalcSwapSentAmount(t *testing.T) {
+	testcases := []struct {
+		name                   string
+		toRowan                bool
+		X, y, Y                sdk.Uint
+		pmtpCurrentRunningRate sdk.Dec
+		swapFeeRate            sdk.Dec
+		ok                     bool
+	}{
+		{"to rowan", true, sdk.NewUint(1000000), sdk.NewUint(1000), sdk.NewUint(2000000), sdk.ZeroDec(), sdk.NewDecWithPrec(3, 3), true},
+		{"from rowan with pmtp", false, sdk.NewUint(1000000), sdk.NewUint(12345), sdk.NewUint(2000000), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(3, 3), true},
+		{"deep pool", false, sdk.NewUintFromString("1000000000000000000000000"), sdk.NewUintFromString("1000000000000000000"), sdk.NewUintFromString("3000000000000000000000000"), sdk.ZeroDec(), sdk.NewDecWithPrec(1, 3), true},
+		{"nothing to receive", true, sdk.NewUint(1000000), sdk.ZeroUint(), sdk.NewUint(2000000), sdk.ZeroDec(), sdk.NewDecWithPrec(3, 3), true},
+		{"more than the pool holds", true, sdk.NewUint(1000000), sdk.NewUint(2000000), sdk.NewUint(2000000), sdk.ZeroDec(), sdk.NewDecWithPrec(3, 3), false},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			x, ok := clpkeeper.CalcSwapSentAmount(tc.toRowan, tc.X, tc.y, tc.Y, tc.pmtpCurrentRunningRate, tc.swapFeeRate)
+			require.Equal(t, tc.ok, ok)
+			if !ok || tc.y.IsZero() {
+				return
+			}
+			// x is the least amount that pays y
+			y, _ := clpkeeper.CalcSwapResult(tc.toRowan, tc.X, x, tc.Y, tc.pmtpCurrentRunningRate, tc.swapFeeRate)
+			require.True(t, y.GTE(tc.y), "%s < %s", y, tc.y)
+			y, _ = clpkeeper.CalcSwapResult(tc.toRowan, tc.X, x.Sub(sdk.OneUint()), tc.Y, tc.pmtpCurrentRunningRate, tc.swapFeeRate)
+			require.True(t, y.LT(tc.y), "%s >= %s", y, tc.y)
+		})
+	}
+}
+
 func TestKeeper_CalcDenomChangeMultiplier(t *testing.T) {
 	testcases := []struct {
 		name      string
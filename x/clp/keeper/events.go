@@ -23,13 +23,13 @@ func CreateEventBlockHeight(ctx sdk.Context, eventType string, attribute sdk.Att
 	)
 }
 
-func emitProcessedRemovalRequest(ctx sdk.Context, request *types.RemovalRequest, points sdk.Int, rowanRemoved sdk.Uint) {
+func emitProcessedRemovalRequest(ctx sdk.Context, request *types.RemovalRequest, units sdk.Uint, rowanRemoved sdk.Uint) {
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeProcessedRemovalQueue,
 		sdk.NewAttribute("id", strconv.FormatInt(request.Id, 10)),
 		sdk.NewAttribute(types.AttributeKeyLiquidityProvider, request.Msg.Signer),
 		sdk.NewAttribute(types.AttributeKeyPool, request.Msg.ExternalAsset.Symbol),
-		sdk.NewAttribute("points_requested", request.Msg.WBasisPoints.String()),
-		sdk.NewAttribute("points_processed", points.String()),
+		sdk.NewAttribute("units_left", request.Units.String()),
+		sdk.NewAttribute("units_processed", units.String()),
 		sdk.NewAttribute("value_in_rowan_processed", rowanRemoved.String()),
 	))
 }
@@ -41,6 +41,7 @@ func emitQueueRemoval(ctx sdk.Context, request *types.RemovalRequest, queue *typ
 		sdk.NewAttribute(types.AttributeKeyLiquidityProvider, request.Msg.Signer),
 		sdk.NewAttribute(types.AttributeKeyPool, request.Msg.ExternalAsset.Symbol),
 		sdk.NewAttribute("points_requested", request.Msg.WBasisPoints.String()),
+		sdk.NewAttribute("units_requested", request.Units.String()),
 		sdk.NewAttribute("asymmetry", request.Msg.Asymmetry.String()),
 	))
 }
@@ -60,5 +61,15 @@ func emitRemovalQueueError(ctx sdk.Context, request *types.RemovalRequest) {
 		sdk.NewAttribute(types.AttributeKeyLiquidityProvider, request.Msg.Signer),
 		sdk.NewAttribute(types.AttributeKeyPool, request.Msg.ExternalAsset.Symbol),
 		sdk.NewAttribute("points_requested", request.Msg.WBasisPoints.String()),
+		sdk.NewAttribute("units_requested", request.Units.String()),
 	))
 }
+
+func emitLimitOrder(ctx sdk.Context, eventType string, order *types.LimitOrder, attributes ...sdk.Attribute) {
+	attributes = append([]sdk.Attribute{
+		sdk.NewAttribute("id", strconv.FormatUint(order.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyLimitOrder, order.String()),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	}, attributes...)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+}
@@ -51,11 +51,15 @@ func (k Keeper) CreatePool(ctx sdk.Context, poolUints sdk.Uint, msg *types.MsgCr
 	return &pool, nil
 }
 
-func (k Keeper) CreateLiquidityProvider(ctx sdk.Context, asset *types.Asset, lpunits sdk.Uint, lpaddress sdk.AccAddress) types.LiquidityProvider {
+func (k Keeper) CreateLiquidityProvider(ctx sdk.Context, asset *types.Asset, lpunits sdk.Uint, lpaddress sdk.AccAddress) (types.LiquidityProvider, error) {
 	lp := types.NewLiquidityProvider(asset, lpunits, lpaddress)
+	err := k.MintPoolShares(ctx, asset.Symbol, lpaddress, lpunits)
+	if err != nil {
+		return lp, err
+	}
 	k.SetLiquidityProvider(ctx, &lp)
 
-	return lp
+	return lp, nil
 }
 
 func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool types.Pool, newPoolUnits sdk.Uint, lpUnits sdk.Uint) (*types.LiquidityProvider, error) {
@@ -105,7 +109,10 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 	// Create new Liquidity provider or add liquidity units
 	lp, err := k.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
 	if err != nil {
-		lp = k.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpUnits, addr)
+		lp, err = k.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpUnits, addr)
+		if err != nil {
+			return nil, err
+		}
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeCreateLiquidityProvider,
@@ -114,6 +121,18 @@ func (k Keeper) AddLiquidity(ctx sdk.Context, msg *types.MsgAddLiquidity, pool t
 			),
 		})
 		lpUnits = sdk.ZeroUint()
+		checkpoint := getRewardPerUnit(&pool)
+		lp.RewardPerUnitCheckpoint = &checkpoint
+	} else {
+		// rewards accrued by the current units are paid before the units change
+		_, err = k.ClaimLiquidityProviderRewards(ctx, &pool, &lp)
+		if err != nil {
+			return nil, err
+		}
+		err = k.MintPoolShares(ctx, msg.ExternalAsset.Symbol, addr, lpUnits)
+		if err != nil {
+			return nil, err
+		}
 	}
 	lp.LiquidityProviderUnits = lp.LiquidityProviderUnits.Add(lpUnits)
 	// Save new pool balances
@@ -132,6 +151,12 @@ func (k Keeper) RemoveLiquidityProvider(ctx sdk.Context, coins sdk.Coins, lp typ
 		return err
 	}
 
+	// every pool share the liquidity provider holds goes, so the supply of the denom is gone with the last one
+	shares := k.bankKeeper.GetBalance(ctx, lpaddr, types.GetPoolShareDenom(lp.Asset.Symbol))
+	err = k.BurnPoolShares(ctx, lp.Asset.Symbol, lpaddr, sdk.NewUintFromBigInt(shares.Amount.BigInt()))
+	if err != nil {
+		return err
+	}
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lpaddr, coins)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrUnableToAddBalance, err.Error())
@@ -141,10 +166,20 @@ func (k Keeper) RemoveLiquidityProvider(ctx sdk.Context, coins sdk.Coins, lp typ
 }
 
 func (k Keeper) DecommissionPool(ctx sdk.Context, pool types.Pool) error {
-	err := k.DestroyPool(ctx, pool.ExternalAsset.Symbol)
+	supply := k.GetPoolShareSupply(ctx, pool.ExternalAsset.Symbol)
+	if !supply.IsZero() {
+		return sdkerrors.Wrapf(types.ErrPoolSharesCheck, "%s pool shares of %s left in circulation", supply, pool.ExternalAsset.Symbol)
+	}
+	// the liquidity providers have claimed their rewards, what is left is rounding dust
+	err := k.BurnUnclaimedRewards(ctx, &pool)
+	if err != nil {
+		return err
+	}
+	err = k.DestroyPool(ctx, pool.ExternalAsset.Symbol)
 	if err != nil {
 		return sdkerrors.Wrap(types.ErrUnableToDestroyPool, err.Error())
 	}
+	k.DeletePriceAccumulators(ctx, pool.ExternalAsset.Symbol)
 	return nil
 }
 
@@ -167,6 +202,15 @@ func (k Keeper) RemoveLiquidity(ctx sdk.Context, pool types.Pool, externalAssetC
 	if externalAssetCoin.Amount.GTE(sdk.Int(poolOriginalEB)) || nativeAssetCoin.Amount.GTE(sdk.Int(poolOriginalNB)) {
 		return sdkerrors.Wrap(types.ErrPoolTooShallow, "Pool Balance nil after adjusting asymmetry")
 	}
+	err = k.BurnPoolShares(ctx, lp.Asset.Symbol, lpAddr, lp.LiquidityProviderUnits.Sub(lpUnitsLeft))
+	if err != nil {
+		return err
+	}
+	// rewards accrued by the current units are paid before the units change
+	_, err = k.ClaimLiquidityProviderRewards(ctx, &pool, &lp)
+	if err != nil {
+		return err
+	}
 
 	err = k.SetPool(ctx, &pool)
 	if err != nil {
@@ -235,102 +279,44 @@ func (k Keeper) ParseToInt(nu string) (sdk.Int, bool) {
 	return sdk.NewIntFromString(nu)
 }
 
-func (k Keeper) ProcessRemoveLiquidityMsg(ctx sdk.Context, msg *types.MsgRemoveLiquidity) (sdk.Int, sdk.Int, sdk.Uint, error) {
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	_, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
-	if err != nil {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrTokenNotSupported
-	}
-	pool, err := k.GetPool(ctx, msg.ExternalAsset.Symbol)
-	if err != nil {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrPoolDoesNotExist
-	}
-	//Get LP
-	lp, err := k.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
-	if err != nil {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrLiquidityProviderDoesNotExist
-	}
+// ProcessRemoveLiquidityUnits removes units of lp symmetrically from the pool and returns the value removed in rowan
+func (k Keeper) ProcessRemoveLiquidityUnits(ctx sdk.Context, pool types.Pool, lp types.LiquidityProvider, units sdk.Uint) (sdk.Uint, error) {
 	poolOriginalEB := pool.ExternalAssetBalance
 	poolOriginalNB := pool.NativeAssetBalance
 	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
-	externalSwapFeeRate := k.GetSwapFeeRate(ctx, *msg.ExternalAsset, false)
-	nativeSwapFeeRate := k.GetSwapFeeRate(ctx, types.GetSettlementAsset(), false)
+	externalSwapFeeRate := k.GetSwapFeeRate(ctx, *pool.ExternalAsset, false)
 
 	nativeAssetDepth, externalAssetDepth := pool.ExtractDebt(pool.NativeAssetBalance, pool.ExternalAssetBalance, false)
 
 	//Calculate amount to withdraw
-	withdrawNativeAssetAmount, withdrawExternalAssetAmount, lpUnitsLeft, swapAmount := CalculateWithdrawal(pool.PoolUnits,
-		nativeAssetDepth.String(), externalAssetDepth.String(), lp.LiquidityProviderUnits.String(),
-		msg.WBasisPoints.String(), msg.Asymmetry)
+	withdrawNativeAssetAmount, withdrawExternalAssetAmount, lpUnitsLeft := CalculateWithdrawalFromUnits(pool.PoolUnits,
+		nativeAssetDepth.String(), externalAssetDepth.String(), lp.LiquidityProviderUnits.String(), units)
 
 	extRowanValue := CalculateWithdrawalRowanValue(withdrawExternalAssetAmount, types.GetSettlementAsset(), pool, pmtpCurrentRunningRate, externalSwapFeeRate)
 
 	withdrawExternalAssetAmountInt, ok := k.ParseToInt(withdrawExternalAssetAmount.String())
 	if !ok {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
+		return sdk.ZeroUint(), types.ErrUnableToParseInt
 	}
 	withdrawNativeAssetAmountInt, ok := k.ParseToInt(withdrawNativeAssetAmount.String())
 	if !ok {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
+		return sdk.ZeroUint(), types.ErrUnableToParseInt
 	}
-	externalAssetCoin := sdk.NewCoin(msg.ExternalAsset.Symbol, withdrawExternalAssetAmountInt)
+	externalAssetCoin := sdk.NewCoin(pool.ExternalAsset.Symbol, withdrawExternalAssetAmountInt)
 	nativeAssetCoin := sdk.NewCoin(types.GetSettlementAsset().Symbol, withdrawNativeAssetAmountInt)
+	if withdrawNativeAssetAmount.GTE(poolOriginalNB) || withdrawExternalAssetAmount.GTE(poolOriginalEB) {
+		return sdk.ZeroUint(), types.ErrPoolTooShallow
+	}
 	// Subtract Value from pool
 	pool.PoolUnits = pool.PoolUnits.Sub(lp.LiquidityProviderUnits).Add(lpUnitsLeft)
 	pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(withdrawNativeAssetAmount)
 	pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(withdrawExternalAssetAmount)
-	// Check if withdrawal makes pool too shallow , checking only for asymetric withdraw.
-	if !msg.Asymmetry.IsZero() && (pool.ExternalAssetBalance.IsZero() || pool.NativeAssetBalance.IsZero()) {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), sdkerrors.Wrap(types.ErrPoolTooShallow, "pool balance nil before adjusting asymmetry")
-	}
-	// Swapping between Native and External based on Asymmetry
-	if msg.Asymmetry.IsPositive() {
-		swapResult, _, _, swappedPool, err := SwapOne(types.GetSettlementAsset(), swapAmount, *msg.ExternalAsset, pool, pmtpCurrentRunningRate, nativeSwapFeeRate)
-		if err != nil {
-			return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
-		}
-		if !swapResult.IsZero() {
-			swapResultInt, ok := k.ParseToInt(swapResult.String())
-			if !ok {
-				return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
-			}
-			swapAmountInt, ok := k.ParseToInt(swapAmount.String())
-			if !ok {
-				return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
-			}
-			swapCoin := sdk.NewCoin(msg.ExternalAsset.Symbol, swapResultInt)
-			swapAmountInCoin := sdk.NewCoin(types.GetSettlementAsset().Symbol, swapAmountInt)
-			externalAssetCoin = externalAssetCoin.Add(swapCoin)
-			nativeAssetCoin = nativeAssetCoin.Sub(swapAmountInCoin)
-		}
-		pool = swappedPool
-	}
-	if msg.Asymmetry.IsNegative() {
-		swapResult, _, _, swappedPool, err := SwapOne(*msg.ExternalAsset, swapAmount, types.GetSettlementAsset(), pool, pmtpCurrentRunningRate, externalSwapFeeRate)
-		if err != nil {
-			return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
-		}
-		if !swapResult.IsZero() {
-			swapInt, ok := k.ParseToInt(swapResult.String())
-			if !ok {
-				return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
-			}
-			swapAmountInt, ok := k.ParseToInt(swapAmount.String())
-			if !ok {
-				return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), types.ErrUnableToParseInt
-			}
-			swapCoin := sdk.NewCoin(types.GetSettlementAsset().Symbol, swapInt)
-			swapAmountInCoin := sdk.NewCoin(msg.ExternalAsset.Symbol, swapAmountInt)
-			nativeAssetCoin = nativeAssetCoin.Add(swapCoin)
-			externalAssetCoin = externalAssetCoin.Sub(swapAmountInCoin)
-		}
-		pool = swappedPool
-	}
+
 	// Check and  remove Liquidity
-	err = k.RemoveLiquidity(ctx, pool, externalAssetCoin, nativeAssetCoin, lp, lpUnitsLeft, poolOriginalEB, poolOriginalNB)
+	err := k.RemoveLiquidity(ctx, pool, externalAssetCoin, nativeAssetCoin, lp, lpUnitsLeft, poolOriginalEB, poolOriginalNB)
 	if err != nil {
-		return sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToRemoveLiquidity, err.Error())
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToRemoveLiquidity, err.Error())
 	}
 
-	return nativeAssetCoin.Amount, externalAssetCoin.Amount, extRowanValue.Add(withdrawNativeAssetAmount), nil
+	return extRowanValue.Add(withdrawNativeAssetAmount), nil
 }
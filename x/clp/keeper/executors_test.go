@@ -148,6 +148,8 @@ func TestKeeper_CreatePool_And_AddLiquidity_RemoveLiquidity(t *testing.T) {
 	res := app.ClpKeeper.HasBalance(ctx, signer, subCoin)
 	assert.True(t, res, "Cannot withdraw pool is too shallow")
 	subCoin = sdk.NewCoin(asset.Symbol, sdk.Int(sdk.NewUint(100)))
+	// lp is never refreshed above and the failed calls are not reverted outside of a tx, restore the shares it burnt
+	require.NoError(t, app.ClpKeeper.MintPoolShares(ctx, asset.Symbol, signer, sdk.NewUint(50)))
 	errorRemoveLiquidity = app.ClpKeeper.RemoveLiquidity(ctx, *pool, subCoin, subCoin, *lp, sdk.NewUint(0), sdk.NewUint(10001), sdk.NewUint(10001))
 	assert.NoError(t, errorRemoveLiquidity)
 	lp.LiquidityProviderAddress = ""
@@ -160,9 +162,10 @@ func TestKeeper_CreateLiquidityProvider(t *testing.T) {
 	asset := types.NewAsset("eth")
 	lpAddess, err := sdk.AccAddressFromBech32("sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v")
 	require.NoError(t, err, "Error Creating Liquidity Provider :", err)
-	lp := app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1), lpAddess)
+	lp, err := app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1), lpAddess)
 	assert.NoError(t, err)
 	assert.Equal(t, lp.LiquidityProviderAddress, "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v")
+	assert.Equal(t, sdk.NewInt(1), app.BankKeeper.GetBalance(ctx, lpAddess, types.GetPoolShareDenom(asset.Symbol)).Amount)
 }
 
 func TestKeeper_RemoveLiquidityProvider(t *testing.T) {
@@ -226,10 +229,19 @@ func TestKeeper_DecommissionPool(t *testing.T) {
 	pool, err := app.ClpKeeper.CreatePool(ctx, sdk.NewUint(1), &msgCreatePool)
 	require.NoError(t, err, "Error Generating new pool :", err)
 
+	// pool shares left in circulation hold the pool up
+	require.NoError(t, app.ClpKeeper.MintPoolShares(ctx, asset.Symbol, signer, sdk.NewUint(1)))
+	err = app.ClpKeeper.DecommissionPool(ctx, *pool)
+	require.ErrorIs(t, err, types.ErrPoolSharesCheck)
+	require.NoError(t, app.ClpKeeper.BurnPoolShares(ctx, asset.Symbol, signer, sdk.NewUint(1)))
+	app.ClpKeeper.RecordPriceAccumulators(ctx.WithBlockHeight(1))
+
 	err = app.ClpKeeper.DecommissionPool(ctx, *pool)
 	require.NoError(t, err)
 	_, err = app.ClpKeeper.GetPool(ctx, pool.ExternalAsset.Symbol)
 	assert.Error(t, err, "Pool should be deleted")
+	_, err = app.ClpKeeper.GetLatestPriceAccumulator(ctx, asset.Symbol, 1)
+	require.ErrorIs(t, err, types.ErrPriceAccumulatorNotFound)
 	err = app.ClpKeeper.DecommissionPool(ctx, *pool)
 	assert.Error(t, err, "Unable to destroy pool")
 }
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 )
 
 const MaxPageLimit = 200
@@ -330,6 +331,148 @@ func (k Querier) GetPoolShareEstimate(c context.Context, req *types.PoolShareEst
 
 }
 
+// GetSwapEstimate simulates MsgSwap against the current pool state without
+// persisting any changes.
+func (k Querier) GetSwapEstimate(c context.Context, req *types.SwapEstimateReq) (*types.SwapEstimateRes, error) {
+	if req == nil || req.SentAsset == nil || req.ReceivedAsset == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.SentAsset.Equals(*req.ReceivedAsset) {
+		return nil, status.Error(codes.InvalidArgument, "sent and received asset cannot be the same")
+	}
+	if req.SentAmount == (sdk.Uint{}) || req.SentAmount.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "sent amount must be positive")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// quote only the swaps MsgSwap would accept
+	sAsset, err := k.Keeper.tokenRegistryKeeper.GetRegistryEntry(ctx, req.SentAsset.Symbol)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not supported", req.SentAsset.Symbol)
+	}
+	rAsset, err := k.Keeper.tokenRegistryKeeper.GetRegistryEntry(ctx, req.ReceivedAsset.Symbol)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "token %s not supported", req.ReceivedAsset.Symbol)
+	}
+	if !k.Keeper.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) ||
+		!k.Keeper.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return nil, status.Error(codes.InvalidArgument, tokenregistrytypes.ErrPermissionDenied.Error())
+	}
+	if k.Keeper.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_SELL}) {
+		return nil, status.Error(codes.InvalidArgument, tokenregistrytypes.ErrNotAllowedToSellAsset.Error())
+	}
+	if k.Keeper.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_BUY}) {
+		return nil, status.Error(codes.InvalidArgument, tokenregistrytypes.ErrNotAllowedToBuyAsset.Error())
+	}
+
+	pmtpCurrentRunningRate := k.Keeper.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	swapFeeRate := k.Keeper.GetSwapFeeRate(ctx, *req.SentAsset, false)
+	nativeAsset := types.GetSettlementAsset()
+
+	blocked := false
+	if k.Keeper.GetLiquidityProtectionParams(ctx).IsActive && req.SentAsset.Equals(nativeAsset) {
+		price, err := k.Keeper.GetNativePrice(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		blocked = k.Keeper.IsBlockedByLiquidityProtection(ctx, req.SentAmount, price)
+	}
+
+	sentAmount := req.SentAmount
+	sentAsset := *req.SentAsset
+	liquidityFeeNative := sdk.ZeroUint()
+	priceImpact := sdk.ZeroUint()
+	if !req.SentAsset.Equals(nativeAsset) && !req.ReceivedAsset.Equals(nativeAsset) {
+		inPool, err := k.Keeper.GetPool(ctx, req.SentAsset.Symbol)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "pool %s not found", req.SentAsset.Symbol)
+		}
+		emitAmount, lp, ts, _, err := SwapOne(sentAsset, sentAmount, nativeAsset, inPool, pmtpCurrentRunningRate, swapFeeRate)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sentAmount = emitAmount
+		sentAsset = nativeAsset
+		priceImpact = priceImpact.Add(ts)
+		liquidityFeeNative = lp
+	}
+
+	outPoolSymbol := req.ReceivedAsset.Symbol
+	if req.ReceivedAsset.Equals(nativeAsset) {
+		outPoolSymbol = req.SentAsset.Symbol
+	}
+	outPool, err := k.Keeper.GetPool(ctx, outPoolSymbol)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "pool %s not found", outPoolSymbol)
+	}
+	emitAmount, lp, ts, _, err := SwapOne(sentAsset, sentAmount, *req.ReceivedAsset, outPool, pmtpCurrentRunningRate, swapFeeRate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// express the total liquidity fee in the received asset, as MsgSwap does
+	liquidityFee := lp
+	if liquidityFeeNative.GT(sdk.ZeroUint()) {
+		liquidityFee = lp.Add(GetSwapFee(liquidityFeeNative, *req.ReceivedAsset, outPool, pmtpCurrentRunningRate, swapFeeRate))
+	}
+
+	return &types.SwapEstimateRes{
+		ReceivedAmount:               emitAmount,
+		LiquidityFee:                 liquidityFee,
+		PriceImpact:                  priceImpact.Add(ts),
+		SwapFeeRate:                  swapFeeRate,
+		BlockedByLiquidityProtection: blocked,
+	}, nil
+}
+
+func (k Querier) GetLimitOrdersByAddress(c context.Context, req *types.LimitOrdersByAddressReq) (*types.LimitOrdersRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	orders, pageRes, err := k.Keeper.GetLimitOrdersByOwnerPaginated(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LimitOrdersRes{
+		LimitOrders: orders,
+		Height:      ctx.BlockHeight(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Querier) GetLimitOrdersByPool(c context.Context, req *types.LimitOrdersByPoolReq) (*types.LimitOrdersRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	orders, pageRes, err := k.Keeper.GetLimitOrdersByPoolPaginated(ctx, req.Symbol, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LimitOrdersRes{
+		LimitOrders: orders,
+		Height:      ctx.BlockHeight(),
+		Pagination:  pageRes,
+	}, nil
+}
+
 func calculateSwapInfo(swapStatus int, swapAmount, nativeAssetDepth, externalAssetDepth sdk.Uint, sellNativeSwapFeeRate, buyNativeSwapFeeRate, pmtpCurrentRunningRate sdk.Dec) (sdk.Dec, sdk.Uint, sdk.Uint, types.SwapStatus) {
 	switch swapStatus {
 	case NoSwap:
@@ -344,3 +487,100 @@ func calculateSwapInfo(swapStatus int, swapAmount, nativeAssetDepth, externalAss
 		panic("expect not to reach here!")
 	}
 }
+
+func (k Querier) GetTwap(c context.Context, req *types.TwapReq) (*types.TwapRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	endHeight := req.EndHeight
+	if endHeight == 0 {
+		endHeight = ctx.BlockHeight()
+	}
+	nativePrice, externalPrice, err := k.Keeper.GetTwap(ctx, req.Symbol, req.StartHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TwapRes{
+		NativePrice:   nativePrice,
+		ExternalPrice: externalPrice,
+		StartHeight:   req.StartHeight,
+		EndHeight:     endHeight,
+		Height:        ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetRemovalQueue(c context.Context, req *types.RemovalQueueReq) (*types.RemovalQueueRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	queue := k.Keeper.GetRemovalQueue(ctx, req.Symbol)
+	return &types.RemovalQueueRes{
+		RemovalQueue: &queue,
+		Height:       ctx.BlockHeight(),
+	}, nil
+}
+
+func (k Querier) GetRemovalRequestsByPool(c context.Context, req *types.RemovalRequestsByPoolReq) (*types.RemovalRequestsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	requests, pageRes, err := k.Keeper.GetRemovalRequestsPaginated(ctx, req.Symbol, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RemovalRequestsRes{
+		RemovalRequests: requests,
+		Height:          ctx.BlockHeight(),
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Querier) GetRemovalRequestsByAddress(c context.Context, req *types.RemovalRequestsByAddressReq) (*types.RemovalRequestsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Pagination == nil {
+		req.Pagination = &query.PageRequest{
+			Limit: MaxPageLimit,
+		}
+	}
+	if req.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	requests, pageRes, err := k.Keeper.GetRemovalRequestsByLPPaginated(ctx, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.RemovalRequestsRes{
+		RemovalRequests: requests,
+		Height:          ctx.BlockHeight(),
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Querier) GetRemovalQueueUnits(c context.Context, req *types.RemovalQueueUnitsReq) (*types.RemovalQueueUnitsRes, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, req.Symbol, req.LpAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "liquidity provider %s not found in pool %s", req.LpAddress, req.Symbol)
+	}
+	return &types.RemovalQueueUnitsRes{
+		Units:  k.Keeper.GetRemovalQueueUnitsForLP(ctx, lp),
+		Height: ctx.BlockHeight(),
+	}, nil
+}
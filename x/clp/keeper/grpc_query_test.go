@@ -12,7 +12,10 @@ import (
 	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestQuerier_GetPool(t *testing.T) {
@@ -259,3 +262,256 @@ func TestQuerier_GetPoolShareEstimate(t *testing.T) {
 		})
 	}
 }
+
+func TestQuerier_GetSwapEstimate(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	testcases := []struct {
+		name                           string
+		sentAsset                      string
+		receivedAsset                  string
+		sentAmount                     sdk.Uint
+		currentRowanLiquidityThreshold sdk.Uint
+		expectedBlocked                bool
+		code                           codes.Code
+	}{
+		{
+			name:                           "sell external asset",
+			sentAsset:                      "eth",
+			receivedAsset:                  "rowan",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+		},
+		{
+			name:                           "buy external asset",
+			sentAsset:                      "rowan",
+			receivedAsset:                  "eth",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+		},
+		{
+			name:                           "swap between two external assets",
+			sentAsset:                      "eth",
+			receivedAsset:                  "cusdc",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+		},
+		{
+			name:                           "blocked by liquidity protection",
+			sentAsset:                      "rowan",
+			receivedAsset:                  "eth",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(100),
+			expectedBlocked:                true,
+		},
+		{
+			name:                           "token not supported",
+			sentAsset:                      "rowan",
+			receivedAsset:                  "atom",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+			code:                           codes.NotFound,
+		},
+		{
+			name:                           "pool does not exist",
+			sentAsset:                      "rowan",
+			receivedAsset:                  "ceth",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+			code:                           codes.NotFound,
+		},
+		{
+			name:                           "selling disabled",
+			sentAsset:                      "cdash",
+			receivedAsset:                  "rowan",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+			code:                           codes.InvalidArgument,
+		},
+		{
+			name:                           "buying disabled",
+			sentAsset:                      "rowan",
+			receivedAsset:                  "cdash",
+			sentAmount:                     sdk.NewUint(10000),
+			currentRowanLiquidityThreshold: sdk.NewUint(1000000),
+			code:                           codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+				trGs := &tokenregistrytypes.GenesisState{
+					Registry: &tokenregistrytypes.Registry{
+						Entries: []*tokenregistrytypes.RegistryEntry{
+							{Denom: "eth", BaseDenom: "eth", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+							{Denom: "cusdc", BaseDenom: "cusdc", Decimals: 6, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+							{Denom: "rowan", BaseDenom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+							{Denom: "ceth", BaseDenom: "ceth", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+							{Denom: "cdash", BaseDenom: "cdash", Decimals: 18, Permissions: []tokenregistrytypes.Permission{
+								tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_DISABLE_SELL, tokenregistrytypes.Permission_DISABLE_BUY,
+							}},
+						},
+					},
+				}
+				bz, _ := app.AppCodec().MarshalJSON(trGs)
+				genesisState["tokenregistry"] = bz
+
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances,
+					banktypes.Balance{Address: address, Coins: sdk.Coins{sdk.NewCoin("eth", sdk.NewInt(10000)), sdk.NewCoin("rowan", sdk.NewInt(10000))}},
+					banktypes.Balance{Address: app.AccountKeeper.GetModuleAddress("clp").String(), Coins: sdk.Coins{
+						sdk.NewCoin("cusdc", sdk.NewInt(2000000)),
+						sdk.NewCoin("eth", sdk.NewInt(1000000)),
+						sdk.NewCoin("rowan", sdk.NewInt(2000000)),
+					}},
+				)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
+
+				clpGs := types.DefaultGenesisState()
+				clpGs.Params = types.Params{
+					MinCreatePoolThreshold: 100,
+				}
+				clpGs.PoolList = append(clpGs.PoolList,
+					&types.Pool{
+						ExternalAsset:        &types.Asset{Symbol: "eth"},
+						NativeAssetBalance:   sdk.NewUint(1000000),
+						ExternalAssetBalance: sdk.NewUint(1000000),
+						PoolUnits:            sdk.NewUint(1000000),
+					},
+					&types.Pool{
+						ExternalAsset:        &types.Asset{Symbol: "cusdc"},
+						NativeAssetBalance:   sdk.NewUint(1000000),
+						ExternalAssetBalance: sdk.NewUint(2000000),
+						PoolUnits:            sdk.NewUint(1000000),
+					},
+				)
+				bz, _ = app.AppCodec().MarshalJSON(clpGs)
+				genesisState["clp"] = bz
+
+				return genesisState
+			})
+
+			app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.NewDec(0))
+			liquidityProtectionParams := app.ClpKeeper.GetLiquidityProtectionParams(ctx)
+			liquidityProtectionParams.MaxRowanLiquidityThresholdAsset = "rowan"
+			liquidityProtectionParams.IsActive = true
+			app.ClpKeeper.SetLiquidityProtectionParams(ctx, liquidityProtectionParams)
+			app.ClpKeeper.SetLiquidityProtectionCurrentRowanLiquidityThreshold(ctx, tc.currentRowanLiquidityThreshold)
+
+			querier := clpkeeper.Querier{app.ClpKeeper}
+			sentAsset := types.NewAsset(tc.sentAsset)
+			receivedAsset := types.NewAsset(tc.receivedAsset)
+			res, err := querier.GetSwapEstimate(sdk.WrapSDKContext(ctx), &types.SwapEstimateReq{
+				SentAsset:     &sentAsset,
+				ReceivedAsset: &receivedAsset,
+				SentAmount:    tc.sentAmount,
+			})
+			if tc.code != codes.OK {
+				require.Equal(t, tc.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedBlocked, res.BlockedByLiquidityProtection)
+			require.Equal(t, app.ClpKeeper.GetSwapFeeRate(ctx, sentAsset, false).String(), res.SwapFeeRate.String())
+
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+			swapRes, err := msgServer.Swap(sdk.WrapSDKContext(ctx), &types.MsgSwap{
+				Signer:             address,
+				SentAsset:          &sentAsset,
+				ReceivedAsset:      &receivedAsset,
+				SentAmount:         tc.sentAmount,
+				MinReceivingAmount: sdk.ZeroUint(),
+			})
+			if tc.expectedBlocked {
+				require.ErrorIs(t, err, types.ErrReachedMaxRowanLiquidityThreshold)
+				return
+			}
+			require.NoError(t, err)
+
+			// the estimate must match what the swap actually delivered
+			signer, _ := sdk.AccAddressFromBech32(address)
+			received := app.BankKeeper.GetBalance(ctx, signer, tc.receivedAsset).Amount
+			initial := sdk.ZeroInt()
+			if tc.receivedAsset != "cusdc" {
+				initial = sdk.NewInt(10000)
+			}
+			require.Equal(t, res.ReceivedAmount.String(), received.Sub(initial).String())
+			require.Equal(t, res.ReceivedAmount.String(), swapRes.ReceivedAmount.String())
+			require.Equal(t, res.LiquidityFee.String(), swapRes.LiquidityFee.String())
+			require.Equal(t, res.PriceImpact.String(), swapRes.PriceImpact.String())
+		})
+	}
+}
+
+func TestQuerier_GetSwapEstimate_InvalidAmount(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	sentAsset := types.NewAsset("rowan")
+	receivedAsset := types.NewAsset("ceth")
+
+	for _, amount := range []sdk.Uint{{}, sdk.ZeroUint()} {
+		_, err := querier.GetSwapEstimate(sdk.WrapSDKContext(ctx), &types.SwapEstimateReq{
+			SentAsset:     &sentAsset,
+			ReceivedAsset: &receivedAsset,
+			SentAmount:    amount,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestQuerier_GetRemovalQueue(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	signer := test.GenerateAddress(test.AddressKey1)
+	other := test.GenerateAddress(test.AddressKey2)
+	ceth := types.NewAsset("ceth")
+	cusdc := types.NewAsset("cusdc")
+	queue := func(addr sdk.AccAddress, asset types.Asset, points int64, value uint64) {
+		lp := types.NewLiquidityProvider(&asset, sdk.NewUint(1000), addr)
+		app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+		app.ClpKeeper.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
+			Signer:        addr.String(),
+			ExternalAsset: &asset,
+			WBasisPoints:  sdk.NewInt(points),
+			Asymmetry:     sdk.ZeroInt(),
+		}, sdk.NewUint(uint64(points/10)), sdk.NewUint(value))
+	}
+	queue(signer, ceth, 1000, 100)
+	queue(other, ceth, 5000, 500)
+	queue(other, cusdc, 2000, 200)
+
+	_, err := querier.GetRemovalQueue(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+	queueRes, err := querier.GetRemovalQueue(sdk.WrapSDKContext(ctx), &types.RemovalQueueReq{Symbol: ceth.Symbol})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), queueRes.RemovalQueue.Count)
+	require.Equal(t, sdk.NewUint(600), queueRes.RemovalQueue.TotalValue)
+
+	poolRes, err := querier.GetRemovalRequestsByPool(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByPoolReq{Symbol: ceth.Symbol})
+	require.NoError(t, err)
+	require.Len(t, poolRes.RemovalRequests, 2)
+	_, err = querier.GetRemovalRequestsByPool(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByPoolReq{
+		Symbol:     ceth.Symbol,
+		Pagination: &query.PageRequest{Limit: clpkeeper.MaxPageLimit + 1},
+	})
+	require.Error(t, err)
+
+	addressRes, err := querier.GetRemovalRequestsByAddress(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByAddressReq{Address: signer.String()})
+	require.NoError(t, err)
+	require.Len(t, addressRes.RemovalRequests, 1)
+	require.Equal(t, sdk.NewInt(1000), addressRes.RemovalRequests[0].Msg.WBasisPoints)
+	addressRes, err = querier.GetRemovalRequestsByAddress(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByAddressReq{Address: other.String()})
+	require.NoError(t, err)
+	require.Len(t, addressRes.RemovalRequests, 2)
+
+	unitsRes, err := querier.GetRemovalQueueUnits(sdk.WrapSDKContext(ctx), &types.RemovalQueueUnitsReq{Symbol: ceth.Symbol, LpAddress: signer.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(100), unitsRes.Units)
+	unitsRes, err = querier.GetRemovalQueueUnits(sdk.WrapSDKContext(ctx), &types.RemovalQueueUnitsReq{Symbol: cusdc.Symbol, LpAddress: other.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(200), unitsRes.Units)
+	_, err = querier.GetRemovalQueueUnits(sdk.WrapSDKContext(ctx), &types.RemovalQueueUnitsReq{Symbol: cusdc.Symbol, LpAddress: signer.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
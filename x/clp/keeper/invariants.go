@@ -9,6 +9,7 @@ import (
 
 func RegisterInvariants(registry sdk.InvariantRegistry, k Keeper) {
 	// registry.RegisterRoute(types.ModuleName, "balance-module-account-check", k.BalanceModuleAccountCheck())
+	registry.RegisterRoute(types.ModuleName, "pool-shares-check", k.PoolSharesCheck())
 }
 
 func (k Keeper) BalanceModuleAccountCheck() sdk.Invariant {
@@ -20,9 +21,11 @@ func (k Keeper) BalanceModuleAccountCheck() sdk.Invariant {
 		pools := k.GetPools(ctx)
 		poolsTotalNativeBalanceUint := sdk.ZeroUint()
 		poolsTotalNativeCustodyUint := sdk.ZeroUint()
+		poolsTotalUnclaimedRewardsUint := sdk.ZeroUint()
 		for _, pool := range pools {
 			poolsTotalNativeBalanceUint = poolsTotalNativeBalanceUint.Add(pool.NativeAssetBalance)
 			poolsTotalNativeCustodyUint = poolsTotalNativeCustodyUint.Add(pool.NativeCustody)
+			poolsTotalUnclaimedRewardsUint = poolsTotalUnclaimedRewardsUint.Add(getUnclaimedRewards(pool))
 
 			clpModuleTotalExternalBalance := k.GetBankKeeper().GetBalance(ctx, types.GetCLPModuleAddress(), pool.ExternalAsset.Symbol)
 			clpModuleTotalExternalBalanceUint := sdk.NewUintFromString(clpModuleTotalExternalBalance.Amount.String())
@@ -36,7 +39,7 @@ func (k Keeper) BalanceModuleAccountCheck() sdk.Invariant {
 			}
 		}
 
-		ok := poolsTotalNativeBalanceUint.Add(poolsTotalNativeCustodyUint).Equal(clpModuleTotalNativeBalanceUint)
+		ok := poolsTotalNativeBalanceUint.Add(poolsTotalNativeCustodyUint).Add(poolsTotalUnclaimedRewardsUint).Equal(clpModuleTotalNativeBalanceUint)
 		if !ok {
 			return fmt.Sprintf("native balance mismatch across all pools (module: %s != pools: %s)",
 				clpModuleTotalNativeBalanceUint.String(),
@@ -70,12 +73,14 @@ func (k Keeper) SingleExternalBalanceModuleAccountCheck(externalAsset string) sd
 		pools := k.GetPools(ctx)
 		poolsTotalNativeBalanceUint := sdk.ZeroUint()
 		poolsTotalNativeCustodyUint := sdk.ZeroUint()
+		poolsTotalUnclaimedRewardsUint := sdk.ZeroUint()
 		for _, pool := range pools {
 			poolsTotalNativeBalanceUint = poolsTotalNativeBalanceUint.Add(pool.NativeAssetBalance)
 			poolsTotalNativeCustodyUint = poolsTotalNativeCustodyUint.Add(pool.NativeCustody)
+			poolsTotalUnclaimedRewardsUint = poolsTotalUnclaimedRewardsUint.Add(getUnclaimedRewards(pool))
 		}
 
-		ok := poolsTotalNativeBalanceUint.Add(poolsTotalNativeCustodyUint).Equal(clpModuleTotalNativeBalanceUint)
+		ok := poolsTotalNativeBalanceUint.Add(poolsTotalNativeCustodyUint).Add(poolsTotalUnclaimedRewardsUint).Equal(clpModuleTotalNativeBalanceUint)
 		if !ok {
 			return fmt.Sprintf("native balance mismatch across all pools (module: %s != pools: %s)",
 				clpModuleTotalNativeBalanceUint.String(),
@@ -116,3 +121,46 @@ func (k Keeper) UnitsCheck() sdk.Invariant {
 		return "all pool units vs total lp units match", false
 	}
 }
+
+func (k Keeper) PoolSharesCheck() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balances := make(map[string]sdk.Uint)
+		k.bankKeeper.IterateAllBalances(ctx, func(_ sdk.AccAddress, coin sdk.Coin) bool {
+			if isPoolShareDenom(coin.Denom) {
+				total, ok := balances[coin.Denom]
+				if !ok {
+					total = sdk.ZeroUint()
+				}
+				balances[coin.Denom] = total.Add(sdk.NewUintFromBigInt(coin.Amount.BigInt()))
+			}
+			return false
+		})
+
+		pools := k.GetPools(ctx)
+		for _, pool := range pools {
+			supply := k.GetPoolShareSupply(ctx, pool.ExternalAsset.Symbol)
+			ok := pool.PoolUnits.Equal(supply)
+			if !ok {
+				return fmt.Sprintf("pool units vs pool share supply mismatch in pool %s (pool: %s != supply: %s)",
+					pool.ExternalAsset.Symbol,
+					pool.PoolUnits.String(),
+					supply.String(),
+				), true
+			}
+
+			balance, found := balances[types.GetPoolShareDenom(pool.ExternalAsset.Symbol)]
+			if !found {
+				balance = sdk.ZeroUint()
+			}
+			ok = supply.Equal(balance)
+			if !ok {
+				return fmt.Sprintf("pool share supply vs total balances mismatch in pool %s (supply: %s != balances: %s)",
+					pool.ExternalAsset.Symbol,
+					supply.String(),
+					balance.String(),
+				), true
+			}
+		}
+		return "all pool units vs pool share supplies and balances match", false
+	}
+}
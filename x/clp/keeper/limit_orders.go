@@ -0,0 +1,426 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetLimitOrder stores the order and indexes it under its pool, by target price or, while it waits to be tried again,
+// by retry height, by expiry height and under its owner
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order *types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	id := sdk.Uint64ToBigEndian(order.Id)
+	store.Set(types.GetLimitOrderKey(order.Id), k.cdc.MustMarshal(order))
+	store.Set(limitOrderPoolKey(*order), id)
+	store.Set(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id), id)
+	store.Set(types.GetLimitOrderOwnerKey(order.Owner, order.Id), id)
+}
+
+func (k Keeper) GetLimitOrder(ctx sdk.Context, id uint64) (types.LimitOrder, error) {
+	var order types.LimitOrder
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLimitOrderKey(id))
+	if bz == nil {
+		return order, sdkerrors.Wrap(types.ErrLimitOrderNotFound, strconv.FormatUint(id, 10))
+	}
+	k.cdc.MustUnmarshal(bz, &order)
+	return order, nil
+}
+
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(order.Id))
+	store.Delete(limitOrderPoolKey(order))
+	store.Delete(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.Id))
+	store.Delete(types.GetLimitOrderOwnerKey(order.Owner, order.Id))
+}
+
+// limitOrderPoolKey returns the key indexing the order under its pool
+func limitOrderPoolKey(order types.LimitOrder) []byte {
+	sellNative := order.SentAsset.IsSettlementAsset()
+	if order.RetryHeight > 0 {
+		return types.GetLimitOrderRetryKey(LimitOrderPool(order), sellNative, order.RetryHeight, order.Id)
+	}
+	return types.GetLimitOrderPoolKey(LimitOrderPool(order), sellNative, order.TargetPrice, order.Id)
+}
+
+func (k Keeper) GetLimitOrderIterator(ctx sdk.Context) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return sdk.KVStorePrefixIterator(store, types.LimitOrderPrefix)
+}
+
+// GetLimitOrders returns all open limit orders in order of placement
+func (k Keeper) GetLimitOrders(ctx sdk.Context) []types.LimitOrder {
+	var orders []types.LimitOrder
+	it := k.GetLimitOrderIterator(ctx)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshal(it.Value(), &order)
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// GetLimitOrdersByPoolPaginated returns the limit orders selling either side of the pool of symbol through the pool
+// index
+func (k Keeper) GetLimitOrdersByPoolPaginated(ctx sdk.Context, symbol string, pagination *query.PageRequest) ([]*types.LimitOrder, *query.PageResponse, error) {
+	return k.getIndexedLimitOrdersPaginated(ctx, types.GetLimitOrderPoolSymbolPrefix(symbol), pagination)
+}
+
+// GetLimitOrdersByOwnerPaginated returns the limit orders of owner through the owner index
+func (k Keeper) GetLimitOrdersByOwnerPaginated(ctx sdk.Context, owner string, pagination *query.PageRequest) ([]*types.LimitOrder, *query.PageResponse, error) {
+	return k.getIndexedLimitOrdersPaginated(ctx, types.GetLimitOrderOwnerPrefix(owner), pagination)
+}
+
+func (k Keeper) getIndexedLimitOrdersPaginated(ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest) ([]*types.LimitOrder, *query.PageResponse, error) {
+	var orders []*types.LimitOrder
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		var order types.LimitOrder
+		err := k.cdc.Unmarshal(store.Get(types.GetLimitOrderKey(sdk.BigEndianToUint64(value))), &order)
+		if err != nil {
+			return err
+		}
+		orders = append(orders, &order)
+		return nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return orders, pageRes, nil
+}
+
+func (k Keeper) nextLimitOrderID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get(types.LimitOrderIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	id++
+	store.Set(types.LimitOrderIDKey, sdk.Uint64ToBigEndian(id))
+	return id
+}
+
+// AddLimitOrder escrows the sent coins in the module account and stores the order
+func (k Keeper) AddLimitOrder(ctx sdk.Context, msg *types.MsgPlaceLimitOrder) (types.LimitOrder, error) {
+	owner, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	sentAmountInt, ok := k.ParseToInt(msg.SentAmount.String())
+	if !ok {
+		return types.LimitOrder{}, types.ErrUnableToParseInt
+	}
+	err = k.InitiateSwap(ctx, sdk.NewCoin(msg.SentAsset.Symbol, sentAmountInt), owner)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	order := types.LimitOrder{
+		Id:            k.nextLimitOrderID(ctx),
+		Owner:         msg.Signer,
+		SentAsset:     msg.SentAsset,
+		ReceivedAsset: msg.ReceivedAsset,
+		SentAmount:    msg.SentAmount,
+		TargetPrice:   msg.TargetPrice,
+		ExpiryHeight:  msg.ExpiryHeight,
+	}
+	k.SetLimitOrder(ctx, &order)
+	return order, nil
+}
+
+// RefundLimitOrder returns the escrowed coins to the owner and deletes the order
+func (k Keeper) RefundLimitOrder(ctx sdk.Context, order types.LimitOrder) error {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return err
+	}
+	sentAmountInt, ok := k.ParseToInt(order.SentAmount.String())
+	if !ok {
+		return types.ErrUnableToParseInt
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(order.SentAsset.Symbol, sentAmountInt)))
+	if err != nil {
+		return err
+	}
+	k.DeleteLimitOrder(ctx, order)
+	return nil
+}
+
+// LimitOrderPool returns the symbol of the pool a limit order trades against
+func LimitOrderPool(order types.LimitOrder) string {
+	if order.SentAsset.IsSettlementAsset() {
+		return order.ReceivedAsset.Symbol
+	}
+	return order.SentAsset.Symbol
+}
+
+// LimitOrderMinReceived returns the least the order must receive to execute at its target price. Like the pool swap
+// prices, the target price is quoted in whole tokens, decimals are the decimals of the sent and received assets
+func LimitOrderMinReceived(order types.LimitOrder, decimalsSent, decimalsReceived uint8) sdk.Uint {
+	minReceived := DecToRat(&order.TargetPrice)
+	multiplier := CalcDenomChangeMultiplier(decimalsReceived, decimalsSent)
+	minReceived.Mul(&minReceived, new(big.Rat).SetInt(order.SentAmount.BigInt()))
+	minReceived.Mul(&minReceived, &multiplier)
+	// round up, an order never executes below its target price
+	quo, rem := new(big.Int).QuoRem(minReceived.Num(), minReceived.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	return sdk.NewUintFromBigInt(quo)
+}
+
+// limitOrderSpotPrice returns the spot price of the sent asset of an order selling one side of the pool, quoted in the
+// received asset like the target prices
+func (k Keeper) limitOrderSpotPrice(ctx sdk.Context, pool types.Pool, sellNative bool) (sdk.Dec, error) {
+	decimalsExternal, err := k.GetAssetDecimals(ctx, *pool.ExternalAsset)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	if sellNative {
+		return CalcSpotPriceNative(&pool, decimalsExternal, pmtpCurrentRunningRate)
+	}
+	return CalcSpotPriceExternal(&pool, decimalsExternal, pmtpCurrentRunningRate)
+}
+
+// CheckLimitOrderPermissions checks the token registry lets the sent asset be sold for the received asset through
+// the pools, the same checks Swap makes
+func (k Keeper) CheckLimitOrderPermissions(ctx sdk.Context, sentAsset types.Asset, receivedAsset types.Asset) error {
+	sAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, sentAsset.Symbol)
+	if err != nil {
+		return types.ErrTokenNotSupported
+	}
+	rAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, receivedAsset.Symbol)
+	if err != nil {
+		return types.ErrTokenNotSupported
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return tokenregistrytypes.ErrPermissionDenied
+	}
+	if !k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+		return tokenregistrytypes.ErrPermissionDenied
+	}
+	if k.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_SELL}) {
+		return tokenregistrytypes.ErrNotAllowedToSellAsset
+	}
+	if k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_BUY}) {
+		return tokenregistrytypes.ErrNotAllowedToBuyAsset
+	}
+	return nil
+}
+
+// isLimitOrderPermissionError reports whether err is one of the errors of CheckLimitOrderPermissions
+func isLimitOrderPermissionError(err error) bool {
+	return errors.Is(err, types.ErrTokenNotSupported) ||
+		errors.Is(err, tokenregistrytypes.ErrPermissionDenied) ||
+		errors.Is(err, tokenregistrytypes.ErrNotAllowedToSellAsset) ||
+		errors.Is(err, tokenregistrytypes.ErrNotAllowedToBuyAsset)
+}
+
+// ExecuteLimitOrder swaps the escrowed coins through the order's pool, sends the
+// result to the owner and deletes the order. It fails with ErrLimitOrderPriceNotReached
+// if the swap would pay less than the target price, and with the errors of
+// CheckLimitOrderPermissions if the token registry no longer allows the swap.
+func (k Keeper) ExecuteLimitOrder(ctx sdk.Context, order types.LimitOrder, pool types.Pool) (sdk.Uint, error) {
+	owner, err := sdk.AccAddressFromBech32(order.Owner)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	// the registry may have changed since the order was placed
+	err = k.CheckLimitOrderPermissions(ctx, *order.SentAsset, *order.ReceivedAsset)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	decimalsSent, err := k.GetAssetDecimals(ctx, *order.SentAsset)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	decimalsReceived, err := k.GetAssetDecimals(ctx, *order.ReceivedAsset)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	swapFeeRate := k.GetSwapFeeRate(ctx, *order.SentAsset, false)
+	sellNative := order.SentAsset.IsSettlementAsset()
+
+	var price sdk.Dec
+	liquidityProtectionActive := k.GetLiquidityProtectionParams(ctx).IsActive
+	if liquidityProtectionActive {
+		price, err = k.GetNativePrice(ctx)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+		if sellNative && k.IsBlockedByLiquidityProtection(ctx, order.SentAmount, price) {
+			return sdk.ZeroUint(), types.ErrReachedMaxRowanLiquidityThreshold
+		}
+	}
+
+	emitAmount, _, _, finalPool, err := SwapOne(*order.SentAsset, order.SentAmount, *order.ReceivedAsset, pool, pmtpCurrentRunningRate, swapFeeRate)
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+	minReceived := LimitOrderMinReceived(order, decimalsSent, decimalsReceived)
+	if emitAmount.LT(minReceived) {
+		return sdk.ZeroUint(), sdkerrors.Wrapf(types.ErrLimitOrderPriceNotReached, "swap pays %s, order needs %s", emitAmount, minReceived)
+	}
+	err = k.SetPool(ctx, &finalPool)
+	if err != nil {
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	emitAmountInt, ok := k.ParseToInt(emitAmount.String())
+	if !ok {
+		return sdk.ZeroUint(), types.ErrUnableToParseInt
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(sdk.NewCoin(order.ReceivedAsset.Symbol, emitAmountInt)))
+	if err != nil {
+		return sdk.ZeroUint(), err
+	}
+
+	if liquidityProtectionActive {
+		if sellNative {
+			k.MustUpdateLiquidityProtectionThreshold(ctx, true, CalculateDiscountedSentAmount(order.SentAmount, swapFeeRate), price)
+		} else {
+			k.MustUpdateLiquidityProtectionThreshold(ctx, false, emitAmount, price)
+		}
+	}
+
+	k.DeleteLimitOrder(ctx, order)
+	k.ProcessRemovalQueueAfterSwap(ctx, pool, finalPool)
+	return emitAmount, nil
+}
+
+// getIndexedLimitOrders returns up to limit of the orders indexed under prefix, in index order
+func (k Keeper) getIndexedLimitOrders(ctx sdk.Context, prefix []byte, end []byte, limit int) []types.LimitOrder {
+	var orders []types.LimitOrder
+	it := ctx.KVStore(k.storeKey).Iterator(prefix, end)
+	defer it.Close()
+	for ; it.Valid() && len(orders) < limit; it.Next() {
+		order, err := k.GetLimitOrder(ctx, sdk.BigEndianToUint64(it.Value()))
+		if err != nil {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	return orders
+}
+
+// retryLimitOrders puts back up to limit of the orders selling one side of the pool of symbol whose retry height has
+// been reached under their target price, earliest retry height first
+func (k Keeper) retryLimitOrders(ctx sdk.Context, symbol string, sellNative bool, limit int) {
+	prefix := types.GetLimitOrderRetryPrefix(symbol, sellNative)
+	end := types.GetLimitOrderRetryKey(symbol, sellNative, ctx.BlockHeight()+1, 0)
+	for _, order := range k.getIndexedLimitOrders(ctx, prefix, end, limit) {
+		k.DeleteLimitOrder(ctx, order)
+		order.RetryHeight = 0
+		k.SetLimitOrder(ctx, &order)
+	}
+}
+
+// deferLimitOrder takes an order that failed to execute out of the target price index of its pool until
+// LimitOrderRetryDelay blocks have passed, so it does not hold up the orders behind it
+func (k Keeper) deferLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	k.DeleteLimitOrder(ctx, order)
+	order.RetryHeight = ctx.BlockHeight() + types.LimitOrderRetryDelay
+	k.SetLimitOrder(ctx, &order)
+}
+
+// executeLimitOrders executes the orders selling one side of the pool of symbol, lowest target price first, until
+// the spot price of the pool falls below the target price of the next order. No swap pays more than the spot price,
+// so the orders that follow cannot execute either. Orders the token registry no longer allows are refunded, orders
+// that fail wait LimitOrderRetryDelay blocks before they are tried again. It executes at most limit orders and gives
+// up once failLimit orders failed, and returns the number of orders it executed
+func (k Keeper) executeLimitOrders(ctx sdk.Context, symbol string, sellNative bool, limit int, failLimit int) int {
+	// no more than failLimit orders are deferred per block, putting back as many keeps up with them
+	k.retryLimitOrders(ctx, symbol, sellNative, failLimit)
+	prefix := types.GetLimitOrderPoolPrefix(symbol, sellNative)
+	orders := k.getIndexedLimitOrders(ctx, prefix, types.GetLimitOrderRetryPrefix(symbol, sellNative), limit+failLimit)
+	executed, failed := 0, 0
+	for _, order := range orders {
+		if executed >= limit || failed >= failLimit {
+			break
+		}
+		// expired orders are left to refundExpiredLimitOrders
+		if order.ExpiryHeight <= ctx.BlockHeight() {
+			continue
+		}
+		pool, err := k.GetPool(ctx, symbol)
+		if err != nil {
+			break
+		}
+		spotPrice, err := k.limitOrderSpotPrice(ctx, pool, sellNative)
+		if err != nil || spotPrice.LT(order.TargetPrice) {
+			break
+		}
+		cacheCtx, write := ctx.CacheContext()
+		received, err := k.ExecuteLimitOrder(cacheCtx, order, pool)
+		if isLimitOrderPermissionError(err) {
+			ctx.Logger().Info(fmt.Sprintf("refunding limit order: %s", err.Error()), "id", order.Id)
+			err = k.RefundLimitOrder(ctx, order)
+			if err != nil {
+				ctx.Logger().Error(fmt.Sprintf("error refunding limit order: %s", err.Error()), "id", order.Id)
+				failed++
+				continue
+			}
+			emitLimitOrder(ctx, types.EventTypeCancelLimitOrder, &order)
+			continue
+		}
+		if err != nil {
+			// the order is too large to fill at its target price for now, it stays open until it expires
+			ctx.Logger().Debug(fmt.Sprintf("limit order not executed: %s", err.Error()), "id", order.Id)
+			k.deferLimitOrder(ctx, order)
+			failed++
+			continue
+		}
+		executed++
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		emitLimitOrder(ctx, types.EventTypeExecuteLimitOrder, &order,
+			sdk.NewAttribute(types.AttributeKeySwapAmount, received.String()))
+	}
+	return executed
+}
+
+// refundExpiredLimitOrders refunds up to limit of the orders that expired by the current block, earliest expiry first
+func (k Keeper) refundExpiredLimitOrders(ctx sdk.Context, limit int) {
+	end := types.GetLimitOrderExpiryKey(ctx.BlockHeight()+1, 0)
+	orders := k.getIndexedLimitOrders(ctx, types.LimitOrderExpiryPrefix, end, limit)
+	for _, order := range orders {
+		err := k.RefundLimitOrder(ctx, order)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("error refunding limit order: %s", err.Error()), "id", order.Id)
+			continue
+		}
+		emitLimitOrder(ctx, types.EventTypeExpireLimitOrder, &order)
+	}
+}
+
+// ProcessLimitOrders refunds the orders that have expired unfilled, then executes the open orders whose target price
+// the pools can pay. Each block refunds at most MaxExpiredLimitOrdersPerBlock orders and executes at most
+// MaxLimitOrdersPerBlock. Each side of each pool stops trying once MaxFailedLimitOrdersPerPool of its orders failed,
+// so orders that cannot fill in one pool do not hold up the others; the rest wait for the next block, when the failed
+// orders are out of their way.
+func (k Keeper) ProcessLimitOrders(ctx sdk.Context) {
+	k.refundExpiredLimitOrders(ctx, types.MaxExpiredLimitOrdersPerBlock)
+	limit := types.MaxLimitOrdersPerBlock
+	for _, pool := range k.GetPools(ctx) {
+		for _, sellNative := range []bool{true, false} {
+			if limit <= 0 {
+				return
+			}
+			limit -= k.executeLimitOrders(ctx, pool.ExternalAsset.Symbol, sellNative, limit, types.MaxFailedLimitOrdersPerPool)
+		}
+	}
+}
@@ -0,0 +1,533 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// 10000 and 1000000 tokens of 18 decimals
+	limitOrderBalance   = sdk.NewIntWithDecimal(10000, 18)
+	limitOrderPoolDepth = sdk.NewIntWithDecimal(1000000, 18)
+	// 100 tokens, worth more than MinLimitOrderValue
+	limitOrderAmount = sdk.NewUintFromBigInt(sdk.NewIntWithDecimal(100, 18).BigInt())
+)
+
+func createLimitOrderTestApp(t *testing.T, address string) (sdk.Context, *sifapp.SifchainApp) {
+	ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+		trGs := &tokenregistrytypes.GenesisState{
+			Registry: &tokenregistrytypes.Registry{
+				Entries: []*tokenregistrytypes.RegistryEntry{
+					{Denom: "eth", BaseDenom: "eth", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+					{Denom: "rowan", BaseDenom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+				},
+			},
+		}
+		bz, _ := app.AppCodec().MarshalJSON(trGs)
+		genesisState["tokenregistry"] = bz
+
+		bankGs := banktypes.DefaultGenesisState()
+		bankGs.Balances = append(bankGs.Balances,
+			banktypes.Balance{Address: address, Coins: sdk.Coins{sdk.NewCoin("eth", limitOrderBalance), sdk.NewCoin("rowan", limitOrderBalance)}},
+			banktypes.Balance{Address: app.AccountKeeper.GetModuleAddress("clp").String(), Coins: sdk.Coins{sdk.NewCoin("eth", limitOrderPoolDepth), sdk.NewCoin("rowan", limitOrderPoolDepth)}},
+		)
+		bz, _ = app.AppCodec().MarshalJSON(bankGs)
+		genesisState["bank"] = bz
+
+		clpGs := types.DefaultGenesisState()
+		clpGs.Params = types.Params{
+			MinCreatePoolThreshold: 100,
+		}
+		clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+			ExternalAsset:        &types.Asset{Symbol: "eth"},
+			NativeAssetBalance:   sdk.NewUintFromBigInt(limitOrderPoolDepth.BigInt()),
+			ExternalAssetBalance: sdk.NewUintFromBigInt(limitOrderPoolDepth.BigInt()),
+			PoolUnits:            sdk.NewUintFromBigInt(limitOrderPoolDepth.BigInt()),
+		})
+		bz, _ = app.AppCodec().MarshalJSON(clpGs)
+		genesisState["clp"] = bz
+
+		return genesisState
+	})
+	app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.NewDec(0))
+	return ctx, app
+}
+
+func TestKeeper_LimitOrders(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif1gy2ne7m62uer4h5s4e7xlfq7aeem5zpwx6nu9q"
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	escrowed := limitOrderBalance.Sub(sdk.NewIntFromBigInt(limitOrderAmount.BigInt()))
+
+	// the pools trade one for one, a swap of limitOrderAmount pays a little less than that once fees are taken
+	testcases := []struct {
+		name            string
+		sentAsset       types.Asset
+		receivedAsset   types.Asset
+		expiryHeight    int64
+		targetPrice     sdk.Dec
+		blockHeight     int64
+		cancelBy        string
+		err             error
+		expectOpen      bool
+		expectFilled    bool
+		expectedBalance sdk.Int
+	}{
+		{
+			name:            "order stays open above the spot price",
+			sentAsset:       eth,
+			receivedAsset:   rowan,
+			expiryHeight:    100,
+			targetPrice:     sdk.MustNewDecFromStr("1.1"),
+			blockHeight:     50,
+			expectOpen:      true,
+			expectedBalance: escrowed,
+		},
+		{
+			name:            "order stays open while the swap pays less than the target price",
+			sentAsset:       eth,
+			receivedAsset:   rowan,
+			expiryHeight:    100,
+			targetPrice:     sdk.OneDec(),
+			blockHeight:     50,
+			expectOpen:      true,
+			expectedBalance: escrowed,
+		},
+		{
+			name:          "sell external asset once target price is reached",
+			sentAsset:     eth,
+			receivedAsset: rowan,
+			expiryHeight:  100,
+			targetPrice:   sdk.MustNewDecFromStr("0.9"),
+			blockHeight:   50,
+			expectFilled:  true,
+		},
+		{
+			name:          "sell native asset once target price is reached",
+			sentAsset:     rowan,
+			receivedAsset: eth,
+			expiryHeight:  100,
+			targetPrice:   sdk.MustNewDecFromStr("0.9"),
+			blockHeight:   50,
+			expectFilled:  true,
+		},
+		{
+			name:            "expired order is refunded",
+			sentAsset:       eth,
+			receivedAsset:   rowan,
+			expiryHeight:    100,
+			targetPrice:     sdk.MustNewDecFromStr("1.1"),
+			blockHeight:     100,
+			expectedBalance: limitOrderBalance,
+		},
+		{
+			name:            "owner cancels order",
+			sentAsset:       eth,
+			receivedAsset:   rowan,
+			expiryHeight:    100,
+			targetPrice:     sdk.MustNewDecFromStr("1.1"),
+			blockHeight:     50,
+			cancelBy:        address,
+			expectedBalance: limitOrderBalance,
+		},
+		{
+			name:            "only owner can cancel order",
+			sentAsset:       eth,
+			receivedAsset:   rowan,
+			expiryHeight:    100,
+			targetPrice:     sdk.MustNewDecFromStr("1.1"),
+			blockHeight:     50,
+			cancelBy:        other,
+			err:             sdkerrors.ErrUnauthorized,
+			expectOpen:      true,
+			expectedBalance: escrowed,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := createLimitOrderTestApp(t, address)
+			ctx = ctx.WithBlockHeight(1)
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+			querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+			signer, _ := sdk.AccAddressFromBech32(address)
+
+			res, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+				Signer:        address,
+				SentAsset:     &tc.sentAsset,
+				ReceivedAsset: &tc.receivedAsset,
+				SentAmount:    limitOrderAmount,
+				TargetPrice:   tc.targetPrice,
+				ExpiryHeight:  tc.expiryHeight,
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), res.Id)
+			require.Equal(t, escrowed.String(), app.BankKeeper.GetBalance(ctx, signer, tc.sentAsset.Symbol).Amount.String())
+
+			poolBefore, _ := app.ClpKeeper.GetPool(ctx, "eth")
+			ctx = ctx.WithBlockHeight(tc.blockHeight)
+			if tc.cancelBy != "" {
+				_, err = msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelLimitOrder{Signer: tc.cancelBy, Id: res.Id})
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+				} else {
+					require.NoError(t, err)
+				}
+			} else {
+				app.ClpKeeper.ProcessLimitOrders(ctx)
+			}
+
+			_, err = app.ClpKeeper.GetLimitOrder(ctx, res.Id)
+			if tc.expectOpen {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+			}
+
+			byAddress, err := querier.GetLimitOrdersByAddress(sdk.WrapSDKContext(ctx), &types.LimitOrdersByAddressReq{Address: address})
+			require.NoError(t, err)
+			byPool, err := querier.GetLimitOrdersByPool(sdk.WrapSDKContext(ctx), &types.LimitOrdersByPoolReq{Symbol: "eth"})
+			require.NoError(t, err)
+			if tc.expectOpen {
+				require.Len(t, byAddress.LimitOrders, 1)
+				require.Len(t, byPool.LimitOrders, 1)
+			} else {
+				require.Len(t, byAddress.LimitOrders, 0)
+				require.Len(t, byPool.LimitOrders, 0)
+			}
+
+			if tc.expectFilled {
+				expected, _, _, _, err := clpkeeper.SwapOne(tc.sentAsset, limitOrderAmount, tc.receivedAsset, poolBefore, sdk.ZeroDec(), app.ClpKeeper.GetSwapFeeRate(ctx, tc.sentAsset, false))
+				require.NoError(t, err)
+				received := app.BankKeeper.GetBalance(ctx, signer, tc.receivedAsset.Symbol).Amount
+				require.Equal(t, limitOrderBalance.Add(sdk.NewIntFromBigInt(expected.BigInt())).String(), received.String())
+				return
+			}
+			require.Equal(t, tc.expectedBalance.String(), app.BankKeeper.GetBalance(ctx, signer, tc.sentAsset.Symbol).Amount.String())
+		})
+	}
+}
+
+func TestKeeper_ProcessLimitOrders_Index(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+
+	// placed highest target price first, executed lowest target price first
+	targets := []string{"1.1", "0.95", "0.9", "0.85"}
+	for _, target := range targets {
+		_, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+			Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr(target), ExpiryHeight: 100,
+		})
+		require.NoError(t, err)
+	}
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	for id, open := range map[uint64]bool{1: true, 2: false, 3: false, 4: false} {
+		_, err := app.ClpKeeper.GetLimitOrder(ctx, id)
+		if open {
+			require.NoError(t, err)
+		} else {
+			require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+		}
+	}
+
+	// the order left open is refunded once it expires and every index entry goes with it
+	ctx = ctx.WithBlockHeight(100)
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	_, err := app.ClpKeeper.GetLimitOrder(ctx, 1)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, prefix := range [][]byte{types.LimitOrderPrefix, types.LimitOrderPoolPrefix, types.LimitOrderExpiryPrefix} {
+		it := sdk.KVStorePrefixIterator(store, prefix)
+		require.False(t, it.Valid())
+		it.Close()
+	}
+}
+
+func TestKeeper_ProcessLimitOrders_Limit(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	orders := types.MaxLimitOrdersPerBlock + 10
+	for i := 0; i < orders; i++ {
+		_, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+			Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUint(1000000), TargetPrice: sdk.MustNewDecFromStr("0.5"), ExpiryHeight: 100,
+		})
+		require.NoError(t, err)
+	}
+
+	// each block executes at most MaxLimitOrdersPerBlock orders
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), orders-types.MaxLimitOrdersPerBlock)
+	app.ClpKeeper.ProcessLimitOrders(ctx.WithBlockHeight(2))
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), 0)
+}
+
+func TestKeeper_ProcessLimitOrders_FailedAndExpired(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	// too large to fill at its target price, it is tried first and fails
+	failing, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUintFromBigInt(limitOrderBalance.MulRaw(9).QuoRaw(10).BigInt()), TargetPrice: sdk.MustNewDecFromStr("0.992"), ExpiryHeight: 100,
+	})
+	require.NoError(t, err)
+	for i := 0; i < types.MaxLimitOrdersPerBlock; i++ {
+		_, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+			Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUint(1000000), TargetPrice: sdk.MustNewDecFromStr("0.992"), ExpiryHeight: 100,
+		})
+		require.NoError(t, err)
+	}
+	expiring, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUint(1000000), TargetPrice: sdk.MustNewDecFromStr("0.5"), ExpiryHeight: 2,
+	})
+	require.NoError(t, err)
+	ethBalance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "eth").Amount
+
+	// the failed order does not use up the orders executed in the block and the expired order is
+	// refunded rather than executed
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	orders := app.ClpKeeper.GetLimitOrders(ctx)
+	require.Len(t, orders, 1)
+	require.Equal(t, failing.Id, orders[0].Id)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, expiring.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	expired := false
+	for _, event := range ctx.EventManager().Events() {
+		expired = expired || event.Type == types.EventTypeExpireLimitOrder
+	}
+	require.True(t, expired)
+	require.Equal(t, ethBalance.AddRaw(1000000).String(), app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "eth").Amount.String())
+}
+
+func TestKeeper_ProcessLimitOrders_FailedPerPool(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	// the spot price reaches the target price but no swap pays it after the swap fee, so every rowan order fails
+	for i := 0; i < types.MaxFailedLimitOrdersPerPool+5; i++ {
+		_, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+			Signer: address, SentAsset: &rowan, ReceivedAsset: &eth, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("0.999"), ExpiryHeight: 100,
+		})
+		require.NoError(t, err)
+	}
+	order, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("0.5"), ExpiryHeight: 100,
+	})
+	require.NoError(t, err)
+
+	// the failed rowan orders do not use up the failures the eth orders may have
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, order.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), types.MaxFailedLimitOrdersPerPool+5)
+}
+
+func TestKeeper_ProcessLimitOrders_FailedDeferred(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	// the lowest target prices, too large to fill at them, they use up the failures of the pool side
+	var blocking []types.LimitOrder
+	for i := 0; i < types.MaxFailedLimitOrdersPerPool; i++ {
+		order, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+			Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUintFromBigInt(sdk.NewIntWithDecimal(900, 18).BigInt()), TargetPrice: sdk.MustNewDecFromStr("0.9962"), ExpiryHeight: 200,
+		})
+		require.NoError(t, err)
+		blocking = append(blocking, order)
+	}
+	fillable, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUint(1000000), TargetPrice: sdk.MustNewDecFromStr("0.9965"), ExpiryHeight: 200,
+	})
+	require.NoError(t, err)
+
+	// the blocking orders fail and are deferred, the fillable order is not reached
+	ctx = ctx.WithBlockHeight(2)
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, fillable.Id)
+	require.NoError(t, err)
+	for _, order := range blocking {
+		order, err = app.ClpKeeper.GetLimitOrder(ctx, order.Id)
+		require.NoError(t, err)
+		require.Equal(t, int64(2+types.LimitOrderRetryDelay), order.RetryHeight)
+	}
+
+	// the blocking orders are out of the way
+	ctx = ctx.WithBlockHeight(3)
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, fillable.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	require.Len(t, app.ClpKeeper.GetLimitOrders(ctx), types.MaxFailedLimitOrdersPerPool)
+
+	// once their retry height is reached the blocking orders are tried again, fail and are deferred again
+	ctx = ctx.WithBlockHeight(2 + types.LimitOrderRetryDelay)
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	for _, order := range blocking {
+		order, err = app.ClpKeeper.GetLimitOrder(ctx, order.Id)
+		require.NoError(t, err)
+		require.Equal(t, int64(2+2*types.LimitOrderRetryDelay), order.RetryHeight)
+	}
+}
+
+func TestKeeper_ProcessLimitOrders_Permissions(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	order, err := app.ClpKeeper.AddLimitOrder(ctx, &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("0.5"), ExpiryHeight: 100,
+	})
+	require.NoError(t, err)
+	ethBalance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "eth").Amount
+	rowanBalance := app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "rowan").Amount
+
+	// selling eth is disabled after the order was placed, the order is refunded instead of executed
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom: "eth", BaseDenom: "eth", Decimals: 18,
+		Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_DISABLE_SELL},
+	})
+	pool, err := app.ClpKeeper.GetPool(ctx, "eth")
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.ExecuteLimitOrder(ctx, order, pool)
+	require.ErrorIs(t, err, tokenregistrytypes.ErrNotAllowedToSellAsset)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.ClpKeeper.ProcessLimitOrders(ctx)
+	_, err = app.ClpKeeper.GetLimitOrder(ctx, order.Id)
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+	cancelled := false
+	for _, event := range ctx.EventManager().Events() {
+		cancelled = cancelled || event.Type == types.EventTypeCancelLimitOrder
+	}
+	require.True(t, cancelled)
+	require.Equal(t, ethBalance.Add(sdk.NewIntFromBigInt(limitOrderAmount.BigInt())).String(), app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "eth").Amount.String())
+	require.Equal(t, rowanBalance.String(), app.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(address), "rowan").Amount.String())
+}
+
+func TestQuerier_GetLimitOrdersIndexed(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	other := "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(1)
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+	eth := types.NewAsset("eth")
+	atom := types.NewAsset("atom")
+	rowan := types.GetSettlementAsset()
+	orders := []types.LimitOrder{
+		{Id: 1, Owner: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("2"), ExpiryHeight: 100},
+		{Id: 2, Owner: other, SentAsset: &rowan, ReceivedAsset: &eth, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("2"), ExpiryHeight: 100},
+		{Id: 3, Owner: address, SentAsset: &rowan, ReceivedAsset: &atom, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("2"), ExpiryHeight: 100},
+		{Id: 4, Owner: other, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("3"), ExpiryHeight: 100, RetryHeight: 50},
+		{Id: 5, Owner: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.MustNewDecFromStr("1"), ExpiryHeight: 100},
+	}
+	for i := range orders {
+		app.ClpKeeper.SetLimitOrder(ctx, &orders[i])
+	}
+	ids := func(orders []*types.LimitOrder) []uint64 {
+		var ids []uint64
+		for _, order := range orders {
+			ids = append(ids, order.Id)
+		}
+		return ids
+	}
+
+	// the orders selling either side of the pool, deferred orders included
+	byPool, err := querier.GetLimitOrdersByPool(sdk.WrapSDKContext(ctx), &types.LimitOrdersByPoolReq{Symbol: "eth"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{1, 2, 4, 5}, ids(byPool.LimitOrders))
+	byAddress, err := querier.GetLimitOrdersByAddress(sdk.WrapSDKContext(ctx), &types.LimitOrdersByAddressReq{Address: address})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 5}, ids(byAddress.LimitOrders))
+
+	// pages follow the index
+	page, err := querier.GetLimitOrdersByAddress(sdk.WrapSDKContext(ctx), &types.LimitOrdersByAddressReq{Address: address, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, ids(page.LimitOrders))
+	page, err = querier.GetLimitOrdersByAddress(sdk.WrapSDKContext(ctx), &types.LimitOrdersByAddressReq{Address: address, Pagination: &query.PageRequest{Key: page.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, ids(page.LimitOrders))
+
+	// deleted orders leave both indexes
+	app.ClpKeeper.DeleteLimitOrder(ctx, orders[3])
+	app.ClpKeeper.DeleteLimitOrder(ctx, orders[4])
+	byPool, err = querier.GetLimitOrdersByPool(sdk.WrapSDKContext(ctx), &types.LimitOrdersByPoolReq{Symbol: "eth"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []uint64{1, 2}, ids(byPool.LimitOrders))
+	byAddress, err = querier.GetLimitOrdersByAddress(sdk.WrapSDKContext(ctx), &types.LimitOrdersByAddressReq{Address: other})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, ids(byAddress.LimitOrders))
+}
+
+func TestLimitOrderMinReceived(t *testing.T) {
+	order := types.LimitOrder{SentAmount: sdk.NewUint(3), TargetPrice: sdk.MustNewDecFromStr("1.5")}
+	// rounded up
+	require.Equal(t, sdk.NewUint(5), clpkeeper.LimitOrderMinReceived(order, 18, 18))
+	// 3 base units of an 18 decimals token at 1.5 are worth 4.5e-12 base units of a 6 decimals token
+	require.Equal(t, sdk.NewUint(1), clpkeeper.LimitOrderMinReceived(order, 18, 6))
+	order.SentAmount = sdk.NewUint(1000000)
+	require.Equal(t, sdk.NewUintFromString("1500000000000000000"), clpkeeper.LimitOrderMinReceived(order, 6, 18))
+}
+
+func TestMsgServer_PlaceLimitOrderValidation(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	ctx, app := createLimitOrderTestApp(t, address)
+	ctx = ctx.WithBlockHeight(10)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	eth := types.NewAsset("eth")
+	rowan := types.GetSettlementAsset()
+	atom := types.NewAsset("atom")
+
+	_, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.OneDec(), ExpiryHeight: 10,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLimitOrder)
+
+	// orders may stay open for at most MaxLimitOrderLifetime blocks
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.OneDec(), ExpiryHeight: 10 + types.MaxLimitOrderLifetime + 1,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLimitOrder)
+
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &atom, ReceivedAsset: &rowan, SentAmount: limitOrderAmount, TargetPrice: sdk.OneDec(), ExpiryHeight: 20,
+	})
+	require.ErrorIs(t, err, types.ErrTokenNotSupported)
+
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUintFromBigInt(limitOrderBalance.MulRaw(2).BigInt()), TargetPrice: sdk.OneDec(), ExpiryHeight: 20,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLimitOrder)
+
+	// orders worth less than MinLimitOrderValue are rejected
+	_, err = msgServer.PlaceLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgPlaceLimitOrder{
+		Signer: address, SentAsset: &eth, ReceivedAsset: &rowan, SentAmount: sdk.NewUint(1000), TargetPrice: sdk.OneDec(), ExpiryHeight: 20,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLimitOrder)
+
+	_, err = msgServer.CancelLimitOrder(sdk.WrapSDKContext(ctx), &types.MsgCancelLimitOrder{Signer: address, Id: 1})
+	require.ErrorIs(t, err, types.ErrLimitOrderNotFound)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Sifchain/sifnode/x/clp/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k Keeper) SetLiquidityProtectionParams(ctx sdk.Context, params *types.LiquidityProtectionParams) {
@@ -56,8 +57,8 @@ func (k Keeper) MustUpdateLiquidityProtectionThreshold(ctx sdk.Context, sellNati
 	}
 }
 
-// Currently this calculates the native price on the fly
-// Calculates the price of the native token in MaxRowanLiquidityThresholdAsset
+// Calculates the price of the native token in MaxRowanLiquidityThresholdAsset,
+// on the fly or as a TWAP over the last TwapWindow blocks when that is set
 func (k Keeper) GetNativePrice(ctx sdk.Context) (sdk.Dec, error) {
 	liquidityProtectionParams := k.GetLiquidityProtectionParams(ctx)
 	maxRowanLiquidityThresholdAsset := liquidityProtectionParams.MaxRowanLiquidityThresholdAsset
@@ -70,6 +71,24 @@ func (k Keeper) GetNativePrice(ctx sdk.Context) (sdk.Dec, error) {
 		return sdk.Dec{}, types.ErrMaxRowanLiquidityThresholdAssetPoolDoesNotExist
 	}
 
+	if liquidityProtectionParams.TwapWindow > types.MaxTwapWindow {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapWindow, "must not exceed %d blocks: %d", types.MaxTwapWindow, liquidityProtectionParams.TwapWindow)
+	}
+	if liquidityProtectionParams.TwapWindow > 0 {
+		twapNative, _, err := k.GetTwapForWindow(ctx, maxRowanLiquidityThresholdAsset, liquidityProtectionParams.TwapWindow)
+		if err == nil {
+			return twapNative, nil
+		}
+		if !errors.Is(err, types.ErrPriceAccumulatorNotFound) && !errors.Is(err, types.ErrInvalidTwapWindow) {
+			return sdk.Dec{}, err
+		}
+		// the chain or the pool does not have TwapWindow blocks of price history yet
+		ctx.Logger().Info("not enough price history for the twap window, using the spot price",
+			"pool", maxRowanLiquidityThresholdAsset,
+			"twapWindow", liquidityProtectionParams.TwapWindow,
+			"error", err.Error())
+	}
+
 	return CalcRowanSpotPrice(&pool, k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
 
 }
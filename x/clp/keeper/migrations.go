@@ -86,3 +86,104 @@ func (m Migrator) MigrateToVer4(ctx sdk.Context) error {
 
 	return nil
 }
+
+// MigrateToVer5 starts the reward accumulators of every pool and the checkpoints of every liquidity provider at
+// zero, rewards are no longer pushed to liquidity providers on distribution blocks but accrued per pool unit. The
+// push model paid every reward out in the block it was distributed, so there is nothing outstanding to settle
+func (m Migrator) MigrateToVer5(ctx sdk.Context) error {
+	iterator := m.keeper.GetLiquidityProviderIterator(ctx)
+	defer iterator.Close()
+	var lps []types.LiquidityProvider
+	for ; iterator.Valid(); iterator.Next() {
+		var lp types.LiquidityProvider
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &lp)
+		lps = append(lps, lp)
+	}
+	for i := range lps {
+		checkpoint := sdk.ZeroDec()
+		lps[i].RewardPerUnitCheckpoint = &checkpoint
+		m.keeper.SetLiquidityProvider(ctx, &lps[i])
+	}
+
+	for _, pool := range m.keeper.GetPools(ctx) {
+		rewardPerUnit := sdk.ZeroDec()
+		pool.RewardPerUnit = &rewardPerUnit
+		err := m.keeper.SetPool(ctx, pool)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MigrateToVer6 mints the pool shares of the units every liquidity provider already holds
+func (m Migrator) MigrateToVer6(ctx sdk.Context) error {
+	iterator := m.keeper.GetLiquidityProviderIterator(ctx)
+	defer iterator.Close()
+	var lps []types.LiquidityProvider
+	for ; iterator.Valid(); iterator.Next() {
+		var lp types.LiquidityProvider
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &lp)
+		lps = append(lps, lp)
+	}
+	for _, lp := range lps {
+		addr, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		if err != nil {
+			return err
+		}
+		err = m.keeper.MintPoolShares(ctx, lp.Asset.Symbol, addr, lp.LiquidityProviderUnits)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MigrateToVer7 moves the queued removal requests, stored by liquidity provider address, under the queue of their pool
+// ordered by id. Requests now remember the units they have left to remove, computed from the units of the liquidity
+// provider at the time of the migration
+func (m Migrator) MigrateToVer7(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RemovalRequestPrefix)
+	var keys [][]byte
+	var requests []types.RemovalRequest
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.RemovalRequest
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &request)
+		keys = append(keys, iterator.Key())
+		requests = append(requests, request)
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	// the queues are rebuilt from the requests that are still owed
+	for _, pool := range m.keeper.GetPools(ctx) {
+		queue := m.keeper.GetRemovalQueue(ctx, pool.ExternalAsset.Symbol)
+		queue.Count = 0
+		queue.TotalValue = sdk.ZeroUint()
+		m.keeper.SetRemovalQueue(ctx, queue, pool.ExternalAsset.Symbol)
+	}
+	for _, request := range requests {
+		lp, err := m.keeper.GetLiquidityProvider(ctx, request.Msg.ExternalAsset.Symbol, request.Msg.Signer)
+		if err != nil {
+			ctx.Logger().Error("dropping queued removal of missing LP for migration", "lp", request.Msg.Signer, "request", request.Id)
+			continue
+		}
+		request.Units = ConvWBasisPointsToExactUnits(lp.LiquidityProviderUnits, request.Msg.WBasisPoints)
+		if request.Units.IsZero() {
+			continue
+		}
+		m.keeper.SetRemovalRequest(ctx, request)
+
+		queue := m.keeper.GetRemovalQueue(ctx, request.Msg.ExternalAsset.Symbol)
+		queue.Count++
+		queue.TotalValue = queue.TotalValue.Add(request.Value)
+		m.keeper.SetRemovalQueue(ctx, queue, request.Msg.ExternalAsset.Symbol)
+	}
+
+	return nil
+}
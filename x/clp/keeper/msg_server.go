@@ -355,7 +355,11 @@ func (k msgServer) DecommissionPool(goCtx context.Context, msg *types.MsgDecommi
 		withdrawNativeCoins := sdk.NewCoin(types.GetSettlementAsset().Symbol, withdrawNativeAssetInt)
 		withdrawExternalCoins := sdk.NewCoin(msg.Symbol, withdrawExternalAssetInt)
 		refundingCoins := sdk.NewCoins(withdrawExternalCoins, withdrawNativeCoins)
-		err := k.Keeper.RemoveLiquidityProvider(ctx, refundingCoins, *lp)
+		_, err := k.Keeper.ClaimLiquidityProviderRewards(ctx, &pool, lp)
+		if err != nil {
+			return nil, err
+		}
+		err = k.Keeper.RemoveLiquidityProvider(ctx, refundingCoins, *lp)
 		if err != nil {
 			return nil, sdkerrors.Wrap(types.ErrUnableToRemoveLiquidityProvider, err.Error())
 		}
@@ -394,8 +398,7 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	if msg.NativeAssetAmount.LT(MinThreshold) { // Need to verify
 		return nil, types.ErrTotalAmountTooLow
 	}
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	eAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.ExternalAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
@@ -426,7 +429,10 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	if err != nil {
 		return nil, err
 	}
-	lp := k.Keeper.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpunits, accAddr)
+	lp, err := k.Keeper.CreateLiquidityProvider(ctx, msg.ExternalAsset, lpunits, accAddr)
+	if err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreatePool,
@@ -450,7 +456,11 @@ func (k msgServer) CreatePool(goCtx context.Context, msg *types.MsgCreatePool) (
 	// 	return nil, sdkerrors.Wrap(types.ErrBalanceModuleAccountCheck, res)
 	// }
 
-	return &types.MsgCreatePoolResponse{}, nil
+	return &types.MsgCreatePoolResponse{
+		UnitsMinted:         lpunits,
+		NativeAssetAmount:   msg.NativeAssetAmount,
+		ExternalAssetAmount: msg.ExternalAssetAmount,
+	}, nil
 }
 
 func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSwapResponse, error) {
@@ -458,12 +468,11 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	var (
 		priceImpact sdk.Uint
 	)
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	sAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.SentAsset.Symbol)
+	sAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.SentAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
-	rAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ReceivedAsset.Symbol)
+	rAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.ReceivedAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
@@ -509,6 +518,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	// Get native asset
 	nativeAsset := types.GetSettlementAsset()
 	inPool, outPool := types.Pool{}, types.Pool{}
+	var touchedPools []*types.Pool
 	// If sending rowan ,deduct directly from the Native balance  instead of fetching from rowan pool
 	if !msg.SentAsset.Equals(types.GetSettlementAsset()) {
 		inPool, err = k.Keeper.GetPool(ctx, msg.SentAsset.Symbol)
@@ -544,6 +554,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		sentAsset = &nativeAsset
 		priceImpact = priceImpact.Add(ts)
 		liquidityFeeNative = liquidityFeeNative.Add(lp)
+		touchedPools = append(touchedPools, &finalPool)
 	}
 	// If receiving  rowan , add directly to  Native balance  instead of fetching from rowan pool
 	if msg.ReceivedAsset.Equals(types.GetSettlementAsset()) {
@@ -585,6 +596,7 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
 	}
+	touchedPools = append(touchedPools, &finalPool)
 	if liquidityFeeNative.GT(sdk.ZeroUint()) {
 		liquidityFeeExternal = liquidityFeeExternal.Add(lp)
 		firstSwapFeeInOutputAsset := GetSwapFee(liquidityFeeNative, *msg.ReceivedAsset, outPool, pmtpCurrentRunningRate, swapFeeRate)
@@ -625,6 +637,12 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 		}
 	}
 
+	// queued removals are served from the pools the swap left healthier
+	if !msg.SentAsset.Equals(nativeAsset) && !msg.ReceivedAsset.Equals(nativeAsset) {
+		k.Keeper.ProcessRemovalQueueAfterSwap(ctx, inPool, *touchedPools[0])
+	}
+	k.Keeper.ProcessRemovalQueueAfterSwap(ctx, outPool, finalPool)
+
 	// if !msg.SentAsset.Equals(types.GetSettlementAsset()) {
 	// 	res, stop := k.SingleExternalBalanceModuleAccountCheck(msg.SentAsset.Symbol)(ctx)
 	// 	if stop {
@@ -638,19 +656,336 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwap) (*types.MsgSw
 	// 	}
 	// }
 
-	return &types.MsgSwapResponse{}, nil
+	return &types.MsgSwapResponse{
+		ReceivedAmount: emitAmount,
+		LiquidityFee:   totalLiquidityFee,
+		PriceImpact:    priceImpact,
+		Pools:          touchedPools,
+	}, nil
+}
+
+// SwapRoute swaps along an explicit route of assets, applying SwapOne once per
+// consecutive pair. Like Swap, every hop is charged the swap fee rate of the sent
+// asset. Only the output of the final hop is checked against MinReceivingAmount.
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entries := make([]*tokenregistrytypes.RegistryEntry, len(msg.Assets))
+	for i, asset := range msg.Assets {
+		entry, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, asset.Symbol)
+		if err != nil {
+			return nil, types.ErrTokenNotSupported
+		}
+		if !k.tokenRegistryKeeper.CheckEntryPermissions(entry, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}) {
+			return nil, tokenregistrytypes.ErrPermissionDenied
+		}
+		entries[i] = entry
+	}
+	sAsset := entries[0]
+	rAsset := entries[len(entries)-1]
+	if k.tokenRegistryKeeper.CheckEntryPermissions(sAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_SELL}) {
+		return nil, tokenregistrytypes.ErrNotAllowedToSellAsset
+	}
+	if k.tokenRegistryKeeper.CheckEntryPermissions(rAsset, []tokenregistrytypes.Permission{tokenregistrytypes.Permission_DISABLE_BUY}) {
+		return nil, tokenregistrytypes.ErrNotAllowedToBuyAsset
+	}
+
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	swapFeeRate := k.GetSwapFeeRate(ctx, *msg.Assets[0], false)
+
+	var price sdk.Dec
+	var err error
+	if k.GetLiquidityProtectionParams(ctx).IsActive {
+		// calculate the price before any changes are made to the pools
+		price, err = k.GetNativePrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if types.StringCompare(sAsset.Denom, types.NativeSymbol) {
+			if k.IsBlockedByLiquidityProtection(ctx, msg.SentAmount, price) {
+				return nil, types.ErrReachedMaxRowanLiquidityThreshold
+			}
+		}
+	}
+
+	sentAmountInt, ok := k.Keeper.ParseToInt(msg.SentAmount.String())
+	if !ok {
+		return nil, types.ErrUnableToParseInt
+	}
+	accAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.InitiateSwap(ctx, sdk.NewCoin(msg.Assets[0].Symbol, sentAmountInt), accAddr)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+
+	amount := msg.SentAmount
+	priceImpact := sdk.ZeroUint()
+	liquidityFee := sdk.ZeroUint()
+	touchedPools := make([]*types.Pool, 0, len(msg.Assets)-1)
+	// a route may pass through a pool more than once, keep each pool as it was before its first hop
+	var poolSymbols []string
+	initialPools := make(map[string]types.Pool)
+	for i := 0; i < len(msg.Assets)-1; i++ {
+		from, to := *msg.Assets[i], *msg.Assets[i+1]
+		poolAsset := from
+		if from.IsSettlementAsset() {
+			poolAsset = to
+		}
+		pool, err := k.Keeper.GetPool(ctx, poolAsset.Symbol)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, poolAsset.String())
+		}
+		if _, ok := initialPools[poolAsset.Symbol]; !ok {
+			poolSymbols = append(poolSymbols, poolAsset.Symbol)
+			initialPools[poolAsset.Symbol] = pool
+		}
+		emitAmount, lp, ts, finalPool, err := SwapOne(from, amount, to, pool, pmtpCurrentRunningRate, swapFeeRate)
+		if err != nil {
+			return nil, err
+		}
+		err = k.Keeper.SetPool(ctx, &finalPool)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSwapHop,
+			sdk.NewAttribute(types.AttributeKeyHop, strconv.Itoa(i)),
+			sdk.NewAttribute(types.AttributeKeySentAsset, from.Symbol),
+			sdk.NewAttribute(types.AttributeKeySentAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceivedAsset, to.Symbol),
+			sdk.NewAttribute(types.AttributeKeySwapAmount, emitAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityFee, lp.String()),
+			sdk.NewAttribute(types.AttributeKeyPriceImpact, ts.String()),
+			sdk.NewAttribute(types.AttributeKeyPool, finalPool.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+		// carry the fees of earlier hops over into the asset received from this hop
+		if !liquidityFee.IsZero() {
+			liquidityFee = GetSwapFee(liquidityFee, to, pool, pmtpCurrentRunningRate, swapFeeRate)
+		}
+		liquidityFee = liquidityFee.Add(lp)
+		amount = emitAmount
+		priceImpact = priceImpact.Add(ts)
+		touchedPools = append(touchedPools, &finalPool)
+	}
+
+	if amount.LT(msg.MinReceivingAmount) {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSwapFailed,
+				sdk.NewAttribute(types.AttributeKeySwapAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyThreshold, msg.MinReceivingAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+			),
+		})
+		return &types.MsgSwapRouteResponse{}, types.ErrReceivedAmountBelowExpected
+	}
+	receivedAmountInt, ok := k.Keeper.ParseToInt(amount.String())
+	if !ok {
+		return nil, types.ErrUnableToParseInt
+	}
+	receivedCoin := sdk.NewCoin(msg.Assets[len(msg.Assets)-1].Symbol, receivedAmountInt)
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, accAddr, sdk.NewCoins(receivedCoin))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSwap, err.Error())
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSwap,
+			sdk.NewAttribute(types.AttributeKeySwapAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidityFee, liquidityFee.String()),
+			sdk.NewAttribute(types.AttributeKeyPriceImpact, priceImpact.String()),
+			sdk.NewAttribute(types.AttributePmtpBlockRate, k.GetPmtpRateParams(ctx).PmtpPeriodBlockRate.String()),
+			sdk.NewAttribute(types.AttributePmtpCurrentRunningRate, pmtpCurrentRunningRate.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+	})
+
+	if k.GetLiquidityProtectionParams(ctx).IsActive {
+		if types.StringCompare(sAsset.Denom, types.NativeSymbol) {
+			// selling rowan
+			discountedSentAmount := CalculateDiscountedSentAmount(msg.SentAmount, swapFeeRate)
+			k.MustUpdateLiquidityProtectionThreshold(ctx, true, discountedSentAmount, price)
+		}
+
+		if types.StringCompare(rAsset.Denom, types.NativeSymbol) {
+			// buying rowan
+			k.MustUpdateLiquidityProtectionThreshold(ctx, false, amount, price)
+		}
+	}
+
+	// queued removals are served from the pools the route left healthier, once per pool
+	for _, symbol := range poolSymbols {
+		finalPool, err := k.Keeper.GetPool(ctx, symbol)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, symbol)
+		}
+		k.Keeper.ProcessRemovalQueueAfterSwap(ctx, initialPools[symbol], finalPool)
+	}
+
+	return &types.MsgSwapRouteResponse{
+		ReceivedAmount: amount,
+		LiquidityFee:   liquidityFee,
+		PriceImpact:    priceImpact,
+		Pools:          touchedPools,
+	}, nil
+}
+
+func (k msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.CheckLimitOrderPermissions(ctx, *msg.SentAsset, *msg.ReceivedAsset)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ExpiryHeight <= ctx.BlockHeight() {
+		return nil, sdkerrors.Wrap(types.ErrInvalidLimitOrder, "expiry height must be in the future")
+	}
+	if msg.ExpiryHeight-ctx.BlockHeight() > types.MaxLimitOrderLifetime {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "expiry height must be at most %d blocks away", types.MaxLimitOrderLifetime)
+	}
+	order := types.LimitOrder{SentAsset: msg.SentAsset, ReceivedAsset: msg.ReceivedAsset}
+	pool, err := k.Keeper.GetPool(ctx, LimitOrderPool(order))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrPoolDoesNotExist, LimitOrderPool(order))
+	}
+	// orders too small to be worth executing would only weigh on the EndBlocker
+	value := msg.SentAmount
+	if !msg.SentAsset.IsSettlementAsset() {
+		_, priceExternal, err := CalcPoolPrices(&pool, k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidLimitOrder, err.Error())
+		}
+		value = CalcRowanValue(msg.SentAmount, priceExternal)
+	}
+	minValue := sdk.NewUintFromString(types.MinLimitOrderValue)
+	if value.LT(minValue) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidLimitOrder, "order worth %s rowan, must be worth at least %s", value, minValue)
+	}
+
+	order, err = k.Keeper.AddLimitOrder(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidLimitOrder, err.Error())
+	}
+	emitLimitOrder(ctx, types.EventTypePlaceLimitOrder, &order)
+	ctx.EventManager().EmitEvent(CreateEventMsg(msg.Signer))
+
+	return &types.MsgPlaceLimitOrderResponse{Id: order.Id}, nil
+}
+
+func (k msgServer) CancelLimitOrder(goCtx context.Context, msg *types.MsgCancelLimitOrder) (*types.MsgCancelLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order, err := k.Keeper.GetLimitOrder(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if order.Owner != msg.Signer {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the owner can cancel a limit order")
+	}
+	err = k.Keeper.RefundLimitOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	emitLimitOrder(ctx, types.EventTypeCancelLimitOrder, &order)
+	ctx.EventManager().EmitEvent(CreateEventMsg(msg.Signer))
+
+	return &types.MsgCancelLimitOrderResponse{}, nil
+}
+
+func (k msgServer) ClaimRewards(goCtx context.Context, msg *types.MsgClaimRewards) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	lp, err := k.Keeper.GetLiquidityProvider(ctx, msg.ExternalAsset.Symbol, msg.Signer)
+	if err != nil {
+		return nil, types.ErrLiquidityProviderDoesNotExist
+	}
+	rewards, err := k.Keeper.ClaimLiquidityProviderRewards(ctx, &pool, &lp)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.SetPool(ctx, &pool)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	k.Keeper.SetLiquidityProvider(ctx, &lp)
+	ctx.EventManager().EmitEvent(CreateEventMsg(msg.Signer))
+
+	return &types.MsgClaimRewardsResponse{Amount: rewards}, nil
+}
+
+func (k msgServer) TransferLiquidity(goCtx context.Context, msg *types.MsgTransferLiquidity) (*types.MsgTransferLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pool, err := k.Keeper.GetPool(ctx, msg.ExternalAsset.Symbol)
+	if err != nil {
+		return nil, types.ErrPoolDoesNotExist
+	}
+	receiverAddr, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	signerAddr, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.TransferLiquidityProviderUnits(ctx, pool.ExternalAsset.Symbol, signerAddr, receiverAddr, msg.Units)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.SendPoolShares(ctx, pool.ExternalAsset.Symbol, signerAddr, receiverAddr, msg.Units)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferLiquidity,
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+			sdk.NewAttribute(types.AttributeKeyUnits, msg.Units.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+		CreateEventMsg(msg.Signer),
+	})
+	return &types.MsgTransferLiquidityResponse{}, nil
+}
+
+func (k msgServer) CancelRemovalRequest(goCtx context.Context, msg *types.MsgCancelRemovalRequest) (*types.MsgCancelRemovalRequestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	request, err := k.Keeper.GetRemovalRequest(ctx, msg.ExternalAsset.Symbol, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.CancelQueuedRemoval(ctx, request)
+	ctx.EventManager().EmitEvent(CreateEventMsg(msg.Signer))
+
+	return &types.MsgCancelRemovalRequestResponse{}, nil
 }
 
 func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidity) (*types.MsgAddLiquidityResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
 
-	nAsset, err := k.tokenRegistryKeeper.GetEntry(registry, types.NativeSymbol)
+	nAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, types.NativeSymbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
 
-	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	eAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.ExternalAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
@@ -752,12 +1087,18 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 		),
 	})
 
+	res := &types.MsgAddLiquidityResponse{
+		UnitsMinted:         lpUnits,
+		NativeAssetAmount:   msg.NativeAssetAmount,
+		ExternalAssetAmount: msg.ExternalAssetAmount,
+	}
+
 	// Skip when queueing is disabled, and for pools that are not margin enabled.
 	if !k.GetMarginKeeper().IsPoolEnabled(ctx, msg.ExternalAsset.Symbol) || !k.IsRemovalQueueEnabled(ctx) {
-		return &types.MsgAddLiquidityResponse{}, nil
+		return res, nil
 	}
 	if k.GetRemovalQueue(ctx, msg.ExternalAsset.Symbol).Count > 0 {
-		k.ProcessRemovalQueue(ctx, msg, newPoolUnits)
+		k.ProcessRemovalQueue(ctx, msg.ExternalAsset, lpUnits)
 	}
 
 	// res, stop := k.SingleExternalBalanceModuleAccountCheck(msg.ExternalAsset.Symbol)(ctx)
@@ -765,13 +1106,12 @@ func (k msgServer) AddLiquidity(goCtx context.Context, msg *types.MsgAddLiquidit
 	// 	return nil, sdkerrors.Wrap(types.ErrBalanceModuleAccountCheck, res)
 	// }
 
-	return &types.MsgAddLiquidityResponse{}, nil
+	return res, nil
 }
 
 func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRemoveLiquidityUnits) (*types.MsgRemoveLiquidityUnitsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	eAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.ExternalAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
@@ -821,13 +1161,20 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 		futurePool.ExternalAssetBalance = futurePool.ExternalAssetBalance.Sub(withdrawExternalAssetAmount)
 		if k.GetMarginKeeper().CalculatePoolHealth(&futurePool).LT(k.GetMarginKeeper().GetRemovalQueueThreshold(ctx)) {
 			if k.IsRemovalQueueEnabled(ctx) {
-				k.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
+				request := k.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
 					Signer:        msg.Signer,
 					ExternalAsset: msg.ExternalAsset,
 					WBasisPoints:  ConvUnitsToWBasisPoints(lp.LiquidityProviderUnits, msg.WithdrawUnits),
 					Asymmetry:     sdk.ZeroInt(),
-				}, extRowanValue.Add(withdrawNativeAssetAmount))
-				return nil, types.ErrQueued
+				}, msg.WithdrawUnits, extRowanValue.Add(withdrawNativeAssetAmount))
+				// succeed so the queued request is committed with the transaction
+				return &types.MsgRemoveLiquidityUnitsResponse{
+					UnitsBurned:         sdk.ZeroUint(),
+					NativeAssetAmount:   sdk.ZeroUint(),
+					ExternalAssetAmount: sdk.ZeroUint(),
+					Queued:              true,
+					RemovalRequestId:    request.Id,
+				}, nil
 			}
 			return nil, types.ErrRemovalsBlockedByHealth
 		}
@@ -874,13 +1221,16 @@ func (k msgServer) RemoveLiquidityUnits(goCtx context.Context, msg *types.MsgRem
 	// 	return nil, sdkerrors.Wrap(types.ErrBalanceModuleAccountCheck, res)
 	// }
 
-	return &types.MsgRemoveLiquidityUnitsResponse{}, nil
+	return &types.MsgRemoveLiquidityUnitsResponse{
+		UnitsBurned:         lp.LiquidityProviderUnits.Sub(lpUnitsLeft),
+		NativeAssetAmount:   sdk.NewUintFromBigInt(nativeAssetCoin.Amount.BigInt()),
+		ExternalAssetAmount: sdk.NewUintFromBigInt(externalAssetCoin.Amount.BigInt()),
+	}, nil
 }
 
 func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLiquidity) (*types.MsgRemoveLiquidityResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	registry := k.tokenRegistryKeeper.GetRegistry(ctx)
-	eAsset, err := k.tokenRegistryKeeper.GetEntry(registry, msg.ExternalAsset.Symbol)
+	eAsset, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.ExternalAsset.Symbol)
 	if err != nil {
 		return nil, types.ErrTokenNotSupported
 	}
@@ -937,8 +1287,15 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 		futurePool.ExternalAssetBalance = futurePool.ExternalAssetBalance.Sub(withdrawExternalAssetAmount)
 		if k.GetMarginKeeper().CalculatePoolHealth(&futurePool).LT(k.GetMarginKeeper().GetRemovalQueueThreshold(ctx)) {
 			if k.IsRemovalQueueEnabled(ctx) {
-				k.QueueRemoval(ctx, msg, extRowanValue.Add(withdrawExternalAssetAmount))
-				return nil, types.ErrQueued
+				request := k.QueueRemoval(ctx, msg, ConvWBasisPointsToExactUnits(lp.LiquidityProviderUnits, msg.WBasisPoints), extRowanValue.Add(withdrawExternalAssetAmount))
+				// succeed so the queued request is committed with the transaction
+				return &types.MsgRemoveLiquidityResponse{
+					UnitsBurned:         sdk.ZeroUint(),
+					NativeAssetAmount:   sdk.ZeroUint(),
+					ExternalAssetAmount: sdk.ZeroUint(),
+					Queued:              true,
+					RemovalRequestId:    request.Id,
+				}, nil
 			}
 			return nil, types.ErrRemovalsBlockedByHealth
 		}
@@ -1034,7 +1391,11 @@ func (k msgServer) RemoveLiquidity(goCtx context.Context, msg *types.MsgRemoveLi
 	// 	return nil, sdkerrors.Wrap(types.ErrBalanceModuleAccountCheck, res)
 	// }
 
-	return &types.MsgRemoveLiquidityResponse{}, nil
+	return &types.MsgRemoveLiquidityResponse{
+		UnitsBurned:         lp.LiquidityProviderUnits.Sub(lpUnitsLeft),
+		NativeAssetAmount:   sdk.NewUintFromBigInt(nativeAssetCoin.Amount.BigInt()),
+		ExternalAssetAmount: sdk.NewUintFromBigInt(externalAssetCoin.Amount.BigInt()),
+	}, nil
 }
 
 func (k msgServer) UpdateLiquidityProtectionParams(goCtx context.Context, msg *types.MsgUpdateLiquidityProtectionParams) (*types.MsgUpdateLiquidityProtectionParamsResponse, error) {
@@ -1047,11 +1408,15 @@ func (k msgServer) UpdateLiquidityProtectionParams(goCtx context.Context, msg *t
 	if !k.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_CLPDEX, signer) {
 		return response, errors.Wrap(types.ErrNotEnoughPermissions, fmt.Sprintf("Sending Account : %s", msg.Signer))
 	}
+	if msg.TwapWindow > types.MaxTwapWindow {
+		return response, sdkerrors.Wrapf(types.ErrInvalidTwapWindow, "must not exceed %d blocks: %d", types.MaxTwapWindow, msg.TwapWindow)
+	}
 	params := k.GetLiquidityProtectionParams(ctx)
 	params.MaxRowanLiquidityThreshold = msg.MaxRowanLiquidityThreshold
 	params.MaxRowanLiquidityThresholdAsset = msg.MaxRowanLiquidityThresholdAsset
 	params.EpochLength = msg.EpochLength
 	params.IsActive = msg.IsActive
+	params.TwapWindow = msg.TwapWindow
 	k.SetLiquidityProtectionParams(ctx, params)
 	k.SetLiquidityProtectionCurrentRowanLiquidityThreshold(ctx, params.MaxRowanLiquidityThreshold)
 	ctx.EventManager().EmitEvents(sdk.Events{
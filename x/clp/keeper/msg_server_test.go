@@ -832,6 +832,171 @@ func TestMsgServer_Swap(t *testing.T) {
 	}
 }
 
+func TestMsgServer_SwapRoute(t *testing.T) {
+	address := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	route := []*types.Asset{{Symbol: "eth"}, {Symbol: "rowan"}, {Symbol: "cusdc"}}
+	swapFeeParams := types.SwapFeeParams{
+		DefaultSwapFeeRate: sdk.NewDecWithPrec(3, 3),
+		TokenParams: []*types.SwapFeeTokenParams{
+			{Asset: "rowan", SwapFeeRate: sdk.NewDecWithPrec(1, 3)},
+		},
+	}
+	testcases := []struct {
+		name            string
+		ethPermissions  []tokenregistrytypes.Permission
+		createCusdcPool bool
+		msg             *types.MsgSwapRoute
+		err             error
+	}{
+		{
+			name:            "successful two hop route",
+			ethPermissions:  []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+			createCusdcPool: true,
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             route,
+				SentAmount:         sdk.NewUint(10000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+		},
+		{
+			name:            "sent asset not allowed to be sold",
+			ethPermissions:  []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP, tokenregistrytypes.Permission_DISABLE_SELL},
+			createCusdcPool: true,
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             route,
+				SentAmount:         sdk.NewUint(10000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			err: tokenregistrytypes.ErrNotAllowedToSellAsset,
+		},
+		{
+			name:            "pool for last hop does not exist",
+			ethPermissions:  []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+			createCusdcPool: false,
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             route,
+				SentAmount:         sdk.NewUint(10000),
+				MinReceivingAmount: sdk.NewUint(1),
+			},
+			err: types.ErrPoolDoesNotExist,
+		},
+		{
+			name:            "received amount below expected",
+			ethPermissions:  []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+			createCusdcPool: true,
+			msg: &types.MsgSwapRoute{
+				Signer:             address,
+				Assets:             route,
+				SentAmount:         sdk.NewUint(10000),
+				MinReceivingAmount: sdk.NewUint(100000),
+			},
+			err: types.ErrReceivedAmountBelowExpected,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+				trGs := &tokenregistrytypes.GenesisState{
+					Registry: &tokenregistrytypes.Registry{
+						Entries: []*tokenregistrytypes.RegistryEntry{
+							{Denom: "eth", BaseDenom: "eth", Decimals: 18, Permissions: tc.ethPermissions},
+							{Denom: "cusdc", BaseDenom: "cusdc", Decimals: 6, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+							{Denom: "rowan", BaseDenom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}},
+						},
+					},
+				}
+				bz, _ := app.AppCodec().MarshalJSON(trGs)
+				genesisState["tokenregistry"] = bz
+
+				pools := []*types.Pool{
+					{
+						ExternalAsset:        &types.Asset{Symbol: "eth"},
+						NativeAssetBalance:   sdk.NewUint(1000000),
+						ExternalAssetBalance: sdk.NewUint(1000000),
+						PoolUnits:            sdk.NewUint(1000000),
+					},
+				}
+				clpCoins := sdk.Coins{sdk.NewCoin("eth", sdk.NewInt(1000000)), sdk.NewCoin("rowan", sdk.NewInt(1000000))}
+				if tc.createCusdcPool {
+					pools = append(pools, &types.Pool{
+						ExternalAsset:        &types.Asset{Symbol: "cusdc"},
+						NativeAssetBalance:   sdk.NewUint(1000000),
+						ExternalAssetBalance: sdk.NewUint(2000000),
+						PoolUnits:            sdk.NewUint(1000000),
+					})
+					clpCoins = sdk.Coins{sdk.NewCoin("cusdc", sdk.NewInt(2000000)), sdk.NewCoin("eth", sdk.NewInt(1000000)), sdk.NewCoin("rowan", sdk.NewInt(2000000))}
+				}
+
+				bankGs := banktypes.DefaultGenesisState()
+				bankGs.Balances = append(bankGs.Balances,
+					banktypes.Balance{Address: address, Coins: sdk.Coins{sdk.NewCoin("eth", sdk.NewInt(10000))}},
+					banktypes.Balance{Address: app.AccountKeeper.GetModuleAddress("clp").String(), Coins: clpCoins},
+				)
+				bz, _ = app.AppCodec().MarshalJSON(bankGs)
+				genesisState["bank"] = bz
+
+				clpGs := types.DefaultGenesisState()
+				clpGs.Params = types.Params{
+					MinCreatePoolThreshold: 100,
+				}
+				clpGs.PoolList = append(clpGs.PoolList, pools...)
+				bz, _ = app.AppCodec().MarshalJSON(clpGs)
+				genesisState["clp"] = bz
+
+				return genesisState
+			})
+
+			app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.NewDec(0))
+			app.ClpKeeper.SetSwapFeeParams(ctx, &swapFeeParams)
+
+			ethPool, _ := app.ClpKeeper.GetPool(ctx, "eth")
+			cusdcPool, _ := app.ClpKeeper.GetPool(ctx, "cusdc")
+
+			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+			// a two hop route pays what the same swap through Swap pays
+			cacheCtx, _ := ctx.CacheContext()
+			swapRes, swapErr := msgServer.Swap(sdk.WrapSDKContext(cacheCtx), &types.MsgSwap{
+				Signer: address, SentAsset: route[0], ReceivedAsset: route[2], SentAmount: tc.msg.SentAmount, MinReceivingAmount: tc.msg.MinReceivingAmount,
+			})
+			res, err := msgServer.SwapRoute(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, swapErr)
+			require.Equal(t, swapRes.ReceivedAmount.String(), res.ReceivedAmount.String())
+
+			// every hop uses the swap fee rate of the sent asset
+			rowanAmount, _, _, expectedEthPool, err := clpkeeper.SwapOne(types.NewAsset("eth"), tc.msg.SentAmount, types.GetSettlementAsset(), ethPool, sdk.ZeroDec(), swapFeeParams.DefaultSwapFeeRate)
+			require.NoError(t, err)
+			cusdcAmount, _, _, expectedCusdcPool, err := clpkeeper.SwapOne(types.GetSettlementAsset(), rowanAmount, types.NewAsset("cusdc"), cusdcPool, sdk.ZeroDec(), swapFeeParams.DefaultSwapFeeRate)
+			require.NoError(t, err)
+
+			ethPool, _ = app.ClpKeeper.GetPool(ctx, "eth")
+			cusdcPool, _ = app.ClpKeeper.GetPool(ctx, "cusdc")
+			require.Equal(t, expectedEthPool.NativeAssetBalance.String(), ethPool.NativeAssetBalance.String())
+			require.Equal(t, expectedEthPool.ExternalAssetBalance.String(), ethPool.ExternalAssetBalance.String())
+			require.Equal(t, expectedCusdcPool.NativeAssetBalance.String(), cusdcPool.NativeAssetBalance.String())
+			require.Equal(t, expectedCusdcPool.ExternalAssetBalance.String(), cusdcPool.ExternalAssetBalance.String())
+
+			signer, _ := sdk.AccAddressFromBech32(address)
+			require.Equal(t, "0", app.BankKeeper.GetBalance(ctx, signer, "eth").Amount.String())
+			require.Equal(t, cusdcAmount.String(), app.BankKeeper.GetBalance(ctx, signer, "cusdc").Amount.String())
+			require.True(t, cusdcAmount.GT(sdk.ZeroUint()))
+			require.Equal(t, cusdcAmount.String(), res.ReceivedAmount.String())
+			require.Len(t, res.Pools, 2)
+			require.Equal(t, ethPool.String(), res.Pools[0].String())
+			require.Equal(t, cusdcPool.String(), res.Pools[1].String())
+		})
+	}
+}
+
 func TestMsgServer_RemoveLiquidity(t *testing.T) {
 	testcases := []struct {
 		name                   string
@@ -1045,10 +1210,15 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 			})
 
 			app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.NewDec(1))
+			if tc.createPool && tc.createLPs {
+				err := app.ClpKeeper.MintPoolShares(ctx, tc.poolAsset, sdk.MustAccAddressFromBech32(tc.address), tc.nativeAssetAmount)
+				require.NoError(t, err)
+			}
 
 			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
 
-			_, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), tc.msg)
+			lpBefore, _ := app.ClpKeeper.GetLiquidityProvider(ctx, tc.poolAsset, tc.address)
+			res, err := msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.errString != nil {
 				require.EqualError(t, err, tc.errString.Error())
@@ -1059,6 +1229,13 @@ func TestMsgServer_RemoveLiquidity(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+
+			lpAfter, err := app.ClpKeeper.GetLiquidityProvider(ctx, tc.poolAsset, tc.address)
+			unitsLeft := sdk.ZeroUint()
+			if err == nil {
+				unitsLeft = lpAfter.LiquidityProviderUnits
+			}
+			require.Equal(t, lpBefore.LiquidityProviderUnits.Sub(unitsLeft).String(), res.UnitsBurned.String())
 		})
 	}
 }
@@ -1254,7 +1431,7 @@ func TestMsgServer_CreatePool(t *testing.T) {
 
 			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
 
-			_, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), tc.msg)
+			res, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.errString != nil {
 				require.EqualError(t, err, tc.errString.Error())
@@ -1265,6 +1442,10 @@ func TestMsgServer_CreatePool(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+
+			lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, tc.msg.ExternalAsset.Symbol, tc.msg.Signer)
+			require.NoError(t, err)
+			require.Equal(t, lp.LiquidityProviderUnits.String(), res.UnitsMinted.String())
 		})
 	}
 }
@@ -1804,7 +1985,11 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 
 			msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
 
-			_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), tc.msg)
+			lpUnitsBefore := sdk.ZeroUint()
+			if lpBefore, err := app.ClpKeeper.GetLiquidityProvider(ctx, tc.poolAsset, tc.address); err == nil {
+				lpUnitsBefore = lpBefore.LiquidityProviderUnits
+			}
+			res, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), tc.msg)
 
 			if tc.errString != nil {
 				require.EqualError(t, err, tc.errString.Error())
@@ -1821,6 +2006,9 @@ func TestMsgServer_AddLiquidity(t *testing.T) {
 
 			require.Equal(t, tc.expectedPoolUnits.String(), pool.PoolUnits.String()) // compare strings so that the expected amounts can be read from the failure message
 			require.Equal(t, tc.expectedLPUnits.String(), lp.LiquidityProviderUnits.String())
+			require.Equal(t, lp.LiquidityProviderUnits.Sub(lpUnitsBefore).String(), res.UnitsMinted.String())
+			require.Equal(t, tc.msg.NativeAssetAmount.String(), res.NativeAssetAmount.String())
+			require.Equal(t, tc.msg.ExternalAssetAmount.String(), res.ExternalAssetAmount.String())
 
 			updatedThreshold := app.ClpKeeper.GetLiquidityProtectionRateParams(ctx).CurrentRowanLiquidityThreshold
 			require.Equal(t, tc.expectedUpdatedRowanLiquidityThreshold.String(), updatedThreshold.String())
@@ -1880,3 +2068,179 @@ func TestMsgServer_AddProviderDistribution(t *testing.T) {
 	require.Equal(t, 1, len(cbp.DistributionPeriods))
 	require.Equal(t, *cbp.DistributionPeriods[0], validPeriod)
 }
+
+func TestMsgServer_DecommissionPool_RewardDust(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	admin := test.GenerateAddress(test.AddressKey1)
+	app.ClpKeeper.SetClpWhiteList(ctx, []sdk.AccAddress{admin})
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(300), sdk.NewUint(300), sdk.NewUint(3))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(310)), sdk.NewCoin(asset.Symbol, sdk.NewInt(300)))))
+	lps := []sdk.AccAddress{admin, test.GenerateAddress(test.AddressKey2), test.GenerateAddress(test.AddressKey3)}
+	for _, lp := range lps {
+		_, err := app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1), lp)
+		require.NoError(t, err)
+	}
+	// 10 rowan over 3 units leaves 1 rowan no liquidity provider can claim
+	require.True(t, clpkeeper.AccrueRewards(&pool, sdk.NewUint(10)))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	startingSupply := app.BankKeeper.GetSupply(ctx, types.NativeSymbol)
+
+	_, err := msgServer.DecommissionPool(sdk.WrapSDKContext(ctx), &types.MsgDecommissionPool{Signer: admin.String(), Symbol: asset.Symbol})
+	require.NoError(t, err)
+	for _, lp := range lps {
+		require.Equal(t, sdk.NewInt(103), app.BankKeeper.GetBalance(ctx, lp, types.NativeSymbol).Amount)
+	}
+	require.True(t, app.ClpKeeper.GetModuleRowan(ctx).IsZero())
+	require.Equal(t, startingSupply.Amount.SubRaw(1), app.BankKeeper.GetSupply(ctx, types.NativeSymbol).Amount)
+	require.True(t, app.ClpKeeper.GetPoolShareSupply(ctx, asset.Symbol).IsZero())
+}
+
+func TestMsgServer_TransferLiquidity(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	ctx = ctx.WithBlockHeight(10)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer := test.GenerateAddress(test.AddressKey1)
+	receiver := test.GenerateAddress(test.AddressKey2)
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.NewUint(1000))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(1000), signer)
+	lp.Unlocks = []*types.LiquidityUnlock{
+		{RequestHeight: 9, Units: sdk.NewUint(300)},
+		{RequestHeight: 10, Units: sdk.NewUint(400)},
+	}
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	require.NoError(t, app.ClpKeeper.MintPoolShares(ctx, asset.Symbol, signer, lp.LiquidityProviderUnits))
+	app.ClpKeeper.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
+		Signer:        signer.String(),
+		ExternalAsset: &asset,
+		WBasisPoints:  sdk.NewInt(1000),
+		Asymmetry:     sdk.ZeroInt(),
+	}, sdk.NewUint(100), sdk.NewUint(200))
+
+	// rewards accrued before the transfer are paid to the signer
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(500)))))
+	require.True(t, clpkeeper.AccrueRewards(&pool, sdk.NewUint(500)))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+
+	// units queued for removal cannot be transferred
+	transfer := types.NewMsgTransferLiquidity(signer, asset, receiver, sdk.NewUint(950))
+	_, err := msgServer.TransferLiquidity(sdk.WrapSDKContext(ctx), &transfer)
+	require.ErrorIs(t, err, types.ErrUnableToTransferLiquidity)
+
+	transfer = types.NewMsgTransferLiquidity(signer, asset, receiver, sdk.NewUint(600))
+	_, err = msgServer.TransferLiquidity(sdk.WrapSDKContext(ctx), &transfer)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, signer, types.NativeSymbol).Amount)
+
+	signerLp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(400), signerLp.LiquidityProviderUnits)
+	require.Equal(t, []*types.LiquidityUnlock{
+		{RequestHeight: 9, Units: sdk.NewUint(300)},
+		{RequestHeight: 10, Units: sdk.NewUint(100)},
+	}, signerLp.Unlocks)
+	require.Equal(t, sdk.NewUint(100), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, signerLp))
+
+	receiverLp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, receiver.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(600), receiverLp.LiquidityProviderUnits)
+	require.Equal(t, []*types.LiquidityUnlock{
+		{RequestHeight: 10, Units: sdk.NewUint(300)},
+	}, receiverLp.Unlocks)
+	require.True(t, clpkeeper.PendingRewards(pool, receiverLp).IsZero())
+
+	// a second transfer merges into the receiver's position
+	transfer = types.NewMsgTransferLiquidity(signer, asset, receiver, sdk.NewUint(300))
+	_, err = msgServer.TransferLiquidity(sdk.WrapSDKContext(ctx), &transfer)
+	require.NoError(t, err)
+
+	signerLp, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(100), signerLp.LiquidityProviderUnits)
+	require.Equal(t, []*types.LiquidityUnlock{
+		{RequestHeight: 9, Units: sdk.NewUint(100)},
+	}, signerLp.Unlocks)
+	require.Equal(t, sdk.NewUint(100), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, signerLp))
+
+	receiverLp, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, receiver.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(900), receiverLp.LiquidityProviderUnits)
+	require.Equal(t, []*types.LiquidityUnlock{
+		{RequestHeight: 9, Units: sdk.NewUint(200)},
+		{RequestHeight: 10, Units: sdk.NewUint(300)},
+		{RequestHeight: 10, Units: sdk.NewUint(100)},
+	}, receiverLp.Unlocks)
+
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, signer, types.GetPoolShareDenom(asset.Symbol)).Amount)
+	require.Equal(t, sdk.NewInt(900), app.BankKeeper.GetBalance(ctx, receiver, types.GetPoolShareDenom(asset.Symbol)).Amount)
+
+	storedPool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(1000), storedPool.PoolUnits)
+	_, stop := app.ClpKeeper.UnitsCheck()(ctx)
+	require.False(t, stop)
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+
+	// the receiver can remove the units it was given, burning the shares that came with them
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(1000)), sdk.NewCoin(asset.Symbol, sdk.NewInt(1000)))))
+	remove := types.NewMsgRemoveLiquidityUnits(receiver, asset, sdk.NewUint(500))
+	_, err = msgServer.RemoveLiquidityUnits(sdk.WrapSDKContext(ctx), &remove)
+	require.NoError(t, err)
+	receiverLp, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, receiver.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(400), receiverLp.LiquidityProviderUnits)
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, receiver, types.GetPoolShareDenom(asset.Symbol)).Amount)
+	require.Equal(t, sdk.NewInt(500), app.BankKeeper.GetBalance(ctx, receiver, asset.Symbol).Amount)
+	_, stop = app.ClpKeeper.UnitsCheck()(ctx)
+	require.False(t, stop)
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+}
+
+func TestMsgServer_CancelRemovalRequest(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	msgServer := clpkeeper.NewMsgServerImpl(app.ClpKeeper)
+	signer := test.GenerateAddress(test.AddressKey1)
+	other := test.GenerateAddress(test.AddressKey2)
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.NewUint(1000))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(1000), signer)
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	for _, value := range []uint64{200, 300} {
+		app.ClpKeeper.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
+			Signer:        signer.String(),
+			ExternalAsset: &asset,
+			WBasisPoints:  sdk.NewInt(1000),
+			Asymmetry:     sdk.ZeroInt(),
+		}, sdk.NewUint(100), sdk.NewUint(value))
+	}
+	require.Equal(t, sdk.NewUint(200), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lp))
+
+	// only the signer's own requests in the pool can be cancelled
+	msg := types.NewMsgCancelRemovalRequest(other, asset, 1)
+	_, err := msgServer.CancelRemovalRequest(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrRemovalRequestNotFound)
+	msg = types.NewMsgCancelRemovalRequest(signer, types.NewAsset("cusdc"), 1)
+	_, err = msgServer.CancelRemovalRequest(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrRemovalRequestNotFound)
+	msg = types.NewMsgCancelRemovalRequest(signer, asset, 3)
+	_, err = msgServer.CancelRemovalRequest(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrRemovalRequestNotFound)
+
+	msg = types.NewMsgCancelRemovalRequest(signer, asset, 1)
+	_, err = msgServer.CancelRemovalRequest(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	queue := app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, int64(1), queue.Count)
+	require.Equal(t, sdk.NewUint(300), queue.TotalValue)
+	require.Equal(t, sdk.NewUint(100), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lp))
+
+	// a cancelled request cannot be cancelled again
+	_, err = msgServer.CancelRemovalRequest(sdk.WrapSDKContext(ctx), &msg)
+	require.ErrorIs(t, err, types.ErrRemovalRequestNotFound)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"strings"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// PoolShareBankKeeper is the bank keeper of the app. Pool shares sent between accounts move the LP units they back,
+// so ownership of the units always follows the pool share balance. Pool shares cannot be sent to or from module
+// accounts, the clp keeper mints and burns them through the base bank keeper
+type PoolShareBankKeeper struct {
+	bankkeeper.Keeper
+	getClpKeeper func() Keeper
+}
+
+var _ bankkeeper.Keeper = PoolShareBankKeeper{}
+
+func NewPoolShareBankKeeper(bankKeeper bankkeeper.Keeper, getClpKeeper func() Keeper) PoolShareBankKeeper {
+	return PoolShareBankKeeper{
+		Keeper:       bankKeeper,
+		getClpKeeper: getClpKeeper,
+	}
+}
+
+func isPoolShareDenom(denom string) bool {
+	return strings.HasPrefix(denom, types.PoolShareDenomPrefix)
+}
+
+func hasPoolShares(amt sdk.Coins) bool {
+	for _, coin := range amt {
+		if isPoolShareDenom(coin.Denom) {
+			return true
+		}
+	}
+	return false
+}
+
+// SendCoins sends amt and moves the LP units of the pool shares in amt from fromAddr to toAddr
+func (k PoolShareBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	return k.transferPoolShareUnits(ctx, fromAddr, toAddr, amt)
+}
+
+// InputOutputCoins only moves pool shares out of a single input, the units of the input are split across the outputs
+func (k PoolShareBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	poolShares := false
+	for _, input := range inputs {
+		poolShares = poolShares || hasPoolShares(input.Coins)
+	}
+	if !poolShares {
+		return k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	}
+	if len(inputs) != 1 {
+		return sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, "pool shares can only be sent from a single input")
+	}
+	err := k.Keeper.InputOutputCoins(ctx, inputs, outputs)
+	if err != nil {
+		return err
+	}
+	fromAddr, err := sdk.AccAddressFromBech32(inputs[0].Address)
+	if err != nil {
+		return err
+	}
+	for _, output := range outputs {
+		toAddr, err := sdk.AccAddressFromBech32(output.Address)
+		if err != nil {
+			return err
+		}
+		err = k.transferPoolShareUnits(ctx, fromAddr, toAddr, output.Coins)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k PoolShareBankKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if hasPoolShares(amt) {
+		return sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, "pool shares cannot be sent from a module account")
+	}
+	return k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
+
+func (k PoolShareBankKeeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	if hasPoolShares(amt) {
+		return sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, "pool shares cannot be sent from a module account")
+	}
+	return k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+}
+
+func (k PoolShareBankKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	if hasPoolShares(amt) {
+		return sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, "pool shares cannot be sent to a module account")
+	}
+	return k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+}
+
+func (k PoolShareBankKeeper) transferPoolShareUnits(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if fromAddr.Equals(toAddr) {
+		return nil
+	}
+	clpKeeper := k.getClpKeeper()
+	for _, coin := range amt {
+		if !isPoolShareDenom(coin.Denom) {
+			continue
+		}
+		symbol := strings.TrimPrefix(coin.Denom, types.PoolShareDenomPrefix)
+		units := sdk.NewUintFromBigInt(coin.Amount.BigInt())
+		err := clpKeeper.TransferLiquidityProviderUnits(ctx, symbol, fromAddr, toAddr, units)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The units of every pool are represented by bank coins of denom clp/<external asset>. They are minted to liquidity
+// providers when their units are created and burnt from them when their units are removed, so the supply of a pool
+// share denom always equals the units of the pool. Pool shares can be sent like any other bank coin, sends through
+// the app bank keeper (see PoolShareBankKeeper) move the LP units with them so the LiquidityProvider record of an
+// account always matches its pool share balance.
+
+func newPoolShareCoins(symbol string, units sdk.Uint) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(symbol), sdk.NewIntFromBigInt(units.BigInt())))
+}
+
+// MintPoolShares mints units of the pool share denom of symbol to addr
+func (k Keeper) MintPoolShares(ctx sdk.Context, symbol string, addr sdk.AccAddress, units sdk.Uint) error {
+	if units.IsZero() {
+		return nil
+	}
+	coins := newPoolShareCoins(symbol, units)
+	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+}
+
+// BurnPoolShares burns units of the pool share denom of symbol held by addr
+func (k Keeper) BurnPoolShares(ctx sdk.Context, symbol string, addr sdk.AccAddress, units sdk.Uint) error {
+	if units.IsZero() {
+		return nil
+	}
+	coins := newPoolShareCoins(symbol, units)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, coins)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInsufficientPoolShares, err.Error())
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// SendPoolShares moves units of the pool share denom of symbol from one account to another
+func (k Keeper) SendPoolShares(ctx sdk.Context, symbol string, from, to sdk.AccAddress, units sdk.Uint) error {
+	if units.IsZero() {
+		return nil
+	}
+	err := k.bankKeeper.SendCoins(ctx, from, to, newPoolShareCoins(symbol, units))
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInsufficientPoolShares, err.Error())
+	}
+	return nil
+}
+
+// GetPoolShareSupply returns the units of the pool of symbol in circulation as bank coins
+func (k Keeper) GetPoolShareSupply(ctx sdk.Context, symbol string) sdk.Uint {
+	supply := k.bankKeeper.GetSupply(ctx, types.GetPoolShareDenom(symbol))
+	return sdk.NewUintFromBigInt(supply.Amount.BigInt())
+}
+
+// TransferLiquidityProviderUnits moves units of the pool of symbol, with the unlock requests they back, from the
+// liquidity provider of from to the liquidity provider of to. Pending rewards of both are paid first. It only updates
+// the clp records, the caller moves the pool shares
+func (k Keeper) TransferLiquidityProviderUnits(ctx sdk.Context, symbol string, from, to sdk.AccAddress, units sdk.Uint) error {
+	pool, err := k.GetPool(ctx, symbol)
+	if err != nil {
+		return types.ErrPoolDoesNotExist
+	}
+	lp, err := k.GetLiquidityProvider(ctx, symbol, from.String())
+	if err != nil {
+		return types.ErrLiquidityProviderDoesNotExist
+	}
+
+	// units queued for removal stay with the sender, the queue keeps serving them
+	lpQueuedUnits := k.GetRemovalQueueUnitsForLP(ctx, lp)
+	if lpQueuedUnits.Add(units).GT(lp.LiquidityProviderUnits) {
+		return sdkerrors.Wrap(types.ErrUnableToTransferLiquidity, fmt.Sprintf("Units %s greater than total LP units %s minus queued removals", units, lp.LiquidityProviderUnits))
+	}
+
+	params := k.GetRewardsParams(ctx)
+	k.PruneUnlockRecords(ctx, &lp, params.LiquidityRemovalLockPeriod, params.LiquidityRemovalCancelPeriod)
+	// rewards accrued by the current units are paid before the units change
+	_, err = k.ClaimLiquidityProviderRewards(ctx, &pool, &lp)
+	if err != nil {
+		return err
+	}
+
+	receiver, err := k.GetLiquidityProvider(ctx, symbol, to.String())
+	if err != nil {
+		receiver = types.NewLiquidityProvider(pool.ExternalAsset, sdk.ZeroUint(), to)
+		checkpoint := getRewardPerUnit(&pool)
+		receiver.RewardPerUnitCheckpoint = &checkpoint
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCreateLiquidityProvider,
+			sdk.NewAttribute(types.AttributeKeyLiquidityProvider, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		))
+	} else {
+		k.PruneUnlockRecords(ctx, &receiver, params.LiquidityRemovalLockPeriod, params.LiquidityRemovalCancelPeriod)
+		_, err = k.ClaimLiquidityProviderRewards(ctx, &pool, &receiver)
+		if err != nil {
+			return err
+		}
+	}
+
+	lpUnitsLeft := lp.LiquidityProviderUnits.Sub(units)
+	// unlock requests the sender can no longer back with its units move with the units
+	transferredUnlocks := SplitUnlockRecords(&lp, lpUnitsLeft)
+	receiver.Unlocks = append(receiver.Unlocks, transferredUnlocks...)
+	sort.SliceStable(receiver.Unlocks, func(i, j int) bool {
+		return receiver.Unlocks[i].RequestHeight < receiver.Unlocks[j].RequestHeight
+	})
+	receiver.LiquidityProviderUnits = receiver.LiquidityProviderUnits.Add(units)
+
+	err = k.SetPool(ctx, &pool)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrUnableToSetPool, err.Error())
+	}
+	if lpUnitsLeft.IsZero() {
+		k.DestroyLiquidityProvider(ctx, lp.Asset.Symbol, lp.LiquidityProviderAddress)
+	} else {
+		lp.LiquidityProviderUnits = lpUnitsLeft
+		k.SetLiquidityProvider(ctx, &lp)
+	}
+	k.SetLiquidityProvider(ctx, &receiver)
+	return nil
+}
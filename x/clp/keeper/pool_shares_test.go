@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_PoolShares(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	msgServer := keeper.NewMsgServerImpl(app.ClpKeeper)
+	signer := test.GenerateAddress(test.AddressKey1)
+	asset := types.NewAsset("ceth")
+	shareDenom := types.GetPoolShareDenom(asset.Symbol)
+	require.Equal(t, "clp/ceth", shareDenom)
+	externalCoin := sdk.NewCoin(asset.Symbol, sdk.NewIntFromUint64(10000000000000000000))
+	nativeCoin := sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromUint64(10000000000000000000))
+	require.NoError(t, sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin, nativeCoin)))
+
+	amount := sdk.NewUint(1000000000000000000)
+	msgCreatePool := types.NewMsgCreatePool(signer, asset, amount, amount)
+	createRes, err := msgServer.CreatePool(sdk.WrapSDKContext(ctx), &msgCreatePool)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewIntFromBigInt(createRes.UnitsMinted.BigInt()), app.BankKeeper.GetBalance(ctx, signer, shareDenom).Amount)
+
+	msgAddLiquidity := types.NewMsgAddLiquidity(signer, asset, amount, amount)
+	addRes, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewIntFromBigInt(createRes.UnitsMinted.Add(addRes.UnitsMinted).BigInt()), app.BankKeeper.GetBalance(ctx, signer, shareDenom).Amount)
+	_, stop := app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+
+	// pool shares sent through the bank module move the units they back
+	other := test.GenerateAddress(test.AddressKey2)
+	shares := app.BankKeeper.GetBalance(ctx, signer, shareDenom)
+	sent := sdk.NewCoin(shareDenom, shares.Amount.QuoRaw(4))
+	msgSend := banktypes.NewMsgSend(signer, other, sdk.NewCoins(sent))
+	_, err = bankkeeper.NewMsgServerImpl(app.BankKeeper).Send(sdk.WrapSDKContext(ctx), msgSend)
+	require.NoError(t, err)
+	lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	require.Equal(t, shares.Amount.Sub(sent.Amount), sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.BigInt()))
+	require.Equal(t, sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.BigInt()), app.BankKeeper.GetBalance(ctx, signer, shareDenom).Amount)
+	otherLp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, other.String())
+	require.NoError(t, err)
+	require.Equal(t, sent.Amount, sdk.NewIntFromBigInt(otherLp.LiquidityProviderUnits.BigInt()))
+	require.Equal(t, sent.Amount, app.BankKeeper.GetBalance(ctx, other, shareDenom).Amount)
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+
+	// a multi send only moves pool shares out of a single input
+	inputs := []banktypes.Input{banktypes.NewInput(signer, sdk.NewCoins(sent)), banktypes.NewInput(other, sdk.NewCoins(sent))}
+	outputs := []banktypes.Output{banktypes.NewOutput(signer, sdk.NewCoins(sent.Add(sent)))}
+	require.ErrorIs(t, app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrUnableToTransferLiquidity)
+
+	// pool shares cannot be parked in a module account
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, other, types.ModuleName, sdk.NewCoins(sent))
+	require.ErrorIs(t, err, types.ErrUnableToTransferLiquidity)
+
+	// the receiver owns the units and can remove them
+	msgRemoveLiquidity := types.NewMsgRemoveLiquidity(other, asset, sdk.NewInt(10000), sdk.ZeroInt())
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &msgRemoveLiquidity)
+	require.NoError(t, err)
+	_, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, other.String())
+	require.Error(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, other, shareDenom).IsZero())
+
+	msgRemoveLiquidity = types.NewMsgRemoveLiquidity(signer, asset, sdk.NewInt(5000), sdk.ZeroInt())
+	_, err = msgServer.RemoveLiquidity(sdk.WrapSDKContext(ctx), &msgRemoveLiquidity)
+	require.NoError(t, err)
+	lp, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, signer.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.BigInt()), app.BankKeeper.GetBalance(ctx, signer, shareDenom).Amount)
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+
+	// shares minted outside of the pool break the invariant
+	require.NoError(t, app.ClpKeeper.MintPoolShares(ctx, asset.Symbol, other, sdk.NewUint(1)))
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.True(t, stop)
+}
+
+func TestMigrator_MigrateToVer6(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.NewUint(1000))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	addr1 := test.GenerateAddress(test.AddressKey1)
+	addr2 := test.GenerateAddress(test.AddressKey2)
+	lp1 := types.NewLiquidityProvider(&asset, sdk.NewUint(400), addr1)
+	lp2 := types.NewLiquidityProvider(&asset, sdk.NewUint(600), addr2)
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp1)
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp2)
+	_, stop := app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.True(t, stop)
+
+	require.NoError(t, keeper.NewMigrator(app.ClpKeeper).MigrateToVer6(ctx))
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, addr1, "clp/ceth").Amount)
+	require.Equal(t, sdk.NewInt(600), app.BankKeeper.GetBalance(ctx, addr2, "clp/ceth").Amount)
+	_, stop = app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+}
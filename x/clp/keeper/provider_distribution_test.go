@@ -10,13 +10,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestKeeper_CalcProviderDistributionAmount(t *testing.T) {
-	rowanProviderDistributioned := sdk.NewDec(10)
-	totalPoolUnits := sdk.NewUint(999)
-	lpPoolUnits := sdk.NewUint(333)
-	expectedAmount := sdk.NewUint(3)
+func TestKeeper_CalcProviderDistribution(t *testing.T) {
+	blockRate := sdk.MustNewDecFromStr("0.003141590000000000")
+	poolDepthRowan := sdk.NewUint(200_000)
+	expectedAmount := sdk.NewUint(628)
 
-	amount := keeper.CalcProviderDistributionAmount(rowanProviderDistributioned, totalPoolUnits, lpPoolUnits)
+	amount := keeper.CalcProviderDistribution(blockRate, poolDepthRowan)
 
 	require.Equal(t, expectedAmount, amount)
 }
@@ -56,91 +55,69 @@ func TestKeeper_FindActivePeriod(t *testing.T) {
 	require.Nil(t, period)
 }
 
-func TestKeeper_CollectProviderDistributionAndEvents(t *testing.T) {
+func TestKeeper_AccrueProviderDistributionsAndClaim(t *testing.T) {
 	blockRate := sdk.MustNewDecFromStr("0.003141590000000000")
-	poolDepthRowan := sdk.NewDec(200_000)
+	poolDepthRowan := sdk.NewUint(200_000)
 	totalProviderDistributioned := sdk.NewUint(628) // blockRate * poolDepthRowan
-	// only used for events collection
 	ctx, app := test.CreateTestAppClp(false)
-	_ = app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(2*628)))) //x2 since there's 2 pools
-	// clear MintCoins events
-	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_ = app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromBigInt(poolDepthRowan.BigInt()))))
 
 	poolUnitss := []uint64{10, 0, 3, 5, 12}
-	providerDistributions := []sdk.Uint{sdk.NewUint(209), sdk.ZeroUint(), sdk.NewUint(63), sdk.NewUint(105), sdk.NewUint(251)}
+	providerDistributions := []sdk.Uint{sdk.NewUint(209), sdk.ZeroUint(), sdk.NewUint(62), sdk.NewUint(104), sdk.NewUint(251)}
 	totalPoolUnits := uint64(0)
-	providerSum := sdk.ZeroUint()
-
-	for i := 0; i < len(poolUnitss); i++ {
-		totalPoolUnits += poolUnitss[i]
-		providerSum = providerSum.Add(providerDistributions[i])
+	for _, poolUnits := range poolUnitss {
+		totalPoolUnits += poolUnits
 	}
-	require.Equal(t, totalProviderDistributioned, providerSum)
-
-	lps := test.GenerateRandomLPWithUnits(poolUnitss)
-	assetStr := "cusdc"
-	asset := types.NewAsset(assetStr)
-	pool := types.NewPool(&asset, totalProviderDistributioned, sdk.ZeroUint(), sdk.ZeroUint())
-
-	lpsFiltered := keeper.FilterValidLiquidityProviders(ctx, lps)
-	lpRowanMap := make(keeper.LpRowanMap, 0)
-	lpPoolMap := make(keeper.LpPoolMap, 0)
-	poolRowanMap := make(keeper.PoolRowanMap, 1)
-	rowanToDistribute := keeper.CollectProviderDistribution(ctx, &pool, poolDepthRowan, blockRate, sdk.NewUint(totalPoolUnits), lpsFiltered, lpRowanMap, lpPoolMap)
-	require.Equal(t, totalProviderDistributioned, rowanToDistribute)
-	poolRowanMap[&pool] = rowanToDistribute
-
-	for i, providerDistribution := range providerDistributions {
-		addr := lps[i].LiquidityProviderAddress
-		//addr, _ := sdk.AccAddressFromBech32(lps[i].LiquidityProviderAddress)
-		//tuple := findTupleByAddress(addr, tuples)
-		require.Equal(t, providerDistribution, lpRowanMap[addr])
 
-		// We clear the EventManager before every call as Events accumulate throughout calls
-		//ctx = ctx.WithEventManager(sdk.NewEventManager())
-
-		//transferEvents := createTransferEvents(providerDistribution, addr)
-		// NOTE: we use Subset here as bankKeeper.SendCoinsFromModuleToAccount does fire Events itself which we do not care for at this point
-		//require.Subset(t, ctx.EventManager().Events(), transferEvents)
+	asset := types.NewAsset("cusdc")
+	lps := test.GenerateRandomLPWithUnitsAndAsset(poolUnitss, asset)
+	for _, lp := range lps {
+		app.ClpKeeper.SetLiquidityProvider(ctx, lp)
 	}
+	pool := types.NewPool(&asset, poolDepthRowan, sdk.ZeroUint(), sdk.NewUint(totalPoolUnits))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
 
-	// repeat for a second pool
-	assetStr2 := "ceth"
-	asset2 := types.NewAsset(assetStr2)
-	pool2 := types.NewPool(&asset2, totalProviderDistributioned, sdk.ZeroUint(), sdk.ZeroUint())
-	rowanToDistribute2 := keeper.CollectProviderDistribution(ctx, &pool2, poolDepthRowan, blockRate, sdk.NewUint(totalPoolUnits), lpsFiltered, lpRowanMap, lpPoolMap)
-	poolRowanMap[&pool2] = rowanToDistribute2
-
-	app.ClpKeeper.TransferProviderDistribution(ctx, poolRowanMap, lpRowanMap, lpPoolMap)
-
-	// pool empty after all LPs got paid
-	poolStored, _ := app.ClpKeeper.GetPool(ctx, assetStr)
-	require.Equal(t, sdk.ZeroUint().String(), poolStored.NativeAssetBalance.String())
+	lpPools := app.ClpKeeper.AccrueProviderDistributions(ctx, []*types.Pool{&pool}, blockRate)
+	require.Len(t, lpPools, 1)
+	require.Equal(t, totalProviderDistributioned, lpPools[0].Amount)
 
-	require.Subset(t, ctx.EventManager().Events(), createDistributeEvent(lps[len(lps)-1].LiquidityProviderAddress))
-}
+	// the distribution is taken out of the pool depth and held until claimed
+	poolStored, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.Equal(t, poolDepthRowan.Sub(totalProviderDistributioned).String(), poolStored.NativeAssetBalance.String())
+	require.Equal(t, totalProviderDistributioned.String(), poolStored.UnclaimedRewards.String())
+	_, stop := app.ClpKeeper.BalanceModuleAccountCheck()(ctx)
+	require.False(t, stop)
 
-//nolint
-func createDistributeEvent(address string) []sdk.Event {
-	return []sdk.Event{sdk.NewEvent("lppd/distribution",
-		sdk.NewAttribute("recipient", address),
-		sdk.NewAttribute("total_amount", "502"),
-		sdk.NewAttribute("amounts", "[{\"pool\":\"cusdc\",\"amount\":\"251\"},{\"pool\":\"ceth\",\"amount\":\"251\"}]")),
+	for i, providerDistribution := range providerDistributions {
+		lp, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lps[i].LiquidityProviderAddress)
+		require.NoError(t, err)
+		require.Equal(t, providerDistribution, keeper.PendingRewards(poolStored, lp))
+
+		claimed, err := app.ClpKeeper.ClaimLiquidityProviderRewards(ctx, &poolStored, &lp)
+		require.NoError(t, err)
+		require.Equal(t, providerDistribution, claimed)
+		require.True(t, keeper.PendingRewards(poolStored, lp).IsZero())
+		addr, _ := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		require.Equal(t, providerDistribution.String(), app.BankKeeper.GetBalance(ctx, addr, types.NativeSymbol).Amount.String())
 	}
+	// rounding leaves dust in the pool rewards, never a deficit
+	require.Equal(t, sdk.NewUint(2).String(), poolStored.UnclaimedRewards.String())
 }
 
-func TestKeeper_CollectProviderDistributions(t *testing.T) {
+func TestKeeper_AccrueProviderDistributions(t *testing.T) {
 	blockRate := sdk.MustNewDecFromStr("0.003141590000000000")
 	nPools := 100
 	nLPs := 800
 	ctx, app := test.CreateTestAppClp(false)
 	pools := test.GeneratePoolsSetLPs(app.ClpKeeper, ctx, nPools, nLPs)
-	poolRowanMap, lpRowanMap, lpPoolMap := app.ClpKeeper.CollectProviderDistributions(ctx, pools, blockRate)
+	lpPools := app.ClpKeeper.AccrueProviderDistributions(ctx, pools, blockRate)
 
-	// TODO: something better
-	require.Equal(t, nPools, len(poolRowanMap))
-	require.Equal(t, nLPs, len(lpRowanMap))
-	require.Equal(t, len(lpPoolMap), len(lpRowanMap))
+	require.Equal(t, nPools, len(lpPools))
+	for _, lpPool := range lpPools {
+		require.True(t, lpPool.Pool.RewardPerUnit.IsPositive())
+		require.Equal(t, lpPool.Amount, *lpPool.Pool.UnclaimedRewards)
+	}
 }
 
 func TestKeeper_IsDistributionBlock(t *testing.T) {
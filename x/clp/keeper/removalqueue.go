@@ -4,19 +4,24 @@ import (
 	"fmt"
 
 	"github.com/Sifchain/sifnode/x/clp/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (k Keeper) QueueRemoval(ctx sdk.Context, msg *types.MsgRemoveLiquidity, rowanValue sdk.Uint) {
+// QueueRemoval adds a removal of units worth rowanValue to the removal queue of the pool and returns the request
+func (k Keeper) QueueRemoval(ctx sdk.Context, msg *types.MsgRemoveLiquidity, units sdk.Uint, rowanValue sdk.Uint) types.RemovalRequest {
 	queue := k.GetRemovalQueue(ctx, msg.ExternalAsset.Symbol)
 	request := types.RemovalRequest{
 		Id:    queue.Id + 1,
 		Msg:   msg,
 		Value: rowanValue,
+		Units: units,
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetRemovalRequestKey(request), k.cdc.MustMarshal(&request))
+	k.SetRemovalRequest(ctx, request)
 
 	queue.Count++
 	queue.Id++
@@ -27,6 +32,14 @@ func (k Keeper) QueueRemoval(ctx sdk.Context, msg *types.MsgRemoveLiquidity, row
 	k.SetRemovalQueue(ctx, queue, msg.ExternalAsset.Symbol)
 
 	emitQueueRemoval(ctx, &request, &queue)
+	return request
+}
+
+func (k Keeper) SetRemovalRequest(ctx sdk.Context, request types.RemovalRequest) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRemovalRequestKey(request.Msg.ExternalAsset.Symbol, request.Id)
+	store.Set(key, k.cdc.MustMarshal(&request))
+	store.Set(types.GetRemovalRequestLPKey(request.Msg.Signer, request.Msg.ExternalAsset.Symbol, request.Id), key)
 }
 
 func (k Keeper) GetRemovalQueue(ctx sdk.Context, symbol string) types.RemovalQueue {
@@ -47,76 +60,156 @@ func (k Keeper) SetRemovalQueue(ctx sdk.Context, queue types.RemovalQueue, symbo
 	store.Set(types.GetRemovalQueueKey(symbol), k.cdc.MustMarshal(&queue))
 }
 
-func (k Keeper) GetRemovalQueueIterator(ctx sdk.Context) sdk.Iterator {
+// GetRemovalQueueIterator iterates the removal requests queued for the pool of symbol in the order they were queued
+func (k Keeper) GetRemovalQueueIterator(ctx sdk.Context, symbol string) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, types.RemovalRequestPrefix)
+	return sdk.KVStorePrefixIterator(store, types.GetRemovalRequestPoolPrefix(symbol))
 }
 
-func (k Keeper) ProcessRemovalQueue(ctx sdk.Context, msg *types.MsgAddLiquidity, unitsToDistribute sdk.Uint) {
-	queue := k.GetRemovalQueue(ctx, msg.ExternalAsset.Symbol)
-	perRequestUnits := unitsToDistribute.Quo(sdk.NewUint(uint64(queue.Count)))
+// MaxRemovalRequestsPerProcess bounds the removal requests served by one pass over a removal queue, so the
+// transaction that triggers it pays a bounded amount of gas
+const MaxRemovalRequestsPerProcess = 10
 
-	it := k.GetRemovalQueueIterator(ctx)
-	defer it.Close()
-	for ; it.Valid(); it.Next() {
+// ProcessRemovalQueue serves the removal requests queued for the pool of asset first come first served, until
+// maxUnits have been removed or removing more would take the health of the pool below the removal queue threshold.
+// Units a request does not need carry over to the next request, a request that is only partly served stays at the
+// head of the queue with the units it has left. Requests that fail are dropped from the queue
+func (k Keeper) ProcessRemovalQueue(ctx sdk.Context, asset *types.Asset, maxUnits sdk.Uint) {
+	var requests []types.RemovalRequest
+	it := k.GetRemovalQueueIterator(ctx, asset.Symbol)
+	for ; it.Valid() && len(requests) < MaxRemovalRequestsPerProcess; it.Next() {
 		var request types.RemovalRequest
 		k.cdc.MustUnmarshal(it.Value(), &request)
+		requests = append(requests, request)
+	}
+	it.Close()
+
+	threshold := k.GetMarginKeeper().GetRemovalQueueThreshold(ctx)
+	unitsLeft := maxUnits
+	for _, request := range requests {
+		if unitsLeft.IsZero() {
+			return
+		}
+		pool, err := k.GetPool(ctx, asset.Symbol)
+		if err != nil {
+			return
+		}
+		lp, err := k.GetLiquidityProvider(ctx, asset.Symbol, request.Msg.Signer)
+		if err != nil {
+			k.failRemovalRequest(ctx, request, err)
+			continue
+		}
 
-		if msg.ExternalAsset.Equals(*request.Msg.ExternalAsset) {
-			lp, err := k.GetLiquidityProvider(ctx, request.Msg.ExternalAsset.Symbol, request.Msg.Signer)
-			if err != nil {
-				emitRemovalQueueError(ctx, &request)
-				ctx.Logger().Error(fmt.Sprintf("error processing queued removal: %s", err.Error()),
-					"request", request.Id,
-					"lp", request.Msg.Signer,
-					"externalAsset", request.Msg.ExternalAsset.Symbol)
-				continue
-			}
-
-			requestUnits := ConvWBasisPointsToUnits(lp.LiquidityProviderUnits, request.Msg.WBasisPoints)
-			withdrawUnits := sdk.MinUint(requestUnits, perRequestUnits)
-			withdrawWBasisPoints := ConvUnitsToWBasisPoints(lp.LiquidityProviderUnits, withdrawUnits)
-
-			// Reuse removal logic using withdrawWBasisPoints
-			_, _, totalRowanValue, err := k.ProcessRemoveLiquidityMsg(ctx, &types.MsgRemoveLiquidity{
-				Signer:        request.Msg.Signer,
-				ExternalAsset: msg.ExternalAsset,
-				WBasisPoints:  withdrawWBasisPoints,
-				Asymmetry:     request.Msg.Asymmetry,
-			})
-			if err != nil {
-				emitRemovalQueueError(ctx, &request)
-				ctx.Logger().Error(fmt.Sprintf("error processing queued removal: %s", err.Error()),
-					"request", request.Id,
-					"lp", request.Msg.Signer,
-					"externalAsset", request.Msg.ExternalAsset.Symbol)
-				continue
-			}
-
-			// Update the queued request
-			k.SetProcessedRemovalRequest(ctx, request, withdrawWBasisPoints, totalRowanValue)
+		requestUnits := sdk.MinUint(request.Units, lp.LiquidityProviderUnits)
+		withdrawUnits := k.GetMaxHealthyRemovalUnits(pool, sdk.MinUint(requestUnits, unitsLeft), threshold)
+		if withdrawUnits.IsZero() {
+			return
+		}
+		cacheCtx, write := ctx.CacheContext()
+		totalRowanValue, err := k.ProcessRemoveLiquidityUnits(cacheCtx, pool, lp, withdrawUnits)
+		if err != nil {
+			// a request that cannot be served does not hold up the requests behind it
+			k.failRemovalRequest(ctx, request, err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		unitsLeft = unitsLeft.Sub(withdrawUnits)
+
+		// units requested beyond what the liquidity provider holds can never be served
+		k.SetProcessedRemovalRequest(ctx, request, request.Units.Sub(requestUnits).Add(withdrawUnits), totalRowanValue)
+		if withdrawUnits.LT(requestUnits) {
+			// the pool cannot take more removals for now, later requests wait for their turn
+			return
 		}
 	}
 }
 
-func (k Keeper) SetProcessedRemovalRequest(ctx sdk.Context, request types.RemovalRequest, pointsProcessed sdk.Int, rowanRemoved sdk.Uint) {
-	request.Msg.WBasisPoints = request.Msg.WBasisPoints.Sub(pointsProcessed)
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetRemovalRequestKey(request), k.cdc.MustMarshal(&request))
+// failRemovalRequest drops a removal request that could not be served from the queue, the liquidity provider can
+// request the removal again
+func (k Keeper) failRemovalRequest(ctx sdk.Context, request types.RemovalRequest, err error) {
+	emitRemovalQueueError(ctx, &request)
+	ctx.Logger().Error(fmt.Sprintf("error processing queued removal: %s", err.Error()),
+		"request", request.Id,
+		"lp", request.Msg.Signer,
+		"externalAsset", request.Msg.ExternalAsset.Symbol)
+	k.CancelQueuedRemoval(ctx, request)
+}
+
+// GetMaxHealthyRemovalUnits returns the largest number of units, up to units, that can be removed symmetrically from
+// the pool without taking its health below threshold
+func (k Keeper) GetMaxHealthyRemovalUnits(pool types.Pool, units sdk.Uint, threshold sdk.Dec) sdk.Uint {
+	if k.isHealthyRemoval(pool, units, threshold) {
+		return units
+	}
+	// the health of the pool only falls as more units are removed
+	low, high := sdk.ZeroUint(), units
+	for high.Sub(low).GT(sdk.OneUint()) {
+		mid := low.Add(high.Sub(low).QuoUint64(2))
+		if k.isHealthyRemoval(pool, mid, threshold) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low
+}
+
+func (k Keeper) isHealthyRemoval(pool types.Pool, units sdk.Uint, threshold sdk.Dec) bool {
+	if units.IsZero() {
+		return true
+	}
+	nativeAssetDepth, externalAssetDepth := pool.ExtractDebt(pool.NativeAssetBalance, pool.ExternalAssetBalance, false)
+	withdrawNativeAssetAmount, withdrawExternalAssetAmount, _ := CalculateWithdrawalFromUnits(pool.PoolUnits,
+		nativeAssetDepth.String(), externalAssetDepth.String(), pool.PoolUnits.String(), units)
+	if withdrawNativeAssetAmount.GTE(pool.NativeAssetBalance) || withdrawExternalAssetAmount.GTE(pool.ExternalAssetBalance) {
+		return false
+	}
+	futurePool := pool
+	futurePool.NativeAssetBalance = futurePool.NativeAssetBalance.Sub(withdrawNativeAssetAmount)
+	futurePool.ExternalAssetBalance = futurePool.ExternalAssetBalance.Sub(withdrawExternalAssetAmount)
+	return k.GetMarginKeeper().CalculatePoolHealth(&futurePool).GTE(threshold)
+}
+
+// ProcessRemovalQueueAfterSwap drains the removal queue of a pool a swap took from before to after, if the swap
+// improved the health of the pool. The units served are the share of the pool units the health improved by
+func (k Keeper) ProcessRemovalQueueAfterSwap(ctx sdk.Context, before types.Pool, after types.Pool) {
+	if !k.GetMarginKeeper().IsPoolEnabled(ctx, after.ExternalAsset.Symbol) || !k.IsRemovalQueueEnabled(ctx) {
+		return
+	}
+	if k.GetRemovalQueue(ctx, after.ExternalAsset.Symbol).Count == 0 {
+		return
+	}
+	improvement := k.GetMarginKeeper().CalculatePoolHealth(&after).Sub(k.GetMarginKeeper().CalculatePoolHealth(&before))
+	if !improvement.IsPositive() {
+		return
+	}
+	maxUnits := improvement.MulInt(sdk.NewIntFromBigInt(after.PoolUnits.BigInt())).TruncateInt()
+	k.ProcessRemovalQueue(ctx, after.ExternalAsset, sdk.NewUintFromBigInt(maxUnits.BigInt()))
+}
+
+func (k Keeper) SetProcessedRemovalRequest(ctx sdk.Context, request types.RemovalRequest, unitsProcessed sdk.Uint, rowanRemoved sdk.Uint) {
+	request.Units = request.Units.Sub(unitsProcessed)
+	// the value of a request is what is left to remove
+	request.Value = request.Value.Sub(sdk.MinUint(request.Value, rowanRemoved))
+	k.SetRemovalRequest(ctx, request)
 
 	queue := k.GetRemovalQueue(ctx, request.Msg.ExternalAsset.Symbol)
-	queue.TotalValue = queue.TotalValue.Sub(rowanRemoved)
+	queue.TotalValue = queue.TotalValue.Sub(sdk.MinUint(queue.TotalValue, rowanRemoved))
 	k.SetRemovalQueue(ctx, queue, request.Msg.ExternalAsset.Symbol)
 
-	if request.Msg.WBasisPoints.LTE(sdk.ZeroInt()) {
-		k.DequeueRemovalRequest(ctx, request)
+	if request.Units.IsZero() {
+		// rounding can leave value on a served request, it is no longer owed
+		k.CancelQueuedRemoval(ctx, request)
 	}
 
-	emitProcessedRemovalRequest(ctx, &request, pointsProcessed, rowanRemoved)
+	emitProcessedRemovalRequest(ctx, &request, unitsProcessed, rowanRemoved)
 }
 
 func (k Keeper) DequeueRemovalRequest(ctx sdk.Context, request types.RemovalRequest) {
-	ctx.KVStore(k.storeKey).Delete(types.GetRemovalRequestKey(request))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRemovalRequestKey(request.Msg.ExternalAsset.Symbol, request.Id))
+	store.Delete(types.GetRemovalRequestLPKey(request.Msg.Signer, request.Msg.ExternalAsset.Symbol, request.Id))
 	queue := k.GetRemovalQueue(ctx, request.Msg.ExternalAsset.Symbol)
 	queue.Count--
 	k.SetRemovalQueue(ctx, queue, request.Msg.ExternalAsset.Symbol)
@@ -124,20 +217,79 @@ func (k Keeper) DequeueRemovalRequest(ctx sdk.Context, request types.RemovalRequ
 	emitDequeueRemoval(ctx, &request, &queue)
 }
 
+// GetRemovalRequest returns the queued removal request id of lpaddress in the pool of symbol
+func (k Keeper) GetRemovalRequest(ctx sdk.Context, symbol string, lpaddress string, id int64) (types.RemovalRequest, error) {
+	var request types.RemovalRequest
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRemovalRequestKey(symbol, id))
+	if bz == nil {
+		return request, types.ErrRemovalRequestNotFound
+	}
+	k.cdc.MustUnmarshal(bz, &request)
+	if request.Msg.Signer != lpaddress {
+		return request, types.ErrRemovalRequestNotFound
+	}
+	return request, nil
+}
+
+// CancelQueuedRemoval takes the request, and the value it has left to remove, out of the removal queue
+func (k Keeper) CancelQueuedRemoval(ctx sdk.Context, request types.RemovalRequest) {
+	k.DequeueRemovalRequest(ctx, request)
+	queue := k.GetRemovalQueue(ctx, request.Msg.ExternalAsset.Symbol)
+	queue.TotalValue = queue.TotalValue.Sub(sdk.MinUint(queue.TotalValue, request.Value))
+	k.SetRemovalQueue(ctx, queue, request.Msg.ExternalAsset.Symbol)
+}
+
+// GetRemovalRequestsPaginated returns the removal requests queued for the pool of symbol in the order they were queued
+func (k Keeper) GetRemovalRequestsPaginated(ctx sdk.Context, symbol string, pagination *query.PageRequest) ([]*types.RemovalRequest, *query.PageResponse, error) {
+	var requests []*types.RemovalRequest
+	store := ctx.KVStore(k.storeKey)
+	requestStore := prefix.NewStore(store, types.GetRemovalRequestPoolPrefix(symbol))
+	pageRes, err := query.Paginate(requestStore, pagination, func(key []byte, value []byte) error {
+		var request types.RemovalRequest
+		err := k.cdc.Unmarshal(value, &request)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, &request)
+		return nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return requests, pageRes, nil
+}
+
+// GetRemovalRequestsByLPPaginated returns the removal requests of lpaddress in every pool through the liquidity
+// provider index
+func (k Keeper) GetRemovalRequestsByLPPaginated(ctx sdk.Context, lpaddress string, pagination *query.PageRequest) ([]*types.RemovalRequest, *query.PageResponse, error) {
+	var requests []*types.RemovalRequest
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetRemovalRequestLPPrefix(lpaddress))
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, value []byte) error {
+		var request types.RemovalRequest
+		err := k.cdc.Unmarshal(store.Get(value), &request)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, &request)
+		return nil
+	})
+	if err != nil {
+		return nil, &query.PageResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	return requests, pageRes, nil
+}
+
 func (k Keeper) GetRemovalQueueUnitsForLP(ctx sdk.Context, lp types.LiquidityProvider) sdk.Uint {
 	store := ctx.KVStore(k.storeKey)
-	prefix := types.GetRemovalRequestLPPrefix(lp.LiquidityProviderAddress)
-	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetRemovalRequestLPPoolPrefix(lp.LiquidityProviderAddress, lp.Asset.Symbol))
 	defer iterator.Close()
 
 	units := sdk.ZeroUint()
 	for ; iterator.Valid(); iterator.Next() {
 		var request types.RemovalRequest
-		k.cdc.MustUnmarshal(iterator.Value(), &request)
-
-		if lp.Asset.Equals(*request.Msg.ExternalAsset) {
-			units = units.Add(ConvWBasisPointsToUnits(lp.LiquidityProviderUnits, request.Msg.WBasisPoints))
-		}
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &request)
+		units = units.Add(request.Units)
 	}
 
 	return units
@@ -0,0 +1,348 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	"github.com/Sifchain/sifnode/x/clp"
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRemovalQueue(t *testing.T, threshold sdk.Dec) (sdk.Context, *sifapp.SifchainApp, types.MsgServer, types.Asset) {
+	ctx, app := test.CreateTestAppClp(false)
+	asset := types.NewAsset("ceth")
+	app.ClpKeeper.SetParams(ctx, types.Params{
+		MinCreatePoolThreshold: 100,
+		EnableRemovalQueue:     true,
+	})
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{Denom: "rowan", Decimals: 18, Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP}})
+	marginParams := app.MarginKeeper.GetParams(ctx)
+	marginParams.Pools = []string{asset.Symbol}
+	marginParams.RemovalQueueThreshold = threshold
+	app.MarginKeeper.SetParams(ctx, &marginParams)
+
+	msgServer := keeper.NewMsgServerImpl(app.ClpKeeper)
+	for _, key := range []string{test.AddressKey1, test.AddressKey2, test.AddressKey3} {
+		coins := sdk.NewCoins(sdk.NewCoin(asset.Symbol, sdk.NewInt(10000000)), sdk.NewCoin(types.NativeSymbol, sdk.NewInt(10000000)))
+		require.NoError(t, sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, test.GenerateAddress(key), coins))
+	}
+	// pools below the minimum to create one through MsgCreatePool keep the numbers readable
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(asset.Symbol, sdk.NewInt(1000000)), sdk.NewCoin(types.NativeSymbol, sdk.NewInt(1000000)))))
+	pool := types.NewPool(&asset, sdk.NewUint(1000000), sdk.NewUint(1000000), sdk.NewUint(1000000))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	_, err := app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1000000), test.GenerateAddress(test.AddressKey1))
+	require.NoError(t, err)
+	return ctx, app, msgServer, asset
+}
+
+func queueRemoval(ctx sdk.Context, app *sifapp.SifchainApp, asset types.Asset, lp sdk.AccAddress, units uint64, value uint64) {
+	app.ClpKeeper.QueueRemoval(ctx, &types.MsgRemoveLiquidity{
+		Signer:        lp.String(),
+		ExternalAsset: &asset,
+		WBasisPoints:  sdk.NewInt(10000),
+		Asymmetry:     sdk.ZeroInt(),
+	}, sdk.NewUint(units), sdk.NewUint(value))
+}
+
+func TestKeeper_ProcessRemovalQueue_AddLiquidity(t *testing.T) {
+	ctx, app, msgServer, asset := setupRemovalQueue(t, sdk.NewDecWithPrec(1, 1))
+	signer := test.GenerateAddress(test.AddressKey1)
+	lp1 := test.GenerateAddress(test.AddressKey2)
+	lp2 := test.GenerateAddress(test.AddressKey3)
+	for _, addr := range []sdk.AccAddress{lp1, lp2} {
+		msg := types.NewMsgAddLiquidity(addr, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+		_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+	}
+	queueRemoval(ctx, app, asset, lp1, 30000, 60000)
+	queueRemoval(ctx, app, asset, lp2, 50000, 100000)
+
+	// the first request is served in full and the units it does not need carry over to the second
+	msg := types.NewMsgAddLiquidity(signer, asset, sdk.NewUint(40000), sdk.NewUint(40000))
+	res, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(40000), res.UnitsMinted)
+
+	queue := app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, int64(1), queue.Count)
+	lp1Units, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp1.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(70000), lp1Units.LiquidityProviderUnits)
+	require.True(t, app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lp1Units).IsZero())
+	lp2Units, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp2.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(90000), lp2Units.LiquidityProviderUnits)
+	require.Equal(t, sdk.NewUint(40000), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lp2Units))
+	require.Equal(t, sdk.NewInt(10000000-100000+30000), app.BankKeeper.GetBalance(ctx, lp1, asset.Symbol).Amount)
+
+	// the next add serves the rest of the queue
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	queue = app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, int64(0), queue.Count)
+	require.True(t, queue.TotalValue.IsZero())
+	lp2Units, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp2.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(50000), lp2Units.LiquidityProviderUnits)
+	require.Equal(t, sdk.NewInt(10000000-100000+50000), app.BankKeeper.GetBalance(ctx, lp2, asset.Symbol).Amount)
+	require.Equal(t, sdk.NewInt(10000000-100000+50000), app.BankKeeper.GetBalance(ctx, lp2, types.NativeSymbol).Amount)
+
+	_, stop := app.ClpKeeper.PoolSharesCheck()(ctx)
+	require.False(t, stop)
+}
+
+func TestKeeper_ProcessRemovalQueue_FailedRequest(t *testing.T) {
+	ctx, app, msgServer, asset := setupRemovalQueue(t, sdk.NewDecWithPrec(1, 1))
+	signer := test.GenerateAddress(test.AddressKey1)
+	lp := test.GenerateAddress(test.AddressKey2)
+	msgAddLiquidity := types.NewMsgAddLiquidity(lp, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+	// the liquidity provider of the first request has no liquidity left in the pool
+	queueRemoval(ctx, app, asset, test.GenerateAddress(test.AddressKey3), 30000, 60000)
+	queueRemoval(ctx, app, asset, lp, 30000, 60000)
+
+	// the failed request is dropped and does not hold up the one behind it
+	msg := types.NewMsgAddLiquidity(signer, asset, sdk.NewUint(40000), sdk.NewUint(40000))
+	_, err = msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	queue := app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, int64(0), queue.Count)
+	require.True(t, queue.TotalValue.IsZero())
+	lpUnits, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(70000), lpUnits.LiquidityProviderUnits)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		found = found || event.Type == types.EventTypeProcessRemovalError
+	}
+	require.True(t, found)
+}
+
+func TestKeeper_ProcessRemovalQueue_Swap(t *testing.T) {
+	threshold := sdk.MustNewDecFromStr("0.49")
+	ctx, app, msgServer, asset := setupRemovalQueue(t, threshold)
+	swapper := test.GenerateAddress(test.AddressKey1)
+	lp := test.GenerateAddress(test.AddressKey2)
+	msgAddLiquidity := types.NewMsgAddLiquidity(lp, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+
+	// margin positions borrowed from the pool leave it at a health of 0.5
+	pool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	pool.ExternalLiabilities = pool.ExternalAssetBalance
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), app.MarginKeeper.CalculatePoolHealth(&pool))
+
+	msgRemoveLiquidity := types.NewMsgRemoveLiquidityUnits(lp, asset, sdk.NewUint(100000))
+	res, err := msgServer.RemoveLiquidityUnits(sdk.WrapSDKContext(ctx), &msgRemoveLiquidity)
+	require.NoError(t, err)
+	require.True(t, res.Queued)
+	require.Equal(t, int64(1), res.RemovalRequestId)
+	lpUnits, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp.String())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(100000), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lpUnits))
+
+	// a swap that lowers the health of the pool leaves the queue alone
+	msgSwap := types.NewMsgSwap(swapper, types.GetSettlementAsset(), asset, sdk.NewUint(10000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &msgSwap)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(100000), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lpUnits))
+
+	// a swap that improves it serves units in proportion to the health it restored
+	msgSwap = types.NewMsgSwap(swapper, asset, types.GetSettlementAsset(), sdk.NewUint(50000), sdk.ZeroUint())
+	_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &msgSwap)
+	require.NoError(t, err)
+	lpUnits, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp.String())
+	require.NoError(t, err)
+	queuedUnits := app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lpUnits)
+	require.True(t, queuedUnits.GT(sdk.ZeroUint()), queuedUnits.String())
+	require.True(t, queuedUnits.LT(sdk.NewUint(100000)), queuedUnits.String())
+	require.Equal(t, queuedUnits, lpUnits.LiquidityProviderUnits)
+	pool, err = app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.True(t, app.MarginKeeper.CalculatePoolHealth(&pool).GTE(threshold))
+
+	// until swaps have restored enough of the pool to serve the liquidity provider in full
+	for i := 0; i < 20; i++ {
+		msgSwap = types.NewMsgSwap(swapper, asset, types.GetSettlementAsset(), sdk.NewUint(50000), sdk.ZeroUint())
+		_, err = msgServer.Swap(sdk.WrapSDKContext(ctx), &msgSwap)
+		require.NoError(t, err)
+		_, err = app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp.String())
+		if err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+	queue := app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, int64(0), queue.Count)
+	require.True(t, queue.TotalValue.IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, lp, types.GetPoolShareDenom(asset.Symbol)).IsZero())
+	pool, err = app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.True(t, app.MarginKeeper.CalculatePoolHealth(&pool).GTE(threshold))
+}
+
+func TestKeeper_ProcessRemovalQueue_SwapRoute(t *testing.T) {
+	threshold := sdk.MustNewDecFromStr("0.49")
+	ctx, app, msgServer, asset := setupRemovalQueue(t, threshold)
+	swapper := test.GenerateAddress(test.AddressKey1)
+	lp := test.GenerateAddress(test.AddressKey2)
+	msgAddLiquidity := types.NewMsgAddLiquidity(lp, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	pool.ExternalLiabilities = pool.ExternalAssetBalance
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	msgRemoveLiquidity := types.NewMsgRemoveLiquidityUnits(lp, asset, sdk.NewUint(100000))
+	res, err := msgServer.RemoveLiquidityUnits(sdk.WrapSDKContext(ctx), &msgRemoveLiquidity)
+	require.NoError(t, err)
+	require.True(t, res.Queued)
+
+	// the route buys ceth from the pool and sells it back, the queue is served for the net change only and not
+	// for the health the second hop restored
+	before, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	rowan := types.GetSettlementAsset()
+	msgSwapRoute := types.NewMsgSwapRoute(swapper, []*types.Asset{&rowan, &asset, &rowan}, sdk.NewUint(50000), sdk.ZeroUint())
+	_, err = msgServer.SwapRoute(sdk.WrapSDKContext(ctx), &msgSwapRoute)
+	require.NoError(t, err)
+	after, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	improvement := app.MarginKeeper.CalculatePoolHealth(&after).Sub(app.MarginKeeper.CalculatePoolHealth(&before))
+	maxUnits := sdk.NewUintFromBigInt(improvement.MulInt(sdk.NewIntFromBigInt(after.PoolUnits.BigInt())).TruncateInt().BigInt())
+	lpUnits, err := app.ClpKeeper.GetLiquidityProvider(ctx, asset.Symbol, lp.String())
+	require.NoError(t, err)
+	served := sdk.NewUint(100000).Sub(app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lpUnits))
+	require.True(t, served.LTE(maxUnits), "served %s, at most %s", served, maxUnits)
+}
+
+func TestMigrator_MigrateToVer7(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.NewUint(1000))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	lp1 := test.GenerateAddress(test.AddressKey1)
+	lp2 := test.GenerateAddress(test.AddressKey2)
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(400), lp1)
+	app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	requests := []types.RemovalRequest{
+		{Id: 2, Value: sdk.NewUint(10), Msg: &types.MsgRemoveLiquidity{Signer: lp1.String(), ExternalAsset: &asset, WBasisPoints: sdk.NewInt(2500), Asymmetry: sdk.ZeroInt()}},
+		{Id: 1, Value: sdk.NewUint(20), Msg: &types.MsgRemoveLiquidity{Signer: lp2.String(), ExternalAsset: &asset, WBasisPoints: sdk.NewInt(5000), Asymmetry: sdk.ZeroInt()}},
+	}
+	for _, request := range requests {
+		request := request
+		key := append(types.RemovalRequestPrefix, []byte(fmt.Sprintf("%s_%d", request.Msg.Signer, request.Id))...)
+		store.Set(key, app.AppCodec().MustMarshal(&request))
+	}
+	app.ClpKeeper.SetRemovalQueue(ctx, types.RemovalQueue{Count: 2, Id: 2, TotalValue: sdk.NewUint(30)}, asset.Symbol)
+
+	require.NoError(t, keeper.NewMigrator(app.ClpKeeper).MigrateToVer7(ctx))
+	// the request of the liquidity provider that no longer exists is dropped
+	queue := app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol)
+	require.Equal(t, types.RemovalQueue{Count: 1, Id: 2, TotalValue: sdk.NewUint(10)}, queue)
+	request, err := app.ClpKeeper.GetRemovalRequest(ctx, asset.Symbol, lp1.String(), 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(100), request.Units)
+	require.Equal(t, sdk.NewUint(100), app.ClpKeeper.GetRemovalQueueUnitsForLP(ctx, lp))
+	_, err = app.ClpKeeper.GetRemovalRequest(ctx, asset.Symbol, lp2.String(), 1)
+	require.ErrorIs(t, err, types.ErrRemovalRequestNotFound)
+}
+
+func TestHandler_RemoveLiquidityQueued(t *testing.T) {
+	ctx, app, msgServer, asset := setupRemovalQueue(t, sdk.MustNewDecFromStr("0.49"))
+	lp := test.GenerateAddress(test.AddressKey2)
+	msgAddLiquidity := types.NewMsgAddLiquidity(lp, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	pool.ExternalLiabilities = pool.ExternalAssetBalance
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+
+	handler := clp.NewHandler(app.ClpKeeper)
+	for i, msg := range []sdk.Msg{
+		&types.MsgRemoveLiquidity{Signer: lp.String(), ExternalAsset: &asset, WBasisPoints: sdk.NewInt(5000), Asymmetry: sdk.ZeroInt()},
+		&types.MsgRemoveLiquidityUnits{Signer: lp.String(), ExternalAsset: &asset, WithdrawUnits: sdk.NewUint(50000)},
+	} {
+		// as in DeliverTx, the state changes of a message are only written when its handler succeeds
+		msgCtx, write := ctx.CacheContext()
+		res, err := handler(msgCtx, msg)
+		require.NoError(t, err)
+		write()
+
+		var queued bool
+		var id int64
+		switch msg.(type) {
+		case *types.MsgRemoveLiquidity:
+			var response types.MsgRemoveLiquidityResponse
+			require.NoError(t, response.Unmarshal(res.Data))
+			queued, id = response.Queued, response.RemovalRequestId
+		case *types.MsgRemoveLiquidityUnits:
+			var response types.MsgRemoveLiquidityUnitsResponse
+			require.NoError(t, response.Unmarshal(res.Data))
+			queued, id = response.Queued, response.RemovalRequestId
+		}
+		require.True(t, queued)
+		require.Equal(t, int64(i+1), id)
+		request, err := app.ClpKeeper.GetRemovalRequest(ctx, asset.Symbol, lp.String(), id)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewUint(50000), request.Units)
+	}
+	require.Equal(t, int64(2), app.ClpKeeper.GetRemovalQueue(ctx, asset.Symbol).Count)
+}
+
+func TestHandler_QueueQueryAndCancelRemoval(t *testing.T) {
+	ctx, app, msgServer, asset := setupRemovalQueue(t, sdk.MustNewDecFromStr("0.49"))
+	lp := test.GenerateAddress(test.AddressKey2)
+	msgAddLiquidity := types.NewMsgAddLiquidity(lp, asset, sdk.NewUint(100000), sdk.NewUint(100000))
+	_, err := msgServer.AddLiquidity(sdk.WrapSDKContext(ctx), &msgAddLiquidity)
+	require.NoError(t, err)
+	pool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	pool.ExternalLiabilities = pool.ExternalAssetBalance
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	handler := clp.NewHandler(app.ClpKeeper)
+	deliver := func(msg sdk.Msg) *sdk.Result {
+		msgCtx, write := ctx.CacheContext()
+		res, err := handler(msgCtx, msg)
+		require.NoError(t, err)
+		write()
+		return res
+	}
+
+	res := deliver(&types.MsgRemoveLiquidityUnits{Signer: lp.String(), ExternalAsset: &asset, WithdrawUnits: sdk.NewUint(60000)})
+	var response types.MsgRemoveLiquidityUnitsResponse
+	require.NoError(t, response.Unmarshal(res.Data))
+	require.True(t, response.Queued)
+
+	querier := keeper.Querier{Keeper: app.ClpKeeper}
+	byPool, err := querier.GetRemovalRequestsByPool(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByPoolReq{Symbol: asset.Symbol})
+	require.NoError(t, err)
+	require.Len(t, byPool.RemovalRequests, 1)
+	require.Equal(t, response.RemovalRequestId, byPool.RemovalRequests[0].Id)
+	byAddress, err := querier.GetRemovalRequestsByAddress(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByAddressReq{Address: lp.String()})
+	require.NoError(t, err)
+	require.Equal(t, byPool.RemovalRequests, byAddress.RemovalRequests)
+	units, err := querier.GetRemovalQueueUnits(sdk.WrapSDKContext(ctx), &types.RemovalQueueUnitsReq{Symbol: asset.Symbol, LpAddress: lp.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(60000), units.Units)
+
+	deliver(&types.MsgCancelRemovalRequest{Signer: lp.String(), ExternalAsset: &asset, Id: response.RemovalRequestId})
+	byPool, err = querier.GetRemovalRequestsByPool(sdk.WrapSDKContext(ctx), &types.RemovalRequestsByPoolReq{Symbol: asset.Symbol})
+	require.NoError(t, err)
+	require.Empty(t, byPool.RemovalRequests)
+	queue, err := querier.GetRemovalQueue(sdk.WrapSDKContext(ctx), &types.RemovalQueueReq{Symbol: asset.Symbol})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), queue.RemovalQueue.Count)
+	require.True(t, queue.RemovalQueue.TotalValue.IsZero())
+}
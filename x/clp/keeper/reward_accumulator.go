@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Rewards paid to liquidity providers are not transferred on distribution blocks. Each pool keeps the
+// cumulative rowan rewarded per pool unit and the rowan held by the module until it is claimed. A liquidity
+// provider is owed its units times the growth of the pool accumulator since its checkpoint, it claims with
+// MsgClaimRewards or automatically whenever its units change. The accumulator fields are nil on pools and
+// liquidity providers that never earned rewards.
+
+func getRewardPerUnit(pool *types.Pool) sdk.Dec {
+	if pool.RewardPerUnit == nil {
+		return sdk.ZeroDec()
+	}
+	return *pool.RewardPerUnit
+}
+
+func getUnclaimedRewards(pool *types.Pool) sdk.Uint {
+	if pool.UnclaimedRewards == nil {
+		return sdk.ZeroUint()
+	}
+	return *pool.UnclaimedRewards
+}
+
+func getRewardPerUnitCheckpoint(lp *types.LiquidityProvider) sdk.Dec {
+	if lp.RewardPerUnitCheckpoint == nil {
+		return sdk.ZeroDec()
+	}
+	return *lp.RewardPerUnitCheckpoint
+}
+
+// AccrueRewards credits amount of rowan, already held by the module, to every unit of the pool.
+// It returns false and leaves the pool untouched if the pool has no units to credit
+func AccrueRewards(pool *types.Pool, amount sdk.Uint) bool {
+	if pool.PoolUnits.IsZero() {
+		return false
+	}
+	rewardPerUnit := sdk.NewDecFromBigInt(amount.BigInt()).QuoInt(sdk.NewIntFromBigInt(pool.PoolUnits.BigInt()))
+	accumulated := getRewardPerUnit(pool).Add(rewardPerUnit)
+	unclaimed := getUnclaimedRewards(pool).Add(amount)
+	pool.RewardPerUnit = &accumulated
+	pool.UnclaimedRewards = &unclaimed
+	return true
+}
+
+// PendingRewards returns the rowan the liquidity provider can claim from the pool
+func PendingRewards(pool types.Pool, lp types.LiquidityProvider) sdk.Uint {
+	growth := getRewardPerUnit(&pool).Sub(getRewardPerUnitCheckpoint(&lp))
+	if !growth.IsPositive() {
+		return sdk.ZeroUint()
+	}
+	rewards := growth.MulInt(sdk.NewIntFromBigInt(lp.LiquidityProviderUnits.BigInt())).TruncateInt()
+	// rounding never lets the liquidity providers of a pool claim more than was accrued
+	return sdk.MinUint(sdk.NewUintFromBigInt(rewards.BigInt()), getUnclaimedRewards(&pool))
+}
+
+// ClaimLiquidityProviderRewards pays the liquidity provider its pending rewards and moves its checkpoint to the pool
+// accumulator. It must run before the units of the liquidity provider change, the caller saves pool and lp
+func (k Keeper) ClaimLiquidityProviderRewards(ctx sdk.Context, pool *types.Pool, lp *types.LiquidityProvider) (sdk.Uint, error) {
+	rewards := PendingRewards(*pool, *lp)
+	if !rewards.IsZero() {
+		addr, err := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
+		if err != nil {
+			return sdk.ZeroUint(), err
+		}
+		coins := sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromBigInt(rewards.BigInt())))
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+		if err != nil {
+			return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrUnableToClaimRewards, err.Error())
+		}
+		unclaimed := getUnclaimedRewards(pool).Sub(rewards)
+		pool.UnclaimedRewards = &unclaimed
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimRewards,
+				sdk.NewAttribute(types.AttributeKeyLiquidityProvider, lp.LiquidityProviderAddress),
+				sdk.NewAttribute(types.AttributeKeyPool, pool.ExternalAsset.Symbol),
+				sdk.NewAttribute(types.AttributeKeyRewardAmount, rewards.String()),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			),
+		})
+	}
+	checkpoint := getRewardPerUnit(pool)
+	lp.RewardPerUnitCheckpoint = &checkpoint
+	return rewards, nil
+}
+
+// BurnUnclaimedRewards burns the rewards of the pool no liquidity provider can claim anymore, the rounding dust
+// left once every liquidity provider has claimed. The caller saves pool
+func (k Keeper) BurnUnclaimedRewards(ctx sdk.Context, pool *types.Pool) error {
+	unclaimed := getUnclaimedRewards(pool)
+	if !unclaimed.IsZero() {
+		err := k.BurnRowan(ctx, sdk.NewIntFromBigInt(unclaimed.BigInt()))
+		if err != nil {
+			return err
+		}
+	}
+	zero := sdk.ZeroUint()
+	pool.UnclaimedRewards = &zero
+	return nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	"github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeeper_AccrueRewards(t *testing.T) {
+	asset := types.NewAsset("ceth")
+	pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.ZeroUint())
+	require.False(t, keeper.AccrueRewards(&pool, sdk.NewUint(100)))
+	require.Nil(t, pool.RewardPerUnit)
+
+	pool.PoolUnits = sdk.NewUint(400)
+	require.True(t, keeper.AccrueRewards(&pool, sdk.NewUint(100)))
+	require.True(t, keeper.AccrueRewards(&pool, sdk.NewUint(100)))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), *pool.RewardPerUnit)
+	require.Equal(t, sdk.NewUint(200), *pool.UnclaimedRewards)
+
+	lp := types.NewLiquidityProvider(&asset, sdk.NewUint(100), test.GenerateAddress(test.AddressKey1))
+	require.Equal(t, sdk.NewUint(50), keeper.PendingRewards(pool, lp))
+	checkpoint := sdk.MustNewDecFromStr("0.25")
+	lp.RewardPerUnitCheckpoint = &checkpoint
+	require.Equal(t, sdk.NewUint(25), keeper.PendingRewards(pool, lp))
+}
+
+func TestMsgServer_ClaimRewards(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	msgServer := keeper.NewMsgServerImpl(app.ClpKeeper)
+	signer := test.GenerateAddress(test.AddressKey1)
+	asset := types.NewAsset("ceth")
+	externalCoin := sdk.NewCoin(asset.Symbol, sdk.NewInt(10000))
+	nativeCoin := sdk.NewCoin(types.NativeSymbol, sdk.NewInt(10000))
+	require.NoError(t, sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(externalCoin, nativeCoin)))
+
+	msgCreatePool := types.NewMsgCreatePool(signer, asset, sdk.NewUint(1000), sdk.NewUint(1000))
+	pool, err := app.ClpKeeper.CreatePool(ctx, sdk.NewUint(1000), &msgCreatePool)
+	require.NoError(t, err)
+	app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1000), signer)
+
+	_, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &types.MsgClaimRewards{Signer: test.GenerateAddress(test.AddressKey2).String(), ExternalAsset: &asset})
+	require.ErrorIs(t, err, types.ErrLiquidityProviderDoesNotExist)
+
+	// rewards are minted to the module and accrued to the pool units
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(500)))))
+	require.True(t, keeper.AccrueRewards(pool, sdk.NewUint(500)))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, pool))
+	_, stop := app.ClpKeeper.BalanceModuleAccountCheck()(ctx)
+	require.False(t, stop)
+
+	res, err := msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &types.MsgClaimRewards{Signer: signer.String(), ExternalAsset: &asset})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewUint(500), res.Amount)
+	require.Equal(t, sdk.NewInt(9500), app.BankKeeper.GetBalance(ctx, signer, types.NativeSymbol).Amount)
+	_, stop = app.ClpKeeper.BalanceModuleAccountCheck()(ctx)
+	require.False(t, stop)
+
+	// nothing left to claim
+	res, err = msgServer.ClaimRewards(sdk.WrapSDKContext(ctx), &types.MsgClaimRewards{Signer: signer.String(), ExternalAsset: &asset})
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	// adding liquidity pays the rewards accrued before the units change
+	storedPool, err := app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(100)))))
+	require.True(t, keeper.AccrueRewards(&storedPool, sdk.NewUint(100)))
+	msgAddLiquidity := types.NewMsgAddLiquidity(signer, asset, sdk.NewUint(100), sdk.NewUint(100))
+	lp, err := app.ClpKeeper.AddLiquidity(ctx, &msgAddLiquidity, storedPool, sdk.NewUint(1100), sdk.NewUint(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(9500), app.BankKeeper.GetBalance(ctx, signer, types.NativeSymbol).Amount)
+	require.Equal(t, *storedPool.RewardPerUnit, *lp.RewardPerUnitCheckpoint)
+	storedPool, err = app.ClpKeeper.GetPool(ctx, asset.Symbol)
+	require.NoError(t, err)
+	require.True(t, storedPool.UnclaimedRewards.IsZero())
+	_, stop = app.ClpKeeper.BalanceModuleAccountCheck()(ctx)
+	require.False(t, stop)
+}
+
+func TestMigrator_MigrateToVer5(t *testing.T) {
+	ctx, app := test.CreateTestAppClp(false)
+	lp1 := test.GenerateAddress(test.AddressKey1)
+	lp2 := test.GenerateAddress(test.AddressKey2)
+	// pools and liquidity providers as the push model left them, without accumulators or checkpoints
+	for _, symbol := range []string{"ceth", "cusdc"} {
+		asset := types.NewAsset(symbol)
+		pool := types.NewPool(&asset, sdk.NewUint(1000), sdk.NewUint(1000), sdk.NewUint(3))
+		pool.RewardPerUnit = nil
+		require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+		require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewInt(1000)), sdk.NewCoin(symbol, sdk.NewInt(1000)))))
+		lp := types.NewLiquidityProvider(&asset, sdk.NewUint(2), lp1)
+		lp.RewardPerUnitCheckpoint = nil
+		app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+		lp = types.NewLiquidityProvider(&asset, sdk.NewUint(1), lp2)
+		lp.RewardPerUnitCheckpoint = nil
+		app.ClpKeeper.SetLiquidityProvider(ctx, &lp)
+	}
+
+	// the accumulators start at zero and no coins move
+	require.NoError(t, keeper.NewMigrator(app.ClpKeeper).MigrateToVer5(ctx))
+	require.Equal(t, sdk.NewInt(2000), app.ClpKeeper.GetModuleRowan(ctx).Amount)
+	for _, symbol := range []string{"ceth", "cusdc"} {
+		storedPool, err := app.ClpKeeper.GetPool(ctx, symbol)
+		require.NoError(t, err)
+		require.NotNil(t, storedPool.RewardPerUnit)
+		require.True(t, storedPool.RewardPerUnit.IsZero())
+		require.Equal(t, sdk.NewUint(1000), storedPool.NativeAssetBalance)
+		for _, addr := range []sdk.AccAddress{lp1, lp2} {
+			storedLp, err := app.ClpKeeper.GetLiquidityProvider(ctx, symbol, addr.String())
+			require.NoError(t, err)
+			require.NotNil(t, storedLp.RewardPerUnitCheckpoint)
+			require.True(t, storedLp.RewardPerUnitCheckpoint.IsZero())
+			require.True(t, app.BankKeeper.GetBalance(ctx, addr, types.NativeSymbol).IsZero())
+		}
+	}
+}
@@ -47,7 +47,6 @@ func (k Keeper) DistributeDepthRewards(ctx sdk.Context, blockDistribution sdk.Ui
 	}
 
 	tuples, coinsToMint := CollectPoolRewardTuples(pools, blockDistribution, totalDepth, period)
-	moduleBalancePreMinting := k.GetModuleRowan(ctx)
 	rewardCoins := sdk.NewCoins(sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromBigInt(coinsToMint.BigInt())))
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, rewardCoins)
 	if err != nil {
@@ -55,68 +54,22 @@ func (k Keeper) DistributeDepthRewards(ctx sdk.Context, blockDistribution sdk.Ui
 	}
 
 	shouldDistribute := period.RewardPeriodDistribute
-	poolRowanMap := make(PoolRowanMap)
-	lpRowanMap := make(LpRowanMap)
-	lpPoolMap := make(LpPoolMap)
-
-	var partitions map[types.Asset][]*types.LiquidityProvider
-	if shouldDistribute {
-		partitions, err = k.GetAllLiquidityProvidersPartitions(ctx)
-		if err != nil {
-			fireLPPGetLPsErrorEvent(ctx, err)
-		}
-	}
-
 	for _, e := range tuples {
-		if shouldDistribute {
-			lps, exists := partitions[*e.Pool.ExternalAsset]
-			if !exists { // TODO: fire event
-				k.Logger(ctx).Error(fmt.Sprintf("No liquidity providers for asset %s ", e.Pool.ExternalAsset.Symbol))
-
-				// if this fails, we add rewards to pool instead
-				k.addRewardsToPool(ctx, e.Pool, e.Reward)
-				continue
-			}
-
-			lpsFiltered := FilterValidLiquidityProviders(ctx, lps)
-			CollectRewards(ctx, e.Pool, e.Reward, e.Pool.PoolUnits, lpsFiltered, poolRowanMap, lpRowanMap, lpPoolMap)
-		} else {
+		// rewards of a pool without liquidity providers are added to the pool instead
+		if !shouldDistribute || !AccrueRewards(e.Pool, e.Reward) {
 			k.addRewardsToPool(ctx, e.Pool, e.Reward)
+			continue
 		}
-	}
-
-	if shouldDistribute {
-		poolRowanMapSum := sdk.ZeroUint()
-		for _, rowan := range poolRowanMap {
-			poolRowanMapSum = poolRowanMapSum.Add(rowan)
-		}
-
-		if !coinsToMint.Equal(poolRowanMapSum) {
-			k.Logger(ctx).Info(fmt.Sprintln("coinsToMint", coinsToMint.String(), " != poolRowanMapSum", poolRowanMapSum.String()))
-		}
-
-		// this updates poolRowanMap in case coin tranfers fails
-		k.TransferRewards(ctx, poolRowanMap, lpRowanMap, lpPoolMap)
 
-		for pool, rowan := range poolRowanMap {
-			if rowan.Equal(sdk.ZeroUint()) {
-				continue
-			}
-
-			pool.RewardPeriodNativeDistributed = pool.RewardPeriodNativeDistributed.Add(rowan)
-			k.SetPool(ctx, pool) // nolint:errcheck
+		e.Pool.RewardPeriodNativeDistributed = e.Pool.RewardPeriodNativeDistributed.Add(e.Reward)
+		err = k.SetPool(ctx, e.Pool)
+		if err != nil {
+			return err
 		}
+	}
 
-		// As we have already minted all coins we wanted to distribute, check if we could distribute them all.
-		// If not, burn what we could not distribute
-		moduleBalancePostTransfer := k.GetModuleRowan(ctx)
-		diff := moduleBalancePostTransfer.Sub(moduleBalancePreMinting).Amount // post is always >= pre
-
-		if !diff.IsZero() {
-			k.BurnRowan(ctx, diff) // nolint:errcheck
-		}
-		coinsMinted := sdk.NewIntFromBigInt(coinsToMint.BigInt()).Sub(diff)
-		fireRewardsEvent(ctx, "rewards/distribution", coinsMinted, PoolRowanMapToLPPools(poolRowanMap))
+	if shouldDistribute {
+		fireRewardsEvent(ctx, "rewards/distribution", sdk.NewIntFromBigInt(coinsToMint.BigInt()), poolRewardsToLPPools(tuples))
 	} else {
 		fireRewardsEvent(ctx, "rewards/accumulation", sdk.NewIntFromBigInt(coinsToMint.BigInt()), poolRewardsToLPPools(tuples))
 	}
@@ -153,10 +106,6 @@ func (k Keeper) BurnRowan(ctx sdk.Context, amount sdk.Int) error {
 	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin))
 }
 
-func (k Keeper) TransferRewards(ctx sdk.Context, poolRowanMap PoolRowanMap, lpRowanMap LpRowanMap, lpPoolMap LpPoolMap) {
-	k.TransferProviderDistributionGeneric(ctx, poolRowanMap, lpRowanMap, lpPoolMap, "rewards/distribution_error", "rewards/distribution")
-}
-
 type PoolReward struct {
 	Pool   *types.Pool
 	Reward sdk.Uint
@@ -210,11 +159,6 @@ func calcPoolDistribution(multiplier sdk.Dec, poolNativeBalance sdk.Uint, totalD
 	return sdk.NewUintFromBigInt(poolDistribution.TruncateInt().BigInt())
 }
 
-func CollectRewards(ctx sdk.Context, pool *types.Pool, poolDistribution sdk.Uint, poolUnits sdk.Uint, lps []ValidLiquidityProvider, poolRowanMap PoolRowanMap, lpRowanMap LpRowanMap, lpPoolMap LpPoolMap) {
-	rowanToDistribute := CollectProviderDistribution(ctx, pool, sdk.NewDecFromBigInt(poolDistribution.BigInt()), sdk.NewDec(1), poolUnits, lps, lpRowanMap, lpPoolMap)
-	poolRowanMap[pool] = rowanToDistribute
-}
-
 func (k Keeper) calcTotalDepth(ctx sdk.Context, pools []*types.Pool, period *types.RewardPeriod, height uint64) (sdk.Dec, error) {
 	totalDepth := sdk.ZeroDec()
 	for _, pool := range pools {
@@ -315,6 +259,40 @@ func (k Keeper) PruneUnlockRecords(ctx sdk.Context, lp *types.LiquidityProvider,
 	}
 }
 
+// SplitUnlockRecords trims the unlock records of lp down to unitsLeft and returns the units trimmed, taken
+// from the newest records first so that the oldest requests stay with lp
+func SplitUnlockRecords(lp *types.LiquidityProvider, unitsLeft sdk.Uint) []*types.LiquidityUnlock {
+	totalUnlocks := sdk.ZeroUint()
+	for _, record := range lp.Unlocks {
+		totalUnlocks = totalUnlocks.Add(record.Units)
+	}
+	if totalUnlocks.LTE(unitsLeft) {
+		return nil
+	}
+
+	excess := totalUnlocks.Sub(unitsLeft)
+	split := make([]*types.LiquidityUnlock, 0)
+	for i := len(lp.Unlocks) - 1; i >= 0 && !excess.IsZero(); i-- {
+		record := lp.Unlocks[i]
+		units := sdk.MinUint(record.Units, excess)
+		record.Units = record.Units.Sub(units)
+		excess = excess.Sub(units)
+		split = append(split, &types.LiquidityUnlock{
+			RequestHeight: record.RequestHeight,
+			Units:         units,
+		})
+	}
+
+	records := make([]*types.LiquidityUnlock, 0)
+	for _, record := range lp.Unlocks {
+		if !record.Units.IsZero() {
+			records = append(records, record)
+		}
+	}
+	lp.Unlocks = records
+	return split
+}
+
 func GetPoolMultiplier(asset string, period *types.RewardPeriod) sdk.Dec {
 	for _, m := range period.RewardPeriodPoolMultipliers {
 		if types.StringCompare(asset, m.PoolMultiplierAsset) {
@@ -56,6 +56,12 @@ func TestEndBlock(t *testing.T) {
 		RewardPeriodNativeDistributed: sdk.ZeroUint(),
 	})
 	require.NoError(t, err)
+	// the pool units are held by a liquidity provider, as the pool shares invariant expects
+	for _, symbol := range []string{"atom", "cusdc", "ceth"} {
+		asset := types.NewAsset(symbol)
+		_, err = app.ClpKeeper.CreateLiquidityProvider(ctx, &asset, sdk.NewUint(1000), test.GenerateAddress(test.AddressKey1))
+		require.NoError(t, err)
+	}
 	startingSupply := app.BankKeeper.GetSupply(ctx, "rowan")
 	for block := 1; block <= 10; block++ {
 		app.BeginBlock(abci.RequestBeginBlock{Header: tenderminttypes.Header{Height: int64(block)}})
@@ -186,25 +192,40 @@ func TestKeeper_RewardsDistribution(t *testing.T) {
 	lpAddr, _ := sdk.AccAddressFromBech32(lp.LiquidityProviderAddress)
 
 	lpCoinsBefore := app.BankKeeper.GetBalance(ctx, lpAddr, types.NativeSymbol)
-	// Disitribute coins to the LP
+	// Accrue coins to the LP
 	blockDistribution := keeper.CalcBlockDistribution(&period)
 	err := app.ClpKeeper.DistributeDepthRewards(ctx, blockDistribution, &period, pools)
 	require.Nil(t, err)
-	lpCoinsAfter1 := app.BankKeeper.GetBalance(ctx, lpAddr, types.NativeSymbol)
-	require.True(t, lpCoinsBefore.IsLT(lpCoinsAfter1))
-	require.Equal(t, lpCoinsAfter1, totalCoinsDistribution)
 	require.Subset(t, ctx.EventManager().Events(), createRewardsDistributeEvent(totalCoinsDistribution, pool.ExternalAsset))
+	// nothing is transferred until the LP claims
+	require.Equal(t, lpCoinsBefore, app.BankKeeper.GetBalance(ctx, lpAddr, types.NativeSymbol))
+	require.Equal(t, startBalance.Add(totalCoinsDistribution).String(), app.ClpKeeper.GetModuleRowan(ctx).String())
+
+	storedPool, err := app.ClpKeeper.GetPool(ctx, pool.ExternalAsset.Symbol)
+	require.NoError(t, err)
+	storedLP, err := app.ClpKeeper.GetLiquidityProvider(ctx, pool.ExternalAsset.Symbol, lp.LiquidityProviderAddress)
+	require.NoError(t, err)
+	claimed, err := app.ClpKeeper.ClaimLiquidityProviderRewards(ctx, &storedPool, &storedLP)
+	require.NoError(t, err)
+	// the single LP owns every unit, only rounding dust stays unclaimed
+	require.Equal(t, allocation.String(), claimed.Add(*storedPool.UnclaimedRewards).String())
+	require.True(t, storedPool.UnclaimedRewards.LTE(sdk.OneUint()))
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &storedPool))
+	app.ClpKeeper.SetLiquidityProvider(ctx, &storedLP)
+	lpCoinsAfter1 := app.BankKeeper.GetBalance(ctx, lpAddr, types.NativeSymbol)
+	require.Equal(t, claimed.String(), lpCoinsAfter1.Amount.String())
 
 	distributed1 := pool.RewardPeriodNativeDistributed
 	moduleBalance1 := app.ClpKeeper.GetModuleRowan(ctx)
-	// We transferred all minted coins
-	require.Equal(t, startBalance.String(), moduleBalance1.String())
+	dust := sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromBigInt(storedPool.UnclaimedRewards.BigInt()))
+	require.Equal(t, startBalance.Add(dust).String(), moduleBalance1.String())
 
 	// This time, we do not distribute coins to the LP
 	period.RewardPeriodDistribute = false
+	pool = &storedPool
 	// reset to easier keep track of it
 	pool.RewardPeriodNativeDistributed = sdk.ZeroUint()
-	err = app.ClpKeeper.DistributeDepthRewards(ctx, blockDistribution, &period, pools)
+	err = app.ClpKeeper.DistributeDepthRewards(ctx, blockDistribution, &period, []*types.Pool{pool})
 	require.Nil(t, err)
 	distributed2 := pool.RewardPeriodNativeDistributed
 	require.Equal(t, distributed1, distributed2)
@@ -216,7 +237,7 @@ func TestKeeper_RewardsDistribution(t *testing.T) {
 	moduleBalance2 := app.ClpKeeper.GetModuleRowan(ctx)
 	diffBalance := sdk.NewCoin(types.NativeSymbol, sdk.NewIntFromBigInt(distributed2.BigInt()))
 	// we did not distribute the newly minted coins
-	require.Equal(t, startBalance.Add(diffBalance).String(), moduleBalance2.String())
+	require.Equal(t, moduleBalance1.Add(diffBalance).String(), moduleBalance2.String())
 }
 
 // nolint
@@ -262,28 +283,25 @@ func TestKeeper_RewardsDistributionFailure(t *testing.T) {
 	require.Equal(t, lpAddress, lpAddr)
 
 	lpCoinsBefore := app.BankKeeper.GetBalance(ctx, lpAddress, types.NativeSymbol)
-	// Distribute coins to the LP
+	// Accrue coins to the LP
 	blockDistribution := keeper.CalcBlockDistribution(&period)
 	err := app.ClpKeeper.DistributeDepthRewards(ctx, blockDistribution, &period, pools)
 	require.Nil(t, err)
 
-	// Nope, distribution failed
+	// Nope, claiming failed
+	storedPool, err := app.ClpKeeper.GetPool(ctx, pool.ExternalAsset.Symbol)
+	require.NoError(t, err)
+	storedLP, err := app.ClpKeeper.GetLiquidityProvider(ctx, pool.ExternalAsset.Symbol, lp.LiquidityProviderAddress)
+	require.NoError(t, err)
+	pending := keeper.PendingRewards(storedPool, storedLP)
+	require.False(t, pending.IsZero())
+	_, err = app.ClpKeeper.ClaimLiquidityProviderRewards(ctx, &storedPool, &storedLP)
+	require.ErrorIs(t, err, types.ErrUnableToClaimRewards)
 	lpCoinsAfter := app.BankKeeper.GetBalance(ctx, lpAddress, types.NativeSymbol)
 	require.Equal(t, lpCoinsBefore, lpCoinsAfter)
-	require.Equal(t, pool.RewardPeriodNativeDistributed.String(), sdk.ZeroInt().String())
 
-	// Check tokens got burnt
+	// the rewards stay claimable and held by the module
+	require.Equal(t, pending, keeper.PendingRewards(storedPool, storedLP))
 	moduleBalance := app.ClpKeeper.GetModuleRowan(ctx)
-	require.Equal(t, startBalance, moduleBalance)
-
-	failedEvent := createFailedEvent(lpAddress)
-	require.Subset(t, ctx.EventManager().Events(), failedEvent)
-}
-
-func createFailedEvent(receiver sdk.AccAddress) []sdk.Event {
-	return []sdk.Event{sdk.NewEvent("rewards/distribution_error",
-		sdk.NewAttribute("liquidity_provider", receiver.String()),
-		sdk.NewAttribute("error", fmt.Sprint(receiver.String(), " is not allowed to receive funds: unauthorized")),
-		sdk.NewAttribute("height", "0")),
-	}
+	require.Equal(t, startBalance.AddAmount(sdk.NewIntFromBigInt(allocation.BigInt())), moduleBalance)
 }
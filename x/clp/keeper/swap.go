@@ -23,3 +23,27 @@ func (k Keeper) CLPCalcSwap(ctx sdk.Context, sentAmount sdk.Uint, to types.Asset
 
 	return swapResult, nil
 }
+
+// CLPCalcSwapSentAmount returns the least amount that has to be sent into the pool to receive receivedAmount of to,
+// charging the same swap fee as CLPCalcSwap
+func (k Keeper) CLPCalcSwapSentAmount(ctx sdk.Context, receivedAmount sdk.Uint, to types.Asset, pool types.Pool, marginEnabled bool) (sdk.Uint, error) {
+
+	X, Y, toRowan, from := pool.ExtractValues(to)
+
+	Xincl, Yincl := pool.ExtractDebt(X, Y, toRowan)
+
+	if receivedAmount.GTE(Y) {
+		return sdk.ZeroUint(), types.ErrNotEnoughAssetTokens
+	}
+
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+
+	swapFeeRate := k.GetSwapFeeRate(ctx, from, marginEnabled)
+
+	sentAmount, ok := CalcSwapSentAmount(toRowan, Xincl, receivedAmount, Yincl, pmtpCurrentRunningRate, swapFeeRate)
+	if !ok {
+		return sdk.ZeroUint(), types.ErrNotEnoughAssetTokens
+	}
+
+	return sentAmount, nil
+}
@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) SetPriceAccumulator(ctx sdk.Context, accumulator *types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceAccumulatorKey(accumulator.Symbol, accumulator.Height), k.cdc.MustMarshal(accumulator))
+}
+
+// GetLatestPriceAccumulator returns the most recent price accumulator of a pool taken
+// at or before height
+func (k Keeper) GetLatestPriceAccumulator(ctx sdk.Context, symbol string, height int64) (types.PriceAccumulator, error) {
+	var accumulator types.PriceAccumulator
+	store := ctx.KVStore(k.storeKey)
+	// accumulators are recorded every block so try the exact height before iterating
+	if bz := store.Get(types.GetPriceAccumulatorKey(symbol, height)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &accumulator)
+		return accumulator, nil
+	}
+	it := store.ReverseIterator(types.GetPriceAccumulatorPrefix(symbol), types.GetPriceAccumulatorKey(symbol, height+1))
+	defer it.Close()
+	if !it.Valid() {
+		return accumulator, sdkerrors.Wrapf(types.ErrPriceAccumulatorNotFound, "%s at height %d", symbol, height)
+	}
+	k.cdc.MustUnmarshal(it.Value(), &accumulator)
+	return accumulator, nil
+}
+
+// PrunePriceAccumulators deletes the price accumulators of a pool that are not needed
+// for a twap starting at or after height. The latest accumulator at or before height
+// is kept as pools that skipped blocks extend it to height. Pruning runs every block so
+// at most one accumulator is left before height, and it is only replaced once the pool
+// has an accumulator at height.
+func (k Keeper) PrunePriceAccumulators(ctx sdk.Context, symbol string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetPriceAccumulatorKey(symbol, height)) {
+		return
+	}
+	it := store.ReverseIterator(types.GetPriceAccumulatorPrefix(symbol), types.GetPriceAccumulatorKey(symbol, height))
+	var key []byte
+	if it.Valid() {
+		key = it.Key()
+	}
+	it.Close()
+	if key != nil {
+		store.Delete(key)
+	}
+}
+
+// DeletePriceAccumulators deletes all the price accumulators of a pool
+func (k Keeper) DeletePriceAccumulators(ctx sdk.Context, symbol string) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.GetPriceAccumulatorPrefix(symbol))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// CumulativePricesAt returns the cumulative prices of the accumulator extended to height
+func CumulativePricesAt(accumulator types.PriceAccumulator, height int64) (sdk.Dec, sdk.Dec) {
+	elapsed := sdk.NewDec(height - accumulator.Height)
+	return accumulator.CumulativePriceNative.Add(accumulator.PriceNative.Mul(elapsed)),
+		accumulator.CumulativePriceExternal.Add(accumulator.PriceExternal.Mul(elapsed))
+}
+
+// CalcPoolPrices returns the price of the native asset in the external asset and the
+// price of the external asset in the native asset, in base units and adjusted by PMTP.
+func CalcPoolPrices(pool *types.Pool, pmtpCurrentRunningRate sdk.Dec) (sdk.Dec, sdk.Dec, error) {
+	nativeBal, externalBal := pool.ExtractDebt(pool.NativeAssetBalance, pool.ExternalAssetBalance, false)
+	if nativeBal.IsZero() || externalBal.IsZero() {
+		return sdk.ZeroDec(), sdk.ZeroDec(), types.ErrInValidAmount
+	}
+	nativeBalance := sdk.NewDecFromBigInt(nativeBal.BigInt())
+	externalBalance := sdk.NewDecFromBigInt(externalBal.BigInt())
+	pmtpFactor := pmtpCurrentRunningRate.Add(sdk.OneDec())
+	priceNative := externalBalance.Quo(nativeBalance).Mul(pmtpFactor)
+	priceExternal := nativeBalance.Quo(externalBalance).Quo(pmtpFactor)
+	return priceNative, priceExternal, nil
+}
+
+// RecordPriceAccumulators extends the price accumulator of every pool to the current
+// block using the pool prices as they stand at the beginning of the block, and prunes
+// the accumulators that have fallen out of MaxTwapWindow.
+// NOTE: this is run in the BeginBlocker and must not panic
+func (k Keeper) RecordPriceAccumulators(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	pmtpCurrentRunningRate := k.GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	for _, pool := range k.GetPools(ctx) {
+		symbol := pool.ExternalAsset.Symbol
+		if height > types.MaxTwapWindow {
+			k.PrunePriceAccumulators(ctx, symbol, height-types.MaxTwapWindow)
+		}
+		priceNative, priceExternal, err := CalcPoolPrices(pool, pmtpCurrentRunningRate)
+		if err != nil {
+			// empty pools keep accumulating at their last recorded price
+			ctx.Logger().Error(sdkerrors.Wrap(err, fmt.Sprintf("error calculating %s pool prices", symbol)).Error())
+			continue
+		}
+		accumulator := types.PriceAccumulator{
+			Symbol:                  symbol,
+			Height:                  height,
+			CumulativePriceNative:   sdk.ZeroDec(),
+			CumulativePriceExternal: sdk.ZeroDec(),
+			PriceNative:             priceNative,
+			PriceExternal:           priceExternal,
+		}
+		if previous, err := k.GetLatestPriceAccumulator(ctx, symbol, height-1); err == nil {
+			accumulator.CumulativePriceNative, accumulator.CumulativePriceExternal = CumulativePricesAt(previous, height)
+		}
+		k.SetPriceAccumulator(ctx, &accumulator)
+	}
+}
+
+// GetTwap returns the time weighted average prices of a pool over the blocks from
+// startHeight up to, but not including, endHeight. The native price is quoted in the
+// external asset and the external price in the native asset, both in base units.
+func (k Keeper) GetTwap(ctx sdk.Context, symbol string, startHeight, endHeight int64) (sdk.Dec, sdk.Dec, error) {
+	if startHeight <= 0 || startHeight >= endHeight || endHeight > ctx.BlockHeight() {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapWindow, "%d to %d", startHeight, endHeight)
+	}
+	start, err := k.GetLatestPriceAccumulator(ctx, symbol, startHeight)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	end, err := k.GetLatestPriceAccumulator(ctx, symbol, endHeight)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	startNative, startExternal := CumulativePricesAt(start, startHeight)
+	endNative, endExternal := CumulativePricesAt(end, endHeight)
+	blocks := sdk.NewDec(endHeight - startHeight)
+	return endNative.Sub(startNative).Quo(blocks), endExternal.Sub(startExternal).Quo(blocks), nil
+}
+
+// GetTwapForWindow returns the time weighted average prices of a pool over the last
+// window blocks
+func (k Keeper) GetTwapForWindow(ctx sdk.Context, symbol string, window uint64) (sdk.Dec, sdk.Dec, error) {
+	if window == 0 || window > types.MaxTwapWindow {
+		return sdk.Dec{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidTwapWindow, "%d blocks", window)
+	}
+	endHeight := ctx.BlockHeight()
+	return k.GetTwap(ctx, symbol, endHeight-int64(window), endHeight)
+}
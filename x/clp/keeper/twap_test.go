@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sifapp "github.com/Sifchain/sifnode/app"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
+	"github.com/Sifchain/sifnode/x/clp/test"
+	"github.com/Sifchain/sifnode/x/clp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// recordPrices sets the cusdc pool to each of the native prices in turn, recording the
+// price accumulators at heights 1, 2, ... and returns the context at the last height
+func recordPrices(t *testing.T, ctx sdk.Context, app *sifapp.SifchainApp, nativePrices []int64) sdk.Context {
+	for i, price := range nativePrices {
+		pool, err := app.ClpKeeper.GetPool(ctx, "cusdc")
+		require.NoError(t, err)
+		pool.NativeAssetBalance = sdk.NewUint(1000)
+		pool.ExternalAssetBalance = sdk.NewUint(uint64(1000 * price))
+		require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		app.ClpKeeper.RecordPriceAccumulators(ctx)
+	}
+	return ctx
+}
+
+func createTwapTestApp(t *testing.T) (sdk.Context, *sifapp.SifchainApp) {
+	ctx, app := test.CreateTestAppClpFromGenesis(false, func(app *sifapp.SifchainApp, genesisState sifapp.GenesisState) sifapp.GenesisState {
+		clpGs := types.DefaultGenesisState()
+		clpGs.Params = types.Params{
+			MinCreatePoolThreshold: 1,
+		}
+		clpGs.PoolList = append(clpGs.PoolList, &types.Pool{
+			ExternalAsset:        &types.Asset{Symbol: "cusdc"},
+			NativeAssetBalance:   sdk.NewUint(1000),
+			ExternalAssetBalance: sdk.NewUint(1000),
+			PoolUnits:            sdk.NewUint(1000),
+		})
+		bz, _ := app.AppCodec().MarshalJSON(clpGs)
+		genesisState["clp"] = bz
+		return genesisState
+	})
+	app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.ZeroDec())
+	return ctx, app
+}
+
+func TestKeeper_GetTwap(t *testing.T) {
+	testcases := []struct {
+		name                  string
+		symbol                string
+		startHeight           int64
+		endHeight             int64
+		currentHeight         int64
+		expectedNativePrice   sdk.Dec
+		expectedExternalPrice sdk.Dec
+		expectedError         error
+	}{
+		{
+			name:                  "full window",
+			symbol:                "cusdc",
+			startHeight:           1,
+			endHeight:             4,
+			currentHeight:         4,
+			expectedNativePrice:   sdk.NewDec(7).QuoInt64(3),
+			expectedExternalPrice: sdk.MustNewDecFromStr("1.75").QuoInt64(3),
+		},
+		{
+			name:                  "partial window",
+			symbol:                "cusdc",
+			startHeight:           2,
+			endHeight:             4,
+			currentHeight:         4,
+			expectedNativePrice:   sdk.NewDec(3),
+			expectedExternalPrice: sdk.MustNewDecFromStr("0.375"),
+		},
+		{
+			name:                  "window ending after the last accumulator",
+			symbol:                "cusdc",
+			startHeight:           4,
+			endHeight:             6,
+			currentHeight:         6,
+			expectedNativePrice:   sdk.NewDec(1),
+			expectedExternalPrice: sdk.NewDec(1),
+		},
+		{
+			name:          "empty window",
+			symbol:        "cusdc",
+			startHeight:   3,
+			endHeight:     3,
+			currentHeight: 4,
+			expectedError: types.ErrInvalidTwapWindow,
+		},
+		{
+			name:          "window ending in the future",
+			symbol:        "cusdc",
+			startHeight:   1,
+			endHeight:     5,
+			currentHeight: 4,
+			expectedError: types.ErrInvalidTwapWindow,
+		},
+		{
+			name:          "pool without price history",
+			symbol:        "ceth",
+			startHeight:   1,
+			endHeight:     4,
+			currentHeight: 4,
+			expectedError: types.ErrPriceAccumulatorNotFound,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := createTwapTestApp(t)
+			ctx = recordPrices(t, ctx, app, []int64{1, 2, 4, 1})
+			ctx = ctx.WithBlockHeight(tc.currentHeight)
+
+			nativePrice, externalPrice, err := app.ClpKeeper.GetTwap(ctx, tc.symbol, tc.startHeight, tc.endHeight)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNativePrice.String(), nativePrice.String())
+			require.Equal(t, tc.expectedExternalPrice.String(), externalPrice.String())
+		})
+	}
+}
+
+func TestKeeper_RecordPriceAccumulatorsPrunes(t *testing.T) {
+	ctx, app := createTwapTestApp(t)
+	ctx = recordPrices(t, ctx, app, []int64{1, 2})
+
+	ctx = ctx.WithBlockHeight(types.MaxTwapWindow + 2)
+	app.ClpKeeper.RecordPriceAccumulators(ctx)
+
+	_, err := app.ClpKeeper.GetLatestPriceAccumulator(ctx, "cusdc", 1)
+	require.ErrorIs(t, err, types.ErrPriceAccumulatorNotFound)
+	accumulator, err := app.ClpKeeper.GetLatestPriceAccumulator(ctx, "cusdc", 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), accumulator.Height)
+
+	nativePrice, _, err := app.ClpKeeper.GetTwapForWindow(ctx, "cusdc", types.MaxTwapWindow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).String(), nativePrice.String())
+}
+
+func TestKeeper_RecordPriceAccumulatorsPrunesSkippedHeights(t *testing.T) {
+	ctx, app := createTwapTestApp(t)
+	ctx = recordPrices(t, ctx, app, []int64{1, 2, 3})
+	app.ClpKeeper.RecordPriceAccumulators(ctx.WithBlockHeight(10))
+
+	// everything below the window goes but the accumulator the window starts from
+	for height := int64(types.MaxTwapWindow + 1); height <= types.MaxTwapWindow+20; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.ClpKeeper.RecordPriceAccumulators(ctx)
+	}
+	for _, height := range []int64{1, 2, 3} {
+		_, err := app.ClpKeeper.GetLatestPriceAccumulator(ctx, "cusdc", height)
+		require.ErrorIs(t, err, types.ErrPriceAccumulatorNotFound)
+	}
+	accumulator, err := app.ClpKeeper.GetLatestPriceAccumulator(ctx, "cusdc", 20)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), accumulator.Height)
+
+	nativePrice, _, err := app.ClpKeeper.GetTwapForWindow(ctx, "cusdc", types.MaxTwapWindow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3).String(), nativePrice.String())
+}
+
+func TestKeeper_GetNativePriceTwap(t *testing.T) {
+	testcases := []struct {
+		name          string
+		twapWindow    uint64
+		expectedPrice sdk.Dec
+		expectedError error
+	}{
+		{
+			name:          "spot price",
+			twapWindow:    0,
+			expectedPrice: sdk.NewDec(1),
+		},
+		{
+			name:          "twap",
+			twapWindow:    3,
+			expectedPrice: sdk.NewDec(7).QuoInt64(3),
+		},
+		{
+			name:          "spot price without enough price history",
+			twapWindow:    10,
+			expectedPrice: sdk.NewDec(1),
+		},
+		{
+			name:          "window above the price history kept",
+			twapWindow:    types.MaxTwapWindow + 1,
+			expectedError: types.ErrInvalidTwapWindow,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, app := createTwapTestApp(t)
+			ctx = recordPrices(t, ctx, app, []int64{1, 2, 4, 1})
+
+			liquidityProtectionParams := app.ClpKeeper.GetLiquidityProtectionParams(ctx)
+			liquidityProtectionParams.MaxRowanLiquidityThresholdAsset = "cusdc"
+			liquidityProtectionParams.TwapWindow = tc.twapWindow
+			app.ClpKeeper.SetLiquidityProtectionParams(ctx, liquidityProtectionParams)
+
+			price, err := app.ClpKeeper.GetNativePrice(ctx)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice.String(), price.String())
+		})
+	}
+}
+
+func TestQuerier_GetTwap(t *testing.T) {
+	ctx, app := createTwapTestApp(t)
+	ctx = recordPrices(t, ctx, app, []int64{1, 2, 4, 1})
+	querier := clpkeeper.Querier{Keeper: app.ClpKeeper}
+
+	res, err := querier.GetTwap(sdk.WrapSDKContext(ctx), &types.TwapReq{Symbol: "cusdc", StartHeight: 2})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3).String(), res.NativePrice.String())
+	require.Equal(t, int64(4), res.EndHeight)
+	require.Equal(t, int64(4), res.Height)
+
+	_, err = querier.GetTwap(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
@@ -53,6 +53,11 @@ func GetSettlementAsset() Asset {
 
 }
 
+// GetPoolShareDenom returns the bank denom of the units of the pool of symbol, e.g. clp/ceth
+func GetPoolShareDenom(symbol string) string {
+	return PoolShareDenomPrefix + symbol
+}
+
 func GetCLPModuleAddress() sdk.AccAddress {
 	return authtypes.NewModuleAddress(ModuleName)
 }
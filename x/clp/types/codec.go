@@ -15,6 +15,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgRemoveLiquidity{}, "clp/RemoveLiquidity", nil)
 	cdc.RegisterConcrete(&MsgRemoveLiquidityUnits{}, "clp/RemoveLiquidityUnits", nil)
 	cdc.RegisterConcrete(&MsgSwap{}, "clp/Swap", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "clp/SwapRoute", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "clp/PlaceLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "clp/CancelLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "clp/ClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgTransferLiquidity{}, "clp/TransferLiquidity", nil)
+	cdc.RegisterConcrete(&MsgCancelRemovalRequest{}, "clp/CancelRemovalRequest", nil)
 	cdc.RegisterConcrete(&MsgDecommissionPool{}, "clp/DecommissionPool", nil)
 	cdc.RegisterConcrete(&MsgUnlockLiquidityRequest{}, "clp/UnlockLiquidity", nil)
 }
@@ -38,6 +44,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreatePool{},
 		&MsgAddLiquidity{},
 		&MsgSwap{},
+		&MsgSwapRoute{},
+		&MsgPlaceLimitOrder{},
+		&MsgCancelLimitOrder{},
+		&MsgClaimRewards{},
+		&MsgTransferLiquidity{},
+		&MsgCancelRemovalRequest{},
 		&MsgDecommissionPool{},
 		&MsgUnlockLiquidityRequest{},
 	)
@@ -44,4 +44,15 @@ var (
 	ErrRemovalsBlockedByHealth                         = sdkerrors.Register(ModuleName, 42, "Cannot remove liquidity due to low pool health")
 	ErrBalanceModuleAccountCheck                       = sdkerrors.Register(ModuleName, 43, "Balance of module account check failed")
 	ErrUnitsCheck                                      = sdkerrors.Register(ModuleName, 44, "Pool vs LP units check failed")
+	ErrInvalidSwapRoute                                = sdkerrors.Register(ModuleName, 45, "Invalid swap route")
+	ErrLimitOrderNotFound                              = sdkerrors.Register(ModuleName, 46, "Limit order not found")
+	ErrInvalidLimitOrder                               = sdkerrors.Register(ModuleName, 47, "Invalid limit order")
+	ErrPriceAccumulatorNotFound                        = sdkerrors.Register(ModuleName, 48, "Price accumulator not found")
+	ErrInvalidTwapWindow                               = sdkerrors.Register(ModuleName, 49, "Invalid TWAP window")
+	ErrUnableToClaimRewards                            = sdkerrors.Register(ModuleName, 50, "Unable to claim rewards")
+	ErrUnableToTransferLiquidity                       = sdkerrors.Register(ModuleName, 51, "Unable to transfer liquidity")
+	ErrInsufficientPoolShares                          = sdkerrors.Register(ModuleName, 52, "Not enough pool share coins to back the liquidity units")
+	ErrPoolSharesCheck                                 = sdkerrors.Register(ModuleName, 53, "Pool share supply vs pool units check failed")
+	ErrRemovalRequestNotFound                          = sdkerrors.Register(ModuleName, 54, "Removal request not found")
+	ErrLimitOrderPriceNotReached                       = sdkerrors.Register(ModuleName, 55, "Limit order target price not reached")
 )
@@ -14,6 +14,11 @@ const (
 	EventTypeCancelUnlock                        = "cancel_unlock_liquidity"
 	EventTypeSwap                                = "swap_successful"
 	EventTypeSwapFailed                          = "swap_failed"
+	EventTypeSwapHop                             = "swap_hop"
+	EventTypePlaceLimitOrder                     = "limit_order_placed"
+	EventTypeCancelLimitOrder                    = "limit_order_cancelled"
+	EventTypeExecuteLimitOrder                   = "limit_order_executed"
+	EventTypeExpireLimitOrder                    = "limit_order_expired"
 	EventTypeUpdateLiquidityProtectionParams     = "liquidity_protection_update_params"
 	EventTypeUpdateLiquidityProtectionRateParams = "liquidity_protection_update_rate_params"
 	EventTypeAddNewProviderDistributionPolicy    = "lppd_new_policy"
@@ -22,8 +27,15 @@ const (
 	EventTypeQueueRemovalRequest                 = "queue_removal_request"
 	EventTypeDequeueRemovalRequest               = "dequeue_removal_request"
 	EventTypeProcessRemovalError                 = "process_removal_error"
+	EventTypeClaimRewards                        = "claim_rewards"
+	EventTypeTransferLiquidity                   = "transfer_liquidity"
 	AttributeKeyThreshold                        = "min_threshold"
 	AttributeKeySwapAmount                       = "swap_amount"
+	AttributeKeyHop                              = "hop"
+	AttributeKeyLimitOrder                       = "limit_order"
+	AttributeKeySentAsset                        = "sent_asset"
+	AttributeKeySentAmount                       = "sent_amount"
+	AttributeKeyReceivedAsset                    = "received_asset"
 	AttributeKeyLiquidityFee                     = "liquidity_fee"
 	AttributeKeyPriceImpact                      = "price_impact"
 	AttributeKeyInPool                           = "in_pool"
@@ -34,6 +46,8 @@ const (
 	AttributeKeyHeight                           = "height"
 	AttributeKeyLiquidityProvider                = "liquidity_provider"
 	AttributeKeyUnits                            = "liquidity_units"
+	AttributeKeyRewardAmount                     = "reward_amount"
+	AttributeKeyReceiver                         = "receiver"
 	AttributeKeyPmtpPolicyParams                 = "pmtp_policy_params"
 	AttributeKeyPmtpRateParams                   = "pmtp_rate_params"
 	AttributeKeyLiquidityProtectionParams        = "liquidity_protection_params"
@@ -24,6 +24,8 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
 }
 
 type AuthKeeper interface {
@@ -32,9 +34,8 @@ type AuthKeeper interface {
 }
 
 type TokenRegistryKeeper interface {
-	GetEntry(registry tokenregistryTypes.Registry, denom string) (*tokenregistryTypes.RegistryEntry, error)
+	GetRegistryEntry(ctx sdk.Context, denom string) (*tokenregistryTypes.RegistryEntry, error)
 	CheckEntryPermissions(entry *tokenregistryTypes.RegistryEntry, permissions []tokenregistryTypes.Permission) bool
-	GetRegistry(ctx sdk.Context) tokenregistryTypes.Registry
 }
 
 type AdminKeeper interface {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -25,6 +26,28 @@ const (
 
 	MaxSymbolLength = 71
 	MaxWbasis       = 10000
+
+	// MaxTwapWindow is the number of blocks of price accumulators kept per pool
+	MaxTwapWindow = 14400
+
+	// MaxSwapRouteLength is the most assets a swap route may pass through, the sent and received assets included
+	MaxSwapRouteLength = 5
+
+	// MinLimitOrderValue is the least rowan a limit order must be worth when it is placed
+	MinLimitOrderValue = "10000000000000000000"
+	// MaxLimitOrdersPerBlock is the most limit orders executed in one block
+	MaxLimitOrdersPerBlock = 100
+	// MaxFailedLimitOrdersPerPool is the most limit orders selling one side of a pool that may fail to execute in one block
+	MaxFailedLimitOrdersPerPool = 10
+	// LimitOrderRetryDelay is the blocks a limit order that failed to execute waits before it is tried again
+	LimitOrderRetryDelay = 100
+	// MaxExpiredLimitOrdersPerBlock is the most expired limit orders refunded in one block
+	MaxExpiredLimitOrdersPerBlock = 100
+	// MaxLimitOrderLifetime is the most blocks a limit order may stay open, a week of 6 second blocks
+	MaxLimitOrderLifetime = 100800
+
+	// PoolShareDenomPrefix prefixes the bank denom that represents the units of a pool
+	PoolShareDenomPrefix = "clp/"
 )
 
 var (
@@ -43,6 +66,13 @@ var (
 	SwapFeeParamsPrefix                 = []byte{0x0c}
 	RemovalRequestPrefix                = []byte{0x0d}
 	RemovalQueuePrefix                  = []byte{0x0e}
+	LimitOrderPrefix                    = []byte{0x0f}
+	LimitOrderIDKey                     = []byte{0x10} // Key to store the id of the last limit order
+	PriceAccumulatorPrefix              = []byte{0x11} // Key to store the per block price accumulators of a pool
+	RemovalRequestLPPrefix              = []byte{0x12} // Key to index the removal requests of a liquidity provider
+	LimitOrderPoolPrefix                = []byte{0x13} // Key to index the open limit orders of a pool by target price
+	LimitOrderExpiryPrefix              = []byte{0x14} // Key to index the open limit orders by expiry height
+	LimitOrderOwnerPrefix               = []byte{0x15} // Key to index the open limit orders of an owner
 )
 
 // Generates a key for storing a specific pool
@@ -88,16 +118,102 @@ func GetDefaultLiquidityProtectionParams() *LiquidityProtectionParams {
 	}
 }
 
+// GetRemovalRequestPoolPrefix returns the prefix under which the removal requests
+// queued for a pool are stored
+func GetRemovalRequestPoolPrefix(symbol string) []byte {
+	return append(RemovalRequestPrefix, address.MustLengthPrefix([]byte(symbol))...)
+}
+
 // GetRemovalRequestKey generates a key to store a removal request,
-// the key is in the format: lpaddress_id
-func GetRemovalRequestKey(request RemovalRequest) []byte {
-	key := []byte(fmt.Sprintf("%s_%d", request.Msg.Signer, request.Id))
-	return append(RemovalRequestPrefix, key...)
+// the key is in the format: symbol_id ordered by id
+func GetRemovalRequestKey(symbol string, id int64) []byte {
+	return append(GetRemovalRequestPoolPrefix(symbol), sdk.Uint64ToBigEndian(uint64(id))...)
 }
 
+// GetRemovalRequestLPPrefix returns the prefix under which the removal requests
+// of a liquidity provider are indexed
 func GetRemovalRequestLPPrefix(lpaddress string) []byte {
-	key := []byte(fmt.Sprintf("%s", lpaddress))
-	return append(RemovalRequestPrefix, key...)
+	return append(RemovalRequestLPPrefix, address.MustLengthPrefix([]byte(lpaddress))...)
+}
+
+// GetRemovalRequestLPPoolPrefix returns the prefix under which the removal requests
+// of a liquidity provider in a pool are indexed
+func GetRemovalRequestLPPoolPrefix(lpaddress string, symbol string) []byte {
+	return append(GetRemovalRequestLPPrefix(lpaddress), address.MustLengthPrefix([]byte(symbol))...)
+}
+
+// GetRemovalRequestLPKey generates the key indexing a removal request under its liquidity provider,
+// the key is in the format: lpaddress_symbol_id and holds the key of the request
+func GetRemovalRequestLPKey(lpaddress string, symbol string, id int64) []byte {
+	return append(GetRemovalRequestLPPoolPrefix(lpaddress, symbol), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// GetLimitOrderKey generates a key to store a limit order, ordered by id
+func GetLimitOrderKey(id uint64) []byte {
+	return append(LimitOrderPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderPoolSymbolPrefix returns the prefix under which the limit orders selling either side of the pool of
+// symbol are indexed
+func GetLimitOrderPoolSymbolPrefix(symbol string) []byte {
+	return append(LimitOrderPoolPrefix, address.MustLengthPrefix([]byte(symbol))...)
+}
+
+// GetLimitOrderPoolPrefix returns the prefix under which the limit orders selling one side of the pool of symbol
+// are indexed
+func GetLimitOrderPoolPrefix(symbol string, sellNative bool) []byte {
+	side := byte(0)
+	if sellNative {
+		side = 1
+	}
+	return append(GetLimitOrderPoolSymbolPrefix(symbol), side)
+}
+
+// GetLimitOrderPoolKey generates the key indexing a limit order under its pool, ordered by target price then id
+func GetLimitOrderPoolKey(symbol string, sellNative bool, targetPrice sdk.Dec, id uint64) []byte {
+	key := append(GetLimitOrderPoolPrefix(symbol, sellNative), sdk.SortableDecBytes(targetPrice)...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderRetryPrefix returns the prefix under which the limit orders selling one side of the pool of symbol that
+// wait to be tried again are indexed. It sorts after the keys of every target price of that side
+func GetLimitOrderRetryPrefix(symbol string, sellNative bool) []byte {
+	return append(GetLimitOrderPoolPrefix(symbol, sellNative), 0xff)
+}
+
+// GetLimitOrderRetryKey generates the key indexing a limit order that waits to be tried again under its pool, ordered
+// by retry height then id
+func GetLimitOrderRetryKey(symbol string, sellNative bool, retryHeight int64, id uint64) []byte {
+	key := append(GetLimitOrderRetryPrefix(symbol, sellNative), sdk.Uint64ToBigEndian(uint64(retryHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderOwnerPrefix returns the prefix under which the limit orders of owner are indexed
+func GetLimitOrderOwnerPrefix(owner string) []byte {
+	return append(LimitOrderOwnerPrefix, address.MustLengthPrefix([]byte(owner))...)
+}
+
+// GetLimitOrderOwnerKey generates the key indexing a limit order under its owner, ordered by id
+func GetLimitOrderOwnerKey(owner string, id uint64) []byte {
+	return append(GetLimitOrderOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLimitOrderExpiryKey generates the key indexing a limit order by expiry height then id
+func GetLimitOrderExpiryKey(expiryHeight int64, id uint64) []byte {
+	key := append(LimitOrderExpiryPrefix, sdk.Uint64ToBigEndian(uint64(expiryHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPriceAccumulatorPrefix returns the prefix under which the price accumulators
+// of a pool are stored
+func GetPriceAccumulatorPrefix(symbol string) []byte {
+	return append(PriceAccumulatorPrefix, address.MustLengthPrefix([]byte(symbol))...)
+}
+
+// GetPriceAccumulatorKey generates a key to store a price accumulator,
+// the key is in the format: symbol_height ordered by height
+func GetPriceAccumulatorKey(symbol string, height int64) []byte {
+	return append(GetPriceAccumulatorPrefix(symbol), sdk.Uint64ToBigEndian(uint64(height))...)
 }
 
 func GetRemovalQueueKey(symbol string) []byte {
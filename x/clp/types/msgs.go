@@ -15,6 +15,12 @@ var (
 	_ sdk.Msg = &MsgCreatePool{}
 	_ sdk.Msg = &MsgAddLiquidity{}
 	_ sdk.Msg = &MsgSwap{}
+	_ sdk.Msg = &MsgSwapRoute{}
+	_ sdk.Msg = &MsgPlaceLimitOrder{}
+	_ sdk.Msg = &MsgCancelLimitOrder{}
+	_ sdk.Msg = &MsgClaimRewards{}
+	_ sdk.Msg = &MsgTransferLiquidity{}
+	_ sdk.Msg = &MsgCancelRemovalRequest{}
 	_ sdk.Msg = &MsgDecommissionPool{}
 	_ sdk.Msg = &MsgUnlockLiquidityRequest{}
 	_ sdk.Msg = &MsgUpdateRewardsParamsRequest{}
@@ -34,6 +40,12 @@ var (
 	_ legacytx.LegacyMsg = &MsgCreatePool{}
 	_ legacytx.LegacyMsg = &MsgAddLiquidity{}
 	_ legacytx.LegacyMsg = &MsgSwap{}
+	_ legacytx.LegacyMsg = &MsgSwapRoute{}
+	_ legacytx.LegacyMsg = &MsgPlaceLimitOrder{}
+	_ legacytx.LegacyMsg = &MsgCancelLimitOrder{}
+	_ legacytx.LegacyMsg = &MsgClaimRewards{}
+	_ legacytx.LegacyMsg = &MsgTransferLiquidity{}
+	_ legacytx.LegacyMsg = &MsgCancelRemovalRequest{}
 	_ legacytx.LegacyMsg = &MsgDecommissionPool{}
 	_ legacytx.LegacyMsg = &MsgUnlockLiquidityRequest{}
 	_ legacytx.LegacyMsg = &MsgUpdateRewardsParamsRequest{}
@@ -325,6 +337,266 @@ func (m MsgSwap) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func NewMsgSwapRoute(signer sdk.AccAddress, assets []*Asset, sentAmount sdk.Uint, minReceivingAmount sdk.Uint) MsgSwapRoute {
+	return MsgSwapRoute{Signer: signer.String(), Assets: assets, SentAmount: sentAmount, MinReceivingAmount: minReceivingAmount}
+}
+
+func (m MsgSwapRoute) Route() string {
+	return RouterKey
+}
+
+func (m MsgSwapRoute) Type() string {
+	return "swap_route"
+}
+
+func (m MsgSwapRoute) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if len(m.Assets) < 2 {
+		return sdkerrors.Wrap(ErrInvalidSwapRoute, "route must contain at least two assets")
+	}
+	if len(m.Assets) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(ErrInvalidSwapRoute, "route must contain at most %d assets", MaxSwapRouteLength)
+	}
+	for _, asset := range m.Assets {
+		if asset == nil || !asset.Validate() {
+			return sdkerrors.Wrap(ErrInValidAsset, fmt.Sprintf("%v", asset))
+		}
+	}
+	for i := 0; i < len(m.Assets)-1; i++ {
+		if m.Assets[i].Equals(*m.Assets[i+1]) {
+			return sdkerrors.Wrap(ErrInvalidSwapRoute, "consecutive assets cannot be the same")
+		}
+		// Every pool is paired against the native asset, so each hop has to include it.
+		if !m.Assets[i].IsSettlementAsset() && !m.Assets[i+1].IsSettlementAsset() {
+			return sdkerrors.Wrapf(ErrInvalidSwapRoute, "no pool for %s -> %s", m.Assets[i].Symbol, m.Assets[i+1].Symbol)
+		}
+	}
+	if m.SentAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, m.SentAmount.String())
+	}
+	return nil
+}
+
+func (m MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgPlaceLimitOrder(signer sdk.AccAddress, sentAsset Asset, receivedAsset Asset, sentAmount sdk.Uint, targetPrice sdk.Dec, expiryHeight int64) MsgPlaceLimitOrder {
+	return MsgPlaceLimitOrder{Signer: signer.String(), SentAsset: &sentAsset, ReceivedAsset: &receivedAsset, SentAmount: sentAmount, TargetPrice: targetPrice, ExpiryHeight: expiryHeight}
+}
+
+func (m MsgPlaceLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgPlaceLimitOrder) Type() string {
+	return "place_limit_order"
+}
+
+func (m MsgPlaceLimitOrder) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.SentAsset == nil || !m.SentAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, fmt.Sprintf("%v", m.SentAsset))
+	}
+	if m.ReceivedAsset == nil || !m.ReceivedAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, fmt.Sprintf("%v", m.ReceivedAsset))
+	}
+	if m.SentAsset.Equals(*m.ReceivedAsset) {
+		return sdkerrors.Wrap(ErrInValidAsset, "Sent And Received asset cannot be the same")
+	}
+	// Limit orders execute against a single pool, so one side has to be the native asset.
+	if !m.SentAsset.IsSettlementAsset() && !m.ReceivedAsset.IsSettlementAsset() {
+		return sdkerrors.Wrapf(ErrInvalidLimitOrder, "no pool for %s -> %s", m.SentAsset.Symbol, m.ReceivedAsset.Symbol)
+	}
+	if m.SentAmount.IsZero() {
+		return sdkerrors.Wrap(ErrInValidAmount, m.SentAmount.String())
+	}
+	if m.TargetPrice.IsNil() || !m.TargetPrice.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "target price must be positive")
+	}
+	if !sdk.ValidSortableDec(m.TargetPrice) {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "target price too high")
+	}
+	if m.ExpiryHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidLimitOrder, "expiry height must be positive")
+	}
+	return nil
+}
+
+func (m MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCancelLimitOrder(signer sdk.AccAddress, id uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{Signer: signer.String(), Id: id}
+}
+
+func (m MsgCancelLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelLimitOrder) Type() string {
+	return "cancel_limit_order"
+}
+
+func (m MsgCancelLimitOrder) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrLimitOrderNotFound, "id must be positive")
+	}
+	return nil
+}
+
+func (m MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgClaimRewards(signer sdk.AccAddress, externalAsset Asset) MsgClaimRewards {
+	return MsgClaimRewards{Signer: signer.String(), ExternalAsset: &externalAsset}
+}
+
+func (m MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (m MsgClaimRewards) Type() string {
+	return "claim_rewards"
+}
+
+func (m MsgClaimRewards) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgTransferLiquidity(signer sdk.AccAddress, externalAsset Asset, receiver sdk.AccAddress, units sdk.Uint) MsgTransferLiquidity {
+	return MsgTransferLiquidity{Signer: signer.String(), ExternalAsset: &externalAsset, Receiver: receiver.String(), Units: units}
+}
+
+func (m MsgTransferLiquidity) Route() string {
+	return RouterKey
+}
+
+func (m MsgTransferLiquidity) Type() string {
+	return "transfer_liquidity"
+}
+
+func (m MsgTransferLiquidity) ValidateBasic() error {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid receiver %s: %s", m.Receiver, err.Error()))
+	}
+	if receiver.Equals(signer) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "receiver must differ from signer")
+	}
+	if !m.Units.GT(sdk.ZeroUint()) {
+		return sdkerrors.Wrap(ErrInValidAmount, fmt.Sprintf("Units must be greater than 0 : %s", m.Units.String()))
+	}
+	return nil
+}
+
+func (m MsgTransferLiquidity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgTransferLiquidity) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func NewMsgCancelRemovalRequest(signer sdk.AccAddress, externalAsset Asset, id int64) MsgCancelRemovalRequest {
+	return MsgCancelRemovalRequest{Signer: signer.String(), ExternalAsset: &externalAsset, Id: id}
+}
+
+func (m MsgCancelRemovalRequest) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelRemovalRequest) Type() string {
+	return "cancel_removal_request"
+}
+
+func (m MsgCancelRemovalRequest) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.ExternalAsset == nil || !m.ExternalAsset.Validate() {
+		return sdkerrors.Wrap(ErrInValidAsset, "invalid external asset")
+	}
+	if m.Id <= 0 {
+		return sdkerrors.Wrap(ErrRemovalRequestNotFound, fmt.Sprintf("invalid id %d", m.Id))
+	}
+	return nil
+}
+
+func (m MsgCancelRemovalRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelRemovalRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
 func NewMsgRemoveLiquidity(signer sdk.AccAddress, externalAsset Asset, wBasisPoints sdk.Int, asymmetry sdk.Int) MsgRemoveLiquidity {
 	return MsgRemoveLiquidity{Signer: signer.String(), ExternalAsset: &externalAsset, WBasisPoints: wBasisPoints, Asymmetry: asymmetry}
 }
@@ -552,6 +824,9 @@ func (m *MsgUpdateLiquidityProtectionParams) ValidateBasic() error {
 	if m.EpochLength <= 0 {
 		return fmt.Errorf("liquidity protection epoch length must be greated than zero: %d", m.EpochLength)
 	}
+	if m.TwapWindow > MaxTwapWindow {
+		return sdkerrors.Wrapf(ErrInvalidTwapWindow, "must not exceed %d blocks: %d", MaxTwapWindow, m.TwapWindow)
+	}
 	return nil
 }
 
@@ -3,9 +3,11 @@ package types
 import (
 	"bytes"
 	"strconv"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -130,6 +132,160 @@ func TestNewMsgSwap(t *testing.T) {
 	assert.Error(t, err, "amount is invalid")
 }
 
+func TestNewMsgSwapRoute(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	eth := GetETHAsset()
+	rowan := GetROWANAsset()
+	cusdc := NewAsset("cusdc")
+	wrongAsset := GetWrongAsset()
+	testcases := []struct {
+		name   string
+		signer sdk.AccAddress
+		route  []*Asset
+		amount sdk.Uint
+		err    error
+	}{
+		{"valid single hop", signer, []*Asset{&eth, &rowan}, sdk.NewUint(100), nil},
+		{"valid two hops", signer, []*Asset{&eth, &rowan, &cusdc}, sdk.NewUint(100), nil},
+		{"invalid address", nil, []*Asset{&eth, &rowan}, sdk.NewUint(100), sdkerrors.ErrInvalidAddress},
+		{"route too short", signer, []*Asset{&eth}, sdk.NewUint(100), ErrInvalidSwapRoute},
+		{"route too long", signer, []*Asset{&eth, &rowan, &cusdc, &rowan, &eth, &rowan}, sdk.NewUint(100), ErrInvalidSwapRoute},
+		{"invalid asset", signer, []*Asset{&wrongAsset, &rowan}, sdk.NewUint(100), ErrInValidAsset},
+		{"repeated asset", signer, []*Asset{&eth, &eth}, sdk.NewUint(100), ErrInvalidSwapRoute},
+		{"hop without native asset", signer, []*Asset{&eth, &cusdc}, sdk.NewUint(100), ErrInvalidSwapRoute},
+		{"zero amount", signer, []*Asset{&eth, &rowan}, sdk.NewUint(0), ErrInValidAmount},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgSwapRoute(tc.signer, tc.route, tc.amount, sdk.NewUint(90))
+			err := msg.ValidateBasic()
+			if tc.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, "clp", msg.Route())
+				assert.Equal(t, "swap_route", msg.Type())
+				assert.Equal(t, tc.signer, msg.GetSigners()[0])
+				return
+			}
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewMsgPlaceLimitOrder(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	eth := GetETHAsset()
+	rowan := GetROWANAsset()
+	cusdc := NewAsset("cusdc")
+	wrongAsset := GetWrongAsset()
+	testcases := []struct {
+		name          string
+		signer        sdk.AccAddress
+		sentAsset     Asset
+		receivedAsset Asset
+		amount        sdk.Uint
+		targetPrice   sdk.Dec
+		expiryHeight  int64
+		err           error
+	}{
+		{"valid sell external", signer, eth, rowan, sdk.NewUint(100), sdk.OneDec(), 10, nil},
+		{"valid sell native", signer, rowan, eth, sdk.NewUint(100), sdk.OneDec(), 10, nil},
+		{"invalid address", nil, eth, rowan, sdk.NewUint(100), sdk.OneDec(), 10, sdkerrors.ErrInvalidAddress},
+		{"invalid asset", signer, wrongAsset, rowan, sdk.NewUint(100), sdk.OneDec(), 10, ErrInValidAsset},
+		{"same asset", signer, eth, eth, sdk.NewUint(100), sdk.OneDec(), 10, ErrInValidAsset},
+		{"no native asset", signer, eth, cusdc, sdk.NewUint(100), sdk.OneDec(), 10, ErrInvalidLimitOrder},
+		{"zero amount", signer, eth, rowan, sdk.NewUint(0), sdk.OneDec(), 10, ErrInValidAmount},
+		{"zero target price", signer, eth, rowan, sdk.NewUint(100), sdk.ZeroDec(), 10, ErrInvalidLimitOrder},
+		{"target price too high", signer, eth, rowan, sdk.NewUint(100), sdk.MaxSortableDec.Add(sdk.OneDec()), 10, ErrInvalidLimitOrder},
+		{"zero expiry height", signer, eth, rowan, sdk.NewUint(100), sdk.OneDec(), 0, ErrInvalidLimitOrder},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgPlaceLimitOrder(tc.signer, tc.sentAsset, tc.receivedAsset, tc.amount, tc.targetPrice, tc.expiryHeight)
+			err := msg.ValidateBasic()
+			if tc.err == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, "clp", msg.Route())
+				assert.Equal(t, "place_limit_order", msg.Type())
+				assert.Equal(t, tc.signer, msg.GetSigners()[0])
+				return
+			}
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewMsgCancelLimitOrder(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	msg := NewMsgCancelLimitOrder(signer, 1)
+	err := msg.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, "cancel_limit_order", msg.Type())
+	msg = NewMsgCancelLimitOrder(nil, 1)
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgCancelLimitOrder(signer, 0)
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrLimitOrderNotFound)
+}
+
+func TestNewMsgClaimRewards(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	msg := NewMsgClaimRewards(signer, GetETHAsset())
+	err := msg.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, "claim_rewards", msg.Type())
+	msg = NewMsgClaimRewards(nil, GetETHAsset())
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgClaimRewards(signer, NewAsset(""))
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+}
+
+func TestNewMsgTransferLiquidity(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	receiver := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA7")
+	msg := NewMsgTransferLiquidity(signer, GetETHAsset(), receiver, sdk.NewUint(100))
+	err := msg.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, "transfer_liquidity", msg.Type())
+	msg = NewMsgTransferLiquidity(signer, GetETHAsset(), signer, sdk.NewUint(100))
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgTransferLiquidity(signer, GetETHAsset(), receiver, sdk.NewUint(100))
+	msg.Receiver = strings.ToUpper(signer.String())
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgTransferLiquidity(signer, GetETHAsset(), nil, sdk.NewUint(100))
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgTransferLiquidity(signer, GetETHAsset(), receiver, sdk.ZeroUint())
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAmount)
+	msg = NewMsgTransferLiquidity(signer, NewAsset(""), receiver, sdk.NewUint(100))
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+}
+
+func TestNewMsgCancelRemovalRequest(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	msg := NewMsgCancelRemovalRequest(signer, GetETHAsset(), 1)
+	err := msg.ValidateBasic()
+	assert.NoError(t, err)
+	assert.Equal(t, "cancel_removal_request", msg.Type())
+	msg = NewMsgCancelRemovalRequest(nil, GetETHAsset(), 1)
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+	msg = NewMsgCancelRemovalRequest(signer, NewAsset(""), 1)
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrInValidAsset)
+	msg = NewMsgCancelRemovalRequest(signer, GetETHAsset(), 0)
+	err = msg.ValidateBasic()
+	assert.ErrorIs(t, err, ErrRemovalRequestNotFound)
+}
+
 func TestNewMsgAddLiquidity(t *testing.T) {
 	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
 	asset := GetETHAsset()
@@ -219,3 +375,12 @@ func TestNewMsgAddProviderDistributionPeriodRequest(t *testing.T) {
 	err = tx.ValidateBasic()
 	assert.Error(t, err)
 }
+
+func TestMsgUpdateLiquidityProtectionParams_ValidateBasic(t *testing.T) {
+	signer := NewSigner("A58856F0FD53BF058B4909A21AEC019107BA6")
+	tx := MsgUpdateLiquidityProtectionParams{Signer: signer.String(), EpochLength: 1, TwapWindow: MaxTwapWindow}
+	assert.NoError(t, tx.ValidateBasic())
+
+	tx.TwapWindow = MaxTwapWindow + 1
+	assert.ErrorIs(t, tx.ValidateBasic(), ErrInvalidTwapWindow)
+}
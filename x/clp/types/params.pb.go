@@ -382,6 +382,8 @@ type LiquidityProtectionParams struct {
 	MaxRowanLiquidityThresholdAsset string                                  `protobuf:"bytes,2,opt,name=max_rowan_liquidity_threshold_asset,json=maxRowanLiquidityThresholdAsset,proto3" json:"max_rowan_liquidity_threshold_asset,omitempty"`
 	EpochLength                     uint64                                  `protobuf:"varint,3,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
 	IsActive                        bool                                    `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// number of blocks to average the native price over, spot price is used when zero
+	TwapWindow uint64 `protobuf:"varint,5,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
 }
 
 func (m *LiquidityProtectionParams) Reset()         { *m = LiquidityProtectionParams{} }
@@ -438,6 +440,13 @@ func (m *LiquidityProtectionParams) GetIsActive() bool {
 	return false
 }
 
+func (m *LiquidityProtectionParams) GetTwapWindow() uint64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
 type LiquidityProtectionRateParams struct {
 	CurrentRowanLiquidityThreshold github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=current_rowan_liquidity_threshold,json=currentRowanLiquidityThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"current_rowan_liquidity_threshold"`
 }
@@ -688,77 +697,79 @@ func init() {
 func init() { proto.RegisterFile("sifnode/clp/v1/params.proto", fileDescriptor_61de66e331088d04) }
 
 var fileDescriptor_61de66e331088d04 = []byte{
-	// 1119 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0xae, 0x93, 0xb6, 0xb4, 0xa7, 0x3f, 0xc0, 0x34, 0x69, 0xdd, 0xbf, 0xa4, 0x6b, 0x24, 0xa8,
-	0x8a, 0x48, 0xe8, 0xa2, 0x65, 0xe1, 0x32, 0xfd, 0x01, 0x2d, 0xea, 0x4a, 0xc1, 0x2d, 0x37, 0x48,
-	0xc8, 0x9a, 0xda, 0xd3, 0x64, 0x54, 0xdb, 0xe3, 0x9d, 0x99, 0xa4, 0x2d, 0x48, 0x3c, 0xc3, 0x5e,
-	0x71, 0x83, 0xe0, 0x3d, 0x78, 0x00, 0xa4, 0xbd, 0x5c, 0xc4, 0x0d, 0xda, 0x8b, 0x0a, 0xb5, 0x2f,
-	0x82, 0x66, 0xc6, 0x49, 0xec, 0x24, 0x45, 0xbb, 0x85, 0xab, 0xd6, 0x39, 0x73, 0xbe, 0x6f, 0xce,
-	0x77, 0xce, 0x7c, 0x63, 0xc3, 0xba, 0xa0, 0x67, 0x31, 0x0b, 0x48, 0xdd, 0x0f, 0x93, 0x7a, 0x77,
-	0xb7, 0x9e, 0x60, 0x8e, 0x23, 0x51, 0x4b, 0x38, 0x93, 0x0c, 0x2d, 0xa6, 0xc1, 0x9a, 0x1f, 0x26,
-	0xb5, 0xee, 0xee, 0x5a, 0xa9, 0xc5, 0x5a, 0x4c, 0x87, 0xea, 0xea, 0x3f, 0xb3, 0xca, 0xe9, 0xc0,
-	0x74, 0x53, 0x67, 0xa1, 0xcf, 0x61, 0x35, 0xa2, 0xb1, 0xe7, 0x73, 0x82, 0x25, 0xf1, 0x12, 0xc6,
-	0x42, 0x4f, 0xb6, 0x39, 0x11, 0x6d, 0x16, 0x06, 0xb6, 0xb5, 0x65, 0x6d, 0x4f, 0xba, 0xcb, 0x11,
-	0x8d, 0xf7, 0x75, 0xbc, 0xc9, 0x58, 0x78, 0xd2, 0x8b, 0xa2, 0x8f, 0xa1, 0x44, 0x62, 0x7c, 0x1a,
-	0x12, 0x8f, 0x93, 0x88, 0x75, 0x71, 0xe8, 0x3d, 0xeb, 0x90, 0x0e, 0xb1, 0x0b, 0x5b, 0xd6, 0xf6,
-	0x8c, 0x8b, 0x4c, 0xcc, 0x35, 0xa1, 0xaf, 0x55, 0xc4, 0xf9, 0xa9, 0x00, 0xf3, 0x2e, 0xb9, 0xc0,
-	0x3c, 0x48, 0xd9, 0x1b, 0xb0, 0x19, 0xd2, 0x67, 0x1d, 0x1a, 0x50, 0x79, 0xd5, 0x47, 0x09, 0x99,
-	0x7f, 0xee, 0x25, 0x84, 0x53, 0xd6, 0xdb, 0xc1, 0x5a, 0x7f, 0x51, 0x0a, 0x77, 0xc4, 0xfc, 0xf3,
-	0xa6, 0x5e, 0x81, 0x0e, 0xa1, 0x3a, 0x0a, 0xe1, 0xe3, 0xd8, 0x27, 0x61, 0x0f, 0xa4, 0xa0, 0x41,
-	0x36, 0x86, 0x41, 0xf6, 0xf5, 0xa2, 0x14, 0x66, 0x1f, 0x16, 0xb9, 0xde, 0x59, 0x9a, 0x24, 0xec,
-	0xc9, 0xad, 0xe2, 0xf6, 0xdc, 0xc3, 0x8d, 0x5a, 0x5e, 0xd0, 0x5a, 0xba, 0x7f, 0xbd, 0xc8, 0x5d,
-	0xe0, 0x99, 0x27, 0x81, 0x1e, 0x83, 0x9d, 0x03, 0xf1, 0x84, 0xc4, 0x5c, 0x7a, 0x92, 0x46, 0xc4,
-	0x9e, 0xda, 0xb2, 0xb6, 0x67, 0xdd, 0x72, 0x36, 0xe1, 0x58, 0x45, 0x4f, 0x68, 0x44, 0x9c, 0xdf,
-	0x0b, 0xb0, 0xd8, 0x8c, 0x64, 0xe2, 0x2a, 0x91, 0x8d, 0x34, 0x3e, 0x2c, 0x27, 0x91, 0x4c, 0x7a,
-	0x48, 0xa7, 0x5a, 0x15, 0x8e, 0xa5, 0xd1, 0x77, 0x76, 0xaf, 0xf6, 0xe2, 0xba, 0x3a, 0xf1, 0xea,
-	0xba, 0xfa, 0x7e, 0x8b, 0xca, 0x76, 0xe7, 0xb4, 0xe6, 0xb3, 0xa8, 0xee, 0x33, 0x11, 0x31, 0x91,
-	0xfe, 0xf9, 0x48, 0x04, 0xe7, 0x75, 0x79, 0x95, 0x10, 0x51, 0x3b, 0x20, 0xbe, 0xbb, 0xa4, 0xd0,
-	0x0c, 0xef, 0x9e, 0xc2, 0x52, 0x54, 0x88, 0xc2, 0xaa, 0x26, 0xf1, 0x3b, 0x9c, 0x93, 0x58, 0x7a,
-	0xbc, 0x13, 0xc7, 0x34, 0x6e, 0x19, 0x9e, 0xe2, 0xbd, 0x78, 0xf4, 0xae, 0xf7, 0x0d, 0x9e, 0x6b,
-	0xe0, 0x34, 0x55, 0xaf, 0x1e, 0x1a, 0x4b, 0xc2, 0xbd, 0x84, 0x85, 0xd4, 0xbf, 0x32, 0x3c, 0x93,
-	0xf7, 0xaf, 0xe7, 0x89, 0x02, 0x6b, 0x6a, 0x2c, 0x45, 0xe2, 0xfc, 0x5a, 0x00, 0x50, 0x3a, 0xa6,
-	0x1a, 0x46, 0xb0, 0x9e, 0xd5, 0xb0, 0xc5, 0xba, 0x84, 0xc7, 0xaa, 0xeb, 0x86, 0xd8, 0xba, 0x17,
-	0xb1, 0x3d, 0x10, 0xf2, 0xcb, 0x3e, 0xa0, 0x2e, 0xf1, 0x31, 0xd8, 0x59, 0x3a, 0x92, 0x30, 0xbf,
-	0xed, 0x85, 0x24, 0x6e, 0xc9, 0xb6, 0x6e, 0x5a, 0xd1, 0x2d, 0x0f, 0x72, 0x0f, 0x55, 0xf4, 0x48,
-	0x07, 0xd1, 0x23, 0x58, 0xc9, 0x26, 0x9a, 0xa9, 0xd1, 0x1d, 0xd7, 0x4d, 0x28, 0xba, 0xa5, 0x41,
-	0x9e, 0x1e, 0x1a, 0xdd, 0x41, 0xb4, 0x0b, 0xe5, 0x1c, 0x5f, 0x9c, 0x8e, 0x89, 0x56, 0xb4, 0xe8,
-	0xa2, 0x0c, 0x59, 0x6c, 0x9a, 0xee, 0xfc, 0x31, 0xd9, 0x3f, 0x81, 0x66, 0xee, 0xb7, 0xe1, 0x9d,
-	0xfc, 0xc8, 0x52, 0x73, 0xe8, 0x66, 0xdd, 0xc5, 0xec, 0xa8, 0x3e, 0x09, 0x94, 0x53, 0x8c, 0x1b,
-	0x6e, 0xc3, 0x68, 0x8e, 0xd8, 0xf2, 0xc8, 0x74, 0x9b, 0x8d, 0x3e, 0x82, 0x95, 0x7c, 0xea, 0x60,
-	0xab, 0x45, 0x9d, 0x58, 0xca, 0x26, 0xf6, 0x36, 0x8b, 0xc8, 0xf0, 0x71, 0xc2, 0x61, 0xc8, 0x7c,
-	0x2c, 0x29, 0x8b, 0xd3, 0xa1, 0xf9, 0xf0, 0xd5, 0x75, 0xf5, 0x83, 0xd7, 0xe8, 0xdb, 0x37, 0x34,
-	0x96, 0xf9, 0xdd, 0x35, 0xfa, 0x50, 0xc8, 0x87, 0x4a, 0x9e, 0x46, 0xbb, 0x60, 0xd4, 0x09, 0x25,
-	0x4d, 0x42, 0x4a, 0xb8, 0xb0, 0xa7, 0xb4, 0x15, 0x54, 0x86, 0xad, 0x40, 0xd9, 0xe1, 0xd3, 0xfe,
-	0x32, 0x77, 0x3d, 0x8b, 0x9f, 0x8f, 0x09, 0x24, 0x60, 0x2b, 0x4f, 0x12, 0x90, 0x33, 0xdc, 0x09,
-	0x65, 0x86, 0xc7, 0x9e, 0xd6, 0x35, 0xed, 0xbc, 0xc1, 0x2c, 0x6e, 0x66, 0x29, 0x0f, 0x0c, 0xe2,
-	0x80, 0x15, 0x7d, 0x36, 0x2c, 0x60, 0x40, 0x85, 0xe4, 0xf4, 0xb4, 0x23, 0x89, 0xfd, 0x96, 0x76,
-	0xe9, 0x9c, 0x26, 0x07, 0xfd, 0x28, 0xda, 0x81, 0x77, 0xf3, 0x99, 0x11, 0x0b, 0xec, 0x19, 0xdd,
-	0xab, 0xb7, 0xb3, 0x29, 0x4f, 0x59, 0xe0, 0x3c, 0xb7, 0x60, 0x31, 0x5f, 0x2e, 0x7a, 0x08, 0xe5,
-	0x21, 0x11, 0x3d, 0x2c, 0x04, 0x91, 0xe9, 0x68, 0x2d, 0x25, 0xb9, 0xe5, 0x0d, 0x15, 0x42, 0x5f,
-	0x01, 0x64, 0xb4, 0x28, 0xbc, 0xb1, 0x16, 0x99, 0x6c, 0xe7, 0x97, 0x02, 0xac, 0x1e, 0xf5, 0xec,
-	0xbe, 0xc9, 0x99, 0x24, 0xbe, 0x6a, 0x75, 0x6a, 0x0b, 0x1c, 0x36, 0x23, 0x7c, 0xe9, 0x71, 0x76,
-	0x81, 0x63, 0x6f, 0x70, 0x79, 0xe4, 0xef, 0xbd, 0xd9, 0xbd, 0x7a, 0x6a, 0x0c, 0xaf, 0x3d, 0x60,
-	0x6b, 0x11, 0xbe, 0x74, 0x15, 0x68, 0x9f, 0x7a, 0x70, 0x59, 0x1e, 0xc1, 0x7b, 0xff, 0xca, 0x99,
-	0xea, 0xa3, 0xcb, 0x76, 0xab, 0x77, 0x03, 0x19, 0xad, 0x1e, 0xc0, 0x7c, 0xce, 0x5d, 0xcc, 0x29,
-	0x9a, 0x23, 0x19, 0x4f, 0x59, 0x87, 0x59, 0x2a, 0x3c, 0xec, 0x4b, 0xda, 0x35, 0x16, 0x3b, 0xe3,
-	0xce, 0x50, 0xd1, 0xd0, 0xcf, 0xce, 0xcf, 0x16, 0x6c, 0x8e, 0xd1, 0x27, 0x73, 0xfd, 0x7c, 0x0f,
-	0x0f, 0xfa, 0x97, 0xc2, 0xff, 0xad, 0x53, 0x25, 0x45, 0xbe, 0xa3, 0x44, 0xe7, 0xcf, 0x02, 0xac,
-	0x35, 0x39, 0xeb, 0xd2, 0x80, 0xf0, 0xfe, 0x4c, 0xaa, 0xf6, 0x19, 0xcb, 0x12, 0x50, 0x09, 0x32,
-	0xbf, 0x8e, 0xb9, 0x21, 0xef, 0x67, 0xec, 0xeb, 0xc1, 0x08, 0xd7, 0xe0, 0xa6, 0x3c, 0x84, 0xea,
-	0x38, 0xd2, 0x51, 0x0f, 0xdc, 0x18, 0x45, 0xc9, 0x38, 0x61, 0x03, 0x36, 0xc7, 0xc1, 0x0c, 0xfb,
-	0xe1, 0xda, 0x28, 0x48, 0xdf, 0x15, 0x3f, 0x85, 0x95, 0x71, 0x10, 0xea, 0x80, 0x4e, 0xea, 0xe4,
-	0xf2, 0x68, 0xb2, 0x3a, 0xa6, 0x3f, 0xdc, 0x21, 0xaa, 0xe9, 0xf7, 0x77, 0x50, 0x1a, 0x83, 0x2a,
-	0x6c, 0x4b, 0x5b, 0xdf, 0xce, 0x88, 0xf5, 0xdd, 0xd9, 0x1e, 0x77, 0x69, 0x94, 0x5e, 0x38, 0xbf,
-	0x59, 0xb0, 0x70, 0x7c, 0x81, 0x93, 0x2f, 0x48, 0x6f, 0xc0, 0x30, 0x94, 0x7b, 0x16, 0x28, 0x2e,
-	0x70, 0xe2, 0x9d, 0x91, 0xff, 0x74, 0x2b, 0xa3, 0x14, 0x2c, 0x25, 0x49, 0x7b, 0x36, 0x2f, 0xd9,
-	0x39, 0x89, 0x3d, 0xf3, 0x86, 0x6c, 0x17, 0x74, 0x2d, 0xce, 0x70, 0x2d, 0x69, 0xca, 0x89, 0x5a,
-	0x6a, 0x36, 0xe7, 0xce, 0xc9, 0xc1, 0x83, 0xf3, 0x23, 0xa0, 0xd1, 0x25, 0xa8, 0x04, 0x53, 0x59,
-	0x4b, 0x33, 0x0f, 0xc8, 0x85, 0x85, 0x7c, 0x35, 0xf7, 0x7b, 0x59, 0x9b, 0x13, 0x83, 0x32, 0xf6,
-	0x1a, 0x2f, 0x6e, 0x2a, 0xd6, 0xcb, 0x9b, 0x8a, 0xf5, 0xf7, 0x4d, 0xc5, 0x7a, 0x7e, 0x5b, 0x99,
-	0x78, 0x79, 0x5b, 0x99, 0xf8, 0xeb, 0xb6, 0x32, 0xf1, 0x6d, 0xf6, 0xc4, 0x1d, 0xd3, 0x33, 0xbf,
-	0x8d, 0x69, 0x5c, 0xef, 0x7d, 0x1d, 0x5c, 0xea, 0xef, 0x03, 0x8d, 0x79, 0x3a, 0xad, 0x5f, 0xfb,
-	0x3f, 0xf9, 0x27, 0x00, 0x00, 0xff, 0xff, 0x29, 0x02, 0xcc, 0x33, 0x3b, 0x0c, 0x00, 0x00,
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x6f, 0xe3, 0x44,
+	0x17, 0xae, 0x93, 0xb4, 0x6f, 0x7b, 0xfa, 0xf1, 0xc2, 0x34, 0x69, 0xdd, 0xaf, 0xa4, 0x6b, 0x24,
+	0xa8, 0x8a, 0x48, 0xe8, 0xa2, 0x65, 0xe1, 0x32, 0xfd, 0x00, 0x2d, 0xea, 0x4a, 0xc1, 0x2d, 0x42,
+	0x42, 0x42, 0x96, 0x6b, 0x4f, 0x93, 0x51, 0x6d, 0x8f, 0x77, 0x66, 0x92, 0xb4, 0x20, 0xf1, 0x1b,
+	0xf6, 0x8a, 0x1b, 0x24, 0xfe, 0x07, 0x37, 0xdc, 0x21, 0xed, 0xe5, 0x22, 0x6e, 0xd0, 0x5e, 0x54,
+	0xa8, 0xfd, 0x23, 0x68, 0x66, 0x1c, 0xc7, 0x4e, 0x52, 0xb4, 0x5b, 0xb8, 0x6a, 0xed, 0x33, 0xe7,
+	0x79, 0xe6, 0x3c, 0xe7, 0xcc, 0x33, 0x31, 0x6c, 0x70, 0x72, 0x1e, 0x51, 0x1f, 0x37, 0xbc, 0x20,
+	0x6e, 0xf4, 0xf6, 0x1a, 0xb1, 0xcb, 0xdc, 0x90, 0xd7, 0x63, 0x46, 0x05, 0x45, 0x4b, 0x49, 0xb0,
+	0xee, 0x05, 0x71, 0xbd, 0xb7, 0xb7, 0x5e, 0x6e, 0xd3, 0x36, 0x55, 0xa1, 0x86, 0xfc, 0x4f, 0xaf,
+	0xb2, 0xba, 0x30, 0xd3, 0x52, 0x59, 0xe8, 0x53, 0x58, 0x0b, 0x49, 0xe4, 0x78, 0x0c, 0xbb, 0x02,
+	0x3b, 0x31, 0xa5, 0x81, 0x23, 0x3a, 0x0c, 0xf3, 0x0e, 0x0d, 0x7c, 0xd3, 0xd8, 0x36, 0x76, 0x4a,
+	0xf6, 0x4a, 0x48, 0xa2, 0x03, 0x15, 0x6f, 0x51, 0x1a, 0x9c, 0x0e, 0xa2, 0xe8, 0x43, 0x28, 0xe3,
+	0xc8, 0x3d, 0x0b, 0xb0, 0xc3, 0x70, 0x48, 0x7b, 0x6e, 0xe0, 0x3c, 0xeb, 0xe2, 0x2e, 0x36, 0x0b,
+	0xdb, 0xc6, 0xce, 0xac, 0x8d, 0x74, 0xcc, 0xd6, 0xa1, 0x2f, 0x65, 0xc4, 0xfa, 0xb1, 0x00, 0x0b,
+	0x36, 0xee, 0xbb, 0xcc, 0x4f, 0xd8, 0x9b, 0xb0, 0x15, 0x90, 0x67, 0x5d, 0xe2, 0x13, 0x71, 0x95,
+	0xa2, 0x04, 0xd4, 0xbb, 0x70, 0x62, 0xcc, 0x08, 0x1d, 0xec, 0x60, 0x3d, 0x5d, 0x94, 0xc0, 0x1d,
+	0x53, 0xef, 0xa2, 0xa5, 0x56, 0xa0, 0x23, 0xa8, 0x8d, 0x43, 0x78, 0x6e, 0xe4, 0xe1, 0x60, 0x00,
+	0x52, 0x50, 0x20, 0x9b, 0xa3, 0x20, 0x07, 0x6a, 0x51, 0x02, 0x73, 0x00, 0x4b, 0x4c, 0xed, 0x2c,
+	0x49, 0xe2, 0x66, 0x69, 0xbb, 0xb8, 0x33, 0xff, 0x70, 0xb3, 0x9e, 0x17, 0xb4, 0x9e, 0xec, 0x5f,
+	0x2d, 0xb2, 0x17, 0x59, 0xe6, 0x89, 0xa3, 0xc7, 0x60, 0xe6, 0x40, 0x1c, 0x2e, 0x5c, 0x26, 0x1c,
+	0x41, 0x42, 0x6c, 0x4e, 0x6f, 0x1b, 0x3b, 0x73, 0x76, 0x25, 0x9b, 0x70, 0x22, 0xa3, 0xa7, 0x24,
+	0xc4, 0xd6, 0x6f, 0x05, 0x58, 0x6a, 0x85, 0x22, 0xb6, 0xa5, 0xc8, 0x5a, 0x1a, 0x0f, 0x56, 0xe2,
+	0x50, 0xc4, 0x03, 0xa4, 0x33, 0xa5, 0x0a, 0x73, 0x85, 0xd6, 0x77, 0x6e, 0xbf, 0xfe, 0xe2, 0xba,
+	0x36, 0xf5, 0xea, 0xba, 0xf6, 0x6e, 0x9b, 0x88, 0x4e, 0xf7, 0xac, 0xee, 0xd1, 0xb0, 0xe1, 0x51,
+	0x1e, 0x52, 0x9e, 0xfc, 0xf9, 0x80, 0xfb, 0x17, 0x0d, 0x71, 0x15, 0x63, 0x5e, 0x3f, 0xc4, 0x9e,
+	0xbd, 0x2c, 0xd1, 0x34, 0xef, 0xbe, 0xc4, 0x92, 0x54, 0x88, 0xc0, 0x9a, 0x22, 0xf1, 0xba, 0x8c,
+	0xe1, 0x48, 0x38, 0xac, 0x1b, 0x45, 0x24, 0x6a, 0x6b, 0x9e, 0xe2, 0xbd, 0x78, 0xd4, 0xae, 0x0f,
+	0x34, 0x9e, 0xad, 0xe1, 0x14, 0xd5, 0xa0, 0x1e, 0x12, 0x09, 0xcc, 0x9c, 0x98, 0x06, 0xc4, 0xbb,
+	0xd2, 0x3c, 0xa5, 0xfb, 0xd7, 0xf3, 0x44, 0x82, 0xb5, 0x14, 0x96, 0x24, 0xb1, 0x7e, 0x2e, 0x00,
+	0x48, 0x1d, 0x13, 0x0d, 0x43, 0xd8, 0xc8, 0x6a, 0xd8, 0xa6, 0x3d, 0xcc, 0x22, 0xd9, 0x75, 0x4d,
+	0x6c, 0xdc, 0x8b, 0xd8, 0x1c, 0x0a, 0xf9, 0x79, 0x0a, 0xa8, 0x4a, 0x7c, 0x0c, 0x66, 0x96, 0x0e,
+	0xc7, 0xd4, 0xeb, 0x38, 0x01, 0x8e, 0xda, 0xa2, 0xa3, 0x9a, 0x56, 0xb4, 0x2b, 0xc3, 0xdc, 0x23,
+	0x19, 0x3d, 0x56, 0x41, 0xf4, 0x08, 0x56, 0xb3, 0x89, 0x7a, 0x6a, 0x54, 0xc7, 0x55, 0x13, 0x8a,
+	0x76, 0x79, 0x98, 0xa7, 0x86, 0x46, 0x75, 0x10, 0xed, 0x41, 0x25, 0xc7, 0x17, 0x25, 0x63, 0xa2,
+	0x14, 0x2d, 0xda, 0x28, 0x43, 0x16, 0xe9, 0xa6, 0x5b, 0xbf, 0x97, 0xd2, 0x13, 0xa8, 0xe7, 0x7e,
+	0x07, 0xde, 0xca, 0x8f, 0x2c, 0xd1, 0x87, 0x6e, 0xce, 0x5e, 0xca, 0x8e, 0xea, 0x13, 0x5f, 0x3a,
+	0xc5, 0xa4, 0xe1, 0xd6, 0x8c, 0xfa, 0x88, 0xad, 0x8c, 0x4d, 0xb7, 0xde, 0xe8, 0x23, 0x58, 0xcd,
+	0xa7, 0x0e, 0xb7, 0x5a, 0x54, 0x89, 0xe5, 0x6c, 0xe2, 0x60, 0xb3, 0x08, 0x8f, 0x1e, 0x27, 0x37,
+	0x08, 0xa8, 0xe7, 0x0a, 0x42, 0xa3, 0x64, 0x68, 0xde, 0x7f, 0x75, 0x5d, 0x7b, 0xef, 0x35, 0xfa,
+	0xf6, 0x15, 0x89, 0x44, 0x7e, 0x77, 0xcd, 0x14, 0x0a, 0x79, 0x50, 0xcd, 0xd3, 0x28, 0x17, 0x0c,
+	0xbb, 0x81, 0x20, 0x71, 0x40, 0x30, 0xe3, 0xe6, 0xb4, 0xb2, 0x82, 0xea, 0xa8, 0x15, 0x48, 0x3b,
+	0x7c, 0x9a, 0x2e, 0xb3, 0x37, 0xb2, 0xf8, 0xf9, 0x18, 0x47, 0x1c, 0xb6, 0xf3, 0x24, 0x3e, 0x3e,
+	0x77, 0xbb, 0x81, 0xc8, 0xf0, 0x98, 0x33, 0xaa, 0xa6, 0xdd, 0x37, 0x98, 0xc5, 0xad, 0x2c, 0xe5,
+	0xa1, 0x46, 0x1c, 0xb2, 0xa2, 0x4f, 0x46, 0x05, 0xf4, 0x09, 0x17, 0x8c, 0x9c, 0x75, 0x05, 0x36,
+	0xff, 0xa7, 0x5c, 0x3a, 0xa7, 0xc9, 0x61, 0x1a, 0x45, 0xbb, 0xf0, 0x76, 0x3e, 0x33, 0xa4, 0xbe,
+	0x39, 0xab, 0x7a, 0xf5, 0xff, 0x6c, 0xca, 0x53, 0xea, 0x5b, 0xcf, 0x0d, 0x58, 0xca, 0x97, 0x8b,
+	0x1e, 0x42, 0x65, 0x44, 0x44, 0xc7, 0xe5, 0x1c, 0x8b, 0x64, 0xb4, 0x96, 0xe3, 0xdc, 0xf2, 0xa6,
+	0x0c, 0xa1, 0x2f, 0x00, 0x32, 0x5a, 0x14, 0xde, 0x58, 0x8b, 0x4c, 0xb6, 0xf5, 0x6b, 0x01, 0xd6,
+	0x8e, 0x07, 0x76, 0xdf, 0x62, 0x54, 0x60, 0x4f, 0xb6, 0x3a, 0xb1, 0x05, 0x06, 0x5b, 0xa1, 0x7b,
+	0xe9, 0x30, 0xda, 0x77, 0x23, 0x67, 0x78, 0x79, 0xe4, 0xef, 0xbd, 0xb9, 0xfd, 0x46, 0x62, 0x0c,
+	0xaf, 0x3d, 0x60, 0xeb, 0xa1, 0x7b, 0x69, 0x4b, 0xd0, 0x94, 0x7a, 0x78, 0x59, 0x1e, 0xc3, 0x3b,
+	0xff, 0xc8, 0x99, 0xe8, 0xa3, 0xca, 0xb6, 0x6b, 0x77, 0x03, 0x69, 0xad, 0x1e, 0xc0, 0x42, 0xce,
+	0x5d, 0xf4, 0x29, 0x9a, 0xc7, 0x19, 0x4f, 0xd9, 0x80, 0x39, 0xc2, 0x1d, 0xd7, 0x13, 0xa4, 0xa7,
+	0x2d, 0x76, 0xd6, 0x9e, 0x25, 0xbc, 0xa9, 0x9e, 0x51, 0x0d, 0xe6, 0x45, 0xdf, 0x8d, 0x9d, 0x3e,
+	0x89, 0x7c, 0xda, 0x57, 0x77, 0x53, 0xc9, 0x06, 0xf9, 0xea, 0x6b, 0xf5, 0xc6, 0xfa, 0xc9, 0x80,
+	0xad, 0x09, 0x02, 0x66, 0xee, 0xa7, 0xef, 0xe0, 0x41, 0x7a, 0x6b, 0xfc, 0xd7, 0x42, 0x56, 0x13,
+	0xe4, 0x3b, 0x34, 0xb0, 0xfe, 0x28, 0xc0, 0x7a, 0x8b, 0xd1, 0x1e, 0xf1, 0x31, 0x4b, 0x87, 0x56,
+	0xf6, 0x57, 0x7b, 0x1a, 0x87, 0xaa, 0x9f, 0x79, 0x3b, 0xe1, 0x0a, 0xbd, 0x9f, 0xf3, 0x6f, 0xf8,
+	0x63, 0x5c, 0xc3, 0xab, 0xf4, 0x08, 0x6a, 0x93, 0x48, 0xc7, 0x4d, 0x72, 0x73, 0x1c, 0x25, 0x63,
+	0x95, 0x4d, 0xd8, 0x9a, 0x04, 0x33, 0x6a, 0x98, 0xeb, 0xe3, 0x20, 0xa9, 0x6d, 0x7e, 0x0c, 0xab,
+	0x93, 0x20, 0xe4, 0x09, 0x2e, 0xa9, 0xe4, 0xca, 0x78, 0xb2, 0x3c, 0xc7, 0xdf, 0xdf, 0x21, 0xaa,
+	0xee, 0xf7, 0xb7, 0x50, 0x9e, 0x80, 0xca, 0x4d, 0x43, 0x79, 0xe3, 0xee, 0x98, 0x37, 0xde, 0xd9,
+	0x1e, 0x7b, 0x79, 0x9c, 0x9e, 0x5b, 0xbf, 0x18, 0xb0, 0x78, 0xd2, 0x77, 0xe3, 0xcf, 0xf0, 0x60,
+	0xc0, 0x5c, 0xa8, 0x0c, 0x3c, 0x92, 0xcb, 0x59, 0x3d, 0xc7, 0xff, 0xea, 0xda, 0x46, 0x09, 0x58,
+	0x42, 0x92, 0xf4, 0x6c, 0x41, 0xd0, 0x0b, 0x1c, 0x39, 0xfa, 0x27, 0xb4, 0x59, 0x50, 0xb5, 0x58,
+	0xa3, 0xb5, 0x24, 0x29, 0xa7, 0x72, 0xa9, 0xde, 0x9c, 0x3d, 0x2f, 0x86, 0x0f, 0xd6, 0x0f, 0x80,
+	0xc6, 0x97, 0xa0, 0x32, 0x4c, 0x67, 0x3d, 0x4f, 0x3f, 0x20, 0x1b, 0x16, 0xf3, 0xd5, 0xdc, 0xef,
+	0xd7, 0xdc, 0x3c, 0x1f, 0x96, 0xb1, 0xdf, 0x7c, 0x71, 0x53, 0x35, 0x5e, 0xde, 0x54, 0x8d, 0xbf,
+	0x6e, 0xaa, 0xc6, 0xf3, 0xdb, 0xea, 0xd4, 0xcb, 0xdb, 0xea, 0xd4, 0x9f, 0xb7, 0xd5, 0xa9, 0x6f,
+	0xb2, 0x27, 0xee, 0x84, 0x9c, 0x7b, 0x1d, 0x97, 0x44, 0x8d, 0xc1, 0xe7, 0xc3, 0xa5, 0xfa, 0x80,
+	0x50, 0x98, 0x67, 0x33, 0xea, 0xbb, 0xe0, 0xa3, 0xbf, 0x07, 0x00, 0x1d, 0x85, 0x57, 0x55, 0x5c,
+	0x0c, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1109,6 +1120,11 @@ func (m *LiquidityProtectionParams) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.TwapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -1509,6 +1525,9 @@ func (m *LiquidityProtectionParams) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	if m.TwapWindow != 0 {
+		n += 1 + sovParams(uint64(m.TwapWindow))
+	}
 	return n
 }
 
@@ -2649,6 +2668,25 @@ func (m *LiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsActive = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1386,26 +1386,24 @@ func (m *PoolShareEstimateRes) GetSwapInfo() SwapInfo {
 	return SwapInfo{}
 }
 
-type SwapInfo struct {
-	Status  SwapStatus                              `protobuf:"varint,1,opt,name=status,proto3,enum=sifnode.clp.v1.SwapStatus" json:"status,omitempty"`
-	Fee     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"fee"`
-	FeeRate github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
-	Amount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	Result  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"result"`
+type SwapEstimateReq struct {
+	SentAsset     *Asset                                  `protobuf:"bytes,1,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty"`
+	ReceivedAsset *Asset                                  `protobuf:"bytes,2,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty"`
+	SentAmount    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount"`
 }
 
-func (m *SwapInfo) Reset()         { *m = SwapInfo{} }
-func (m *SwapInfo) String() string { return proto.CompactTextString(m) }
-func (*SwapInfo) ProtoMessage()    {}
-func (*SwapInfo) Descriptor() ([]byte, []int) {
+func (m *SwapEstimateReq) Reset()         { *m = SwapEstimateReq{} }
+func (m *SwapEstimateReq) String() string { return proto.CompactTextString(m) }
+func (*SwapEstimateReq) ProtoMessage()    {}
+func (*SwapEstimateReq) Descriptor() ([]byte, []int) {
 	return fileDescriptor_5f4edede314ca3fd, []int{28}
 }
-func (m *SwapInfo) XXX_Unmarshal(b []byte) error {
+func (m *SwapEstimateReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SwapInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SwapEstimateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SwapInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SwapEstimateReq.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1415,1608 +1413,1842 @@ func (m *SwapInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SwapInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SwapInfo.Merge(m, src)
+func (m *SwapEstimateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapEstimateReq.Merge(m, src)
 }
-func (m *SwapInfo) XXX_Size() int {
+func (m *SwapEstimateReq) XXX_Size() int {
 	return m.Size()
 }
-func (m *SwapInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SwapInfo.DiscardUnknown(m)
+func (m *SwapEstimateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapEstimateReq.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SwapInfo proto.InternalMessageInfo
+var xxx_messageInfo_SwapEstimateReq proto.InternalMessageInfo
 
-func (m *SwapInfo) GetStatus() SwapStatus {
+func (m *SwapEstimateReq) GetSentAsset() *Asset {
 	if m != nil {
-		return m.Status
+		return m.SentAsset
 	}
-	return SwapStatus_UNSPECIFIED
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("sifnode.clp.v1.SwapStatus", SwapStatus_name, SwapStatus_value)
-	proto.RegisterType((*PoolReq)(nil), "sifnode.clp.v1.PoolReq")
-	proto.RegisterType((*PoolRes)(nil), "sifnode.clp.v1.PoolRes")
-	proto.RegisterType((*PoolsReq)(nil), "sifnode.clp.v1.PoolsReq")
-	proto.RegisterType((*PoolsRes)(nil), "sifnode.clp.v1.PoolsRes")
-	proto.RegisterType((*LiquidityProviderReq)(nil), "sifnode.clp.v1.LiquidityProviderReq")
-	proto.RegisterType((*LiquidityProviderRes)(nil), "sifnode.clp.v1.LiquidityProviderRes")
-	proto.RegisterType((*AssetListReq)(nil), "sifnode.clp.v1.AssetListReq")
-	proto.RegisterType((*AssetListRes)(nil), "sifnode.clp.v1.AssetListRes")
-	proto.RegisterType((*LiquidityProviderDataReq)(nil), "sifnode.clp.v1.LiquidityProviderDataReq")
-	proto.RegisterType((*LiquidityProviderDataRes)(nil), "sifnode.clp.v1.LiquidityProviderDataRes")
-	proto.RegisterType((*LiquidityProviderListReq)(nil), "sifnode.clp.v1.LiquidityProviderListReq")
-	proto.RegisterType((*LiquidityProviderListRes)(nil), "sifnode.clp.v1.LiquidityProviderListRes")
-	proto.RegisterType((*LiquidityProvidersReq)(nil), "sifnode.clp.v1.LiquidityProvidersReq")
-	proto.RegisterType((*LiquidityProvidersRes)(nil), "sifnode.clp.v1.LiquidityProvidersRes")
-	proto.RegisterType((*ParamsReq)(nil), "sifnode.clp.v1.ParamsReq")
-	proto.RegisterType((*ParamsRes)(nil), "sifnode.clp.v1.ParamsRes")
-	proto.RegisterType((*RewardParamsReq)(nil), "sifnode.clp.v1.RewardParamsReq")
-	proto.RegisterType((*RewardParamsRes)(nil), "sifnode.clp.v1.RewardParamsRes")
-	proto.RegisterType((*PmtpParamsReq)(nil), "sifnode.clp.v1.PmtpParamsReq")
-	proto.RegisterType((*PmtpParamsRes)(nil), "sifnode.clp.v1.PmtpParamsRes")
-	proto.RegisterType((*LiquidityProtectionParamsReq)(nil), "sifnode.clp.v1.LiquidityProtectionParamsReq")
-	proto.RegisterType((*LiquidityProtectionParamsRes)(nil), "sifnode.clp.v1.LiquidityProtectionParamsRes")
-	proto.RegisterType((*ProviderDistributionParamsReq)(nil), "sifnode.clp.v1.ProviderDistributionParamsReq")
-	proto.RegisterType((*ProviderDistributionParamsRes)(nil), "sifnode.clp.v1.ProviderDistributionParamsRes")
-	proto.RegisterType((*SwapFeeParamsReq)(nil), "sifnode.clp.v1.SwapFeeParamsReq")
-	proto.RegisterType((*SwapFeeParamsRes)(nil), "sifnode.clp.v1.SwapFeeParamsRes")
-	proto.RegisterType((*PoolShareEstimateReq)(nil), "sifnode.clp.v1.PoolShareEstimateReq")
-	proto.RegisterType((*PoolShareEstimateRes)(nil), "sifnode.clp.v1.PoolShareEstimateRes")
-	proto.RegisterType((*SwapInfo)(nil), "sifnode.clp.v1.SwapInfo")
+func (m *SwapEstimateReq) GetReceivedAsset() *Asset {
+	if m != nil {
+		return m.ReceivedAsset
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("sifnode/clp/v1/querier.proto", fileDescriptor_5f4edede314ca3fd) }
-
-var fileDescriptor_5f4edede314ca3fd = []byte{
-	// 1726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1b, 0x5b,
-	0x15, 0xcf, 0xd8, 0x69, 0x3e, 0x4e, 0xda, 0x7c, 0x9c, 0x26, 0xa9, 0x6b, 0x12, 0x27, 0x8c, 0x4a,
-	0x5e, 0x08, 0x89, 0xfd, 0xd2, 0xd7, 0x27, 0x78, 0x3c, 0x58, 0x38, 0x34, 0x31, 0x11, 0x21, 0x98,
-	0x49, 0x4b, 0x05, 0x52, 0xb1, 0xc6, 0xf6, 0xb5, 0x3d, 0xea, 0xd8, 0x33, 0x9e, 0x7b, 0x9d, 0x36,
-	0x2a, 0x05, 0x09, 0x75, 0x81, 0xc4, 0xa6, 0x52, 0x77, 0x2c, 0x50, 0x37, 0x2c, 0x58, 0x20, 0xb1,
-	0x40, 0x62, 0xc3, 0x16, 0xa9, 0x48, 0x48, 0x54, 0x62, 0x03, 0x2c, 0x0a, 0x6a, 0x59, 0x94, 0xff,
-	0x02, 0xdd, 0x3b, 0x77, 0x6c, 0xcf, 0x97, 0xe3, 0x58, 0x15, 0x08, 0x56, 0xc9, 0xcc, 0xf9, 0x9d,
-	0xaf, 0xdf, 0x9c, 0x7b, 0xef, 0xef, 0x26, 0xb0, 0x42, 0x8d, 0x5a, 0xcb, 0xaa, 0x92, 0x5c, 0xc5,
-	0xb4, 0x73, 0xa7, 0xbb, 0xb9, 0x76, 0x87, 0x38, 0x06, 0x71, 0xb2, 0xb6, 0x63, 0x31, 0x0b, 0x67,
-	0xa5, 0x35, 0x5b, 0x31, 0xed, 0xec, 0xe9, 0x6e, 0x7a, 0xb1, 0x6e, 0xd5, 0x2d, 0x61, 0xca, 0xf1,
-	0xdf, 0x5c, 0x54, 0x3a, 0x1d, 0x88, 0xc1, 0xce, 0x6c, 0x42, 0xa5, 0xed, 0x33, 0x01, 0x9b, 0xad,
-	0x3b, 0x7a, 0xd3, 0x33, 0x6e, 0x55, 0x2c, 0xda, 0xb4, 0x68, 0xae, 0xac, 0x53, 0x22, 0x32, 0x9f,
-	0xe5, 0x4e, 0x77, 0xcb, 0x84, 0xe9, 0x1c, 0x57, 0x37, 0x5a, 0x3a, 0x33, 0xac, 0x96, 0xc4, 0xae,
-	0xd4, 0x2d, 0xab, 0x6e, 0x92, 0x9c, 0x6e, 0x1b, 0x39, 0xbd, 0xd5, 0xb2, 0x98, 0x30, 0xca, 0x48,
-	0xea, 0x17, 0x60, 0xb2, 0x68, 0x59, 0xa6, 0x46, 0xda, 0xb8, 0x0c, 0x13, 0xf4, 0xac, 0x59, 0xb6,
-	0xcc, 0x94, 0xb2, 0xae, 0x6c, 0x4e, 0x6b, 0xf2, 0xe9, 0xcb, 0x53, 0x3f, 0x79, 0xb1, 0x36, 0xf6,
-	0xee, 0xc5, 0xda, 0x98, 0x7a, 0xe6, 0x81, 0x29, 0x6e, 0xc2, 0xb8, 0x6d, 0x49, 0xe8, 0xcc, 0xcd,
-	0xc5, 0xac, 0xbf, 0xdf, 0xac, 0x80, 0x09, 0x04, 0x6e, 0x03, 0x56, 0x4c, 0xbb, 0xd4, 0xb4, 0xaa,
-	0x1d, 0x93, 0x94, 0xf4, 0x6a, 0xd5, 0x21, 0x94, 0xa6, 0x12, 0x22, 0xc5, 0x7c, 0xc5, 0xb4, 0xbf,
-	0x29, 0x0c, 0x79, 0xf7, 0x3d, 0x2f, 0xa2, 0x41, 0x8c, 0x7a, 0x83, 0xa5, 0x92, 0xeb, 0xca, 0x66,
-	0x52, 0x93, 0x4f, 0xaa, 0x06, 0x53, 0x3c, 0x26, 0xe5, 0x85, 0x1e, 0x00, 0xf4, 0xba, 0x94, 0x15,
-	0x6c, 0x64, 0x5d, 0x4a, 0xb2, 0x9c, 0x92, 0xac, 0xa0, 0x24, 0x2b, 0x29, 0xc9, 0x16, 0xf5, 0x3a,
-	0xd1, 0x48, 0xbb, 0x43, 0x28, 0xd3, 0xfa, 0x3c, 0xd5, 0xdf, 0x2b, 0xdd, 0xa0, 0x14, 0xb7, 0xe0,
-	0x12, 0x2f, 0x97, 0xa6, 0x94, 0xf5, 0x64, 0x6c, 0x47, 0x2e, 0xe4, 0xfd, 0xb4, 0x84, 0x05, 0x5f,
-	0x1b, 0xe3, 0xa2, 0x8d, 0x0f, 0xce, 0x6d, 0x83, 0xda, 0x56, 0x8b, 0x12, 0x5f, 0x1f, 0xf7, 0x60,
-	0xf1, 0xc8, 0x68, 0x77, 0x8c, 0xaa, 0xc1, 0xce, 0x8a, 0x8e, 0x75, 0x6a, 0x54, 0x89, 0x33, 0xe0,
-	0x83, 0xe2, 0x2a, 0x80, 0x69, 0x07, 0xca, 0x9e, 0x36, 0x6d, 0x59, 0x6f, 0xdf, 0xf7, 0x7e, 0xa7,
-	0x44, 0x46, 0xa6, 0x58, 0x04, 0x34, 0xbd, 0xf7, 0x25, 0x5b, 0x1a, 0xe4, 0x97, 0xf8, 0x6c, 0x90,
-	0xb9, 0x70, 0x84, 0x05, 0x33, 0xf8, 0x0a, 0x3f, 0x84, 0x45, 0xde, 0xcd, 0x29, 0x29, 0xe9, 0x94,
-	0x12, 0x56, 0x2a, 0xeb, 0xa6, 0xde, 0xaa, 0x10, 0x59, 0x1d, 0xba, 0xb6, 0x3c, 0x37, 0xed, 0xb9,
-	0x16, 0xbc, 0x05, 0xcb, 0xe4, 0x11, 0x23, 0x4e, 0x4b, 0x37, 0x03, 0x3e, 0x49, 0xe1, 0xb3, 0xe8,
-	0x59, 0x7d, 0x5e, 0xbd, 0x8f, 0x31, 0xee, 0x9b, 0xaf, 0x1f, 0xc1, 0x65, 0x81, 0x3b, 0x32, 0x28,
-	0xe3, 0xdc, 0xf9, 0x39, 0x52, 0x02, 0x1c, 0x05, 0x46, 0x30, 0x31, 0xea, 0x08, 0xf6, 0x71, 0xfd,
-	0x73, 0xc5, 0x57, 0x01, 0xc5, 0x1d, 0x98, 0x10, 0x6d, 0x79, 0x13, 0xb9, 0x14, 0xe4, 0x55, 0xa0,
-	0x35, 0x09, 0xea, 0x6b, 0x2c, 0x31, 0x60, 0xca, 0x92, 0xa3, 0x4f, 0xd9, 0x4f, 0x15, 0x48, 0x85,
-	0x3e, 0xe5, 0x6d, 0x9d, 0xe9, 0xff, 0x15, 0xba, 0xfe, 0x1a, 0x5f, 0x0d, 0xc5, 0xfb, 0x70, 0x2d,
-	0x3c, 0x9e, 0xa5, 0xaa, 0xce, 0x74, 0xc9, 0xe5, 0xe7, 0xce, 0x9d, 0x51, 0x11, 0x6a, 0xc9, 0x8c,
-	0x7a, 0x1d, 0x4b, 0xf5, 0x41, 0x04, 0xd5, 0xa3, 0xec, 0x4b, 0x4f, 0xa3, 0x7a, 0xf3, 0x06, 0x33,
-	0x6e, 0x51, 0xbf, 0x7f, 0x8a, 0xff, 0x14, 0x5f, 0x06, 0x45, 0x0d, 0xae, 0x86, 0x29, 0xf6, 0x46,
-	0x75, 0x88, 0x2d, 0x00, 0x43, 0xd4, 0xfe, 0x07, 0x46, 0xd8, 0x80, 0xa5, 0x50, 0x25, 0x11, 0x27,
-	0xca, 0xfb, 0x20, 0xef, 0x8f, 0x4a, 0x74, 0xae, 0xff, 0x51, 0xe6, 0x66, 0x60, 0xba, 0x28, 0x04,
-	0x88, 0x46, 0xda, 0xea, 0xd3, 0x44, 0xef, 0x89, 0x62, 0x16, 0x26, 0x5c, 0x6d, 0x22, 0xf7, 0xff,
-	0xe5, 0xd0, 0xc9, 0xe9, 0x42, 0x25, 0x0a, 0xef, 0x03, 0xd2, 0xb3, 0x66, 0x93, 0x30, 0xe7, 0xac,
-	0xc4, 0x1a, 0x0e, 0xa1, 0x0d, 0xcb, 0xac, 0xba, 0xfb, 0xfc, 0x5e, 0xf6, 0xe5, 0xeb, 0xb5, 0xb1,
-	0xbf, 0xbd, 0x5e, 0xdb, 0xa8, 0x1b, 0xac, 0xd1, 0x29, 0x67, 0x2b, 0x56, 0x33, 0x27, 0xa5, 0x8e,
-	0xfb, 0x63, 0x87, 0x56, 0x1f, 0x48, 0x99, 0x74, 0x9b, 0x54, 0xb4, 0x05, 0x2f, 0xd2, 0x1d, 0x2f,
-	0x10, 0x36, 0x20, 0xd5, 0x0d, 0xef, 0xf0, 0xe2, 0xfb, 0x92, 0x24, 0x47, 0x4a, 0xb2, 0xec, 0xc5,
-	0xd3, 0x78, 0xb8, 0x6e, 0x26, 0x75, 0x01, 0xe6, 0x34, 0xf2, 0x50, 0x77, 0xaa, 0x3d, 0x66, 0x0a,
-	0xc1, 0x57, 0x14, 0x6f, 0x05, 0xe8, 0x59, 0x09, 0xd2, 0xe3, 0x73, 0x90, 0x58, 0x75, 0x0e, 0xae,
-	0x14, 0x9b, 0xcc, 0xee, 0x45, 0xfe, 0xbb, 0xe2, 0x7f, 0x43, 0xf1, 0x66, 0x20, 0x70, 0x3a, 0xc4,
-	0x7b, 0x0f, 0xee, 0x71, 0xff, 0x75, 0x98, 0xb7, 0x9b, 0xcc, 0xe6, 0xc4, 0x90, 0x92, 0xf4, 0x76,
-	0xa7, 0x3d, 0x13, 0xe5, 0xad, 0xe9, 0x8c, 0xc8, 0x08, 0xb3, 0xb6, 0xef, 0x19, 0xbf, 0x04, 0x20,
-	0x22, 0x11, 0xdb, 0xaa, 0x34, 0xe4, 0x64, 0x5d, 0x8f, 0x8a, 0xb1, 0xcf, 0x01, 0xda, 0xb4, 0xed,
-	0xfd, 0x1a, 0x7b, 0x02, 0x67, 0x60, 0xa5, 0x7f, 0xd8, 0x19, 0xa9, 0xf0, 0xc9, 0xeb, 0x31, 0xf0,
-	0x07, 0x65, 0x20, 0x80, 0x62, 0x3e, 0x40, 0xc8, 0xe7, 0x07, 0xad, 0x25, 0xbf, 0xb7, 0xc7, 0xcf,
-	0x31, 0xcc, 0x84, 0xa9, 0xd9, 0x19, 0x22, 0x4e, 0x1f, 0x53, 0xe0, 0xf4, 0x58, 0x8a, 0x53, 0xb3,
-	0x6b, 0xb0, 0xda, 0x3d, 0x51, 0x0c, 0xca, 0x1c, 0xa3, 0xdc, 0xf1, 0x37, 0x5b, 0x19, 0x0c, 0xa0,
-	0xb8, 0x17, 0x68, 0x76, 0x2b, 0xc4, 0x7d, 0xbc, 0xbb, 0x37, 0x64, 0x08, 0xf3, 0x27, 0x0f, 0x75,
-	0xfb, 0x80, 0x90, 0x5e, 0xe2, 0xdf, 0x29, 0xa1, 0x97, 0x14, 0x75, 0x58, 0xaa, 0x92, 0x9a, 0xde,
-	0x31, 0x59, 0x89, 0x3e, 0xd4, 0xed, 0x52, 0x8d, 0x10, 0x31, 0x42, 0xee, 0x11, 0x74, 0xe1, 0x05,
-	0x85, 0x32, 0x98, 0xcc, 0xc3, 0xb9, 0xc3, 0x7d, 0xb8, 0xcc, 0xac, 0x07, 0xa4, 0xd5, 0xa3, 0x9e,
-	0x6f, 0x87, 0x6a, 0xb0, 0x2b, 0xe9, 0x72, 0x87, 0x43, 0x65, 0x7d, 0x33, 0xac, 0xf7, 0xa0, 0xfe,
-	0x2c, 0x01, 0x8b, 0x5c, 0xa9, 0x9f, 0x34, 0x74, 0x87, 0xec, 0x53, 0x66, 0x34, 0x75, 0xc6, 0x77,
-	0x6a, 0xfc, 0x0a, 0xcc, 0xfa, 0xd5, 0xa2, 0xe4, 0x2d, 0x46, 0x55, 0x5d, 0xf1, 0x89, 0x47, 0x2c,
-	0xc1, 0x55, 0x9f, 0x3a, 0xd5, 0x9b, 0x56, 0xa7, 0xc5, 0xe4, 0xa6, 0x95, 0x93, 0xed, 0x7f, 0x30,
-	0x44, 0xfb, 0x77, 0x8d, 0x16, 0xd3, 0x16, 0xfa, 0xd4, 0x6c, 0x5e, 0x44, 0xc2, 0x0a, 0x2c, 0x05,
-	0xc4, 0xac, 0x4c, 0x91, 0x1c, 0x2d, 0xc5, 0x55, 0x5f, 0xfd, 0x6e, 0x12, 0xf5, 0x5f, 0xd1, 0xe4,
-	0xf0, 0xb1, 0x07, 0x9b, 0x38, 0x15, 0xd2, 0x62, 0x7a, 0x7d, 0xd4, 0x8f, 0xda, 0x17, 0xe1, 0xff,
-	0x83, 0x2e, 0xfc, 0x14, 0xa6, 0xc5, 0xb4, 0x1b, 0xad, 0x9a, 0x25, 0xaf, 0x67, 0xa9, 0xa8, 0x79,
-	0x3c, 0x6c, 0xd5, 0xac, 0xbd, 0x71, 0x9e, 0x52, 0x9b, 0xa2, 0xf2, 0x59, 0x7d, 0x9d, 0x80, 0x29,
-	0xcf, 0xc8, 0xb7, 0x6a, 0xca, 0x74, 0xd6, 0x71, 0x17, 0xeb, 0x6c, 0x78, 0xab, 0xe6, 0xc8, 0x13,
-	0x81, 0xd0, 0x24, 0x12, 0xf3, 0x90, 0xac, 0x11, 0x32, 0x2a, 0x67, 0xdc, 0x17, 0x0f, 0x61, 0xaa,
-	0xbb, 0x52, 0x47, 0x3b, 0xfa, 0x26, 0x6b, 0x72, 0x79, 0x16, 0x60, 0x42, 0x32, 0x3c, 0x3e, 0x5a,
-	0x41, 0xd2, 0x9d, 0x07, 0x72, 0x08, 0xed, 0x98, 0x2c, 0x75, 0x69, 0xc4, 0x40, 0xae, 0xfb, 0xd6,
-	0x37, 0x00, 0x7a, 0xac, 0xe1, 0x1c, 0xcc, 0xdc, 0x3d, 0x3e, 0x29, 0xee, 0x7f, 0xed, 0xf0, 0xe0,
-	0x70, 0xff, 0xf6, 0xfc, 0x18, 0xce, 0xc0, 0xe4, 0xf1, 0xb7, 0x4a, 0x27, 0xf7, 0xf2, 0xc5, 0x79,
-	0x85, 0x5b, 0x4f, 0xf6, 0x8f, 0x8e, 0x4a, 0xc7, 0xf9, 0x3b, 0x87, 0xdf, 0xd9, 0x9f, 0x4f, 0xe0,
-	0x2c, 0xc0, 0xde, 0xdd, 0xef, 0x7a, 0xcf, 0xc9, 0x9b, 0xbf, 0x9d, 0x83, 0x4b, 0xdf, 0xe6, 0x4a,
-	0x08, 0x2b, 0x30, 0x59, 0x20, 0x8c, 0xaf, 0x12, 0xbc, 0x16, 0xf9, 0x27, 0x00, 0xd2, 0x4e, 0xc7,
-	0x18, 0xa8, 0xba, 0xf1, 0xe3, 0x3f, 0xff, 0xf3, 0x79, 0x62, 0x1d, 0x33, 0x39, 0x6a, 0xd4, 0x2a,
-	0x0d, 0xdd, 0x68, 0x75, 0xff, 0x7a, 0x63, 0x59, 0x66, 0xee, 0xb1, 0x2b, 0xd5, 0x9f, 0xe0, 0xf7,
-	0x61, 0x4a, 0x26, 0xa1, 0x98, 0x8a, 0x0a, 0xc6, 0xb7, 0xe2, 0x74, 0x9c, 0x85, 0xaa, 0x19, 0x91,
-	0x27, 0x85, 0xcb, 0x91, 0x79, 0x28, 0xfe, 0x42, 0x81, 0xc5, 0x02, 0xbf, 0x49, 0x06, 0x6f, 0xd9,
-	0x37, 0xce, 0x97, 0x97, 0xa4, 0x9d, 0x1e, 0x06, 0x45, 0xd5, 0xbc, 0x28, 0xe2, 0x53, 0xfc, 0x24,
-	0x54, 0x44, 0x58, 0xde, 0x76, 0x5b, 0xcf, 0x3d, 0xee, 0x5d, 0x13, 0x9f, 0xe0, 0xaf, 0x14, 0x48,
-	0x45, 0xd5, 0x29, 0x6e, 0x59, 0x9b, 0xc3, 0xdd, 0xd1, 0x48, 0x3b, 0x3d, 0x2c, 0x92, 0xaa, 0x5f,
-	0x15, 0x35, 0x7f, 0x11, 0x3f, 0x1e, 0xa2, 0x66, 0x71, 0x5f, 0xf4, 0xd7, 0xfb, 0x03, 0xb8, 0x5c,
-	0x20, 0xac, 0x7b, 0x4b, 0xc7, 0x95, 0xc8, 0xc3, 0x43, 0xde, 0xd4, 0xd2, 0x83, 0xac, 0x54, 0xfd,
-	0x50, 0x94, 0xb2, 0x85, 0x9b, 0xa1, 0x52, 0xdc, 0x0d, 0xcd, 0x34, 0x28, 0xf3, 0x67, 0x7f, 0xae,
-	0xc0, 0x52, 0x14, 0x5b, 0x14, 0xcf, 0xbf, 0xce, 0x8a, 0x81, 0x1a, 0x0a, 0x46, 0xd5, 0x6d, 0x51,
-	0xd9, 0x06, 0xde, 0x18, 0x82, 0x24, 0x8a, 0xbf, 0x8c, 0xf9, 0x86, 0x82, 0xa0, 0xf3, 0xbf, 0x8c,
-	0x47, 0xd6, 0xb0, 0x48, 0xaa, 0x7e, 0x22, 0xca, 0xfb, 0x08, 0x77, 0x87, 0xf9, 0x86, 0x2e, 0x8b,
-	0xde, 0xba, 0x2b, 0xc3, 0x34, 0x5f, 0x77, 0xae, 0x36, 0xbb, 0x1e, 0x73, 0x4f, 0x21, 0xed, 0x74,
-	0xac, 0x89, 0xaa, 0x6b, 0x22, 0xfb, 0x75, 0xbc, 0x16, 0x5e, 0x7a, 0x6e, 0xd8, 0xc7, 0x30, 0x57,
-	0x20, 0xac, 0x5f, 0xd4, 0xe3, 0xda, 0x40, 0xc9, 0x4f, 0xda, 0xe9, 0x73, 0x00, 0x83, 0x36, 0x16,
-	0x47, 0x20, 0xa5, 0x6a, 0x42, 0x0a, 0x57, 0x78, 0x83, 0x5d, 0xe1, 0x8f, 0xab, 0x03, 0x2e, 0x05,
-	0xa4, 0x9d, 0x1e, 0x68, 0xa6, 0xea, 0x0d, 0x91, 0x36, 0x83, 0x2b, 0xe1, 0x66, 0xb9, 0xf6, 0x97,
-	0x49, 0x7f, 0xad, 0xc0, 0x4a, 0x60, 0x02, 0x7c, 0xea, 0x1a, 0xb7, 0x87, 0x17, 0xe2, 0xa4, 0x9d,
-	0xbe, 0x08, 0x9a, 0xaa, 0xb7, 0x44, 0x89, 0x59, 0xdc, 0x1e, 0x3c, 0x0d, 0xd2, 0xcf, 0x2b, 0xf9,
-	0x37, 0x0a, 0xac, 0x72, 0xa2, 0x62, 0x35, 0x32, 0xee, 0x5c, 0x40, 0x4f, 0x93, 0x76, 0xfa, 0x42,
-	0x70, 0xaa, 0x7e, 0x2c, 0xaa, 0xce, 0xe1, 0x4e, 0x98, 0xd8, 0xee, 0xee, 0xd3, 0xe7, 0xe8, 0x95,
-	0xfd, 0x43, 0x98, 0x2f, 0x10, 0xe6, 0x93, 0xe7, 0xb8, 0x1e, 0x23, 0x91, 0x7b, 0xb5, 0x9d, 0x87,
-	0x18, 0x34, 0x5e, 0x3e, 0xb9, 0x8f, 0xcf, 0xdc, 0x73, 0x25, 0xa4, 0x21, 0xc3, 0xe7, 0x4a, 0x94,
-	0x06, 0x4f, 0x0f, 0x83, 0x1a, 0xb4, 0xfd, 0xf0, 0xc3, 0xad, 0x44, 0x39, 0xbe, 0x44, 0xa4, 0xc3,
-	0x5e, 0xfe, 0xe5, 0x9b, 0x8c, 0xf2, 0xea, 0x4d, 0x46, 0xf9, 0xc7, 0x9b, 0x8c, 0xf2, 0xec, 0x6d,
-	0x66, 0xec, 0xd5, 0xdb, 0xcc, 0xd8, 0x5f, 0xde, 0x66, 0xc6, 0xbe, 0xd7, 0xaf, 0x28, 0x4e, 0xbc,
-	0x48, 0xde, 0x3f, 0x55, 0x1e, 0x89, 0x98, 0x42, 0x56, 0x94, 0x27, 0xc4, 0x7f, 0x42, 0x3e, 0xfa,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xef, 0xab, 0xf9, 0x6e, 0xd2, 0x19, 0x00, 0x00,
+type SwapEstimateRes struct {
+	ReceivedAmount               github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"received_amount"`
+	LiquidityFee                 github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_fee,json=liquidityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_fee"`
+	PriceImpact                  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price_impact"`
+	SwapFeeRate                  github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,4,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate"`
+	BlockedByLiquidityProtection bool                                    `protobuf:"varint,5,opt,name=blocked_by_liquidity_protection,json=blockedByLiquidityProtection,proto3" json:"blocked_by_liquidity_protection,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *SwapEstimateRes) Reset()         { *m = SwapEstimateRes{} }
+func (m *SwapEstimateRes) String() string { return proto.CompactTextString(m) }
+func (*SwapEstimateRes) ProtoMessage()    {}
+func (*SwapEstimateRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{29}
+}
+func (m *SwapEstimateRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapEstimateRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapEstimateRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapEstimateRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapEstimateRes.Merge(m, src)
+}
+func (m *SwapEstimateRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapEstimateRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapEstimateRes.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_SwapEstimateRes proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error)
-	GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error)
-	GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error)
-	GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error)
-	GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error)
-	GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error)
-	GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error)
-	GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error)
-	GetRewardParams(ctx context.Context, in *RewardParamsReq, opts ...grpc.CallOption) (*RewardParamsRes, error)
-	GetPmtpParams(ctx context.Context, in *PmtpParamsReq, opts ...grpc.CallOption) (*PmtpParamsRes, error)
-	GetLiquidityProtectionParams(ctx context.Context, in *LiquidityProtectionParamsReq, opts ...grpc.CallOption) (*LiquidityProtectionParamsRes, error)
-	GetProviderDistributionParams(ctx context.Context, in *ProviderDistributionParamsReq, opts ...grpc.CallOption) (*ProviderDistributionParamsRes, error)
-	GetSwapFeeParams(ctx context.Context, in *SwapFeeParamsReq, opts ...grpc.CallOption) (*SwapFeeParamsRes, error)
-	GetPoolShareEstimate(ctx context.Context, in *PoolShareEstimateReq, opts ...grpc.CallOption) (*PoolShareEstimateRes, error)
+func (m *SwapEstimateRes) GetBlockedByLiquidityProtection() bool {
+	if m != nil {
+		return m.BlockedByLiquidityProtection
+	}
+	return false
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type LimitOrdersByAddressReq struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *LimitOrdersByAddressReq) Reset()         { *m = LimitOrdersByAddressReq{} }
+func (m *LimitOrdersByAddressReq) String() string { return proto.CompactTextString(m) }
+func (*LimitOrdersByAddressReq) ProtoMessage()    {}
+func (*LimitOrdersByAddressReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{30}
 }
-
-func (c *queryClient) GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error) {
-	out := new(PoolRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LimitOrdersByAddressReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error) {
-	out := new(PoolsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersByAddressReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrdersByAddressReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrdersByAddressReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrdersByAddressReq.Merge(m, src)
+}
+func (m *LimitOrdersByAddressReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrdersByAddressReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrdersByAddressReq.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error) {
-	out := new(LiquidityProviderRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrdersByAddressReq proto.InternalMessageInfo
+
+func (m *LimitOrdersByAddressReq) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error) {
-	out := new(LiquidityProviderDataRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersByAddressReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error) {
-	out := new(AssetListRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetAssetList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LimitOrdersByPoolReq struct {
+	Symbol     string             `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error) {
-	out := new(LiquidityProvidersRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersByPoolReq) Reset()         { *m = LimitOrdersByPoolReq{} }
+func (m *LimitOrdersByPoolReq) String() string { return proto.CompactTextString(m) }
+func (*LimitOrdersByPoolReq) ProtoMessage()    {}
+func (*LimitOrdersByPoolReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{31}
+}
+func (m *LimitOrdersByPoolReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrdersByPoolReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrdersByPoolReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrdersByPoolReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrdersByPoolReq.Merge(m, src)
+}
+func (m *LimitOrdersByPoolReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrdersByPoolReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrdersByPoolReq.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error) {
-	out := new(LiquidityProviderListRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrdersByPoolReq proto.InternalMessageInfo
+
+func (m *LimitOrdersByPoolReq) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error) {
-	out := new(ParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersByPoolReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetRewardParams(ctx context.Context, in *RewardParamsReq, opts ...grpc.CallOption) (*RewardParamsRes, error) {
-	out := new(RewardParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRewardParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LimitOrdersRes struct {
+	LimitOrders []*LimitOrder       `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders,omitempty"`
+	Height      int64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetPmtpParams(ctx context.Context, in *PmtpParamsReq, opts ...grpc.CallOption) (*PmtpParamsRes, error) {
-	out := new(PmtpParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPmtpParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersRes) Reset()         { *m = LimitOrdersRes{} }
+func (m *LimitOrdersRes) String() string { return proto.CompactTextString(m) }
+func (*LimitOrdersRes) ProtoMessage()    {}
+func (*LimitOrdersRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{32}
+}
+func (m *LimitOrdersRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrdersRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrdersRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *LimitOrdersRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrdersRes.Merge(m, src)
+}
+func (m *LimitOrdersRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrdersRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrdersRes.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLiquidityProtectionParams(ctx context.Context, in *LiquidityProtectionParamsReq, opts ...grpc.CallOption) (*LiquidityProtectionParamsRes, error) {
-	out := new(LiquidityProtectionParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProtectionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LimitOrdersRes proto.InternalMessageInfo
+
+func (m *LimitOrdersRes) GetLimitOrders() []*LimitOrder {
+	if m != nil {
+		return m.LimitOrders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetProviderDistributionParams(ctx context.Context, in *ProviderDistributionParamsReq, opts ...grpc.CallOption) (*ProviderDistributionParamsRes, error) {
-	out := new(ProviderDistributionParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetProviderDistributionParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetSwapFeeParams(ctx context.Context, in *SwapFeeParamsReq, opts ...grpc.CallOption) (*SwapFeeParamsRes, error) {
-	out := new(SwapFeeParamsRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetSwapFeeParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LimitOrdersRes) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetPoolShareEstimate(ctx context.Context, in *PoolShareEstimateReq, opts ...grpc.CallOption) (*PoolShareEstimateRes, error) {
-	out := new(PoolShareEstimateRes)
-	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPoolShareEstimate", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SwapInfo struct {
+	Status  SwapStatus                              `protobuf:"varint,1,opt,name=status,proto3,enum=sifnode.clp.v1.SwapStatus" json:"status,omitempty"`
+	Fee     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"fee"`
+	FeeRate github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,3,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+	Result  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=result,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"result"`
+}
+
+func (m *SwapInfo) Reset()         { *m = SwapInfo{} }
+func (m *SwapInfo) String() string { return proto.CompactTextString(m) }
+func (*SwapInfo) ProtoMessage()    {}
+func (*SwapInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{33}
+}
+func (m *SwapInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SwapInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapInfo.Merge(m, src)
+}
+func (m *SwapInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapInfo.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	GetPool(context.Context, *PoolReq) (*PoolRes, error)
-	GetPools(context.Context, *PoolsReq) (*PoolsRes, error)
-	GetLiquidityProvider(context.Context, *LiquidityProviderReq) (*LiquidityProviderRes, error)
-	GetLiquidityProviderData(context.Context, *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error)
-	GetAssetList(context.Context, *AssetListReq) (*AssetListRes, error)
-	GetLiquidityProviders(context.Context, *LiquidityProvidersReq) (*LiquidityProvidersRes, error)
-	GetLiquidityProviderList(context.Context, *LiquidityProviderListReq) (*LiquidityProviderListRes, error)
-	GetParams(context.Context, *ParamsReq) (*ParamsRes, error)
-	GetRewardParams(context.Context, *RewardParamsReq) (*RewardParamsRes, error)
-	GetPmtpParams(context.Context, *PmtpParamsReq) (*PmtpParamsRes, error)
-	GetLiquidityProtectionParams(context.Context, *LiquidityProtectionParamsReq) (*LiquidityProtectionParamsRes, error)
-	GetProviderDistributionParams(context.Context, *ProviderDistributionParamsReq) (*ProviderDistributionParamsRes, error)
-	GetSwapFeeParams(context.Context, *SwapFeeParamsReq) (*SwapFeeParamsRes, error)
-	GetPoolShareEstimate(context.Context, *PoolShareEstimateReq) (*PoolShareEstimateRes, error)
+var xxx_messageInfo_SwapInfo proto.InternalMessageInfo
+
+func (m *SwapInfo) GetStatus() SwapStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SwapStatus_UNSPECIFIED
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type TwapReq struct {
+	Symbol      string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	StartHeight int64  `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// defaults to the current height when zero
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (*UnimplementedQueryServer) GetPool(ctx context.Context, req *PoolReq) (*PoolRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
+func (m *TwapReq) Reset()         { *m = TwapReq{} }
+func (m *TwapReq) String() string { return proto.CompactTextString(m) }
+func (*TwapReq) ProtoMessage()    {}
+func (*TwapReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{34}
 }
-func (*UnimplementedQueryServer) GetPools(ctx context.Context, req *PoolsReq) (*PoolsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
+func (m *TwapReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetLiquidityProvider(ctx context.Context, req *LiquidityProviderReq) (*LiquidityProviderRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProvider not implemented")
+func (m *TwapReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetLiquidityProviderData(ctx context.Context, req *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderData not implemented")
+func (m *TwapReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapReq.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAssetList(ctx context.Context, req *AssetListReq) (*AssetListRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssetList not implemented")
+func (m *TwapReq) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetLiquidityProviders(ctx context.Context, req *LiquidityProvidersReq) (*LiquidityProvidersRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviders not implemented")
+func (m *TwapReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapReq.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetLiquidityProviderList(ctx context.Context, req *LiquidityProviderListReq) (*LiquidityProviderListRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderList not implemented")
+
+var xxx_messageInfo_TwapReq proto.InternalMessageInfo
+
+func (m *TwapReq) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetParams(ctx context.Context, req *ParamsReq) (*ParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+
+func (m *TwapReq) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetRewardParams(ctx context.Context, req *RewardParamsReq) (*RewardParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRewardParams not implemented")
+
+func (m *TwapReq) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetPmtpParams(ctx context.Context, req *PmtpParamsReq) (*PmtpParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPmtpParams not implemented")
+
+type TwapRes struct {
+	NativePrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=native_price,json=nativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"native_price"`
+	ExternalPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=external_price,json=externalPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"external_price"`
+	StartHeight   int64                                  `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight     int64                                  `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Height        int64                                  `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
-func (*UnimplementedQueryServer) GetLiquidityProtectionParams(ctx context.Context, req *LiquidityProtectionParamsReq) (*LiquidityProtectionParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProtectionParams not implemented")
+
+func (m *TwapRes) Reset()         { *m = TwapRes{} }
+func (m *TwapRes) String() string { return proto.CompactTextString(m) }
+func (*TwapRes) ProtoMessage()    {}
+func (*TwapRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{35}
 }
-func (*UnimplementedQueryServer) GetProviderDistributionParams(ctx context.Context, req *ProviderDistributionParamsReq) (*ProviderDistributionParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProviderDistributionParams not implemented")
+func (m *TwapRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetSwapFeeParams(ctx context.Context, req *SwapFeeParamsReq) (*SwapFeeParamsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSwapFeeParams not implemented")
+func (m *TwapRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetPoolShareEstimate(ctx context.Context, req *PoolShareEstimateReq) (*PoolShareEstimateRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPoolShareEstimate not implemented")
+func (m *TwapRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRes.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *TwapRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRes.DiscardUnknown(m)
 }
 
-func _Query_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPool(ctx, req.(*PoolReq))
+var xxx_messageInfo_TwapRes proto.InternalMessageInfo
+
+func (m *TwapRes) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPools(ctx, req.(*PoolsReq))
+func (m *TwapRes) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetLiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProviderReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProvider(ctx, req.(*LiquidityProviderReq))
+func (m *TwapRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetLiquidityProviderData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProviderDataReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProviderData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProviderData(ctx, req.(*LiquidityProviderDataReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type RemovalQueueReq struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 }
 
-func _Query_GetAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAssetList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetAssetList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAssetList(ctx, req.(*AssetListReq))
+func (m *RemovalQueueReq) Reset()         { *m = RemovalQueueReq{} }
+func (m *RemovalQueueReq) String() string { return proto.CompactTextString(m) }
+func (*RemovalQueueReq) ProtoMessage()    {}
+func (*RemovalQueueReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{36}
+}
+func (m *RemovalQueueReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalQueueReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalQueueReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemovalQueueReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalQueueReq.Merge(m, src)
+}
+func (m *RemovalQueueReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalQueueReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalQueueReq.DiscardUnknown(m)
 }
 
-func _Query_GetLiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProvidersReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProviders(ctx, req.(*LiquidityProvidersReq))
+var xxx_messageInfo_RemovalQueueReq proto.InternalMessageInfo
+
+func (m *RemovalQueueReq) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetLiquidityProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProviderListReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProviderList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProviderList(ctx, req.(*LiquidityProviderListReq))
-	}
-	return interceptor(ctx, in, info, handler)
+type RemovalQueueRes struct {
+	RemovalQueue *RemovalQueue `protobuf:"bytes,1,opt,name=removal_queue,json=removalQueue,proto3" json:"removal_queue,omitempty"`
+	Height       int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetParams(ctx, req.(*ParamsReq))
+func (m *RemovalQueueRes) Reset()         { *m = RemovalQueueRes{} }
+func (m *RemovalQueueRes) String() string { return proto.CompactTextString(m) }
+func (*RemovalQueueRes) ProtoMessage()    {}
+func (*RemovalQueueRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{37}
+}
+func (m *RemovalQueueRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalQueueRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalQueueRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemovalQueueRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalQueueRes.Merge(m, src)
+}
+func (m *RemovalQueueRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalQueueRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalQueueRes.DiscardUnknown(m)
 }
 
-func _Query_GetRewardParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RewardParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRewardParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetRewardParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRewardParams(ctx, req.(*RewardParamsReq))
+var xxx_messageInfo_RemovalQueueRes proto.InternalMessageInfo
+
+func (m *RemovalQueueRes) GetRemovalQueue() *RemovalQueue {
+	if m != nil {
+		return m.RemovalQueue
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetPmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PmtpParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPmtpParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetPmtpParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPmtpParams(ctx, req.(*PmtpParamsReq))
+func (m *RemovalQueueRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetLiquidityProtectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityProtectionParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLiquidityProtectionParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProtectionParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLiquidityProtectionParams(ctx, req.(*LiquidityProtectionParamsReq))
+type RemovalRequestsByPoolReq struct {
+	Symbol     string             `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RemovalRequestsByPoolReq) Reset()         { *m = RemovalRequestsByPoolReq{} }
+func (m *RemovalRequestsByPoolReq) String() string { return proto.CompactTextString(m) }
+func (*RemovalRequestsByPoolReq) ProtoMessage()    {}
+func (*RemovalRequestsByPoolReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{38}
+}
+func (m *RemovalRequestsByPoolReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalRequestsByPoolReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalRequestsByPoolReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *RemovalRequestsByPoolReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalRequestsByPoolReq.Merge(m, src)
+}
+func (m *RemovalRequestsByPoolReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalRequestsByPoolReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalRequestsByPoolReq.DiscardUnknown(m)
 }
 
-func _Query_GetProviderDistributionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ProviderDistributionParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_RemovalRequestsByPoolReq proto.InternalMessageInfo
+
+func (m *RemovalRequestsByPoolReq) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProviderDistributionParams(ctx, in)
+	return ""
+}
+
+func (m *RemovalRequestsByPoolReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetProviderDistributionParams",
+	return nil
+}
+
+type RemovalRequestsByAddressReq struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RemovalRequestsByAddressReq) Reset()         { *m = RemovalRequestsByAddressReq{} }
+func (m *RemovalRequestsByAddressReq) String() string { return proto.CompactTextString(m) }
+func (*RemovalRequestsByAddressReq) ProtoMessage()    {}
+func (*RemovalRequestsByAddressReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{39}
+}
+func (m *RemovalRequestsByAddressReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalRequestsByAddressReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalRequestsByAddressReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProviderDistributionParams(ctx, req.(*ProviderDistributionParamsReq))
+}
+func (m *RemovalRequestsByAddressReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalRequestsByAddressReq.Merge(m, src)
+}
+func (m *RemovalRequestsByAddressReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalRequestsByAddressReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalRequestsByAddressReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovalRequestsByAddressReq proto.InternalMessageInfo
+
+func (m *RemovalRequestsByAddressReq) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetSwapFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SwapFeeParamsReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RemovalRequestsByAddressReq) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetSwapFeeParams(ctx, in)
+	return nil
+}
+
+type RemovalRequestsRes struct {
+	RemovalRequests []*RemovalRequest   `protobuf:"bytes,1,rep,name=removal_requests,json=removalRequests,proto3" json:"removal_requests,omitempty"`
+	Height          int64               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *RemovalRequestsRes) Reset()         { *m = RemovalRequestsRes{} }
+func (m *RemovalRequestsRes) String() string { return proto.CompactTextString(m) }
+func (*RemovalRequestsRes) ProtoMessage()    {}
+func (*RemovalRequestsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{40}
+}
+func (m *RemovalRequestsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalRequestsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalRequestsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetSwapFeeParams",
+}
+func (m *RemovalRequestsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalRequestsRes.Merge(m, src)
+}
+func (m *RemovalRequestsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalRequestsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalRequestsRes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovalRequestsRes proto.InternalMessageInfo
+
+func (m *RemovalRequestsRes) GetRemovalRequests() []*RemovalRequest {
+	if m != nil {
+		return m.RemovalRequests
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetSwapFeeParams(ctx, req.(*SwapFeeParamsReq))
+	return nil
+}
+
+func (m *RemovalRequestsRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPoolShareEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolShareEstimateReq)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *RemovalRequestsRes) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPoolShareEstimate(ctx, in)
+	return nil
+}
+
+type RemovalQueueUnitsReq struct {
+	Symbol    string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	LpAddress string `protobuf:"bytes,2,opt,name=lp_address,json=lpAddress,proto3" json:"lp_address,omitempty"`
+}
+
+func (m *RemovalQueueUnitsReq) Reset()         { *m = RemovalQueueUnitsReq{} }
+func (m *RemovalQueueUnitsReq) String() string { return proto.CompactTextString(m) }
+func (*RemovalQueueUnitsReq) ProtoMessage()    {}
+func (*RemovalQueueUnitsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{41}
+}
+func (m *RemovalQueueUnitsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalQueueUnitsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalQueueUnitsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Query/GetPoolShareEstimate",
+}
+func (m *RemovalQueueUnitsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalQueueUnitsReq.Merge(m, src)
+}
+func (m *RemovalQueueUnitsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalQueueUnitsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalQueueUnitsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovalQueueUnitsReq proto.InternalMessageInfo
+
+func (m *RemovalQueueUnitsReq) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPoolShareEstimate(ctx, req.(*PoolShareEstimateReq))
+	return ""
+}
+
+func (m *RemovalQueueUnitsReq) GetLpAddress() string {
+	if m != nil {
+		return m.LpAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.clp.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPool",
-			Handler:    _Query_GetPool_Handler,
-		},
-		{
-			MethodName: "GetPools",
-			Handler:    _Query_GetPools_Handler,
-		},
-		{
-			MethodName: "GetLiquidityProvider",
-			Handler:    _Query_GetLiquidityProvider_Handler,
-		},
-		{
-			MethodName: "GetLiquidityProviderData",
-			Handler:    _Query_GetLiquidityProviderData_Handler,
-		},
-		{
-			MethodName: "GetAssetList",
-			Handler:    _Query_GetAssetList_Handler,
-		},
-		{
-			MethodName: "GetLiquidityProviders",
-			Handler:    _Query_GetLiquidityProviders_Handler,
-		},
-		{
-			MethodName: "GetLiquidityProviderList",
-			Handler:    _Query_GetLiquidityProviderList_Handler,
-		},
-		{
-			MethodName: "GetParams",
-			Handler:    _Query_GetParams_Handler,
-		},
-		{
-			MethodName: "GetRewardParams",
-			Handler:    _Query_GetRewardParams_Handler,
-		},
-		{
-			MethodName: "GetPmtpParams",
-			Handler:    _Query_GetPmtpParams_Handler,
-		},
-		{
-			MethodName: "GetLiquidityProtectionParams",
-			Handler:    _Query_GetLiquidityProtectionParams_Handler,
-		},
-		{
-			MethodName: "GetProviderDistributionParams",
-			Handler:    _Query_GetProviderDistributionParams_Handler,
-		},
-		{
-			MethodName: "GetSwapFeeParams",
-			Handler:    _Query_GetSwapFeeParams_Handler,
-		},
-		{
-			MethodName: "GetPoolShareEstimate",
-			Handler:    _Query_GetPoolShareEstimate_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/clp/v1/querier.proto",
+type RemovalQueueUnitsRes struct {
+	// units of the liquidity provider still waiting in the removal queue
+	Units  github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
+	Height int64                                   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *PoolReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RemovalQueueUnitsRes) Reset()         { *m = RemovalQueueUnitsRes{} }
+func (m *RemovalQueueUnitsRes) String() string { return proto.CompactTextString(m) }
+func (*RemovalQueueUnitsRes) ProtoMessage()    {}
+func (*RemovalQueueUnitsRes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f4edede314ca3fd, []int{42}
+}
+func (m *RemovalQueueUnitsRes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemovalQueueUnitsRes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemovalQueueUnitsRes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PoolReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RemovalQueueUnitsRes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovalQueueUnitsRes.Merge(m, src)
+}
+func (m *RemovalQueueUnitsRes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemovalQueueUnitsRes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovalQueueUnitsRes.DiscardUnknown(m)
 }
 
-func (m *PoolReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_RemovalQueueUnitsRes proto.InternalMessageInfo
+
+func (m *RemovalQueueUnitsRes) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PoolRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("sifnode.clp.v1.SwapStatus", SwapStatus_name, SwapStatus_value)
+	proto.RegisterType((*PoolReq)(nil), "sifnode.clp.v1.PoolReq")
+	proto.RegisterType((*PoolRes)(nil), "sifnode.clp.v1.PoolRes")
+	proto.RegisterType((*PoolsReq)(nil), "sifnode.clp.v1.PoolsReq")
+	proto.RegisterType((*PoolsRes)(nil), "sifnode.clp.v1.PoolsRes")
+	proto.RegisterType((*LiquidityProviderReq)(nil), "sifnode.clp.v1.LiquidityProviderReq")
+	proto.RegisterType((*LiquidityProviderRes)(nil), "sifnode.clp.v1.LiquidityProviderRes")
+	proto.RegisterType((*AssetListReq)(nil), "sifnode.clp.v1.AssetListReq")
+	proto.RegisterType((*AssetListRes)(nil), "sifnode.clp.v1.AssetListRes")
+	proto.RegisterType((*LiquidityProviderDataReq)(nil), "sifnode.clp.v1.LiquidityProviderDataReq")
+	proto.RegisterType((*LiquidityProviderDataRes)(nil), "sifnode.clp.v1.LiquidityProviderDataRes")
+	proto.RegisterType((*LiquidityProviderListReq)(nil), "sifnode.clp.v1.LiquidityProviderListReq")
+	proto.RegisterType((*LiquidityProviderListRes)(nil), "sifnode.clp.v1.LiquidityProviderListRes")
+	proto.RegisterType((*LiquidityProvidersReq)(nil), "sifnode.clp.v1.LiquidityProvidersReq")
+	proto.RegisterType((*LiquidityProvidersRes)(nil), "sifnode.clp.v1.LiquidityProvidersRes")
+	proto.RegisterType((*ParamsReq)(nil), "sifnode.clp.v1.ParamsReq")
+	proto.RegisterType((*ParamsRes)(nil), "sifnode.clp.v1.ParamsRes")
+	proto.RegisterType((*RewardParamsReq)(nil), "sifnode.clp.v1.RewardParamsReq")
+	proto.RegisterType((*RewardParamsRes)(nil), "sifnode.clp.v1.RewardParamsRes")
+	proto.RegisterType((*PmtpParamsReq)(nil), "sifnode.clp.v1.PmtpParamsReq")
+	proto.RegisterType((*PmtpParamsRes)(nil), "sifnode.clp.v1.PmtpParamsRes")
+	proto.RegisterType((*LiquidityProtectionParamsReq)(nil), "sifnode.clp.v1.LiquidityProtectionParamsReq")
+	proto.RegisterType((*LiquidityProtectionParamsRes)(nil), "sifnode.clp.v1.LiquidityProtectionParamsRes")
+	proto.RegisterType((*ProviderDistributionParamsReq)(nil), "sifnode.clp.v1.ProviderDistributionParamsReq")
+	proto.RegisterType((*ProviderDistributionParamsRes)(nil), "sifnode.clp.v1.ProviderDistributionParamsRes")
+	proto.RegisterType((*SwapFeeParamsReq)(nil), "sifnode.clp.v1.SwapFeeParamsReq")
+	proto.RegisterType((*SwapFeeParamsRes)(nil), "sifnode.clp.v1.SwapFeeParamsRes")
+	proto.RegisterType((*PoolShareEstimateReq)(nil), "sifnode.clp.v1.PoolShareEstimateReq")
+	proto.RegisterType((*PoolShareEstimateRes)(nil), "sifnode.clp.v1.PoolShareEstimateRes")
+	proto.RegisterType((*SwapEstimateReq)(nil), "sifnode.clp.v1.SwapEstimateReq")
+	proto.RegisterType((*SwapEstimateRes)(nil), "sifnode.clp.v1.SwapEstimateRes")
+	proto.RegisterType((*LimitOrdersByAddressReq)(nil), "sifnode.clp.v1.LimitOrdersByAddressReq")
+	proto.RegisterType((*LimitOrdersByPoolReq)(nil), "sifnode.clp.v1.LimitOrdersByPoolReq")
+	proto.RegisterType((*LimitOrdersRes)(nil), "sifnode.clp.v1.LimitOrdersRes")
+	proto.RegisterType((*SwapInfo)(nil), "sifnode.clp.v1.SwapInfo")
+	proto.RegisterType((*TwapReq)(nil), "sifnode.clp.v1.TwapReq")
+	proto.RegisterType((*TwapRes)(nil), "sifnode.clp.v1.TwapRes")
+	proto.RegisterType((*RemovalQueueReq)(nil), "sifnode.clp.v1.RemovalQueueReq")
+	proto.RegisterType((*RemovalQueueRes)(nil), "sifnode.clp.v1.RemovalQueueRes")
+	proto.RegisterType((*RemovalRequestsByPoolReq)(nil), "sifnode.clp.v1.RemovalRequestsByPoolReq")
+	proto.RegisterType((*RemovalRequestsByAddressReq)(nil), "sifnode.clp.v1.RemovalRequestsByAddressReq")
+	proto.RegisterType((*RemovalRequestsRes)(nil), "sifnode.clp.v1.RemovalRequestsRes")
+	proto.RegisterType((*RemovalQueueUnitsReq)(nil), "sifnode.clp.v1.RemovalQueueUnitsReq")
+	proto.RegisterType((*RemovalQueueUnitsRes)(nil), "sifnode.clp.v1.RemovalQueueUnitsRes")
 }
 
-func (m *PoolRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("sifnode/clp/v1/querier.proto", fileDescriptor_5f4edede314ca3fd) }
+
+var fileDescriptor_5f4edede314ca3fd = []byte{
+	// 2375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xf7, 0x78, 0xed, 0xd8, 0x3e, 0xeb, 0xaf, 0xdc, 0xd8, 0xf1, 0x66, 0xe2, 0xac, 0xdd, 0x51,
+	0x48, 0x9c, 0x0f, 0xef, 0x36, 0x5f, 0x2a, 0x6d, 0xda, 0x07, 0x9b, 0x38, 0xae, 0x45, 0x9a, 0x3a,
+	0xe3, 0x84, 0x02, 0x52, 0x19, 0xcd, 0xce, 0x5e, 0xdb, 0xa3, 0xcc, 0xee, 0xcc, 0xce, 0xbd, 0xeb,
+	0x64, 0x09, 0xa5, 0x12, 0xca, 0x03, 0x12, 0x42, 0x2a, 0xea, 0x1b, 0x48, 0xa8, 0x3c, 0x80, 0x84,
+	0x10, 0x52, 0x1e, 0x78, 0xe4, 0x81, 0x17, 0xa4, 0x22, 0x81, 0xa8, 0xc4, 0x03, 0x1f, 0x0f, 0x01,
+	0x25, 0x3c, 0x94, 0xff, 0x02, 0xdd, 0x3b, 0x77, 0x76, 0xbe, 0x67, 0x37, 0xdb, 0x34, 0x88, 0x3e,
+	0xc5, 0x33, 0xf7, 0xdc, 0x73, 0x7e, 0xe7, 0x77, 0xcf, 0x9c, 0x7b, 0xce, 0xd9, 0xc0, 0x22, 0x31,
+	0x77, 0x9b, 0x76, 0x1d, 0x57, 0x0d, 0xcb, 0xa9, 0x1e, 0x5c, 0xa8, 0xb6, 0xda, 0xd8, 0x35, 0xb1,
+	0x5b, 0x71, 0x5c, 0x9b, 0xda, 0x68, 0x5a, 0xac, 0x56, 0x0c, 0xcb, 0xa9, 0x1c, 0x5c, 0x90, 0xe7,
+	0xf6, 0xec, 0x3d, 0x9b, 0x2f, 0x55, 0xd9, 0x5f, 0x9e, 0x94, 0x2c, 0xc7, 0x74, 0xd0, 0x8e, 0x83,
+	0x89, 0x58, 0x3b, 0x1e, 0x5b, 0x73, 0x74, 0x57, 0x6f, 0xf8, 0x8b, 0x0b, 0xf1, 0x8d, 0xf7, 0xc5,
+	0xc2, 0x59, 0xc3, 0x26, 0x0d, 0x9b, 0x54, 0x6b, 0x3a, 0xc1, 0x1c, 0x52, 0xa7, 0x7a, 0x70, 0xa1,
+	0x86, 0xa9, 0xce, 0x14, 0xec, 0x99, 0x4d, 0x9d, 0x9a, 0x76, 0x53, 0xc8, 0x2e, 0xee, 0xd9, 0xf6,
+	0x9e, 0x85, 0xab, 0xba, 0x63, 0x56, 0xf5, 0x66, 0xd3, 0xa6, 0x7c, 0x51, 0x98, 0x50, 0xce, 0xc1,
+	0xd8, 0xb6, 0x6d, 0x5b, 0x2a, 0x6e, 0xa1, 0xa3, 0x70, 0x88, 0x74, 0x1a, 0x35, 0xdb, 0x2a, 0x49,
+	0xcb, 0xd2, 0xca, 0x84, 0x2a, 0x9e, 0x5e, 0x1b, 0xff, 0xfe, 0x47, 0x4b, 0x43, 0x9f, 0x7e, 0xb4,
+	0x34, 0xa4, 0x74, 0x7c, 0x61, 0x82, 0x56, 0x60, 0xc4, 0xb1, 0x85, 0x68, 0xf1, 0xe2, 0x5c, 0x25,
+	0x4a, 0x44, 0x85, 0x8b, 0x71, 0x09, 0x74, 0x1e, 0x90, 0x61, 0x39, 0x5a, 0xc3, 0xae, 0xb7, 0x2d,
+	0xac, 0xe9, 0xf5, 0xba, 0x8b, 0x09, 0x29, 0x0d, 0x73, 0x13, 0xb3, 0x86, 0xe5, 0xbc, 0xc5, 0x17,
+	0xd6, 0xbc, 0xf7, 0x0c, 0xc4, 0x3e, 0x36, 0xf7, 0xf6, 0x69, 0xa9, 0xb0, 0x2c, 0xad, 0x14, 0x54,
+	0xf1, 0xa4, 0xa8, 0x30, 0xce, 0x74, 0x12, 0x06, 0xf4, 0x3a, 0x40, 0xe0, 0xa5, 0x40, 0x70, 0xaa,
+	0xe2, 0x51, 0x52, 0x61, 0x94, 0x54, 0x38, 0x25, 0x15, 0x41, 0x49, 0x65, 0x5b, 0xdf, 0xc3, 0x2a,
+	0x6e, 0xb5, 0x31, 0xa1, 0x6a, 0x68, 0xa7, 0xf2, 0x7b, 0xa9, 0xab, 0x94, 0xa0, 0xb3, 0x30, 0xca,
+	0xe0, 0x92, 0x92, 0xb4, 0x5c, 0xc8, 0xf4, 0xc8, 0x13, 0x79, 0x3e, 0x2e, 0xa1, 0xcd, 0x88, 0x1b,
+	0x23, 0xdc, 0x8d, 0xd3, 0x3d, 0xdd, 0x20, 0x8e, 0xdd, 0x24, 0x38, 0xe2, 0xc7, 0x3b, 0x30, 0x77,
+	0xc3, 0x6c, 0xb5, 0xcd, 0xba, 0x49, 0x3b, 0xdb, 0xae, 0x7d, 0x60, 0xd6, 0xb1, 0x9b, 0x73, 0xa0,
+	0xe8, 0x04, 0x80, 0xe5, 0xc4, 0x60, 0x4f, 0x58, 0x8e, 0xc0, 0x1b, 0x3a, 0xef, 0x4f, 0xa5, 0x54,
+	0xcd, 0x04, 0x6d, 0x03, 0xb2, 0xfc, 0xf7, 0x9a, 0x23, 0x16, 0xc4, 0x49, 0xbc, 0x14, 0x67, 0x2e,
+	0xa9, 0xe1, 0xb0, 0x15, 0x7f, 0x85, 0x5e, 0x86, 0x39, 0xe6, 0xcd, 0x01, 0xd6, 0x74, 0x42, 0x30,
+	0xd5, 0x6a, 0xba, 0xa5, 0x37, 0x0d, 0x2c, 0xd0, 0x21, 0x6f, 0x6d, 0x8d, 0x2d, 0xad, 0x7b, 0x2b,
+	0xe8, 0x32, 0x1c, 0xc5, 0xf7, 0x29, 0x76, 0x9b, 0xba, 0x15, 0xdb, 0x53, 0xe0, 0x7b, 0xe6, 0xfc,
+	0xd5, 0xc8, 0xae, 0xe0, 0x30, 0x46, 0x22, 0xf1, 0xf5, 0x3e, 0x4c, 0x72, 0xb9, 0x1b, 0x26, 0xa1,
+	0x8c, 0xbb, 0x28, 0x47, 0x52, 0x8c, 0xa3, 0x58, 0x08, 0x0e, 0x0f, 0x1a, 0x82, 0x21, 0xae, 0x7f,
+	0x2a, 0x45, 0x10, 0x10, 0xb4, 0x0a, 0x87, 0xb8, 0x5b, 0x7e, 0x44, 0xce, 0xc7, 0x79, 0xe5, 0xd2,
+	0xaa, 0x10, 0x0a, 0x39, 0x36, 0x9c, 0x13, 0x65, 0x85, 0xc1, 0xa3, 0xec, 0x07, 0x12, 0x94, 0x12,
+	0x47, 0x79, 0x4d, 0xa7, 0xfa, 0xff, 0x84, 0xae, 0xbf, 0x67, 0xa3, 0x21, 0xe8, 0x5d, 0x58, 0x48,
+	0x86, 0xa7, 0x56, 0xd7, 0xa9, 0x2e, 0xb8, 0xfc, 0x52, 0xcf, 0x18, 0xe5, 0xaa, 0xe6, 0xad, 0xb4,
+	0xd7, 0x99, 0x54, 0x5f, 0x4f, 0xa1, 0x7a, 0x90, 0xbc, 0xf4, 0x30, 0xcd, 0x37, 0x3f, 0x30, 0xb3,
+	0x3e, 0xea, 0xe7, 0x4f, 0xf1, 0x9f, 0xb3, 0x61, 0x10, 0xa4, 0xc2, 0x91, 0x24, 0xc5, 0x7e, 0xa8,
+	0xf6, 0x91, 0x02, 0x50, 0x82, 0xda, 0x17, 0x10, 0xc2, 0x26, 0xcc, 0x27, 0x90, 0xa4, 0xdc, 0x28,
+	0xcf, 0x83, 0xbc, 0x3f, 0x4a, 0xe9, 0xb6, 0xfe, 0x4f, 0x99, 0x2b, 0xc2, 0xc4, 0x36, 0xaf, 0x4c,
+	0x54, 0xdc, 0x52, 0x1e, 0x0e, 0x07, 0x4f, 0x04, 0x55, 0xe0, 0x90, 0x57, 0xb4, 0x88, 0xfc, 0x7f,
+	0x34, 0x71, 0x73, 0x7a, 0xa2, 0x42, 0x0a, 0xbd, 0x0b, 0x88, 0x74, 0x1a, 0x0d, 0x4c, 0xdd, 0x8e,
+	0x46, 0xf7, 0x5d, 0x4c, 0xf6, 0x6d, 0xab, 0xee, 0xe5, 0xf9, 0xf5, 0xca, 0xc7, 0x8f, 0x97, 0x86,
+	0xfe, 0xf1, 0x78, 0xe9, 0xd4, 0x9e, 0x49, 0xf7, 0xdb, 0xb5, 0x8a, 0x61, 0x37, 0xaa, 0xa2, 0xd4,
+	0xf1, 0xfe, 0x59, 0x25, 0xf5, 0xbb, 0xa2, 0x7e, 0xba, 0x86, 0x0d, 0xf5, 0xb0, 0xaf, 0xe9, 0xb6,
+	0xaf, 0x08, 0xed, 0x43, 0xa9, 0xab, 0xde, 0x65, 0xe0, 0x43, 0x46, 0x0a, 0x03, 0x19, 0x39, 0xea,
+	0xeb, 0x53, 0x99, 0xba, 0xae, 0x25, 0xe5, 0x30, 0xcc, 0xa8, 0xf8, 0x9e, 0xee, 0xd6, 0x03, 0x66,
+	0x36, 0xe3, 0xaf, 0x08, 0xba, 0x1c, 0xa3, 0x67, 0x31, 0x4e, 0x4f, 0x64, 0x83, 0x90, 0x55, 0x66,
+	0x60, 0x6a, 0xbb, 0x41, 0x9d, 0x40, 0xf3, 0x3f, 0xa5, 0xe8, 0x1b, 0x82, 0x2e, 0xc6, 0x14, 0xcb,
+	0x09, 0xde, 0x03, 0x71, 0x9f, 0xfb, 0x37, 0x61, 0xd6, 0x69, 0x50, 0x87, 0x11, 0x83, 0x35, 0xb1,
+	0xdb, 0x8b, 0xf6, 0x72, 0xda, 0x6e, 0x55, 0xa7, 0x58, 0x68, 0x98, 0x76, 0x22, 0xcf, 0xe8, 0xcb,
+	0x00, 0x5c, 0x13, 0x76, 0x6c, 0x63, 0x5f, 0x44, 0xd6, 0xb1, 0x34, 0x1d, 0x1b, 0x4c, 0x40, 0x9d,
+	0x70, 0xfc, 0x3f, 0x33, 0x6f, 0xe0, 0x32, 0x2c, 0x86, 0x83, 0x9d, 0x62, 0x83, 0x45, 0x5e, 0xc0,
+	0xc0, 0x1f, 0xa4, 0x5c, 0x01, 0x82, 0xd6, 0x62, 0x84, 0x9c, 0xc9, 0xfb, 0x96, 0xa2, 0xbb, 0x7d,
+	0x7e, 0x6e, 0x42, 0x31, 0x49, 0xcd, 0x6a, 0x1f, 0x7a, 0x42, 0x4c, 0x81, 0x1b, 0xb0, 0x94, 0x55,
+	0xcd, 0x2e, 0xc1, 0x89, 0xee, 0x8d, 0x62, 0x12, 0xea, 0x9a, 0xb5, 0x76, 0xd4, 0x59, 0x23, 0x5f,
+	0x80, 0xa0, 0xf5, 0x98, 0xb3, 0x67, 0x13, 0xdc, 0x67, 0x6f, 0xf7, 0x83, 0x0c, 0xc1, 0xec, 0xce,
+	0x3d, 0xdd, 0xb9, 0x8e, 0x71, 0x60, 0xf8, 0xb7, 0x52, 0xe2, 0x25, 0x41, 0x3a, 0xcc, 0xd7, 0xf1,
+	0xae, 0xde, 0xb6, 0xa8, 0x46, 0xee, 0xe9, 0x8e, 0xb6, 0x8b, 0x31, 0x0f, 0xa1, 0x92, 0x34, 0xd0,
+	0x07, 0x85, 0x84, 0x32, 0x61, 0x87, 0x71, 0x87, 0x36, 0x60, 0x92, 0xda, 0x77, 0x71, 0x33, 0xa0,
+	0x9e, 0xa5, 0x43, 0x25, 0xee, 0x95, 0xd8, 0x72, 0x9b, 0x89, 0x0a, 0x7c, 0x45, 0x1a, 0x3c, 0x28,
+	0x3f, 0x1e, 0x86, 0x39, 0x56, 0xa9, 0xef, 0xec, 0xeb, 0x2e, 0xde, 0x20, 0xd4, 0x6c, 0xe8, 0x94,
+	0x65, 0x6a, 0xf4, 0x3a, 0x4c, 0x47, 0xab, 0x45, 0xc1, 0x5b, 0x46, 0x55, 0x35, 0x15, 0x29, 0x1e,
+	0x91, 0x06, 0x47, 0x22, 0xd5, 0xa9, 0xde, 0xb0, 0xdb, 0x4d, 0x2a, 0x92, 0x56, 0x55, 0xb8, 0x7f,
+	0xba, 0x0f, 0xf7, 0xef, 0x98, 0x4d, 0xaa, 0x1e, 0x0e, 0x55, 0xb3, 0x6b, 0x5c, 0x13, 0x32, 0x60,
+	0x3e, 0x56, 0xcc, 0x0a, 0x13, 0x85, 0xc1, 0x4c, 0x1c, 0x89, 0xe0, 0xf7, 0x8c, 0x28, 0xff, 0x49,
+	0x27, 0x87, 0x85, 0x3d, 0x38, 0xd8, 0x35, 0x70, 0x93, 0xea, 0x7b, 0x83, 0x1e, 0x6a, 0x48, 0xc3,
+	0x17, 0x83, 0x2e, 0x74, 0x15, 0x26, 0x78, 0xb4, 0x9b, 0xcd, 0x5d, 0x5b, 0xb4, 0x67, 0xa5, 0xb4,
+	0x78, 0xdc, 0x6a, 0xee, 0xda, 0xeb, 0x23, 0xcc, 0xa4, 0x3a, 0x4e, 0xc4, 0xb3, 0xf2, 0x57, 0x09,
+	0x66, 0xd8, 0x62, 0x38, 0x06, 0x2f, 0x03, 0x10, 0xdc, 0xa4, 0xfd, 0xc4, 0xdf, 0x04, 0x13, 0xe4,
+	0x7f, 0xb2, 0xc8, 0x75, 0xb1, 0x81, 0xcd, 0x03, 0x5c, 0x17, 0x3b, 0x87, 0x73, 0x23, 0xd7, 0x17,
+	0xf6, 0x76, 0x6f, 0x43, 0xd1, 0xb3, 0xf9, 0x99, 0xf8, 0xe1, 0xb8, 0x45, 0x14, 0x3d, 0x2a, 0xc4,
+	0x3d, 0x23, 0xe8, 0xeb, 0x30, 0x13, 0x60, 0xf4, 0x2c, 0x49, 0x83, 0x59, 0xea, 0xfa, 0x2a, 0x0e,
+	0xe1, 0x36, 0x4c, 0x05, 0xd5, 0xd2, 0x2e, 0xc6, 0x83, 0x06, 0xd1, 0x64, 0x57, 0xcb, 0x75, 0x8c,
+	0x91, 0x0a, 0x93, 0x8e, 0x6b, 0x1a, 0x58, 0x33, 0x1b, 0x8e, 0x6e, 0x0c, 0x4c, 0x4b, 0x91, 0x2b,
+	0xd9, 0xe2, 0x3a, 0x90, 0x0a, 0x53, 0xd1, 0xe4, 0x38, 0x32, 0xd0, 0x77, 0x54, 0x24, 0x91, 0xac,
+	0xb8, 0x54, 0xb3, 0x6c, 0xe3, 0x2e, 0xae, 0x6b, 0xb5, 0x8e, 0x16, 0x29, 0x1b, 0xc5, 0xc5, 0x53,
+	0x1a, 0x5d, 0x96, 0x56, 0xc6, 0xd5, 0x45, 0x21, 0xb6, 0xde, 0x49, 0xb9, 0x9c, 0x94, 0x07, 0xb0,
+	0x70, 0xc3, 0x6c, 0x98, 0xf4, 0x6d, 0x97, 0x55, 0x8b, 0xeb, 0x1d, 0xd1, 0x96, 0xb1, 0x98, 0x2c,
+	0xc1, 0x58, 0xb4, 0x6b, 0xf3, 0x1f, 0x9f, 0x57, 0x4d, 0xac, 0x1c, 0xc0, 0x5c, 0xc4, 0x78, 0x8f,
+	0x71, 0xd3, 0x73, 0xb3, 0xfb, 0x48, 0x82, 0xe9, 0x90, 0x61, 0x16, 0xa6, 0x6f, 0xc0, 0xa4, 0xc5,
+	0xde, 0x68, 0xb6, 0x1b, 0xaa, 0xb9, 0xe5, 0xe4, 0xfd, 0xee, 0xef, 0x52, 0x8b, 0x56, 0xa0, 0xe1,
+	0xf3, 0xaf, 0xb2, 0x1f, 0x0f, 0xc3, 0xb8, 0x9f, 0x51, 0x58, 0x7d, 0x47, 0xa8, 0x4e, 0xdb, 0xde,
+	0xc1, 0x4c, 0x27, 0x61, 0x32, 0xc9, 0x1d, 0x2e, 0xa1, 0x0a, 0x49, 0xb4, 0x06, 0x85, 0xcf, 0xf0,
+	0x8d, 0xb0, 0xbd, 0x68, 0x0b, 0xc6, 0xbb, 0x11, 0x3c, 0x58, 0xbd, 0x3c, 0xb6, 0x2b, 0xa2, 0x77,
+	0x13, 0x0e, 0x89, 0x64, 0x30, 0x32, 0x18, 0x20, 0xb1, 0x9d, 0x29, 0x72, 0x31, 0x69, 0x5b, 0xb4,
+	0x34, 0x3a, 0xa0, 0x22, 0x6f, 0xbb, 0x62, 0xc0, 0xd8, 0xed, 0x7b, 0xba, 0x93, 0x17, 0x7e, 0x2f,
+	0xc1, 0x24, 0xa1, 0xba, 0x4b, 0xb5, 0xc8, 0x51, 0x17, 0xf9, 0xbb, 0x37, 0xbd, 0xf3, 0x3e, 0x01,
+	0x80, 0x9b, 0x75, 0x2d, 0x52, 0xd9, 0x4d, 0xe0, 0x66, 0xdd, 0x5b, 0x56, 0x7e, 0x38, 0xec, 0x5b,
+	0x21, 0xe8, 0x16, 0x4c, 0x8a, 0x9b, 0x90, 0xa7, 0x8a, 0x01, 0xef, 0xd6, 0xa2, 0xa7, 0x63, 0x9b,
+	0xa9, 0x40, 0x77, 0x42, 0x95, 0x8c, 0xa7, 0x74, 0xb0, 0xde, 0xa9, 0x5b, 0xe2, 0x78, 0x6a, 0xe3,
+	0x7e, 0x17, 0x7a, 0xf9, 0x3d, 0x12, 0xf3, 0x3b, 0xf4, 0x79, 0x8c, 0x46, 0x8a, 0xdd, 0x33, 0xac,
+	0x29, 0x6a, 0xd8, 0x07, 0xba, 0x75, 0xab, 0x8d, 0xdb, 0x38, 0x87, 0x7c, 0xc5, 0x8a, 0x8b, 0xb2,
+	0x90, 0x9e, 0x72, 0xbd, 0x57, 0x5a, 0x8b, 0xbd, 0xcb, 0x6e, 0xa3, 0x42, 0xfb, 0x26, 0xdd, 0xd0,
+	0x53, 0xd6, 0x77, 0xab, 0x7c, 0x1b, 0x4a, 0x62, 0x97, 0xc8, 0x1f, 0x2f, 0x30, 0x3b, 0xbd, 0x0f,
+	0xc7, 0x13, 0xb6, 0x5f, 0x68, 0x5a, 0xfe, 0x9d, 0x04, 0x28, 0x86, 0x80, 0xd1, 0xbd, 0x05, 0xb3,
+	0x3e, 0xdd, 0xae, 0x78, 0x2d, 0xd2, 0x64, 0x39, 0x83, 0x71, 0x5f, 0xf9, 0x8c, 0x1b, 0xd5, 0xf6,
+	0xf9, 0xa7, 0xcb, 0xb7, 0x60, 0x2e, 0x7c, 0xea, 0x77, 0x9a, 0x26, 0x25, 0x83, 0xcf, 0xbd, 0x95,
+	0x76, 0xaa, 0x3a, 0x82, 0x36, 0x60, 0xb4, 0xcd, 0xfe, 0x1e, 0xb4, 0xa4, 0xf1, 0x76, 0x67, 0xd1,
+	0x71, 0xf6, 0xab, 0x00, 0x41, 0x26, 0x47, 0x33, 0x50, 0xbc, 0x73, 0x73, 0x67, 0x7b, 0xe3, 0x2b,
+	0x5b, 0xd7, 0xb7, 0x36, 0xae, 0xcd, 0x0e, 0xa1, 0x22, 0x8c, 0xdd, 0x7c, 0x5b, 0xdb, 0x79, 0x67,
+	0x6d, 0x7b, 0x56, 0x62, 0xab, 0x3b, 0x1b, 0x37, 0x6e, 0x68, 0x37, 0xd7, 0x6e, 0x6f, 0x7d, 0x6d,
+	0x63, 0x76, 0x18, 0x4d, 0x03, 0xac, 0xdf, 0xf9, 0x86, 0xff, 0x5c, 0xb8, 0xf8, 0xa7, 0x12, 0x8c,
+	0xde, 0x62, 0xec, 0x21, 0x03, 0xc6, 0x36, 0x31, 0x65, 0xe1, 0x8c, 0x16, 0x52, 0x7f, 0xcb, 0xc0,
+	0x2d, 0x39, 0x63, 0x81, 0x28, 0xa7, 0xbe, 0xf7, 0x97, 0x7f, 0x7f, 0x38, 0xbc, 0x8c, 0xca, 0x55,
+	0x62, 0xee, 0x1a, 0xfb, 0xba, 0xd9, 0xec, 0xfe, 0x3e, 0x65, 0xdb, 0x56, 0xf5, 0x81, 0x47, 0xe8,
+	0x7b, 0xe8, 0x5b, 0x30, 0x2e, 0x8c, 0x10, 0x54, 0x4a, 0x53, 0xc6, 0xce, 0x43, 0xce, 0x5a, 0x21,
+	0x4a, 0x99, 0xdb, 0x29, 0xa1, 0xa3, 0xa9, 0x76, 0x08, 0xfa, 0xb9, 0x04, 0x73, 0x9b, 0x98, 0x86,
+	0x6b, 0x1a, 0xef, 0xe7, 0x82, 0x93, 0xbd, 0xe7, 0x64, 0xb8, 0x25, 0xf7, 0x23, 0x45, 0x94, 0x35,
+	0x0e, 0xe2, 0x2a, 0x7a, 0x35, 0x01, 0x22, 0x39, 0xa7, 0xeb, 0xba, 0x5e, 0x7d, 0x10, 0x84, 0xd2,
+	0x7b, 0xe8, 0xd7, 0x12, 0x94, 0xd2, 0x70, 0xf2, 0x71, 0xf1, 0x4a, 0x7f, 0xc3, 0x66, 0xdc, 0x92,
+	0xfb, 0x95, 0x24, 0xca, 0x1b, 0x1c, 0xf3, 0x2b, 0xe8, 0x4a, 0x1f, 0x98, 0xf9, 0xe0, 0x3b, 0x8a,
+	0xf7, 0x3b, 0x30, 0xb9, 0x89, 0x69, 0xf7, 0xe7, 0x06, 0xb4, 0x98, 0xda, 0x4b, 0x88, 0x91, 0xb3,
+	0x9c, 0xb7, 0x4a, 0x94, 0x97, 0x39, 0x94, 0xb3, 0x68, 0x25, 0x01, 0xc5, 0xeb, 0xcc, 0x2c, 0x93,
+	0xd0, 0xa8, 0xf5, 0x0f, 0x25, 0x98, 0x4f, 0x63, 0x8b, 0xa0, 0xde, 0x73, 0x79, 0x1e, 0x50, 0x7d,
+	0x89, 0x11, 0xe5, 0x3c, 0x47, 0x76, 0x0a, 0x9d, 0xec, 0x83, 0x24, 0x82, 0x7e, 0x99, 0x71, 0x86,
+	0x9c, 0xa0, 0xde, 0x27, 0xe3, 0x93, 0xd5, 0xaf, 0x24, 0x51, 0x5e, 0xe5, 0xf0, 0x2e, 0xa1, 0x0b,
+	0xfd, 0x9c, 0xa1, 0xc7, 0xa2, 0xff, 0xdd, 0xd5, 0x60, 0x82, 0x7d, 0x77, 0xde, 0x90, 0xe9, 0x58,
+	0xc6, 0xc0, 0x15, 0xb7, 0xe4, 0xcc, 0x25, 0xa2, 0x2c, 0x71, 0xeb, 0xc7, 0xd0, 0x42, 0xf2, 0xd3,
+	0xf3, 0xd4, 0x3e, 0x80, 0x99, 0x4d, 0x4c, 0xc3, 0xd3, 0x49, 0xb4, 0x94, 0x3b, 0xbb, 0xc4, 0x2d,
+	0xb9, 0x87, 0x40, 0x5e, 0x62, 0x71, 0xb9, 0xa4, 0x18, 0xff, 0x20, 0x02, 0x53, 0xcc, 0xc1, 0xee,
+	0x04, 0x13, 0x9d, 0xc8, 0x99, 0x6e, 0xe2, 0x96, 0x9c, 0xbb, 0x4c, 0x94, 0x93, 0xdc, 0x6c, 0x19,
+	0x2d, 0x26, 0x9d, 0x65, 0x43, 0x4c, 0x61, 0xf4, 0x91, 0x04, 0x8b, 0xb1, 0x08, 0x88, 0x8c, 0x09,
+	0xd1, 0xf9, 0xfe, 0x27, 0x8a, 0xb8, 0x25, 0x3f, 0x8b, 0x34, 0x51, 0x2e, 0x73, 0x88, 0x15, 0x74,
+	0x3e, 0x3f, 0x1a, 0xc4, 0x3e, 0x1f, 0xf2, 0x6f, 0x24, 0x38, 0xc1, 0x88, 0xca, 0x1c, 0xf6, 0xa1,
+	0xd5, 0x67, 0x18, 0x0c, 0xe2, 0x96, 0xfc, 0x4c, 0xe2, 0x44, 0xb9, 0xc2, 0x51, 0x57, 0xd1, 0x6a,
+	0x92, 0xd8, 0x6e, 0xf6, 0x09, 0x6d, 0xf4, 0x61, 0x7f, 0x17, 0x66, 0x37, 0x31, 0x8d, 0xcc, 0x19,
+	0xd1, 0x72, 0xc6, 0xac, 0x2f, 0xc0, 0xd6, 0x4b, 0x22, 0x2f, 0xbc, 0x22, 0xad, 0x39, 0xfa, 0xc0,
+	0xbb, 0x57, 0x12, 0xc3, 0xb0, 0xe4, 0xbd, 0x92, 0x36, 0x4c, 0x94, 0xfb, 0x91, 0xca, 0x4b, 0x3f,
+	0xec, 0x72, 0xd3, 0x08, 0x93, 0xd7, 0xb0, 0x6f, 0xd9, 0xfb, 0xdc, 0xc2, 0x83, 0x95, 0xe4, 0xe7,
+	0x16, 0x1b, 0x28, 0xc9, 0x3d, 0x04, 0x7a, 0xf2, 0xd1, 0x35, 0xfe, 0x13, 0x09, 0x16, 0x78, 0xe4,
+	0x27, 0x87, 0x04, 0xe8, 0x74, 0x76, 0x7b, 0x1c, 0xa9, 0x59, 0xe5, 0x72, 0x8e, 0x20, 0x03, 0xf3,
+	0x0a, 0x07, 0x73, 0x01, 0x55, 0x53, 0x22, 0x3c, 0x68, 0xca, 0xab, 0xe2, 0x9a, 0xa8, 0x3e, 0xe8,
+	0xde, 0x17, 0x3f, 0xf2, 0xab, 0x80, 0xd8, 0x14, 0x21, 0xad, 0x0a, 0x48, 0x0e, 0x1a, 0x7a, 0xe2,
+	0xba, 0xc4, 0x71, 0xad, 0xa2, 0x73, 0xf9, 0xb8, 0xa2, 0x95, 0x8f, 0x57, 0x5e, 0xb1, 0x36, 0x2f,
+	0x59, 0x5e, 0x89, 0x16, 0x53, 0xce, 0x58, 0xc8, 0x3b, 0x16, 0x7a, 0x4f, 0x77, 0x02, 0x23, 0x0f,
+	0x25, 0x91, 0x83, 0x43, 0xcd, 0xcc, 0x52, 0x6e, 0xe3, 0x93, 0x9e, 0x83, 0xc3, 0x02, 0x44, 0xa9,
+	0x72, 0xeb, 0x67, 0xd0, 0xe9, 0x94, 0x1c, 0x1c, 0x6a, 0xb4, 0x02, 0x18, 0x3f, 0xf3, 0x6e, 0xc6,
+	0xd4, 0x5e, 0x29, 0x79, 0x33, 0x66, 0xb5, 0x54, 0xb2, 0xd2, 0x43, 0x32, 0x3f, 0x46, 0xe2, 0x5d,
+	0x49, 0xec, 0x3c, 0x7e, 0x25, 0xc1, 0xf1, 0x34, 0x8c, 0x7e, 0x14, 0x9f, 0xeb, 0x09, 0x33, 0x14,
+	0xc9, 0xfd, 0x20, 0xbd, 0xca, 0x91, 0x5e, 0x41, 0x97, 0x7a, 0x23, 0x4d, 0x46, 0xf4, 0x2f, 0xbc,
+	0x88, 0x4e, 0xb4, 0x1b, 0xc9, 0x88, 0x4e, 0x6b, 0x70, 0xe4, 0x7e, 0xa4, 0x88, 0xb2, 0xce, 0x11,
+	0xbe, 0x8e, 0x5e, 0xcb, 0x3f, 0x67, 0x8d, 0xb7, 0x27, 0xe9, 0x85, 0xed, 0xfa, 0xda, 0xc7, 0x4f,
+	0xca, 0xd2, 0x27, 0x4f, 0xca, 0xd2, 0xbf, 0x9e, 0x94, 0xa5, 0x0f, 0x9e, 0x96, 0x87, 0x3e, 0x79,
+	0x5a, 0x1e, 0xfa, 0xdb, 0xd3, 0xf2, 0xd0, 0x37, 0xc3, 0xed, 0xcf, 0x8e, 0xaf, 0x5f, 0xc0, 0xaa,
+	0xde, 0xe7, 0x96, 0x78, 0x0f, 0x54, 0x3b, 0xc4, 0xff, 0xa3, 0xd9, 0xa5, 0xff, 0x0e, 0x00, 0x35,
+	0xd7, 0xca, 0x38, 0x4a, 0x27, 0x00, 0x00,
 }
 
-func (m *PoolRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClpModuleAddress) > 0 {
-		i -= len(m.ClpModuleAddress)
-		copy(dAtA[i:], m.ClpModuleAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ClpModuleAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pool != nil {
-		{
-			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error)
+	GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error)
+	GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error)
+	GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error)
+	GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error)
+	GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error)
+	GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error)
+	GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error)
+	GetRewardParams(ctx context.Context, in *RewardParamsReq, opts ...grpc.CallOption) (*RewardParamsRes, error)
+	GetPmtpParams(ctx context.Context, in *PmtpParamsReq, opts ...grpc.CallOption) (*PmtpParamsRes, error)
+	GetLiquidityProtectionParams(ctx context.Context, in *LiquidityProtectionParamsReq, opts ...grpc.CallOption) (*LiquidityProtectionParamsRes, error)
+	GetProviderDistributionParams(ctx context.Context, in *ProviderDistributionParamsReq, opts ...grpc.CallOption) (*ProviderDistributionParamsRes, error)
+	GetSwapFeeParams(ctx context.Context, in *SwapFeeParamsReq, opts ...grpc.CallOption) (*SwapFeeParamsRes, error)
+	GetPoolShareEstimate(ctx context.Context, in *PoolShareEstimateReq, opts ...grpc.CallOption) (*PoolShareEstimateRes, error)
+	GetSwapEstimate(ctx context.Context, in *SwapEstimateReq, opts ...grpc.CallOption) (*SwapEstimateRes, error)
+	GetLimitOrdersByAddress(ctx context.Context, in *LimitOrdersByAddressReq, opts ...grpc.CallOption) (*LimitOrdersRes, error)
+	GetLimitOrdersByPool(ctx context.Context, in *LimitOrdersByPoolReq, opts ...grpc.CallOption) (*LimitOrdersRes, error)
+	GetTwap(ctx context.Context, in *TwapReq, opts ...grpc.CallOption) (*TwapRes, error)
+	GetRemovalQueue(ctx context.Context, in *RemovalQueueReq, opts ...grpc.CallOption) (*RemovalQueueRes, error)
+	GetRemovalRequestsByPool(ctx context.Context, in *RemovalRequestsByPoolReq, opts ...grpc.CallOption) (*RemovalRequestsRes, error)
+	GetRemovalRequestsByAddress(ctx context.Context, in *RemovalRequestsByAddressReq, opts ...grpc.CallOption) (*RemovalRequestsRes, error)
+	GetRemovalQueueUnits(ctx context.Context, in *RemovalQueueUnitsReq, opts ...grpc.CallOption) (*RemovalQueueUnitsRes, error)
 }
 
-func (m *PoolsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetPool(ctx context.Context, in *PoolReq, opts ...grpc.CallOption) (*PoolRes, error) {
+	out := new(PoolRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PoolsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPools(ctx context.Context, in *PoolsReq, opts ...grpc.CallOption) (*PoolsRes, error) {
+	out := new(PoolsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PoolsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetLiquidityProvider(ctx context.Context, in *LiquidityProviderReq, opts ...grpc.CallOption) (*LiquidityProviderRes, error) {
+	out := new(LiquidityProviderRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *PoolsRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLiquidityProviderData(ctx context.Context, in *LiquidityProviderDataReq, opts ...grpc.CallOption) (*LiquidityProviderDataRes, error) {
+	out := new(LiquidityProviderDataRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *PoolsRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAssetList(ctx context.Context, in *AssetListReq, opts ...grpc.CallOption) (*AssetListRes, error) {
+	out := new(AssetListRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetAssetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *PoolsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ClpModuleAddress) > 0 {
-		i -= len(m.ClpModuleAddress)
-		copy(dAtA[i:], m.ClpModuleAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ClpModuleAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetLiquidityProviders(ctx context.Context, in *LiquidityProvidersReq, opts ...grpc.CallOption) (*LiquidityProvidersRes, error) {
+	out := new(LiquidityProvidersRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityProviderReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLiquidityProviderList(ctx context.Context, in *LiquidityProviderListReq, opts ...grpc.CallOption) (*LiquidityProviderListRes, error) {
+	out := new(LiquidityProviderListRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProviderList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LiquidityProviderReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetParams(ctx context.Context, in *ParamsReq, opts ...grpc.CallOption) (*ParamsRes, error) {
+	out := new(ParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LiquidityProviderReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetRewardParams(ctx context.Context, in *RewardParamsReq, opts ...grpc.CallOption) (*RewardParamsRes, error) {
+	out := new(RewardParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRewardParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityProviderRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPmtpParams(ctx context.Context, in *PmtpParamsReq, opts ...grpc.CallOption) (*PmtpParamsRes, error) {
+	out := new(PmtpParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPmtpParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LiquidityProviderRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLiquidityProtectionParams(ctx context.Context, in *LiquidityProtectionParamsReq, opts ...grpc.CallOption) (*LiquidityProtectionParamsRes, error) {
+	out := new(LiquidityProtectionParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLiquidityProtectionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LiquidityProviderRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x20
+func (c *queryClient) GetProviderDistributionParams(ctx context.Context, in *ProviderDistributionParamsReq, opts ...grpc.CallOption) (*ProviderDistributionParamsRes, error) {
+	out := new(ProviderDistributionParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetProviderDistributionParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ExternalAssetBalance) > 0 {
-		i -= len(m.ExternalAssetBalance)
-		copy(dAtA[i:], m.ExternalAssetBalance)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ExternalAssetBalance)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *queryClient) GetSwapFeeParams(ctx context.Context, in *SwapFeeParamsReq, opts ...grpc.CallOption) (*SwapFeeParamsRes, error) {
+	out := new(SwapFeeParamsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetSwapFeeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NativeAssetBalance) > 0 {
-		i -= len(m.NativeAssetBalance)
-		copy(dAtA[i:], m.NativeAssetBalance)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.NativeAssetBalance)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *queryClient) GetPoolShareEstimate(ctx context.Context, in *PoolShareEstimateReq, opts ...grpc.CallOption) (*PoolShareEstimateRes, error) {
+	out := new(PoolShareEstimateRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetPoolShareEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LiquidityProvider != nil {
-		{
-			size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) GetSwapEstimate(ctx context.Context, in *SwapEstimateReq, opts ...grpc.CallOption) (*SwapEstimateRes, error) {
+	out := new(SwapEstimateRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetSwapEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AssetListReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLimitOrdersByAddress(ctx context.Context, in *LimitOrdersByAddressReq, opts ...grpc.CallOption) (*LimitOrdersRes, error) {
+	out := new(LimitOrdersRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLimitOrdersByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssetListReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLimitOrdersByPool(ctx context.Context, in *LimitOrdersByPoolReq, opts ...grpc.CallOption) (*LimitOrdersRes, error) {
+	out := new(LimitOrdersRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetLimitOrdersByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) GetTwap(ctx context.Context, in *TwapReq, opts ...grpc.CallOption) (*TwapRes, error) {
+	out := new(TwapRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) GetRemovalQueue(ctx context.Context, in *RemovalQueueReq, opts ...grpc.CallOption) (*RemovalQueueRes, error) {
+	out := new(RemovalQueueRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRemovalQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *AssetListRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRemovalRequestsByPool(ctx context.Context, in *RemovalRequestsByPoolReq, opts ...grpc.CallOption) (*RemovalRequestsRes, error) {
+	out := new(RemovalRequestsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRemovalRequestsByPool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AssetListRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRemovalRequestsByAddress(ctx context.Context, in *RemovalRequestsByAddressReq, opts ...grpc.CallOption) (*RemovalRequestsRes, error) {
+	out := new(RemovalRequestsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRemovalRequestsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *AssetListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (c *queryClient) GetRemovalQueueUnits(ctx context.Context, in *RemovalQueueUnitsReq, opts ...grpc.CallOption) (*RemovalQueueUnitsRes, error) {
+	out := new(RemovalQueueUnitsRes)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Query/GetRemovalQueueUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *LiquidityProviderDataReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	GetPool(context.Context, *PoolReq) (*PoolRes, error)
+	GetPools(context.Context, *PoolsReq) (*PoolsRes, error)
+	GetLiquidityProvider(context.Context, *LiquidityProviderReq) (*LiquidityProviderRes, error)
+	GetLiquidityProviderData(context.Context, *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error)
+	GetAssetList(context.Context, *AssetListReq) (*AssetListRes, error)
+	GetLiquidityProviders(context.Context, *LiquidityProvidersReq) (*LiquidityProvidersRes, error)
+	GetLiquidityProviderList(context.Context, *LiquidityProviderListReq) (*LiquidityProviderListRes, error)
+	GetParams(context.Context, *ParamsReq) (*ParamsRes, error)
+	GetRewardParams(context.Context, *RewardParamsReq) (*RewardParamsRes, error)
+	GetPmtpParams(context.Context, *PmtpParamsReq) (*PmtpParamsRes, error)
+	GetLiquidityProtectionParams(context.Context, *LiquidityProtectionParamsReq) (*LiquidityProtectionParamsRes, error)
+	GetProviderDistributionParams(context.Context, *ProviderDistributionParamsReq) (*ProviderDistributionParamsRes, error)
+	GetSwapFeeParams(context.Context, *SwapFeeParamsReq) (*SwapFeeParamsRes, error)
+	GetPoolShareEstimate(context.Context, *PoolShareEstimateReq) (*PoolShareEstimateRes, error)
+	GetSwapEstimate(context.Context, *SwapEstimateReq) (*SwapEstimateRes, error)
+	GetLimitOrdersByAddress(context.Context, *LimitOrdersByAddressReq) (*LimitOrdersRes, error)
+	GetLimitOrdersByPool(context.Context, *LimitOrdersByPoolReq) (*LimitOrdersRes, error)
+	GetTwap(context.Context, *TwapReq) (*TwapRes, error)
+	GetRemovalQueue(context.Context, *RemovalQueueReq) (*RemovalQueueRes, error)
+	GetRemovalRequestsByPool(context.Context, *RemovalRequestsByPoolReq) (*RemovalRequestsRes, error)
+	GetRemovalRequestsByAddress(context.Context, *RemovalRequestsByAddressReq) (*RemovalRequestsRes, error)
+	GetRemovalQueueUnits(context.Context, *RemovalQueueUnitsReq) (*RemovalQueueUnitsRes, error)
 }
 
-func (m *LiquidityProviderDataReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *LiquidityProviderDataReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetPool(ctx context.Context, req *PoolReq) (*PoolRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
 }
-
-func (m *LiquidityProviderDataRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetPools(ctx context.Context, req *PoolsReq) (*PoolsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProvider(ctx context.Context, req *LiquidityProviderReq) (*LiquidityProviderRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProvider not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProviderData(ctx context.Context, req *LiquidityProviderDataReq) (*LiquidityProviderDataRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderData not implemented")
+}
+func (*UnimplementedQueryServer) GetAssetList(ctx context.Context, req *AssetListReq) (*AssetListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetList not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProviders(ctx context.Context, req *LiquidityProvidersReq) (*LiquidityProvidersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviders not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProviderList(ctx context.Context, req *LiquidityProviderListReq) (*LiquidityProviderListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProviderList not implemented")
+}
+func (*UnimplementedQueryServer) GetParams(ctx context.Context, req *ParamsReq) (*ParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetParams not implemented")
+}
+func (*UnimplementedQueryServer) GetRewardParams(ctx context.Context, req *RewardParamsReq) (*RewardParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRewardParams not implemented")
+}
+func (*UnimplementedQueryServer) GetPmtpParams(ctx context.Context, req *PmtpParamsReq) (*PmtpParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPmtpParams not implemented")
+}
+func (*UnimplementedQueryServer) GetLiquidityProtectionParams(ctx context.Context, req *LiquidityProtectionParamsReq) (*LiquidityProtectionParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidityProtectionParams not implemented")
+}
+func (*UnimplementedQueryServer) GetProviderDistributionParams(ctx context.Context, req *ProviderDistributionParamsReq) (*ProviderDistributionParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderDistributionParams not implemented")
+}
+func (*UnimplementedQueryServer) GetSwapFeeParams(ctx context.Context, req *SwapFeeParamsReq) (*SwapFeeParamsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapFeeParams not implemented")
+}
+func (*UnimplementedQueryServer) GetPoolShareEstimate(ctx context.Context, req *PoolShareEstimateReq) (*PoolShareEstimateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolShareEstimate not implemented")
+}
+func (*UnimplementedQueryServer) GetSwapEstimate(ctx context.Context, req *SwapEstimateReq) (*SwapEstimateRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSwapEstimate not implemented")
+}
+func (*UnimplementedQueryServer) GetLimitOrdersByAddress(ctx context.Context, req *LimitOrdersByAddressReq) (*LimitOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimitOrdersByAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetLimitOrdersByPool(ctx context.Context, req *LimitOrdersByPoolReq) (*LimitOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimitOrdersByPool not implemented")
+}
+func (*UnimplementedQueryServer) GetTwap(ctx context.Context, req *TwapReq) (*TwapRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTwap not implemented")
+}
+func (*UnimplementedQueryServer) GetRemovalQueue(ctx context.Context, req *RemovalQueueReq) (*RemovalQueueRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemovalQueue not implemented")
+}
+func (*UnimplementedQueryServer) GetRemovalRequestsByPool(ctx context.Context, req *RemovalRequestsByPoolReq) (*RemovalRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemovalRequestsByPool not implemented")
+}
+func (*UnimplementedQueryServer) GetRemovalRequestsByAddress(ctx context.Context, req *RemovalRequestsByAddressReq) (*RemovalRequestsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemovalRequestsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetRemovalQueueUnits(ctx context.Context, req *RemovalQueueUnitsReq) (*RemovalQueueUnitsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemovalQueueUnits not implemented")
 }
 
-func (m *LiquidityProviderDataRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *LiquidityProviderDataRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetPool(ctx, in)
 	}
-	if len(m.LiquidityProviderData) > 0 {
-		for iNdEx := len(m.LiquidityProviderData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityProviderData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetPool",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPool(ctx, req.(*PoolReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProviderListReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityProviderListReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityProviderListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetPools(ctx, in)
 	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetPools",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPools(ctx, req.(*PoolsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProviderListRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLiquidityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityProviderListRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProvider(ctx, req.(*LiquidityProviderReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func _Query_GetLiquidityProviderData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderDataReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviderData(ctx, in)
 	}
-	if len(m.LiquidityProviders) > 0 {
-		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderData",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProviderData(ctx, req.(*LiquidityProviderDataReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProvidersReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAssetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetListReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityProvidersReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityProvidersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetAssetList(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetAssetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAssetList(ctx, req.(*AssetListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProvidersRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLiquidityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProvidersReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LiquidityProvidersRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviders(ctx, in)
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviders",
 	}
-	if len(m.LiquidityProviders) > 0 {
-		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProviders(ctx, req.(*LiquidityProvidersReq))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLiquidityProviderList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProviderListReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProviderList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProviderList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProviderList(ctx, req.(*LiquidityProviderListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetParams(ctx, req.(*ParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetRewardParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RewardParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRewardParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetRewardParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRewardParams(ctx, req.(*RewardParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PmtpParamsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetPmtpParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetPmtpParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPmtpParams(ctx, req.(*PmtpParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetLiquidityProtectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityProtectionParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidityProtectionParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLiquidityProtectionParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidityProtectionParams(ctx, req.(*LiquidityProtectionParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SymmetryRatioThreshold.Size()
-		i -= size
-		if _, err := m.SymmetryRatioThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+func _Query_GetProviderDistributionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderDistributionParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.SymmetryThreshold.Size()
-		i -= size
-		if _, err := m.SymmetryThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).GetProviderDistributionParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetProviderDistributionParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProviderDistributionParams(ctx, req.(*ProviderDistributionParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardParamsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetSwapFeeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapFeeParamsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RewardParamsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RewardParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetSwapFeeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetSwapFeeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSwapFeeParams(ctx, req.(*SwapFeeParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RewardParamsRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPoolShareEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolShareEstimateReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RewardParamsRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RewardParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetPoolShareEstimate(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetPoolShareEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPoolShareEstimate(ctx, req.(*PoolShareEstimateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetSwapEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwapEstimateReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetSwapEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetSwapEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetSwapEstimate(ctx, req.(*SwapEstimateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetLimitOrdersByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrdersByAddressReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLimitOrdersByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLimitOrdersByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLimitOrdersByAddress(ctx, req.(*LimitOrdersByAddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetLimitOrdersByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LimitOrdersByPoolReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLimitOrdersByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetLimitOrdersByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLimitOrdersByPool(ctx, req.(*LimitOrdersByPoolReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsRes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TwapReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetTwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTwap(ctx, req.(*TwapReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsRes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetRemovalQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovalQueueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemovalQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetRemovalQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemovalQueue(ctx, req.(*RemovalQueueReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PmtpParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x20
+func _Query_GetRemovalRequestsByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovalRequestsByPoolReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PmtpEpoch != nil {
-		{
-			size, err := m.PmtpEpoch.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemovalRequestsByPool(ctx, in)
 	}
-	if m.PmtpRateParams != nil {
-		{
-			size, err := m.PmtpRateParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetRemovalRequestsByPool",
 	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemovalRequestsByPool(ctx, req.(*RemovalRequestsByPoolReq))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProtectionParamsReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRemovalRequestsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovalRequestsByAddressReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemovalRequestsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetRemovalRequestsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemovalRequestsByAddress(ctx, req.(*RemovalRequestsByAddressReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *LiquidityProtectionParamsReq) MarshalTo(dAtA []byte) (int, error) {
+func _Query_GetRemovalQueueUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovalQueueUnitsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemovalQueueUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Query/GetRemovalQueueUnits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemovalQueueUnits(ctx, req.(*RemovalQueueUnitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.clp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPool",
+			Handler:    _Query_GetPool_Handler,
+		},
+		{
+			MethodName: "GetPools",
+			Handler:    _Query_GetPools_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProvider",
+			Handler:    _Query_GetLiquidityProvider_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProviderData",
+			Handler:    _Query_GetLiquidityProviderData_Handler,
+		},
+		{
+			MethodName: "GetAssetList",
+			Handler:    _Query_GetAssetList_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProviders",
+			Handler:    _Query_GetLiquidityProviders_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProviderList",
+			Handler:    _Query_GetLiquidityProviderList_Handler,
+		},
+		{
+			MethodName: "GetParams",
+			Handler:    _Query_GetParams_Handler,
+		},
+		{
+			MethodName: "GetRewardParams",
+			Handler:    _Query_GetRewardParams_Handler,
+		},
+		{
+			MethodName: "GetPmtpParams",
+			Handler:    _Query_GetPmtpParams_Handler,
+		},
+		{
+			MethodName: "GetLiquidityProtectionParams",
+			Handler:    _Query_GetLiquidityProtectionParams_Handler,
+		},
+		{
+			MethodName: "GetProviderDistributionParams",
+			Handler:    _Query_GetProviderDistributionParams_Handler,
+		},
+		{
+			MethodName: "GetSwapFeeParams",
+			Handler:    _Query_GetSwapFeeParams_Handler,
+		},
+		{
+			MethodName: "GetPoolShareEstimate",
+			Handler:    _Query_GetPoolShareEstimate_Handler,
+		},
+		{
+			MethodName: "GetSwapEstimate",
+			Handler:    _Query_GetSwapEstimate_Handler,
+		},
+		{
+			MethodName: "GetLimitOrdersByAddress",
+			Handler:    _Query_GetLimitOrdersByAddress_Handler,
+		},
+		{
+			MethodName: "GetLimitOrdersByPool",
+			Handler:    _Query_GetLimitOrdersByPool_Handler,
+		},
+		{
+			MethodName: "GetTwap",
+			Handler:    _Query_GetTwap_Handler,
+		},
+		{
+			MethodName: "GetRemovalQueue",
+			Handler:    _Query_GetRemovalQueue_Handler,
+		},
+		{
+			MethodName: "GetRemovalRequestsByPool",
+			Handler:    _Query_GetRemovalRequestsByPool_Handler,
+		},
+		{
+			MethodName: "GetRemovalRequestsByAddress",
+			Handler:    _Query_GetRemovalRequestsByAddress_Handler,
+		},
+		{
+			MethodName: "GetRemovalQueueUnits",
+			Handler:    _Query_GetRemovalQueueUnits_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/clp/v1/querier.proto",
+}
+
+func (m *PoolReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProtectionParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProtectionParamsRes) Marshal() (dAtA []byte, err error) {
+func (m *PoolRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3026,12 +3258,12 @@ func (m *LiquidityProtectionParamsRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LiquidityProtectionParamsRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LiquidityProtectionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3041,21 +3273,16 @@ func (m *LiquidityProtectionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.RateParams != nil {
-		{
-			size, err := m.RateParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuerier(dAtA, i, uint64(size))
-		}
+	if len(m.ClpModuleAddress) > 0 {
+		i -= len(m.ClpModuleAddress)
+		copy(dAtA[i:], m.ClpModuleAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ClpModuleAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Params != nil {
+	if m.Pool != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3068,7 +3295,7 @@ func (m *LiquidityProtectionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ProviderDistributionParamsReq) Marshal() (dAtA []byte, err error) {
+func (m *PoolsReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3078,20 +3305,32 @@ func (m *ProviderDistributionParamsReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProviderDistributionParamsReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProviderDistributionParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ProviderDistributionParamsRes) Marshal() (dAtA []byte, err error) {
+func (m *PoolsRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3101,19 +3340,19 @@ func (m *ProviderDistributionParamsRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProviderDistributionParamsRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolsRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProviderDistributionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3121,12 +3360,38 @@ func (m *ProviderDistributionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClpModuleAddress) > 0 {
+		i -= len(m.ClpModuleAddress)
+		copy(dAtA[i:], m.ClpModuleAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ClpModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapFeeParamsReq) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3136,20 +3401,34 @@ func (m *SwapFeeParamsReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapFeeParamsReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapFeeParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapFeeParamsRes) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3159,44 +3438,51 @@ func (m *SwapFeeParamsRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapFeeParamsRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapFeeParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenParams) > 0 {
-		for iNdEx := len(m.TokenParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokenParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuerier(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	{
-		size := m.DefaultSwapFeeRate.Size()
-		i -= size
-		if _, err := m.DefaultSwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	if len(m.ExternalAssetBalance) > 0 {
+		i -= len(m.ExternalAssetBalance)
+		copy(dAtA[i:], m.ExternalAssetBalance)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.ExternalAssetBalance)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PoolShareEstimateReq) Marshal() (dAtA []byte, err error) {
+	if len(m.NativeAssetBalance) > 0 {
+		i -= len(m.NativeAssetBalance)
+		copy(dAtA[i:], m.NativeAssetBalance)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.NativeAssetBalance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LiquidityProvider != nil {
+		{
+			size, err := m.LiquidityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetListReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3206,39 +3492,19 @@ func (m *PoolShareEstimateReq) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolShareEstimateReq) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetListReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolShareEstimateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ExternalAssetAmount.Size()
-		i -= size
-		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NativeAssetAmount.Size()
-		i -= size
-		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.ExternalAsset != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3246,12 +3512,19 @@ func (m *PoolShareEstimateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolShareEstimateRes) Marshal() (dAtA []byte, err error) {
+func (m *AssetListRes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3261,60 +3534,51 @@ func (m *PoolShareEstimateRes) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolShareEstimateRes) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetListRes) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolShareEstimateRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.SwapInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExternalAssetAmount.Size()
-		i -= size
-		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.NativeAssetAmount.Size()
-		i -= size
-		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Percentage.Size()
-		i -= size
-		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SwapInfo) Marshal() (dAtA []byte, err error) {
+func (m *LiquidityProviderDataReq) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3324,559 +3588,3901 @@ func (m *SwapInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SwapInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataReq) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SwapInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LiquidityProviderDataReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Result.Size()
-		i -= size
-		if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FeeRate.Size()
-		i -= size
-		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Fee.Size()
-		i -= size
-		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
-		i = encodeVarintQuerier(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Status != 0 {
-		i = encodeVarintQuerier(dAtA, i, uint64(m.Status))
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuerier(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuerier(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PoolReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+func (m *LiquidityProviderDataRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.ClpModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	return n
+func (m *LiquidityProviderDataRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityProviderDataRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
-}
-
-func (m *PoolsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
-		}
-	}
-	l = len(m.ClpModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.LiquidityProviderData) > 0 {
+		for iNdEx := len(m.LiquidityProviderData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviderData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
+func (m *LiquidityProviderListReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityProviderRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LiquidityProvider != nil {
-		l = m.LiquidityProvider.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.NativeAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = len(m.ExternalAssetBalance)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	return n
+func (m *LiquidityProviderListReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetListReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *LiquidityProviderListReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetListRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProviderListRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProviderListRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProviderListRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	if len(m.LiquidityProviders) > 0 {
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProvidersReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvidersReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvidersReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProviderDataRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProvidersRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProvidersRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProvidersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityProviderData) > 0 {
-		for _, e := range m.LiquidityProviderData {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
-
-func (m *LiquidityProviderListReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
-}
-
-func (m *LiquidityProviderListRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
 	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+		for iNdEx := len(m.LiquidityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProvidersReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProvidersRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.LiquidityProviders) > 0 {
-		for _, e := range m.LiquidityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	{
+		size := m.SymmetryRatioThreshold.Size()
+		i -= size
+		if _, err := m.SymmetryRatioThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SymmetryThreshold.Size()
+		i -= size
+		if _, err := m.SymmetryThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	l = m.SymmetryThreshold.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.SymmetryRatioThreshold.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	return n
+func (m *RewardParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RewardParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RewardParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RewardParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+func (m *RewardParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PmtpParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *RewardParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PmtpParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RewardParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.PmtpRateParams != nil {
-		l = m.PmtpRateParams.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.PmtpEpoch != nil {
-		l = m.PmtpEpoch.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LiquidityProtectionParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PmtpParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LiquidityProtectionParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PmtpParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PmtpParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuerier(uint64(l))
-	}
-	if m.RateParams != nil {
-		l = m.RateParams.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PmtpParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PmtpParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PmtpParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Height != 0 {
-		n += 1 + sovQuerier(uint64(m.Height))
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.PmtpEpoch != nil {
+		{
+			size, err := m.PmtpEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PmtpRateParams != nil {
+		{
+			size, err := m.PmtpRateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderDistributionParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProtectionParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProtectionParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProtectionParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ProviderDistributionParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidityProtectionParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityProtectionParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityProtectionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RateParams != nil {
+		{
+			size, err := m.RateParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapFeeParamsReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProviderDistributionParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderDistributionParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderDistributionParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SwapFeeParamsRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProviderDistributionParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderDistributionParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderDistributionParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DefaultSwapFeeRate.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	if len(m.TokenParams) > 0 {
-		for _, e := range m.TokenParams {
-			l = e.Size()
-			n += 1 + l + sovQuerier(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolShareEstimateReq) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapFeeParamsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapFeeParamsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeParamsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ExternalAsset != nil {
-		l = m.ExternalAsset.Size()
-		n += 1 + l + sovQuerier(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapFeeParamsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.NativeAssetAmount.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.ExternalAssetAmount.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PoolShareEstimateRes) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SwapFeeParamsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapFeeParamsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenParams) > 0 {
+		for iNdEx := len(m.TokenParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.DefaultSwapFeeRate.Size()
+		i -= size
+		if _, err := m.DefaultSwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolShareEstimateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolShareEstimateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolShareEstimateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolShareEstimateRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolShareEstimateRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolShareEstimateRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SwapInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapEstimateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapEstimateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapEstimateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ReceivedAsset != nil {
+		{
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapEstimateRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapEstimateRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapEstimateRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockedByLiquidityProtection {
+		i--
+		if m.BlockedByLiquidityProtection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityFee.Size()
+		i -= size
+		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrdersByAddressReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrdersByAddressReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrdersByAddressReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrdersByPoolReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrdersByPoolReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrdersByPoolReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LimitOrdersRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrdersRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrdersRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Result.Size()
+		i -= size
+		if _, err := m.Result.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExternalPrice.Size()
+		i -= size
+		if _, err := m.ExternalPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NativePrice.Size()
+		i -= size
+		if _, err := m.NativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalQueueReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalQueueReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalQueueReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalQueueRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalQueueRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalQueueRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RemovalQueue != nil {
+		{
+			size, err := m.RemovalQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalRequestsByPoolReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalRequestsByPoolReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalRequestsByPoolReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalRequestsByAddressReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalRequestsByAddressReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalRequestsByAddressReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalRequestsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalRequestsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalRequestsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuerier(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RemovalRequests) > 0 {
+		for iNdEx := len(m.RemovalRequests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemovalRequests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuerier(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalQueueUnitsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalQueueUnitsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalQueueUnitsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuerier(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemovalQueueUnitsRes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemovalQueueUnitsRes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemovalQueueUnitsRes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuerier(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuerier(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuerier(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuerier(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.ClpModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *PoolsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	l = len(m.ClpModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidityProvider != nil {
+		l = m.LiquidityProvider.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.NativeAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.ExternalAssetBalance)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *AssetListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderDataReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderDataRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviderData) > 0 {
+		for _, e := range m.LiquidityProviderData {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderListReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProviderListRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProvidersReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LiquidityProvidersRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityProviders) > 0 {
+		for _, e := range m.LiquidityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.SymmetryThreshold.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.SymmetryRatioThreshold.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	return n
+}
+
+func (m *RewardParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RewardParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *PmtpParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PmtpParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.PmtpRateParams != nil {
+		l = m.PmtpRateParams.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.PmtpEpoch != nil {
+		l = m.PmtpEpoch.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *LiquidityProtectionParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LiquidityProtectionParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.RateParams != nil {
+		l = m.RateParams.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ProviderDistributionParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ProviderDistributionParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapFeeParamsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SwapFeeParamsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultSwapFeeRate.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if len(m.TokenParams) > 0 {
+		for _, e := range m.TokenParams {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolShareEstimateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	return n
+}
+
+func (m *PoolShareEstimateRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Percentage.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.SwapInfo.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	return n
+}
+
+func (m *SwapEstimateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	return n
+}
+
+func (m *SwapEstimateRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.LiquidityFee.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.BlockedByLiquidityProtection {
+		n += 2
+	}
+	return n
+}
+
+func (m *LimitOrdersByAddressReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrdersByPoolReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *LimitOrdersRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *SwapInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuerier(uint64(m.Status))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.Result.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	return n
+}
+
+func (m *TwapReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuerier(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuerier(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *TwapRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NativePrice.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	l = m.ExternalPrice.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovQuerier(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuerier(uint64(m.EndHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RemovalQueueReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovalQueueRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemovalQueue != nil {
+		l = m.RemovalQueue.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RemovalRequestsByPoolReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovalRequestsByAddressReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovalRequestsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemovalRequests) > 0 {
+		for _, e := range m.RemovalRequests {
+			l = e.Size()
+			n += 1 + l + sovQuerier(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovalQueueUnitsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovQuerier(uint64(l))
+	}
+	return n
+}
+
+func (m *RemovalQueueUnitsRes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Units.Size()
+	n += 1 + l + sovQuerier(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuerier(uint64(m.Height))
+	}
+	return n
+}
+
+func sovQuerier(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuerier(x uint64) (n int) {
+	return sovQuerier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pool == nil {
+				m.Pool = &Pool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidityProvider == nil {
+				m.LiquidityProvider = &LiquidityProvider{}
+			}
+			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetListRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetListRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, &Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderDataReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderDataReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderDataRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderDataRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviderData = append(m.LiquidityProviderData, &LiquidityProviderData{})
+			if err := m.LiquidityProviderData[len(m.LiquidityProviderData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderListReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProviderListRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProviderListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProvidersReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProvidersReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Percentage.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.NativeAssetAmount.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.ExternalAssetAmount.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.SwapInfo.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	return n
-}
 
-func (m *SwapInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuerier(uint64(m.Status))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Fee.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.FeeRate.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.Amount.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	l = m.Result.Size()
-	n += 1 + l + sovQuerier(uint64(l))
-	return n
-}
-
-func sovQuerier(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuerier(x uint64) (n int) {
-	return sovQuerier(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PoolReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3899,17 +7505,17 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProvidersRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProvidersRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -3919,23 +7525,80 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
+			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3958,7 +7621,7 @@ func (m *PoolReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolRes) Unmarshal(dAtA []byte) error {
+func (m *ParamsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3981,15 +7644,65 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4016,16 +7729,50 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &Pool{}
+			if m.Params == nil {
+				m.Params = &Params{}
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SymmetryThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymmetryThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymmetryRatioThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4050,30 +7797,63 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SymmetryRatioThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardParamsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardParamsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -4095,7 +7875,7 @@ func (m *PoolRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsReq) Unmarshal(dAtA []byte) error {
+func (m *RewardParamsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4118,15 +7898,15 @@ func (m *PoolsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardParamsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4153,10 +7933,10 @@ func (m *PoolsReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Params == nil {
+				m.Params = &RewardParams{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4181,7 +7961,7 @@ func (m *PoolsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRes) Unmarshal(dAtA []byte) error {
+func (m *PmtpParamsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4204,15 +7984,65 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PmtpParamsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PmtpParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PmtpParamsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PmtpParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4239,16 +8069,18 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &PmtpParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClpModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpRateParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -4258,46 +8090,31 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClpModuleAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if m.PmtpRateParams == nil {
+				m.PmtpRateParams = &PmtpRateParams{}
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.PmtpRateParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 4:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpEpoch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4324,13 +8141,32 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.PmtpEpoch == nil {
+				m.PmtpEpoch = &PmtpEpoch{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PmtpEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -4352,7 +8188,7 @@ func (m *PoolsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProtectionParamsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4367,84 +8203,20 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityProtectionParamsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityProtectionParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -4466,7 +8238,7 @@ func (m *LiquidityProviderReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
+func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4489,15 +8261,15 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidityProtectionParamsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidityProtectionParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4524,18 +8296,18 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LiquidityProvider == nil {
-				m.LiquidityProvider = &LiquidityProvider{}
+			if m.Params == nil {
+				m.Params = &LiquidityProtectionParams{}
 			}
-			if err := m.LiquidityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -4545,57 +8317,29 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeAssetBalance = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetBalance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
+			if m.RateParams == nil {
+				m.RateParams = &LiquidityProtectionRateParams{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.RateParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ExternalAssetBalance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -4635,7 +8379,7 @@ func (m *LiquidityProviderRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetListReq) Unmarshal(dAtA []byte) error {
+func (m *ProviderDistributionParamsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4658,80 +8402,12 @@ func (m *AssetListReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetListReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderDistributionParamsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetListReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderDistributionParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -4753,7 +8429,7 @@ func (m *AssetListReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetListRes) Unmarshal(dAtA []byte) error {
+func (m *ProviderDistributionParamsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4776,15 +8452,15 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetListRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderDistributionParamsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderDistributionParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4811,66 +8487,63 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, &Asset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &ProviderDistributionParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapFeeParamsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapFeeParamsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapFeeParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -4892,7 +8565,7 @@ func (m *AssetListRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
+func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4915,15 +8588,15 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderDataReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapFeeParamsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderDataReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapFeeParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSwapFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4951,11 +8624,13 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DefaultSwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4982,10 +8657,8 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenParams = append(m.TokenParams, &SwapFeeTokenParams{})
+			if err := m.TokenParams[len(m.TokenParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5010,7 +8683,7 @@ func (m *LiquidityProviderDataReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
+func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5033,15 +8706,15 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderDataRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolShareEstimateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderDataRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolShareEstimateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviderData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5068,35 +8741,18 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviderData = append(m.LiquidityProviderData, &LiquidityProviderData{})
-			if err := m.LiquidityProviderData[len(m.LiquidityProviderData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5106,81 +8762,29 @@ func (m *LiquidityProviderDataRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuerier(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuerier
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderListReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderListReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5208,41 +8812,7 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5267,7 +8837,7 @@ func (m *LiquidityProviderListReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
+func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5290,17 +8860,17 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProviderListRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolShareEstimateRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProviderListRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolShareEstimateRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5310,31 +8880,31 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5344,16 +8914,31 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5363,81 +8948,29 @@ func (m *LiquidityProviderListRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuerier(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuerier
-			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvidersReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvidersReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5464,10 +8997,7 @@ func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5492,7 +9022,7 @@ func (m *LiquidityProvidersReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
+func (m *SwapEstimateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5515,15 +9045,15 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProvidersRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapEstimateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProvidersRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapEstimateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5550,16 +9080,18 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityProviders = append(m.LiquidityProviders, &LiquidityProvider{})
-			if err := m.LiquidityProviders[len(m.LiquidityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5569,16 +9101,33 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5588,25 +9137,23 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5631,7 +9178,7 @@ func (m *LiquidityProvidersRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsReq) Unmarshal(dAtA []byte) error {
+func (m *SwapEstimateRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5654,67 +9201,51 @@ func (m *ParamsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapEstimateRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapEstimateRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuerier(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuerier
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParamsRes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuerier
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -5724,31 +9255,29 @@ func (m *ParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymmetryThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5776,13 +9305,13 @@ func (m *ParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SymmetryThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SymmetryRatioThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5810,10 +9339,30 @@ func (m *ParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SymmetryRatioThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedByLiquidityProtection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlockedByLiquidityProtection = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -5835,7 +9384,7 @@ func (m *ParamsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardParamsReq) Unmarshal(dAtA []byte) error {
+func (m *LimitOrdersByAddressReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5845,25 +9394,93 @@ func (m *RewardParamsReq) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuerier
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrdersByAddressReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrdersByAddressReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RewardParamsReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -5885,7 +9502,7 @@ func (m *RewardParamsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardParamsRes) Unmarshal(dAtA []byte) error {
+func (m *LimitOrdersByPoolReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5908,15 +9525,47 @@ func (m *RewardParamsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardParamsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrdersByPoolReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrdersByPoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5943,10 +9592,10 @@ func (m *RewardParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &RewardParams{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5971,7 +9620,7 @@ func (m *RewardParamsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PmtpParamsReq) Unmarshal(dAtA []byte) error {
+func (m *LimitOrdersRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5994,12 +9643,101 @@ func (m *PmtpParamsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PmtpParamsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: LimitOrdersRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PmtpParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LimitOrdersRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, &LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -6021,7 +9759,7 @@ func (m *PmtpParamsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
+func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6044,17 +9782,36 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PmtpParamsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PmtpParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SwapStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6064,33 +9821,31 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &PmtpParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpRateParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6100,33 +9855,31 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PmtpRateParams == nil {
-				m.PmtpRateParams = &PmtpRateParams{}
-			}
-			if err := m.PmtpRateParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6136,33 +9889,31 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PmtpEpoch == nil {
-				m.PmtpEpoch = &PmtpEpoch{}
-			}
-			if err := m.PmtpEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6172,11 +9923,26 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -6198,7 +9964,7 @@ func (m *PmtpParamsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProtectionParamsReq) Unmarshal(dAtA []byte) error {
+func (m *TwapReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6221,12 +9987,82 @@ func (m *LiquidityProtectionParamsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProtectionParamsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: TwapReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProtectionParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TwapReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -6248,7 +10084,7 @@ func (m *LiquidityProtectionParamsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
+func (m *TwapRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6271,17 +10107,17 @@ func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityProtectionParamsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: TwapRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityProtectionParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TwapRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NativePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6291,33 +10127,31 @@ func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &LiquidityProtectionParams{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6327,29 +10161,65 @@ func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RateParams == nil {
-				m.RateParams = &LiquidityProtectionRateParams{}
-			}
-			if err := m.RateParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -6389,7 +10259,7 @@ func (m *LiquidityProtectionParamsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderDistributionParamsReq) Unmarshal(dAtA []byte) error {
+func (m *RemovalQueueReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6412,12 +10282,44 @@ func (m *ProviderDistributionParamsReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderDistributionParamsReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalQueueReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderDistributionParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalQueueReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -6439,7 +10341,7 @@ func (m *ProviderDistributionParamsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderDistributionParamsRes) Unmarshal(dAtA []byte) error {
+func (m *RemovalQueueRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6462,15 +10364,15 @@ func (m *ProviderDistributionParamsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderDistributionParamsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalQueueRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderDistributionParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalQueueRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6497,63 +10399,32 @@ func (m *ProviderDistributionParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &ProviderDistributionParams{}
+			if m.RemovalQueue == nil {
+				m.RemovalQueue = &RemovalQueue{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemovalQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuerier(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SwapFeeParamsReq) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuerier
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerier
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SwapFeeParamsReq: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapFeeParamsReq: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
@@ -6575,7 +10446,7 @@ func (m *SwapFeeParamsReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
+func (m *RemovalRequestsByPoolReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6598,15 +10469,15 @@ func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapFeeParamsRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalRequestsByPoolReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapFeeParamsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalRequestsByPoolReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSwapFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6634,13 +10505,11 @@ func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6667,8 +10536,10 @@ func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenParams = append(m.TokenParams, &SwapFeeTokenParams{})
-			if err := m.TokenParams[len(m.TokenParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6693,7 +10564,7 @@ func (m *SwapFeeParamsRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
+func (m *RemovalRequestsByAddressReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6716,51 +10587,15 @@ func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolShareEstimateReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalRequestsByAddressReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolShareEstimateReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalRequestsByAddressReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6788,15 +10623,13 @@ func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6806,23 +10639,25 @@ func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6847,7 +10682,7 @@ func (m *PoolShareEstimateReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
+func (m *RemovalRequestsRes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6870,17 +10705,17 @@ func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolShareEstimateRes: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalRequestsRes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolShareEstimateRes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalRequestsRes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalRequests", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6890,31 +10725,31 @@ func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuerier
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuerier
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovalRequests = append(m.RemovalRequests, &RemovalRequest{})
+			if err := m.RemovalRequests[len(m.RemovalRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -6924,63 +10759,14 @@ func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7007,7 +10793,10 @@ func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7032,7 +10821,7 @@ func (m *PoolShareEstimateRes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapInfo) Unmarshal(dAtA []byte) error {
+func (m *RemovalQueueUnitsReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7055,34 +10844,15 @@ func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalQueueUnitsReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalQueueUnitsReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuerier
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= SwapStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7110,13 +10880,11 @@ func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7144,13 +10912,61 @@ func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerier(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuerier
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemovalQueueUnitsRes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerier
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemovalQueueUnitsRes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemovalQueueUnitsRes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7178,15 +10994,15 @@ func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuerier
@@ -7196,26 +11012,11 @@ func (m *SwapInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuerier
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuerier(dAtA[iNdEx:])
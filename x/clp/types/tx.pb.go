@@ -167,6 +167,13 @@ func (m *MsgRemoveLiquidity) GetExternalAsset() *Asset {
 }
 
 type MsgRemoveLiquidityResponse struct {
+	UnitsBurned         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=units_burned,json=unitsBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units_burned" yaml:"units_burned"`
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+	// queued is set when the removal would take the pool health below the
+	// removal queue threshold and was queued as removal_request_id instead
+	Queued           bool  `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	RemovalRequestId int64 `protobuf:"varint,5,opt,name=removal_request_id,json=removalRequestId,proto3" json:"removal_request_id,omitempty"`
 }
 
 func (m *MsgRemoveLiquidityResponse) Reset()         { *m = MsgRemoveLiquidityResponse{} }
@@ -202,6 +209,20 @@ func (m *MsgRemoveLiquidityResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveLiquidityResponse proto.InternalMessageInfo
 
+func (m *MsgRemoveLiquidityResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgRemoveLiquidityResponse) GetRemovalRequestId() int64 {
+	if m != nil {
+		return m.RemovalRequestId
+	}
+	return 0
+}
+
 type MsgRemoveLiquidityUnits struct {
 	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	ExternalAsset *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
@@ -256,6 +277,13 @@ func (m *MsgRemoveLiquidityUnits) GetExternalAsset() *Asset {
 }
 
 type MsgRemoveLiquidityUnitsResponse struct {
+	UnitsBurned         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=units_burned,json=unitsBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units_burned" yaml:"units_burned"`
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
+	// queued is set when the removal would take the pool health below the
+	// removal queue threshold and was queued as removal_request_id instead
+	Queued           bool  `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	RemovalRequestId int64 `protobuf:"varint,5,opt,name=removal_request_id,json=removalRequestId,proto3" json:"removal_request_id,omitempty"`
 }
 
 func (m *MsgRemoveLiquidityUnitsResponse) Reset()         { *m = MsgRemoveLiquidityUnitsResponse{} }
@@ -291,6 +319,20 @@ func (m *MsgRemoveLiquidityUnitsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveLiquidityUnitsResponse proto.InternalMessageInfo
 
+func (m *MsgRemoveLiquidityUnitsResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgRemoveLiquidityUnitsResponse) GetRemovalRequestId() int64 {
+	if m != nil {
+		return m.RemovalRequestId
+	}
+	return 0
+}
+
 type MsgCreatePool struct {
 	Signer              string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	ExternalAsset       *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
@@ -346,6 +388,9 @@ func (m *MsgCreatePool) GetExternalAsset() *Asset {
 }
 
 type MsgCreatePoolResponse struct {
+	UnitsMinted         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=units_minted,json=unitsMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units_minted" yaml:"units_minted"`
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
 }
 
 func (m *MsgCreatePoolResponse) Reset()         { *m = MsgCreatePoolResponse{} }
@@ -436,6 +481,9 @@ func (m *MsgAddLiquidity) GetExternalAsset() *Asset {
 }
 
 type MsgAddLiquidityResponse struct {
+	UnitsMinted         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=units_minted,json=unitsMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units_minted" yaml:"units_minted"`
+	NativeAssetAmount   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=native_asset_amount,json=nativeAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"native_asset_amount" yaml:"native_asset_amount"`
+	ExternalAssetAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=external_asset_amount,json=externalAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"external_asset_amount" yaml:"external_asset_amount"`
 }
 
 func (m *MsgAddLiquidityResponse) Reset()         { *m = MsgAddLiquidityResponse{} }
@@ -750,6 +798,10 @@ func (m *MsgSwap) GetReceivedAsset() *Asset {
 }
 
 type MsgSwapResponse struct {
+	ReceivedAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"received_amount" yaml:"received_amount"`
+	LiquidityFee   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_fee,json=liquidityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_fee" yaml:"liquidity_fee"`
+	PriceImpact    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price_impact" yaml:"price_impact"`
+	Pools          []*Pool                                 `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty" yaml:"pools"`
 }
 
 func (m *MsgSwapResponse) Reset()         { *m = MsgSwapResponse{} }
@@ -785,6 +837,318 @@ func (m *MsgSwapResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapResponse proto.InternalMessageInfo
 
+func (m *MsgSwapResponse) GetPools() []*Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// MsgSwapRoute swaps sent_amount of assets[0] through the pool of each
+// consecutive pair in assets, delivering the last asset to the signer.
+type MsgSwapRoute struct {
+	Signer             string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Assets             []*Asset                                `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets,omitempty" yaml:"assets"`
+	SentAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	MinReceivingAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=min_receiving_amount,json=minReceivingAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_receiving_amount" yaml:"min_receiving_amount"`
+}
+
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{16}
+}
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+func (m *MsgSwapRoute) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSwapRoute) GetAssets() []*Asset {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+type MsgSwapRouteResponse struct {
+	ReceivedAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=received_amount,json=receivedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"received_amount" yaml:"received_amount"`
+	LiquidityFee   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_fee,json=liquidityFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_fee" yaml:"liquidity_fee"`
+	PriceImpact    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"price_impact" yaml:"price_impact"`
+	Pools          []*Pool                                 `protobuf:"bytes,4,rep,name=pools,proto3" json:"pools,omitempty" yaml:"pools"`
+}
+
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{17}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
+
+func (m *MsgSwapRouteResponse) GetPools() []*Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type MsgPlaceLimitOrder struct {
+	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	SentAsset     *Asset                                  `protobuf:"bytes,2,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty" yaml:"sent_asset"`
+	ReceivedAsset *Asset                                  `protobuf:"bytes,3,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty" yaml:"received_asset"`
+	SentAmount    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount" yaml:"sent_amount"`
+	TargetPrice   github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,5,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price" yaml:"target_price"`
+	ExpiryHeight  int64                                   `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty" yaml:"expiry_height"`
+}
+
+func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
+func (m *MsgPlaceLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrder) ProtoMessage()    {}
+func (*MsgPlaceLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{18}
+}
+func (m *MsgPlaceLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrder.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrder) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgPlaceLimitOrder) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
+	}
+	return nil
+}
+
+func (m *MsgPlaceLimitOrder) GetReceivedAsset() *Asset {
+	if m != nil {
+		return m.ReceivedAsset
+	}
+	return nil
+}
+
+func (m *MsgPlaceLimitOrder) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+type MsgPlaceLimitOrderResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
+func (m *MsgPlaceLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceLimitOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{19}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceLimitOrderResponse proto.InternalMessageInfo
+
+func (m *MsgPlaceLimitOrderResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelLimitOrder struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *MsgCancelLimitOrder) Reset()         { *m = MsgCancelLimitOrder{} }
+func (m *MsgCancelLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrder) ProtoMessage()    {}
+func (*MsgCancelLimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{20}
+}
+func (m *MsgCancelLimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrder.Merge(m, src)
+}
+func (m *MsgCancelLimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrder proto.InternalMessageInfo
+
+func (m *MsgCancelLimitOrder) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelLimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelLimitOrderResponse struct {
+}
+
+func (m *MsgCancelLimitOrderResponse) Reset()         { *m = MsgCancelLimitOrderResponse{} }
+func (m *MsgCancelLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelLimitOrderResponse) ProtoMessage()    {}
+func (*MsgCancelLimitOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{21}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelLimitOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelLimitOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelLimitOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
+
 type MsgDecommissionPool struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
 	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
@@ -794,7 +1158,7 @@ func (m *MsgDecommissionPool) Reset()         { *m = MsgDecommissionPool{} }
 func (m *MsgDecommissionPool) String() string { return proto.CompactTextString(m) }
 func (*MsgDecommissionPool) ProtoMessage()    {}
 func (*MsgDecommissionPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{16}
+	return fileDescriptor_a3bff5b30808c4f3, []int{22}
 }
 func (m *MsgDecommissionPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1208,7 @@ func (m *MsgDecommissionPoolResponse) Reset()         { *m = MsgDecommissionPool
 func (m *MsgDecommissionPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDecommissionPoolResponse) ProtoMessage()    {}
 func (*MsgDecommissionPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{17}
+	return fileDescriptor_a3bff5b30808c4f3, []int{23}
 }
 func (m *MsgDecommissionPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1247,7 @@ func (m *MsgUnlockLiquidityRequest) Reset()         { *m = MsgUnlockLiquidityReq
 func (m *MsgUnlockLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockLiquidityRequest) ProtoMessage()    {}
 func (*MsgUnlockLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{18}
+	return fileDescriptor_a3bff5b30808c4f3, []int{24}
 }
 func (m *MsgUnlockLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1297,7 @@ func (m *MsgUnlockLiquidityResponse) Reset()         { *m = MsgUnlockLiquidityRe
 func (m *MsgUnlockLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlockLiquidityResponse) ProtoMessage()    {}
 func (*MsgUnlockLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{19}
+	return fileDescriptor_a3bff5b30808c4f3, []int{25}
 }
 func (m *MsgUnlockLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1336,7 @@ func (m *MsgUpdateRewardsParamsRequest) Reset()         { *m = MsgUpdateRewardsP
 func (m *MsgUpdateRewardsParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardsParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateRewardsParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{20}
+	return fileDescriptor_a3bff5b30808c4f3, []int{26}
 }
 func (m *MsgUpdateRewardsParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1393,7 @@ func (m *MsgUpdateRewardsParamsResponse) Reset()         { *m = MsgUpdateRewards
 func (m *MsgUpdateRewardsParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRewardsParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateRewardsParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{21}
+	return fileDescriptor_a3bff5b30808c4f3, []int{27}
 }
 func (m *MsgUpdateRewardsParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1067,7 +1431,7 @@ func (m *MsgAddRewardPeriodRequest) Reset()         { *m = MsgAddRewardPeriodReq
 func (m *MsgAddRewardPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRewardPeriodRequest) ProtoMessage()    {}
 func (*MsgAddRewardPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{22}
+	return fileDescriptor_a3bff5b30808c4f3, []int{28}
 }
 func (m *MsgAddRewardPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1481,7 @@ func (m *MsgAddRewardPeriodResponse) Reset()         { *m = MsgAddRewardPeriodRe
 func (m *MsgAddRewardPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRewardPeriodResponse) ProtoMessage()    {}
 func (*MsgAddRewardPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{23}
+	return fileDescriptor_a3bff5b30808c4f3, []int{29}
 }
 func (m *MsgAddRewardPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1156,7 +1520,7 @@ func (m *MsgSetSymmetryThreshold) Reset()         { *m = MsgSetSymmetryThreshold
 func (m *MsgSetSymmetryThreshold) String() string { return proto.CompactTextString(m) }
 func (*MsgSetSymmetryThreshold) ProtoMessage()    {}
 func (*MsgSetSymmetryThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{24}
+	return fileDescriptor_a3bff5b30808c4f3, []int{30}
 }
 func (m *MsgSetSymmetryThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1563,7 @@ func (m *MsgSetSymmetryThresholdResponse) Reset()         { *m = MsgSetSymmetryT
 func (m *MsgSetSymmetryThresholdResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetSymmetryThresholdResponse) ProtoMessage()    {}
 func (*MsgSetSymmetryThresholdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{25}
+	return fileDescriptor_a3bff5b30808c4f3, []int{31}
 }
 func (m *MsgSetSymmetryThresholdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1602,7 @@ func (m *MsgCancelUnlock) Reset()         { *m = MsgCancelUnlock{} }
 func (m *MsgCancelUnlock) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnlock) ProtoMessage()    {}
 func (*MsgCancelUnlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{26}
+	return fileDescriptor_a3bff5b30808c4f3, []int{32}
 }
 func (m *MsgCancelUnlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1652,7 @@ func (m *MsgCancelUnlockResponse) Reset()         { *m = MsgCancelUnlockResponse
 func (m *MsgCancelUnlockResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelUnlockResponse) ProtoMessage()    {}
 func (*MsgCancelUnlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{27}
+	return fileDescriptor_a3bff5b30808c4f3, []int{33}
 }
 func (m *MsgCancelUnlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,13 +1685,16 @@ type RemovalRequest struct {
 	Id    int64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"value"`
 	Msg   *MsgRemoveLiquidity                     `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// units of the liquidity provider still to remove, msg keeps the request as
+	// it was queued
+	Units github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units"`
 }
 
 func (m *RemovalRequest) Reset()         { *m = RemovalRequest{} }
 func (m *RemovalRequest) String() string { return proto.CompactTextString(m) }
 func (*RemovalRequest) ProtoMessage()    {}
 func (*RemovalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{28}
+	return fileDescriptor_a3bff5b30808c4f3, []int{34}
 }
 func (m *RemovalRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1379,7 +1746,7 @@ func (m *MsgModifyLiquidityProtectionRates) Reset()         { *m = MsgModifyLiqu
 func (m *MsgModifyLiquidityProtectionRates) String() string { return proto.CompactTextString(m) }
 func (*MsgModifyLiquidityProtectionRates) ProtoMessage()    {}
 func (*MsgModifyLiquidityProtectionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{29}
+	return fileDescriptor_a3bff5b30808c4f3, []int{35}
 }
 func (m *MsgModifyLiquidityProtectionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1426,7 +1793,7 @@ func (m *MsgModifyLiquidityProtectionRatesResponse) String() string {
 }
 func (*MsgModifyLiquidityProtectionRatesResponse) ProtoMessage() {}
 func (*MsgModifyLiquidityProtectionRatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{30}
+	return fileDescriptor_a3bff5b30808c4f3, []int{36}
 }
 func (m *MsgModifyLiquidityProtectionRatesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,13 +1828,14 @@ type MsgUpdateLiquidityProtectionParams struct {
 	MaxRowanLiquidityThresholdAsset string                                  `protobuf:"bytes,4,opt,name=max_rowan_liquidity_threshold_asset,json=maxRowanLiquidityThresholdAsset,proto3" json:"max_rowan_liquidity_threshold_asset,omitempty"`
 	EpochLength                     uint64                                  `protobuf:"varint,3,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
 	IsActive                        bool                                    `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	TwapWindow                      uint64                                  `protobuf:"varint,6,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
 }
 
 func (m *MsgUpdateLiquidityProtectionParams) Reset()         { *m = MsgUpdateLiquidityProtectionParams{} }
 func (m *MsgUpdateLiquidityProtectionParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateLiquidityProtectionParams) ProtoMessage()    {}
 func (*MsgUpdateLiquidityProtectionParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{31}
+	return fileDescriptor_a3bff5b30808c4f3, []int{37}
 }
 func (m *MsgUpdateLiquidityProtectionParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1524,6 +1892,13 @@ func (m *MsgUpdateLiquidityProtectionParams) GetIsActive() bool {
 	return false
 }
 
+func (m *MsgUpdateLiquidityProtectionParams) GetTwapWindow() uint64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
 type MsgUpdateLiquidityProtectionParamsResponse struct {
 }
 
@@ -1535,7 +1910,7 @@ func (m *MsgUpdateLiquidityProtectionParamsResponse) String() string {
 }
 func (*MsgUpdateLiquidityProtectionParamsResponse) ProtoMessage() {}
 func (*MsgUpdateLiquidityProtectionParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{32}
+	return fileDescriptor_a3bff5b30808c4f3, []int{38}
 }
 func (m *MsgUpdateLiquidityProtectionParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1575,7 +1950,7 @@ func (m *MsgAddProviderDistributionPeriodRequest) Reset() {
 func (m *MsgAddProviderDistributionPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAddProviderDistributionPeriodRequest) ProtoMessage()    {}
 func (*MsgAddProviderDistributionPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{33}
+	return fileDescriptor_a3bff5b30808c4f3, []int{39}
 }
 func (m *MsgAddProviderDistributionPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1627,7 +2002,7 @@ func (m *MsgAddProviderDistributionPeriodResponse) Reset() {
 func (m *MsgAddProviderDistributionPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddProviderDistributionPeriodResponse) ProtoMessage()    {}
 func (*MsgAddProviderDistributionPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{34}
+	return fileDescriptor_a3bff5b30808c4f3, []int{40}
 }
 func (m *MsgAddProviderDistributionPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1666,7 +2041,7 @@ func (m *MsgUpdateSwapFeeParamsRequest) Reset()         { *m = MsgUpdateSwapFeeP
 func (m *MsgUpdateSwapFeeParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSwapFeeParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateSwapFeeParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{35}
+	return fileDescriptor_a3bff5b30808c4f3, []int{41}
 }
 func (m *MsgUpdateSwapFeeParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,7 +2091,7 @@ func (m *MsgUpdateSwapFeeParamsResponse) Reset()         { *m = MsgUpdateSwapFee
 func (m *MsgUpdateSwapFeeParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSwapFeeParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateSwapFeeParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a3bff5b30808c4f3, []int{36}
+	return fileDescriptor_a3bff5b30808c4f3, []int{42}
 }
 func (m *MsgUpdateSwapFeeParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1745,6 +2120,293 @@ func (m *MsgUpdateSwapFeeParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateSwapFeeParamsResponse proto.InternalMessageInfo
 
+// MsgClaimRewards pays the signer the rowan rewards accrued by its units in a pool
+type MsgClaimRewards struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+}
+
+func (m *MsgClaimRewards) Reset()         { *m = MsgClaimRewards{} }
+func (m *MsgClaimRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewards) ProtoMessage()    {}
+func (*MsgClaimRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{43}
+}
+func (m *MsgClaimRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewards.Merge(m, src)
+}
+func (m *MsgClaimRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewards proto.InternalMessageInfo
+
+func (m *MsgClaimRewards) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgClaimRewards) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
+}
+
+type MsgClaimRewardsResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgClaimRewardsResponse) Reset()         { *m = MsgClaimRewardsResponse{} }
+func (m *MsgClaimRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{44}
+}
+func (m *MsgClaimRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardsResponse proto.InternalMessageInfo
+
+// MsgTransferLiquidity moves units of the signer's liquidity in a pool, and the
+// unlock requests they back, to the receiver
+type MsgTransferLiquidity struct {
+	Signer        string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset                                  `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	Receiver      string                                  `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
+	Units         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=units,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"units" yaml:"units"`
+}
+
+func (m *MsgTransferLiquidity) Reset()         { *m = MsgTransferLiquidity{} }
+func (m *MsgTransferLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLiquidity) ProtoMessage()    {}
+func (*MsgTransferLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{45}
+}
+func (m *MsgTransferLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLiquidity.Merge(m, src)
+}
+func (m *MsgTransferLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLiquidity proto.InternalMessageInfo
+
+func (m *MsgTransferLiquidity) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgTransferLiquidity) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
+}
+
+func (m *MsgTransferLiquidity) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+type MsgTransferLiquidityResponse struct {
+}
+
+func (m *MsgTransferLiquidityResponse) Reset()         { *m = MsgTransferLiquidityResponse{} }
+func (m *MsgTransferLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLiquidityResponse) ProtoMessage()    {}
+func (*MsgTransferLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{46}
+}
+func (m *MsgTransferLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLiquidityResponse.Merge(m, src)
+}
+func (m *MsgTransferLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferLiquidityResponse proto.InternalMessageInfo
+
+// MsgCancelRemovalRequest withdraws a removal request of the signer that is
+// still waiting in the removal queue of a pool
+type MsgCancelRemovalRequest struct {
+	Signer        string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ExternalAsset *Asset `protobuf:"bytes,2,opt,name=external_asset,json=externalAsset,proto3" json:"external_asset,omitempty" yaml:"external_asset"`
+	Id            int64  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+}
+
+func (m *MsgCancelRemovalRequest) Reset()         { *m = MsgCancelRemovalRequest{} }
+func (m *MsgCancelRemovalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRemovalRequest) ProtoMessage()    {}
+func (*MsgCancelRemovalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{47}
+}
+func (m *MsgCancelRemovalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRemovalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRemovalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRemovalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRemovalRequest.Merge(m, src)
+}
+func (m *MsgCancelRemovalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRemovalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRemovalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRemovalRequest proto.InternalMessageInfo
+
+func (m *MsgCancelRemovalRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelRemovalRequest) GetExternalAsset() *Asset {
+	if m != nil {
+		return m.ExternalAsset
+	}
+	return nil
+}
+
+func (m *MsgCancelRemovalRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelRemovalRequestResponse struct {
+}
+
+func (m *MsgCancelRemovalRequestResponse) Reset()         { *m = MsgCancelRemovalRequestResponse{} }
+func (m *MsgCancelRemovalRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRemovalRequestResponse) ProtoMessage()    {}
+func (*MsgCancelRemovalRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3bff5b30808c4f3, []int{48}
+}
+func (m *MsgCancelRemovalRequestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRemovalRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRemovalRequestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRemovalRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRemovalRequestResponse.Merge(m, src)
+}
+func (m *MsgCancelRemovalRequestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRemovalRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRemovalRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRemovalRequestResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateStakingRewardParams)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParams")
 	proto.RegisterType((*MsgUpdateStakingRewardParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateStakingRewardParamsResponse")
@@ -1762,6 +2424,12 @@ func init() {
 	proto.RegisterType((*MsgUpdatePmtpParamsResponse)(nil), "sifnode.clp.v1.MsgUpdatePmtpParamsResponse")
 	proto.RegisterType((*MsgSwap)(nil), "sifnode.clp.v1.MsgSwap")
 	proto.RegisterType((*MsgSwapResponse)(nil), "sifnode.clp.v1.MsgSwapResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "sifnode.clp.v1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "sifnode.clp.v1.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "sifnode.clp.v1.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "sifnode.clp.v1.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "sifnode.clp.v1.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "sifnode.clp.v1.MsgCancelLimitOrderResponse")
 	proto.RegisterType((*MsgDecommissionPool)(nil), "sifnode.clp.v1.MsgDecommissionPool")
 	proto.RegisterType((*MsgDecommissionPoolResponse)(nil), "sifnode.clp.v1.MsgDecommissionPoolResponse")
 	proto.RegisterType((*MsgUnlockLiquidityRequest)(nil), "sifnode.clp.v1.MsgUnlockLiquidityRequest")
@@ -1783,127 +2451,172 @@ func init() {
 	proto.RegisterType((*MsgAddProviderDistributionPeriodResponse)(nil), "sifnode.clp.v1.MsgAddProviderDistributionPeriodResponse")
 	proto.RegisterType((*MsgUpdateSwapFeeParamsRequest)(nil), "sifnode.clp.v1.MsgUpdateSwapFeeParamsRequest")
 	proto.RegisterType((*MsgUpdateSwapFeeParamsResponse)(nil), "sifnode.clp.v1.MsgUpdateSwapFeeParamsResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "sifnode.clp.v1.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "sifnode.clp.v1.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgTransferLiquidity)(nil), "sifnode.clp.v1.MsgTransferLiquidity")
+	proto.RegisterType((*MsgTransferLiquidityResponse)(nil), "sifnode.clp.v1.MsgTransferLiquidityResponse")
+	proto.RegisterType((*MsgCancelRemovalRequest)(nil), "sifnode.clp.v1.MsgCancelRemovalRequest")
+	proto.RegisterType((*MsgCancelRemovalRequestResponse)(nil), "sifnode.clp.v1.MsgCancelRemovalRequestResponse")
 }
 
 func init() { proto.RegisterFile("sifnode/clp/v1/tx.proto", fileDescriptor_a3bff5b30808c4f3) }
 
 var fileDescriptor_a3bff5b30808c4f3 = []byte{
-	// 1830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xe4, 0x48,
-	0x15, 0x1f, 0xa7, 0x27, 0xc3, 0xe4, 0xe5, 0x63, 0x76, 0x9c, 0x84, 0x64, 0x9c, 0xa4, 0x7b, 0xe2,
-	0x81, 0x9d, 0x8f, 0xdd, 0x4d, 0x33, 0xc3, 0xa2, 0x5d, 0x56, 0x42, 0x22, 0x99, 0x09, 0x0b, 0x22,
-	0x0d, 0x2d, 0x67, 0x47, 0x8b, 0x90, 0x90, 0x71, 0xec, 0x4a, 0xa7, 0x94, 0xb6, 0xcb, 0xeb, 0xaa,
-	0xce, 0x07, 0x12, 0x02, 0x89, 0x23, 0xd2, 0x0a, 0x38, 0x21, 0x24, 0x24, 0xc4, 0xbf, 0xc0, 0xdf,
-	0x80, 0xb4, 0xcb, 0x69, 0x91, 0x38, 0x20, 0x0e, 0x11, 0x9a, 0x91, 0x90, 0x38, 0x70, 0x19, 0xf1,
-	0x07, 0xa0, 0xfa, 0xe8, 0x6a, 0xb7, 0xbb, 0xdc, 0x89, 0x23, 0x0e, 0x39, 0xec, 0x29, 0x71, 0xd5,
-	0x7b, 0xbf, 0xf7, 0x55, 0xef, 0xd5, 0xcf, 0x6e, 0x58, 0xa2, 0x78, 0x3f, 0x21, 0x11, 0x6a, 0x86,
-	0xdd, 0xb4, 0x79, 0xf4, 0xb8, 0xc9, 0x4e, 0x36, 0xd2, 0x8c, 0x30, 0x62, 0xcf, 0xa9, 0x8d, 0x8d,
-	0xb0, 0x9b, 0x6e, 0x1c, 0x3d, 0x76, 0x16, 0x3a, 0xa4, 0x43, 0xc4, 0x56, 0x93, 0xff, 0x27, 0xa5,
-	0x1c, 0xa7, 0xa8, 0x7e, 0x9a, 0x22, 0xaa, 0xf6, 0x56, 0x0a, 0x7b, 0x69, 0x90, 0x05, 0xb1, 0xda,
-	0x74, 0xff, 0x63, 0xc1, 0x6a, 0x8b, 0x76, 0x9e, 0xa7, 0x51, 0xc0, 0xd0, 0x2e, 0x0b, 0x0e, 0x71,
-	0xd2, 0xf1, 0xd0, 0x71, 0x90, 0x45, 0x6d, 0x21, 0x66, 0x3f, 0x84, 0x1b, 0x14, 0x77, 0x12, 0x94,
-	0x2d, 0x5b, 0x77, 0xad, 0x07, 0x53, 0x5b, 0xb7, 0x5f, 0x9d, 0x35, 0x66, 0x4f, 0x83, 0xb8, 0xfb,
-	0x9e, 0x2b, 0xd7, 0x5d, 0x4f, 0x09, 0xd8, 0x6d, 0xb8, 0x11, 0xe3, 0x84, 0xa1, 0x6c, 0x79, 0x42,
-	0x88, 0xbe, 0xfb, 0xc9, 0x59, 0xe3, 0xda, 0x3f, 0xce, 0x1a, 0x5f, 0xe9, 0x60, 0x76, 0xd0, 0xdb,
-	0xdb, 0x08, 0x49, 0xdc, 0x0c, 0x09, 0x8d, 0x09, 0x55, 0x7f, 0xde, 0xa2, 0xd1, 0x61, 0xf3, 0xa4,
-	0xc9, 0x95, 0x94, 0xc7, 0x2d, 0xa1, 0xef, 0x29, 0x1c, 0x8e, 0x28, 0xbd, 0x5d, 0xae, 0x5d, 0x16,
-	0x51, 0x86, 0xe1, 0x29, 0x1c, 0xf7, 0x75, 0xf8, 0xd2, 0xb8, 0x70, 0x3d, 0x44, 0x53, 0x92, 0x50,
-	0xe4, 0xfe, 0x7b, 0x02, 0xec, 0x16, 0xed, 0x78, 0x28, 0x26, 0x47, 0x68, 0x07, 0x7f, 0xd4, 0xc3,
-	0x11, 0x66, 0xa7, 0x55, 0xb2, 0xf1, 0x21, 0xcc, 0xa1, 0x13, 0x86, 0xb2, 0x24, 0xe8, 0xfa, 0x01,
-	0xa5, 0x88, 0x89, 0xac, 0x4c, 0x3f, 0x59, 0xdc, 0x18, 0xae, 0xe8, 0xc6, 0x26, 0xdf, 0xdc, 0xba,
-	0xf3, 0xea, 0xac, 0xb1, 0x28, 0x91, 0x86, 0xd5, 0x5c, 0x6f, 0xb6, 0xbf, 0x20, 0x24, 0xed, 0x18,
-	0xe6, 0x8e, 0xfd, 0xbd, 0x80, 0x62, 0xea, 0xa7, 0x04, 0x27, 0xac, 0x9f, 0x9c, 0xf7, 0x55, 0x72,
-	0x5e, 0x1f, 0x9b, 0x1c, 0x99, 0x95, 0xef, 0x24, 0x6c, 0x60, 0x6f, 0x18, 0xcd, 0xf5, 0x66, 0x8e,
-	0xb7, 0xf8, 0x73, 0x5b, 0x3c, 0xda, 0x3f, 0x86, 0xa9, 0x80, 0x9e, 0xc6, 0x31, 0x62, 0xd9, 0xe9,
-	0xf2, 0x75, 0x61, 0x69, 0xab, 0xb2, 0xa5, 0xd7, 0xa4, 0x25, 0x0d, 0xe4, 0x7a, 0x03, 0x50, 0x77,
-	0x15, 0x9c, 0xd1, 0x54, 0xeb, 0x4a, 0x7c, 0x3c, 0x01, 0x4b, 0xa3, 0xdb, 0xcf, 0x13, 0xcc, 0xe8,
-	0x95, 0x28, 0x07, 0x81, 0xb9, 0x63, 0xcc, 0x0e, 0xa2, 0x2c, 0x38, 0xf6, 0x7b, 0xdc, 0x2b, 0x55,
-	0x8e, 0x6f, 0xab, 0x24, 0xdd, 0xbf, 0x40, 0x92, 0x9e, 0xe3, 0xa1, 0x7a, 0x0c, 0xc1, 0xb9, 0xde,
-	0x6c, 0x7f, 0x41, 0x04, 0xed, 0xae, 0x43, 0xa3, 0x24, 0x1f, 0x3a, 0x67, 0xbf, 0xad, 0xc1, 0x6c,
-	0x8b, 0x76, 0x9e, 0x66, 0x28, 0x60, 0xa8, 0x4d, 0x48, 0xf7, 0x4a, 0x64, 0xea, 0xa7, 0x30, 0x9f,
-	0x04, 0x0c, 0x1f, 0x21, 0xb9, 0xef, 0x07, 0x31, 0xe9, 0x25, 0x4c, 0xa5, 0xab, 0x55, 0x3d, 0x5d,
-	0x8e, 0xb4, 0x6a, 0xc0, 0x74, 0xbd, 0xdb, 0x72, 0x55, 0x18, 0xde, 0x14, 0x6b, 0xf6, 0x2f, 0x2c,
-	0x58, 0x1c, 0xf6, 0xb0, 0xef, 0x81, 0x3c, 0xd5, 0xdf, 0xaf, 0xee, 0xc1, 0xaa, 0x29, 0x6e, 0xed,
-	0xc3, 0xfc, 0x50, 0xf8, 0xd2, 0x0b, 0x77, 0x09, 0x16, 0x87, 0x2a, 0xa3, 0x6b, 0xf6, 0xbb, 0x1a,
-	0xdc, 0x6a, 0xd1, 0xce, 0x66, 0x14, 0x5d, 0xad, 0x71, 0xf3, 0x79, 0xd5, 0x12, 0xe6, 0xde, 0x11,
-	0x33, 0x28, 0x5f, 0x1b, 0x5d, 0xb7, 0x3f, 0x58, 0xe2, 0xa6, 0x68, 0x91, 0x08, 0xef, 0x9f, 0xb6,
-	0x63, 0x96, 0x7a, 0x01, 0x43, 0x95, 0x46, 0xd3, 0x1a, 0xc0, 0x5e, 0x97, 0x84, 0x87, 0x7e, 0x16,
-	0x30, 0x24, 0xef, 0x4e, 0x6f, 0x4a, 0xac, 0x70, 0x28, 0x7b, 0x1d, 0x66, 0xb2, 0x5e, 0x92, 0xe0,
-	0xa4, 0x23, 0x05, 0x44, 0xe6, 0xbd, 0x69, 0xb5, 0x26, 0x44, 0xd6, 0x00, 0x50, 0x12, 0xf9, 0x29,
-	0xe9, 0xe2, 0x50, 0x0e, 0xe9, 0x9b, 0xde, 0x14, 0x4a, 0xa2, 0xb6, 0x58, 0x50, 0x03, 0xb6, 0xe0,
-	0xa1, 0x0e, 0xe0, 0x8f, 0x13, 0x30, 0xaf, 0xef, 0x44, 0xbe, 0x5d, 0xfd, 0xe6, 0xff, 0x06, 0xac,
-	0xa4, 0x31, 0x4b, 0xfd, 0x14, 0x65, 0x98, 0x44, 0x7e, 0x87, 0x1c, 0xf1, 0x0c, 0x26, 0x21, 0xca,
-	0x87, 0xb4, 0xcc, 0x45, 0xda, 0x42, 0xe2, 0x7d, 0x2d, 0x20, 0xdc, 0x7f, 0x07, 0x96, 0xf3, 0xea,
-	0x28, 0x25, 0xe1, 0x81, 0xdf, 0x45, 0x49, 0x87, 0x1d, 0x88, 0x68, 0x6b, 0xde, 0xe2, 0x40, 0x77,
-	0x9b, 0xef, 0xee, 0x88, 0x4d, 0xfb, 0x6b, 0xb0, 0x94, 0x57, 0xa4, 0x2c, 0xc8, 0x98, 0x2f, 0x32,
-	0x27, 0x92, 0x50, 0xf3, 0x16, 0x06, 0x7a, 0xbb, 0x7c, 0x73, 0x8b, 0xef, 0xd9, 0x8f, 0x61, 0x71,
-	0xc8, 0x5e, 0x12, 0x29, 0xa5, 0x49, 0xa1, 0x64, 0xe7, 0x8c, 0x25, 0x91, 0x50, 0x71, 0xd7, 0x60,
-	0xc5, 0x90, 0x23, 0x9d, 0xc3, 0x3f, 0xd7, 0xe0, 0x0b, 0x2d, 0xda, 0xd9, 0x3d, 0x0e, 0xd2, 0x2a,
-	0x79, 0xfb, 0x2e, 0x00, 0x45, 0x09, 0xbb, 0x48, 0xc3, 0x2e, 0xbe, 0x3a, 0x6b, 0xdc, 0x56, 0x28,
-	0x5a, 0xc5, 0xf5, 0xa6, 0xf8, 0x83, 0x6c, 0xd4, 0x0f, 0x61, 0x2e, 0x43, 0x21, 0xc2, 0x47, 0x28,
-	0x52, 0x80, 0xb5, 0x0b, 0x4e, 0x80, 0x61, 0x35, 0xd7, 0x9b, 0xed, 0x2f, 0x48, 0xe0, 0x7d, 0x98,
-	0x96, 0x26, 0xf3, 0x7d, 0xb7, 0x5d, 0xbd, 0xef, 0xec, 0xbc, 0xfb, 0xaa, 0xdb, 0x44, 0xfc, 0xaa,
-	0xd5, 0x7f, 0x6e, 0xc1, 0x42, 0x8c, 0x13, 0x5f, 0x5a, 0xe7, 0xe7, 0x5d, 0x59, 0x9c, 0x14, 0x16,
-	0xbf, 0x57, 0xdd, 0xe2, 0x8a, 0xb4, 0x68, 0x02, 0x75, 0x3d, 0x3b, 0xc6, 0x89, 0xd7, 0x5f, 0x55,
-	0x7d, 0x7e, 0x5b, 0xcc, 0x60, 0x5e, 0x46, 0x5d, 0xda, 0x43, 0xd1, 0x1d, 0xcf, 0x50, 0x48, 0xe2,
-	0x18, 0x53, 0x8a, 0x49, 0x52, 0xf5, 0x42, 0xe5, 0xa2, 0xa7, 0xf1, 0x1e, 0xe9, 0x2a, 0x5e, 0x9c,
-	0x17, 0x15, 0xeb, 0x5c, 0x54, 0xfe, 0x23, 0x8f, 0x59, 0xd1, 0x98, 0xf6, 0xe5, 0x5f, 0x16, 0xdc,
-	0xe1, 0xc7, 0x30, 0xe1, 0x67, 0x32, 0x37, 0x8a, 0x3e, 0xea, 0x21, 0xca, 0xae, 0xc4, 0x6d, 0xb1,
-	0x0d, 0x93, 0x79, 0x12, 0xd4, 0xac, 0x58, 0x33, 0x4f, 0x6a, 0xab, 0x89, 0x35, 0x12, 0xa7, 0x4a,
-	0xc3, 0xdf, 0x2c, 0x58, 0xd3, 0xdd, 0x28, 0xe9, 0x3b, 0xed, 0x37, 0x64, 0xe5, 0x54, 0x6c, 0xc2,
-	0x5a, 0xb7, 0x6f, 0xc1, 0xcf, 0x38, 0xab, 0x0a, 0xba, 0xbe, 0x18, 0xc7, 0x72, 0x3c, 0x88, 0xcc,
-	0x5c, 0xf7, 0x9c, 0xee, 0xc0, 0x0d, 0x21, 0xb3, 0x43, 0xc2, 0x43, 0x39, 0x24, 0xec, 0x6d, 0x68,
-	0x8c, 0x42, 0x84, 0x7c, 0xbc, 0x75, 0xfb, 0x20, 0x35, 0x01, 0xb2, 0x5a, 0x04, 0x79, 0x2a, 0x84,
-	0x24, 0x8c, 0x7b, 0x17, 0xea, 0x65, 0x51, 0xa9, 0xc0, 0x7f, 0x29, 0xeb, 0xbf, 0x19, 0x45, 0xea,
-	0xa5, 0x45, 0x28, 0x5e, 0x22, 0xe8, 0xa7, 0x7c, 0x56, 0x70, 0x04, 0xe5, 0x1f, 0x5d, 0x9e, 0xb8,
-	0x5b, 0x7b, 0x30, 0xfd, 0x64, 0xb5, 0x58, 0xff, 0x21, 0x3b, 0xb3, 0x59, 0xee, 0xa9, 0x5f, 0xa4,
-	0x11, 0x67, 0xfa, 0x23, 0xd1, 0x12, 0x77, 0xe6, 0x2e, 0x62, 0xbb, 0x8a, 0xe8, 0x7f, 0x70, 0x90,
-	0x21, 0x7a, 0x40, 0xba, 0x91, 0xfd, 0xc5, 0x61, 0x4f, 0xb5, 0x5b, 0x3b, 0x30, 0xc5, 0xfa, 0x42,
-	0xaa, 0x59, 0x36, 0x2a, 0xbc, 0x6b, 0x3c, 0x43, 0xa1, 0x37, 0x00, 0xb0, 0x9f, 0xc1, 0x64, 0x16,
-	0x30, 0x4c, 0xd4, 0x59, 0xac, 0x8a, 0x24, 0x95, 0x15, 0xdd, 0x36, 0x85, 0xa1, 0x43, 0xfd, 0xd4,
-	0x12, 0x63, 0x43, 0x16, 0x53, 0x1e, 0xda, 0xd2, 0x10, 0xaf, 0x7a, 0xe7, 0x49, 0xa6, 0x93, 0x0f,
-	0x45, 0x87, 0xf9, 0x7b, 0x0b, 0xe6, 0xd4, 0xb9, 0xed, 0x1f, 0xb9, 0x39, 0x98, 0xc0, 0x91, 0x88,
-	0xb0, 0xe6, 0x4d, 0x60, 0xde, 0x09, 0x93, 0x47, 0x41, 0xb7, 0xa7, 0xae, 0xfc, 0x4b, 0x38, 0x21,
-	0xb4, 0xed, 0xb7, 0xa1, 0x16, 0xd3, 0x8e, 0xba, 0xbf, 0xdc, 0x62, 0x66, 0x0c, 0x2f, 0x8b, 0x5c,
-	0xdc, 0xfd, 0x8b, 0x05, 0xeb, 0x9a, 0xe7, 0xe8, 0xbd, 0x76, 0x46, 0x18, 0x0a, 0x19, 0x26, 0x49,
-	0x65, 0x62, 0xf6, 0x13, 0x58, 0x0f, 0x7b, 0x59, 0xc6, 0xef, 0xab, 0x8c, 0x1c, 0x07, 0x89, 0x3f,
-	0xe8, 0xf2, 0xe2, 0x31, 0xad, 0x1c, 0x69, 0x5d, 0x21, 0x7b, 0x1c, 0x58, 0x3b, 0xab, 0xcf, 0x96,
-	0xfb, 0x06, 0x3c, 0x3c, 0x37, 0x16, 0x5d, 0x99, 0xbf, 0x4e, 0x80, 0xab, 0x47, 0x87, 0x41, 0xba,
-	0x3a, 0xa3, 0xcb, 0x60, 0x2d, 0x0e, 0x4e, 0xfe, 0xff, 0x61, 0x3b, 0x71, 0x70, 0x52, 0x12, 0xb2,
-	0xbd, 0x03, 0xf7, 0xc6, 0xda, 0x54, 0xfd, 0x22, 0xf8, 0x87, 0xd7, 0x28, 0x07, 0x92, 0xfd, 0xb0,
-	0x0e, 0x33, 0x23, 0x44, 0xf2, 0xba, 0x37, 0x8d, 0x72, 0xf4, 0x71, 0x05, 0xa6, 0x30, 0xf5, 0x83,
-	0x90, 0xbf, 0x73, 0x08, 0x92, 0x71, 0xd3, 0xbb, 0x89, 0xe9, 0xa6, 0x78, 0x76, 0xdf, 0x84, 0x47,
-	0xe7, 0xa7, 0x54, 0x57, 0xe0, 0x4f, 0x16, 0xdc, 0x97, 0xc3, 0xb0, 0x9d, 0x91, 0x23, 0x1c, 0xa1,
-	0xec, 0x19, 0xa6, 0x2c, 0xc3, 0x7b, 0x3d, 0x21, 0x7c, 0xd9, 0x39, 0xfd, 0x23, 0x58, 0x88, 0x72,
-	0x38, 0x85, 0x69, 0xfd, 0xa8, 0xd8, 0x19, 0x63, 0x6c, 0xcf, 0x47, 0x23, 0x6b, 0xd4, 0x7d, 0x04,
-	0x0f, 0xce, 0x77, 0x5a, 0x45, 0xf8, 0xdf, 0xfc, 0xa5, 0xcb, 0x19, 0xd2, 0xb7, 0x10, 0xba, 0xf4,
-	0xa5, 0x1b, 0xc0, 0x62, 0x84, 0xf6, 0x83, 0x5e, 0x97, 0xf9, 0xf4, 0x38, 0x48, 0xfd, 0x7d, 0x94,
-	0x7f, 0x55, 0xa8, 0x3c, 0xaa, 0x6d, 0x05, 0xa6, 0xdc, 0x12, 0x2f, 0x15, 0xdb, 0x30, 0xc3, 0xc8,
-	0x21, 0x4a, 0x7c, 0xfd, 0x05, 0xb1, 0x66, 0x1a, 0x26, 0x4a, 0xe5, 0x03, 0x2e, 0xaa, 0xc2, 0x99,
-	0x66, 0x83, 0x87, 0xa1, 0x4b, 0xb9, 0x10, 0xb5, 0x4c, 0xcc, 0x93, 0x4f, 0x6f, 0x41, 0xad, 0x45,
-	0x3b, 0x76, 0x00, 0xb7, 0x8a, 0x9f, 0x0b, 0x2f, 0x30, 0xba, 0x9c, 0x47, 0x17, 0x18, 0x6f, 0xca,
-	0x94, 0x9d, 0xc2, 0x82, 0xf1, 0x3b, 0xd8, 0xfd, 0xf3, 0x31, 0x84, 0xa0, 0xd3, 0xbc, 0xa0, 0xa0,
-	0xb6, 0xe8, 0x01, 0xe4, 0xbe, 0x22, 0xad, 0x19, 0xd4, 0x07, 0xdb, 0xce, 0x97, 0xc7, 0x6e, 0x6b,
-	0xcc, 0x1f, 0xc0, 0xcc, 0xd0, 0x57, 0x8e, 0x86, 0x41, 0x2d, 0x2f, 0xe0, 0xdc, 0x3f, 0x47, 0x40,
-	0x23, 0x7f, 0x13, 0xae, 0x8b, 0x57, 0xb0, 0x25, 0x83, 0x02, 0xdf, 0x70, 0x1a, 0x25, 0x1b, 0x1a,
-	0x21, 0x82, 0xd7, 0x46, 0xa8, 0xfe, 0x3d, 0x83, 0x52, 0x51, 0xc8, 0x79, 0xe3, 0x02, 0x42, 0xda,
-	0xca, 0x01, 0xdc, 0x2a, 0x70, 0x5b, 0xfb, 0xa1, 0x41, 0xdf, 0xcc, 0xf3, 0x8d, 0x27, 0xa6, 0x84,
-	0x2a, 0xdb, 0x0c, 0xe6, 0x0d, 0x84, 0xd2, 0x7e, 0xcb, 0x04, 0x51, 0x4a, 0xa7, 0x9d, 0x8d, 0x8b,
-	0x8a, 0x0f, 0xe2, 0x2b, 0xd0, 0x42, 0x63, 0x7c, 0x66, 0x1e, 0x6b, 0x8c, 0xaf, 0x84, 0x65, 0xf2,
-	0xa6, 0x2b, 0x7e, 0x79, 0x31, 0x35, 0x5d, 0x41, 0xc6, 0x68, 0xa2, 0xe4, 0xfb, 0x08, 0x3f, 0x12,
-	0x23, 0xdf, 0x46, 0xee, 0x95, 0x26, 0x64, 0x20, 0x64, 0x3c, 0x12, 0x65, 0x5f, 0x10, 0xec, 0x9f,
-	0xc1, 0x9d, 0xf2, 0x1f, 0x61, 0xde, 0x2c, 0x45, 0x32, 0x48, 0x3b, 0x6f, 0x57, 0x91, 0xce, 0xcf,
-	0x16, 0x23, 0x57, 0x37, 0x35, 0x9f, 0x49, 0xd0, 0x38, 0x5b, 0xc6, 0xd1, 0x66, 0x7e, 0x09, 0xe4,
-	0x79, 0xe6, 0xf8, 0x81, 0x90, 0x97, 0x34, 0x0e, 0x04, 0x13, 0x65, 0xb5, 0x7f, 0x6d, 0x41, 0xe3,
-	0x3c, 0x56, 0xf4, 0xa4, 0x34, 0x5d, 0xa5, 0x3a, 0xce, 0x7b, 0xd5, 0x75, 0xb4, 0x4f, 0x1f, 0x5b,
-	0x50, 0x3f, 0x87, 0xa3, 0x3e, 0x2e, 0x3d, 0x9e, 0x65, 0x2a, 0xce, 0xd7, 0x2b, 0xab, 0x68, 0x87,
-	0x7e, 0x63, 0xc1, 0xda, 0x58, 0x0e, 0x60, 0xbf, 0x63, 0xee, 0xc8, 0x73, 0xa9, 0x8e, 0xf3, 0x6e,
-	0x75, 0xc5, 0xe2, 0xe0, 0x1a, 0xba, 0x74, 0xc7, 0x0c, 0x2e, 0x13, 0x25, 0x19, 0x33, 0xb8, 0x8c,
-	0x77, 0xf9, 0xd6, 0xe6, 0x27, 0x2f, 0xea, 0xd6, 0x67, 0x2f, 0xea, 0xd6, 0x3f, 0x5f, 0xd4, 0xad,
-	0x5f, 0xbd, 0xac, 0x5f, 0xfb, 0xec, 0x65, 0xfd, 0xda, 0xdf, 0x5f, 0xd6, 0xaf, 0xfd, 0x30, 0xcf,
-	0x70, 0x77, 0xf1, 0x7e, 0x78, 0x10, 0xe0, 0xa4, 0xd9, 0xff, 0x65, 0xf5, 0x44, 0xfc, 0xb6, 0x2a,
-	0xf8, 0xc8, 0xde, 0x0d, 0xf1, 0xc3, 0xea, 0x57, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x02, 0xb9,
-	0xb5, 0x24, 0xd2, 0x1d, 0x00, 0x00,
+	// 2456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0xcf, 0xb8, 0x6d, 0x13, 0x7f, 0x1e, 0xdb, 0x49, 0xdb, 0x5e, 0x4f, 0x3a, 0xb6, 0x27, 0xe9,
+	0x2c, 0xeb, 0xc4, 0xc9, 0x7a, 0x48, 0x58, 0xb4, 0xcb, 0x8a, 0x15, 0xf1, 0x24, 0x4e, 0x36, 0xc2,
+	0x26, 0xa3, 0x72, 0xa2, 0x20, 0x24, 0xd4, 0xb4, 0xa7, 0xcb, 0x33, 0x25, 0x4f, 0x3f, 0xd2, 0x5d,
+	0xe3, 0x07, 0x12, 0x02, 0x89, 0x03, 0x42, 0x48, 0x2b, 0x40, 0x42, 0x5a, 0x21, 0x0e, 0xc0, 0xbf,
+	0xc0, 0x95, 0x2b, 0xd2, 0xc2, 0x69, 0x85, 0x90, 0x40, 0x1c, 0x2c, 0x94, 0xa0, 0x95, 0x38, 0x70,
+	0xb1, 0x10, 0x67, 0x54, 0x8f, 0xee, 0xe9, 0xee, 0xe9, 0xf1, 0x4c, 0x9b, 0x55, 0xd6, 0x48, 0x3e,
+	0x25, 0x55, 0xf5, 0xfb, 0x1e, 0xf5, 0x3d, 0xaa, 0xbe, 0xaf, 0x7a, 0x0c, 0x73, 0x01, 0xd9, 0x76,
+	0x5c, 0x0b, 0x57, 0xea, 0x2d, 0xaf, 0xb2, 0x7b, 0xbb, 0x42, 0xf7, 0x57, 0x3c, 0xdf, 0xa5, 0xae,
+	0x3a, 0x29, 0x17, 0x56, 0xea, 0x2d, 0x6f, 0x65, 0xf7, 0xb6, 0x36, 0xd3, 0x70, 0x1b, 0x2e, 0x5f,
+	0xaa, 0xb0, 0xff, 0x09, 0x94, 0xa6, 0xa5, 0xc9, 0x0f, 0x3c, 0x1c, 0xc8, 0xb5, 0xcb, 0xa9, 0x35,
+	0xcf, 0xf4, 0x4d, 0x5b, 0x2e, 0xea, 0xff, 0x2a, 0xc0, 0xfc, 0x46, 0xd0, 0x78, 0xea, 0x59, 0x26,
+	0xc5, 0x9b, 0xd4, 0xdc, 0x21, 0x4e, 0x03, 0xe1, 0x3d, 0xd3, 0xb7, 0x6a, 0x1c, 0xa6, 0xde, 0x80,
+	0xd1, 0x80, 0x34, 0x1c, 0xec, 0x97, 0x0a, 0x57, 0x0a, 0xd7, 0xc7, 0xaa, 0x17, 0x8f, 0x0e, 0xcb,
+	0x13, 0x07, 0xa6, 0xdd, 0x7a, 0x57, 0x17, 0xf3, 0x3a, 0x92, 0x00, 0xb5, 0x06, 0xa3, 0x36, 0x71,
+	0x28, 0xf6, 0x4b, 0x43, 0x1c, 0xfa, 0xce, 0x47, 0x87, 0xe5, 0x73, 0x7f, 0x3b, 0x2c, 0x7f, 0xa1,
+	0x41, 0x68, 0xb3, 0xbd, 0xb5, 0x52, 0x77, 0xed, 0x4a, 0xdd, 0x0d, 0x6c, 0x37, 0x90, 0xff, 0xbc,
+	0x19, 0x58, 0x3b, 0x95, 0xfd, 0x0a, 0x23, 0x92, 0x1a, 0x6f, 0x70, 0x7a, 0x24, 0xf9, 0x30, 0x8e,
+	0x42, 0xdb, 0x92, 0x72, 0x52, 0x8e, 0x62, 0x1b, 0x48, 0xf2, 0xd1, 0xdf, 0x80, 0xd7, 0x8f, 0xdb,
+	0x2e, 0xc2, 0x81, 0xe7, 0x3a, 0x01, 0xd6, 0xff, 0x39, 0x04, 0xea, 0x46, 0xd0, 0x40, 0xd8, 0x76,
+	0x77, 0xf1, 0x3a, 0x79, 0xde, 0x26, 0x16, 0xa1, 0x07, 0x79, 0xac, 0xf1, 0x0c, 0x26, 0xf1, 0x3e,
+	0xc5, 0xbe, 0x63, 0xb6, 0x0c, 0x33, 0x08, 0x30, 0xe5, 0x56, 0x19, 0xbf, 0x33, 0xbb, 0x92, 0xf4,
+	0xe8, 0xca, 0x2a, 0x5b, 0xac, 0x5e, 0x3a, 0x3a, 0x2c, 0xcf, 0x0a, 0x4e, 0x49, 0x32, 0x1d, 0x4d,
+	0x84, 0x13, 0x1c, 0xa9, 0xda, 0x30, 0xb9, 0x67, 0x6c, 0x99, 0x01, 0x09, 0x0c, 0xcf, 0x25, 0x0e,
+	0x0d, 0x8d, 0xf3, 0x50, 0x1a, 0xe7, 0x8d, 0x63, 0x8d, 0x23, 0xac, 0xf2, 0xc8, 0xa1, 0x1d, 0x79,
+	0x49, 0x6e, 0x3a, 0x2a, 0xee, 0x55, 0xd9, 0xb8, 0xc6, 0x87, 0xea, 0xb7, 0x61, 0xcc, 0x0c, 0x0e,
+	0x6c, 0x1b, 0x53, 0xff, 0xa0, 0x34, 0xcc, 0x25, 0x55, 0x73, 0x4b, 0xba, 0x20, 0x24, 0x45, 0x8c,
+	0x74, 0xd4, 0x61, 0xaa, 0xff, 0x49, 0x01, 0xad, 0xdb, 0xd6, 0xa1, 0x2b, 0x54, 0x02, 0xc5, 0xb6,
+	0x43, 0x68, 0x60, 0x6c, 0xb5, 0x7d, 0x07, 0x5b, 0xd2, 0xf2, 0x0f, 0xa4, 0x0e, 0x4b, 0x03, 0xe8,
+	0xf0, 0x94, 0x70, 0x25, 0xa6, 0x85, 0x12, 0x71, 0x66, 0x3a, 0x1a, 0xe7, 0xc3, 0x2a, 0x1f, 0xa9,
+	0xdf, 0x85, 0x69, 0xc7, 0xa4, 0x64, 0x17, 0x0b, 0xd3, 0x1b, 0xa6, 0xed, 0xb6, 0x1d, 0x2a, 0xc3,
+	0x79, 0x23, 0xbf, 0x44, 0x4d, 0x48, 0xcc, 0xe0, 0xa9, 0xa3, 0x8b, 0x62, 0x96, 0xfb, 0x74, 0x95,
+	0xcf, 0xa9, 0x3f, 0x28, 0xc0, 0x6c, 0xd2, 0xf9, 0xa1, 0x06, 0xc2, 0xc3, 0x8f, 0xf3, 0x6b, 0x30,
+	0x9f, 0x15, 0x52, 0x91, 0x0e, 0xd3, 0x89, 0xc8, 0x92, 0x5a, 0xbc, 0x06, 0xa3, 0xcf, 0xdb, 0xb8,
+	0x8d, 0x2d, 0xee, 0xed, 0xf3, 0x48, 0x8e, 0xd4, 0x5b, 0xa0, 0xfa, 0xcc, 0x45, 0x66, 0xcb, 0xf0,
+	0xf1, 0xf3, 0x36, 0x0e, 0xa8, 0x41, 0xac, 0xd2, 0xc8, 0x95, 0xc2, 0x75, 0x05, 0x5d, 0x90, 0x2b,
+	0x48, 0x2c, 0x3c, 0xb2, 0xf4, 0x0f, 0x86, 0x60, 0xae, 0xdb, 0xa9, 0x4f, 0x99, 0xb1, 0x4f, 0x45,
+	0x16, 0xb9, 0x30, 0xb9, 0x47, 0x68, 0xd3, 0xf2, 0xcd, 0x3d, 0x83, 0x87, 0x80, 0xb4, 0xf1, 0xfb,
+	0xf9, 0x6d, 0x1c, 0xa6, 0x51, 0x82, 0x9d, 0x8e, 0x26, 0xc2, 0x09, 0xbe, 0x69, 0xfd, 0x2f, 0x0a,
+	0x94, 0x7b, 0x18, 0xe4, 0x2c, 0xd4, 0xff, 0xaf, 0x43, 0xfd, 0x43, 0x05, 0x26, 0x36, 0x82, 0xc6,
+	0x3d, 0x1f, 0x9b, 0x14, 0xd7, 0x5c, 0xb7, 0x75, 0x2a, 0x02, 0xbc, 0x87, 0x83, 0x95, 0xcf, 0xdc,
+	0xc1, 0xc3, 0xaf, 0xce, 0xc1, 0xfa, 0x0f, 0x15, 0x98, 0x4d, 0xb8, 0xa6, 0x3b, 0xd5, 0x78, 0xa9,
+	0xf1, 0x69, 0xa5, 0x9a, 0x60, 0x16, 0xa6, 0x1a, 0xaf, 0x66, 0xce, 0x52, 0x8d, 0xcf, 0xfe, 0x42,
+	0x81, 0xa9, 0x8d, 0xa0, 0xb1, 0x6a, 0x59, 0xa7, 0xab, 0x9a, 0x3a, 0x4b, 0x13, 0x87, 0xea, 0x3f,
+	0x52, 0x60, 0x2e, 0xe5, 0x9c, 0xb3, 0x44, 0xf9, 0x8c, 0x12, 0xe5, 0x57, 0x05, 0xde, 0x79, 0x6c,
+	0xb8, 0x16, 0xd9, 0x3e, 0xa8, 0xd9, 0xd4, 0x43, 0x26, 0xc5, 0xb9, 0x6a, 0xa6, 0x05, 0x80, 0xad,
+	0x96, 0x5b, 0xdf, 0x31, 0x7c, 0x93, 0x62, 0x61, 0x3d, 0x34, 0xc6, 0x67, 0x18, 0x2b, 0xf5, 0x2a,
+	0x14, 0xfd, 0xb6, 0xe3, 0x10, 0xa7, 0x21, 0x00, 0x7c, 0x73, 0x68, 0x5c, 0xce, 0x71, 0xc8, 0x02,
+	0x00, 0x76, 0x2c, 0xc3, 0x73, 0x5b, 0xa4, 0x7e, 0x20, 0xef, 0xc6, 0x31, 0xec, 0x58, 0x35, 0x3e,
+	0xa1, 0xcf, 0x83, 0xd6, 0xad, 0x61, 0xd4, 0x3a, 0xfd, 0x66, 0x08, 0xa6, 0xa3, 0x1e, 0x8b, 0x2d,
+	0xe7, 0xef, 0x24, 0xdf, 0x83, 0xcb, 0x9e, 0x4d, 0x3d, 0xc3, 0xc3, 0x3e, 0x71, 0x2d, 0xa3, 0xe1,
+	0xee, 0x32, 0x33, 0x39, 0x75, 0x1c, 0xdf, 0x52, 0x89, 0x41, 0x6a, 0x1c, 0xf1, 0x30, 0x02, 0x70,
+	0xf5, 0xdf, 0x86, 0x52, 0x9c, 0x1c, 0x7b, 0x6e, 0xbd, 0x69, 0xb4, 0xb0, 0xd3, 0xa0, 0x4d, 0xbe,
+	0x5b, 0x05, 0xcd, 0x76, 0x68, 0xd7, 0xd8, 0xea, 0x3a, 0x5f, 0x54, 0xbf, 0x04, 0x73, 0x71, 0xc2,
+	0x80, 0x9a, 0x3e, 0x35, 0xb8, 0xe5, 0xb8, 0x11, 0x14, 0x34, 0xd3, 0xa1, 0xdb, 0x64, 0x8b, 0x55,
+	0xb6, 0xa6, 0xde, 0x86, 0xd9, 0x84, 0x3c, 0xc7, 0x92, 0x44, 0xa2, 0x62, 0x50, 0x63, 0xc2, 0x1c,
+	0x8b, 0x93, 0xe8, 0x0b, 0x70, 0x39, 0xc3, 0x46, 0x91, 0x0d, 0x7f, 0xaf, 0xc0, 0xe7, 0x36, 0x82,
+	0xc6, 0xe6, 0x9e, 0xe9, 0xe5, 0xb1, 0xdb, 0xd7, 0x00, 0x02, 0xec, 0xd0, 0x41, 0x4e, 0xc8, 0xd9,
+	0xa3, 0xc3, 0xf2, 0x45, 0xc9, 0x25, 0x22, 0xd1, 0xd1, 0x18, 0x1b, 0x88, 0x93, 0xf1, 0x19, 0x4c,
+	0xfa, 0xb8, 0x8e, 0xc9, 0x2e, 0xb6, 0x24, 0x43, 0x65, 0xc0, 0x23, 0x37, 0x49, 0xa6, 0xa3, 0x89,
+	0x70, 0x42, 0x30, 0xde, 0x86, 0x71, 0x21, 0x32, 0x7e, 0xd0, 0xad, 0xe5, 0x4f, 0x2e, 0x35, 0xae,
+	0xbe, 0x4c, 0x29, 0xbe, 0x7f, 0x99, 0xcf, 0xdf, 0x2f, 0xc0, 0x8c, 0x4d, 0x1c, 0x43, 0x48, 0x67,
+	0xf1, 0x2e, 0x25, 0x8e, 0x70, 0x89, 0x5f, 0xcf, 0x2f, 0xf1, 0xb2, 0x90, 0x98, 0xc5, 0x54, 0x47,
+	0xaa, 0x4d, 0x1c, 0x14, 0xce, 0xca, 0x64, 0xfe, 0xa5, 0xb8, 0xf5, 0x98, 0x1f, 0xa3, 0x03, 0xd5,
+	0x87, 0xa9, 0x8e, 0x81, 0x84, 0x42, 0xc2, 0xb1, 0x8f, 0xf2, 0x2b, 0xf4, 0x5a, 0xda, 0xe0, 0x52,
+	0x97, 0xc8, 0x73, 0xd2, 0x14, 0x2d, 0x98, 0x68, 0x85, 0x27, 0xbb, 0xb1, 0x8d, 0x65, 0x0a, 0x55,
+	0x1f, 0xe6, 0x97, 0x38, 0x23, 0x24, 0x26, 0xb8, 0xe9, 0xa8, 0x18, 0x8d, 0x1f, 0x60, 0x7e, 0x65,
+	0x78, 0x3e, 0xa9, 0x63, 0x83, 0xd8, 0x9e, 0x59, 0x0f, 0x8f, 0xcf, 0x93, 0x5f, 0x19, 0x71, 0x66,
+	0x3a, 0x1a, 0xe7, 0xc3, 0x47, 0x7c, 0xa4, 0x7e, 0x05, 0x46, 0x3c, 0xd7, 0x6d, 0x05, 0xa5, 0xe1,
+	0x2b, 0xca, 0xf5, 0xf1, 0x3b, 0x33, 0xe9, 0xd8, 0x64, 0x35, 0x5f, 0xf5, 0xc2, 0xd1, 0x61, 0xb9,
+	0x28, 0x59, 0x31, 0xb0, 0x8e, 0x04, 0x91, 0xfe, 0xc9, 0x10, 0x14, 0x43, 0xf7, 0xb8, 0x6d, 0x8a,
+	0xf3, 0xe4, 0xda, 0x5d, 0x18, 0xe5, 0xe1, 0x1d, 0x94, 0x86, 0xae, 0x28, 0xbd, 0xd3, 0x22, 0xc6,
+	0x41, 0xc0, 0x75, 0x24, 0xe9, 0xd2, 0x79, 0xa0, 0xbc, 0xf2, 0x3c, 0x18, 0x7e, 0x65, 0x79, 0xf0,
+	0x6b, 0x05, 0x66, 0xe2, 0x86, 0x3e, 0x4b, 0x86, 0xd3, 0x97, 0x0c, 0xff, 0x51, 0x78, 0xe1, 0x51,
+	0x6b, 0x99, 0x75, 0xbc, 0x4e, 0x6c, 0x42, 0x1f, 0xfb, 0x16, 0xf6, 0xcf, 0xae, 0x9f, 0x93, 0xa6,
+	0x5d, 0x13, 0x8a, 0xd4, 0xf4, 0x1b, 0x98, 0x1a, 0xdc, 0x47, 0xa5, 0x91, 0x84, 0xa0, 0x41, 0xde,
+	0x4e, 0xef, 0xe3, 0x7a, 0xc7, 0xef, 0x71, 0x5e, 0x3a, 0x1a, 0x17, 0xc3, 0x1a, 0x1b, 0xa9, 0xef,
+	0xc1, 0x04, 0xde, 0xf7, 0x88, 0x7f, 0x60, 0x34, 0x31, 0x69, 0x34, 0x69, 0x69, 0x94, 0xd5, 0x1d,
+	0xd5, 0x52, 0x27, 0x42, 0x13, 0xcb, 0x3a, 0x2a, 0x8a, 0xf1, 0xfb, 0x62, 0x78, 0x0b, 0xb4, 0x6e,
+	0xbf, 0x47, 0x19, 0x3a, 0x09, 0x43, 0x44, 0x54, 0xfd, 0xc3, 0x68, 0x88, 0x58, 0xba, 0xc1, 0xab,
+	0xbb, 0x7b, 0xac, 0xd8, 0x6a, 0x9d, 0x2c, 0x4c, 0x16, 0x38, 0x47, 0x16, 0x1e, 0xc3, 0xd5, 0x89,
+	0xa3, 0xc3, 0xf2, 0x98, 0x80, 0x11, 0x4b, 0xe7, 0x02, 0x44, 0x69, 0x94, 0x16, 0x10, 0x95, 0x46,
+	0x3b, 0x5c, 0xfe, 0x7d, 0x5c, 0x77, 0x6d, 0x9b, 0x04, 0x01, 0x71, 0x9d, 0xbc, 0x4f, 0x2e, 0x0c,
+	0x7a, 0x60, 0x6f, 0xb9, 0xad, 0xd2, 0x50, 0x17, 0x94, 0xcf, 0x33, 0xa8, 0xf8, 0x8f, 0xd0, 0x25,
+	0x2d, 0x2c, 0xd2, 0xe5, 0x93, 0x02, 0x5c, 0x62, 0x65, 0x9c, 0xc3, 0x6a, 0xba, 0x58, 0xeb, 0xc4,
+	0x5f, 0x86, 0x4e, 0x45, 0x7b, 0xbb, 0x06, 0x23, 0xf1, 0xd7, 0xcd, 0x4a, 0xce, 0x30, 0x47, 0x82,
+	0x5a, 0x56, 0xfc, 0x5d, 0xfb, 0x94, 0x66, 0xf8, 0x73, 0x01, 0x16, 0xa2, 0x6a, 0x56, 0x7c, 0x4e,
+	0x09, 0xc2, 0x82, 0x36, 0xb7, 0x29, 0x56, 0x61, 0xa1, 0x73, 0x9e, 0x86, 0xaf, 0x70, 0xbc, 0x9d,
+	0x11, 0xe5, 0xb5, 0x08, 0x1c, 0xa4, 0xb5, 0x3a, 0x6a, 0x70, 0xcc, 0xba, 0x5b, 0xdf, 0x11, 0x45,
+	0xb6, 0xba, 0x06, 0xe5, 0x6e, 0x16, 0x75, 0x1e, 0x50, 0x21, 0x13, 0x85, 0x33, 0x99, 0x4f, 0x33,
+	0x11, 0x51, 0x27, 0xd8, 0xe8, 0x57, 0x60, 0xb1, 0xd7, 0xae, 0xe4, 0xc6, 0x7f, 0x2c, 0xfc, 0xbf,
+	0x6a, 0x59, 0x62, 0x5d, 0x10, 0x9e, 0x60, 0xd3, 0xf7, 0xd8, 0x61, 0xc7, 0x38, 0x48, 0xfd, 0xc2,
+	0xa2, 0x62, 0x3e, 0xed, 0xff, 0x84, 0x9c, 0x09, 0x3f, 0x36, 0x0a, 0x9d, 0xd4, 0xa5, 0x4c, 0xd8,
+	0x52, 0x14, 0x78, 0x8f, 0xbf, 0x89, 0xe9, 0xa6, 0xfc, 0xf0, 0xf2, 0xa4, 0xe9, 0xe3, 0xa0, 0xe9,
+	0xb6, 0x2c, 0xf6, 0x0e, 0x1a, 0xd7, 0x34, 0x52, 0x6b, 0x1d, 0xc6, 0x68, 0x08, 0x92, 0xc9, 0xb2,
+	0x92, 0xef, 0xfc, 0x42, 0x1d, 0x06, 0xea, 0x7d, 0x18, 0xf1, 0x4d, 0x4a, 0xdc, 0x92, 0x72, 0x22,
+	0x4e, 0x82, 0x58, 0xbf, 0x0a, 0xe5, 0x1e, 0xdb, 0x88, 0xb6, 0xfa, 0x87, 0x02, 0xaf, 0xba, 0x85,
+	0x33, 0x45, 0xd0, 0xf6, 0xdc, 0xe2, 0x69, 0xcf, 0xbc, 0x4b, 0x30, 0x97, 0xda, 0x4a, 0xb4, 0xcd,
+	0x7f, 0x14, 0x60, 0x12, 0x25, 0x1e, 0xa3, 0x63, 0x87, 0xb5, 0xc2, 0xce, 0x52, 0xa6, 0xc4, 0xae,
+	0xd9, 0x6a, 0x87, 0x25, 0x4e, 0x7e, 0x25, 0x38, 0xb5, 0xfa, 0x16, 0x28, 0x76, 0xd0, 0x90, 0x17,
+	0xb0, 0x9e, 0xb6, 0x4c, 0xc6, 0xb7, 0x3b, 0x06, 0xef, 0x58, 0x60, 0xf8, 0x7f, 0xb2, 0xc0, 0x1f,
+	0x0b, 0x70, 0x35, 0x7a, 0x6e, 0x88, 0x44, 0xd4, 0x7c, 0x97, 0xe2, 0x3a, 0x25, 0xae, 0x93, 0xfb,
+	0x7d, 0xe4, 0x3b, 0x70, 0xb5, 0xde, 0xf6, 0x7d, 0x76, 0x6f, 0xfb, 0xee, 0x9e, 0xe9, 0x18, 0x9d,
+	0xc3, 0x22, 0x1d, 0xed, 0xb9, 0x75, 0x5e, 0x94, 0x9c, 0x11, 0x63, 0x1c, 0x29, 0x1b, 0x85, 0xa8,
+	0x7e, 0x13, 0x6e, 0xf4, 0xdd, 0x4b, 0xe4, 0xe0, 0xa3, 0x21, 0xd0, 0xa3, 0x13, 0x28, 0x03, 0x9d,
+	0xff, 0x61, 0xc5, 0x87, 0x05, 0xdb, 0xdc, 0xff, 0xf4, 0xb7, 0xad, 0xd9, 0xe6, 0x7e, 0x8f, 0x2d,
+	0xab, 0xeb, 0x70, 0xed, 0x58, 0x99, 0x32, 0xed, 0x78, 0x90, 0xa0, 0x72, 0x6f, 0x46, 0x22, 0xad,
+	0xae, 0x42, 0xb1, 0xeb, 0x3d, 0x67, 0x18, 0x8d, 0xe3, 0xd8, 0x2b, 0xce, 0x65, 0x18, 0x23, 0x81,
+	0x61, 0xd6, 0xd9, 0xfb, 0x1e, 0xaf, 0xba, 0xce, 0xa3, 0xf3, 0x24, 0x58, 0xe5, 0x63, 0xb5, 0x0c,
+	0xe3, 0x74, 0xcf, 0xf4, 0x8c, 0x3d, 0xe2, 0x58, 0xee, 0x1e, 0xaf, 0x94, 0x86, 0x11, 0xb0, 0xa9,
+	0x67, 0x7c, 0x46, 0xbf, 0x05, 0xcb, 0xfd, 0x6d, 0x1e, 0xb9, 0xe8, 0xb7, 0x05, 0x58, 0x12, 0x87,
+	0x6e, 0xcd, 0x77, 0x77, 0x89, 0x85, 0xfd, 0xfb, 0x24, 0xa0, 0x3e, 0xd9, 0x6a, 0x73, 0xf0, 0x49,
+	0xef, 0x83, 0x6f, 0xc1, 0x8c, 0x15, 0xe3, 0x93, 0xba, 0x15, 0x96, 0xbb, 0x0a, 0xfb, 0xde, 0xb2,
+	0xa7, 0xad, 0xae, 0xb9, 0x40, 0x5f, 0x86, 0xeb, 0xfd, 0x95, 0x96, 0x3b, 0xfc, 0x77, 0xfc, 0x72,
+	0x67, 0x0d, 0xdc, 0x03, 0x8c, 0x4f, 0x7c, 0xb9, 0x9b, 0x30, 0x6b, 0xe1, 0x6d, 0xb3, 0xdd, 0xa2,
+	0x46, 0xc0, 0xbc, 0xb0, 0x8d, 0xe3, 0x4f, 0x7a, 0xb9, 0xaf, 0x04, 0x55, 0x32, 0x93, 0x6a, 0xf1,
+	0xc7, 0xbf, 0x35, 0x28, 0x52, 0x77, 0x07, 0x3b, 0x46, 0xf4, 0xcb, 0x11, 0x25, 0xeb, 0xd0, 0x92,
+	0x24, 0x4f, 0x18, 0x54, 0x6e, 0x67, 0x9c, 0x76, 0x06, 0x89, 0xcb, 0x3f, 0xb5, 0x6b, 0x69, 0x98,
+	0x9f, 0xcb, 0x5b, 0xa6, 0x65, 0x12, 0x5b, 0x96, 0x07, 0xa7, 0xa1, 0xe4, 0xd3, 0x03, 0x98, 0x4b,
+	0xa9, 0x15, 0xd5, 0xf2, 0xdf, 0x80, 0xd1, 0x44, 0x93, 0x7d, 0x37, 0x7f, 0xd7, 0x23, 0x77, 0x13,
+	0x36, 0x3c, 0x92, 0x1f, 0x7b, 0xf4, 0x65, 0x0d, 0xfe, 0x13, 0xdf, 0x74, 0x82, 0x6d, 0xec, 0x9f,
+	0xae, 0x6f, 0x3c, 0x15, 0x38, 0x2f, 0x5b, 0x40, 0x5f, 0xde, 0xc6, 0xd3, 0x47, 0x87, 0xe5, 0xa9,
+	0x44, 0xb7, 0xe8, 0xeb, 0x28, 0x02, 0xa9, 0x4f, 0x93, 0x37, 0xd7, 0x57, 0xf3, 0x9b, 0xa9, 0x18,
+	0xfb, 0xd8, 0xa1, 0x87, 0x37, 0xd9, 0x22, 0xcc, 0x67, 0xd9, 0x28, 0x8a, 0xa8, 0xdf, 0x15, 0x62,
+	0x97, 0x7d, 0xea, 0x66, 0x3f, 0x0d, 0x76, 0x14, 0x8d, 0x1b, 0x7f, 0x41, 0xcf, 0x6a, 0xdc, 0x44,
+	0x65, 0x96, 0xa5, 0x7d, 0xb8, 0xc3, 0x3b, 0x1f, 0xce, 0x80, 0xb2, 0x11, 0x34, 0x54, 0x13, 0xa6,
+	0xd2, 0x3f, 0xad, 0x1a, 0xa0, 0xac, 0xd0, 0x96, 0xfb, 0x63, 0xa2, 0x58, 0xf7, 0x60, 0x26, 0xf3,
+	0xc7, 0x27, 0x4b, 0xfd, 0x79, 0x70, 0xa0, 0x56, 0x19, 0x10, 0x18, 0x49, 0x44, 0x00, 0xb1, 0xdf,
+	0x00, 0x2c, 0x64, 0x90, 0x77, 0x96, 0xb5, 0xcf, 0x1f, 0xbb, 0x1c, 0xcb, 0xd8, 0x62, 0xe2, 0x93,
+	0x69, 0x39, 0x83, 0x2c, 0x0e, 0xd0, 0x96, 0xfa, 0x00, 0x22, 0xce, 0x77, 0x61, 0x98, 0x7f, 0x5e,
+	0x98, 0xcb, 0x20, 0x60, 0x0b, 0x5a, 0xb9, 0xc7, 0x42, 0xc4, 0xe1, 0x31, 0x8c, 0x75, 0x5e, 0x4e,
+	0xe7, 0x7b, 0xa1, 0xd9, 0xaa, 0xf6, 0xfa, 0x71, 0xab, 0x11, 0x43, 0x13, 0xa6, 0xd2, 0xaf, 0x4f,
+	0x59, 0x51, 0x91, 0xc2, 0x68, 0xcb, 0xfd, 0x31, 0x91, 0x08, 0x0b, 0x2e, 0x74, 0x3d, 0x5d, 0x5c,
+	0xcb, 0x72, 0x45, 0x0a, 0xa4, 0xdd, 0x1c, 0x00, 0x14, 0x97, 0xd2, 0xf5, 0x40, 0x91, 0x25, 0x25,
+	0x0d, 0xd2, 0x6e, 0x0e, 0x00, 0x8a, 0xa4, 0x34, 0x61, 0x2a, 0xd5, 0x91, 0xab, 0x37, 0x32, 0xe8,
+	0xb3, 0x5f, 0x27, 0xb4, 0xe5, 0x41, 0xa0, 0x52, 0x12, 0x85, 0xe9, 0x8c, 0x36, 0x58, 0x7d, 0x33,
+	0x8b, 0x45, 0xcf, 0x47, 0x00, 0x6d, 0x65, 0x50, 0x78, 0x67, 0x7f, 0xa9, 0x66, 0x36, 0x73, 0x7f,
+	0xd9, 0xdd, 0xb7, 0xb6, 0x3c, 0x08, 0xb4, 0x13, 0x78, 0xe9, 0xef, 0xad, 0x59, 0x81, 0x97, 0xc2,
+	0x68, 0xcb, 0xfd, 0x31, 0xf1, 0x90, 0xe8, 0xfa, 0x22, 0x7a, 0xad, 0xa7, 0x41, 0x3a, 0x20, 0xed,
+	0xe6, 0x00, 0xa0, 0x48, 0xca, 0xf7, 0xe0, 0x52, 0xef, 0x9f, 0xf2, 0xde, 0xea, 0xc9, 0x29, 0x03,
+	0xad, 0xbd, 0x95, 0x07, 0x1d, 0x3f, 0x75, 0x33, 0x5f, 0x18, 0xb2, 0x8e, 0xa5, 0x2c, 0xa0, 0x56,
+	0x19, 0x10, 0x18, 0xf3, 0xdd, 0x6c, 0xbc, 0x3b, 0x3e, 0xfe, 0xa8, 0x8c, 0x23, 0xb5, 0xa5, 0x3e,
+	0x80, 0x48, 0xc4, 0x4f, 0x0b, 0x50, 0xee, 0xd7, 0x84, 0xdd, 0xe9, 0x69, 0xae, 0x9e, 0x34, 0xda,
+	0xbb, 0xf9, 0x69, 0x22, 0x9d, 0x3e, 0x28, 0xc0, 0x62, 0x9f, 0x96, 0xf8, 0x76, 0xcf, 0xf0, 0xec,
+	0x45, 0xa2, 0x7d, 0x39, 0x37, 0x49, 0xa4, 0xd0, 0xcf, 0x0a, 0xb0, 0x70, 0x6c, 0x47, 0xa1, 0xbe,
+	0x9d, 0x9d, 0x91, 0x7d, 0x1b, 0x27, 0xed, 0x9d, 0xfc, 0x84, 0xe9, 0x83, 0x2b, 0x51, 0xc2, 0x1f,
+	0x73, 0x70, 0x65, 0x35, 0x38, 0xda, 0xca, 0xa0, 0xf0, 0xce, 0xa5, 0x9d, 0xe8, 0x0a, 0x32, 0x23,
+	0x31, 0x06, 0xd0, 0x96, 0xfa, 0x00, 0x22, 0xce, 0x0d, 0xb8, 0xd8, 0x5d, 0x62, 0x67, 0x5d, 0xae,
+	0x5d, 0x28, 0xed, 0xd6, 0x20, 0xa8, 0x78, 0x1e, 0x67, 0x96, 0xa1, 0xbd, 0x73, 0x26, 0x09, 0xd4,
+	0x2a, 0x03, 0x02, 0x43, 0x89, 0xd5, 0xd5, 0x8f, 0x5e, 0x2c, 0x16, 0x3e, 0x7e, 0xb1, 0x58, 0xf8,
+	0xfb, 0x8b, 0xc5, 0xc2, 0x4f, 0x5e, 0x2e, 0x9e, 0xfb, 0xf8, 0xe5, 0xe2, 0xb9, 0xbf, 0xbe, 0x5c,
+	0x3c, 0xf7, 0xcd, 0x78, 0xd9, 0xbd, 0x49, 0xb6, 0xeb, 0x4d, 0x93, 0x38, 0x15, 0xc9, 0xbd, 0xb2,
+	0xcf, 0xff, 0xac, 0x81, 0xd7, 0xde, 0x5b, 0xa3, 0xfc, 0x6f, 0x1a, 0xbe, 0xf8, 0xdf, 0x01, 0x00,
+	0x62, 0xbb, 0xb4, 0x73, 0x4d, 0x31, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1923,6 +2636,9 @@ type MsgClient interface {
 	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
 	AddLiquidity(ctx context.Context, in *MsgAddLiquidity, opts ...grpc.CallOption) (*MsgAddLiquidityResponse, error)
 	Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOption) (*MsgSwapResponse, error)
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
 	DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error)
 	UnlockLiquidity(ctx context.Context, in *MsgUnlockLiquidityRequest, opts ...grpc.CallOption) (*MsgUnlockLiquidityResponse, error)
 	UpdateRewardsParams(ctx context.Context, in *MsgUpdateRewardsParamsRequest, opts ...grpc.CallOption) (*MsgUpdateRewardsParamsResponse, error)
@@ -1936,6 +2652,9 @@ type MsgClient interface {
 	ModifyLiquidityProtectionRates(ctx context.Context, in *MsgModifyLiquidityProtectionRates, opts ...grpc.CallOption) (*MsgModifyLiquidityProtectionRatesResponse, error)
 	AddProviderDistributionPeriod(ctx context.Context, in *MsgAddProviderDistributionPeriodRequest, opts ...grpc.CallOption) (*MsgAddProviderDistributionPeriodResponse, error)
 	UpdateSwapFeeParams(ctx context.Context, in *MsgUpdateSwapFeeParamsRequest, opts ...grpc.CallOption) (*MsgUpdateSwapFeeParamsResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	TransferLiquidity(ctx context.Context, in *MsgTransferLiquidity, opts ...grpc.CallOption) (*MsgTransferLiquidityResponse, error)
+	CancelRemovalRequest(ctx context.Context, in *MsgCancelRemovalRequest, opts ...grpc.CallOption) (*MsgCancelRemovalRequestResponse, error)
 }
 
 type msgClient struct {
@@ -1991,6 +2710,33 @@ func (c *msgClient) Swap(ctx context.Context, in *MsgSwap, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) DecommissionPool(ctx context.Context, in *MsgDecommissionPool, opts ...grpc.CallOption) (*MsgDecommissionPoolResponse, error) {
 	out := new(MsgDecommissionPoolResponse)
 	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/DecommissionPool", in, out, opts...)
@@ -2108,6 +2854,33 @@ func (c *msgClient) UpdateSwapFeeParams(ctx context.Context, in *MsgUpdateSwapFe
 	return out, nil
 }
 
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLiquidity(ctx context.Context, in *MsgTransferLiquidity, opts ...grpc.CallOption) (*MsgTransferLiquidityResponse, error) {
+	out := new(MsgTransferLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/TransferLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRemovalRequest(ctx context.Context, in *MsgCancelRemovalRequest, opts ...grpc.CallOption) (*MsgCancelRemovalRequestResponse, error) {
+	out := new(MsgCancelRemovalRequestResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.clp.v1.Msg/CancelRemovalRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	RemoveLiquidity(context.Context, *MsgRemoveLiquidity) (*MsgRemoveLiquidityResponse, error)
@@ -2115,6 +2888,9 @@ type MsgServer interface {
 	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
 	AddLiquidity(context.Context, *MsgAddLiquidity) (*MsgAddLiquidityResponse, error)
 	Swap(context.Context, *MsgSwap) (*MsgSwapResponse, error)
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
 	DecommissionPool(context.Context, *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error)
 	UnlockLiquidity(context.Context, *MsgUnlockLiquidityRequest) (*MsgUnlockLiquidityResponse, error)
 	UpdateRewardsParams(context.Context, *MsgUpdateRewardsParamsRequest) (*MsgUpdateRewardsParamsResponse, error)
@@ -2128,6 +2904,9 @@ type MsgServer interface {
 	ModifyLiquidityProtectionRates(context.Context, *MsgModifyLiquidityProtectionRates) (*MsgModifyLiquidityProtectionRatesResponse, error)
 	AddProviderDistributionPeriod(context.Context, *MsgAddProviderDistributionPeriodRequest) (*MsgAddProviderDistributionPeriodResponse, error)
 	UpdateSwapFeeParams(context.Context, *MsgUpdateSwapFeeParamsRequest) (*MsgUpdateSwapFeeParamsResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	TransferLiquidity(context.Context, *MsgTransferLiquidity) (*MsgTransferLiquidityResponse, error)
+	CancelRemovalRequest(context.Context, *MsgCancelRemovalRequest) (*MsgCancelRemovalRequestResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2149,6 +2928,15 @@ func (*UnimplementedMsgServer) AddLiquidity(ctx context.Context, req *MsgAddLiqu
 func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwap) (*MsgSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
 }
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
 func (*UnimplementedMsgServer) DecommissionPool(ctx context.Context, req *MsgDecommissionPool) (*MsgDecommissionPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DecommissionPool not implemented")
 }
@@ -2188,6 +2976,15 @@ func (*UnimplementedMsgServer) AddProviderDistributionPeriod(ctx context.Context
 func (*UnimplementedMsgServer) UpdateSwapFeeParams(ctx context.Context, req *MsgUpdateSwapFeeParamsRequest) (*MsgUpdateSwapFeeParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwapFeeParams not implemented")
 }
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) TransferLiquidity(ctx context.Context, req *MsgTransferLiquidity) (*MsgTransferLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLiquidity not implemented")
+}
+func (*UnimplementedMsgServer) CancelRemovalRequest(ctx context.Context, req *MsgCancelRemovalRequest) (*MsgCancelRemovalRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRemovalRequest not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2283,98 +3080,152 @@ func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_DecommissionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDecommissionPool)
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).DecommissionPool(ctx, in)
+		return srv.(MsgServer).SwapRoute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/DecommissionPool",
+		FullMethod: "/sifnode.clp.v1.Msg/SwapRoute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DecommissionPool(ctx, req.(*MsgDecommissionPool))
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UnlockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnlockLiquidityRequest)
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UnlockLiquidity(ctx, in)
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UnlockLiquidity",
+		FullMethod: "/sifnode.clp.v1.Msg/PlaceLimitOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnlockLiquidity(ctx, req.(*MsgUnlockLiquidityRequest))
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateRewardsParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRewardsParamsRequest)
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateRewardsParams(ctx, in)
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/UpdateRewardsParams",
+		FullMethod: "/sifnode.clp.v1.Msg/CancelLimitOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRewardsParams(ctx, req.(*MsgUpdateRewardsParamsRequest))
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddRewardPeriodRequest)
+func _Msg_DecommissionPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDecommissionPool)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddRewardPeriod(ctx, in)
+		return srv.(MsgServer).DecommissionPool(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/AddRewardPeriod",
+		FullMethod: "/sifnode.clp.v1.Msg/DecommissionPool",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddRewardPeriod(ctx, req.(*MsgAddRewardPeriodRequest))
+		return srv.(MsgServer).DecommissionPool(ctx, req.(*MsgDecommissionPool))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_ModifyPmtpRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModifyPmtpRates)
+func _Msg_UnlockLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlockLiquidityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).ModifyPmtpRates(ctx, in)
+		return srv.(MsgServer).UnlockLiquidity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/sifnode.clp.v1.Msg/ModifyPmtpRates",
+		FullMethod: "/sifnode.clp.v1.Msg/UnlockLiquidity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModifyPmtpRates(ctx, req.(*MsgModifyPmtpRates))
+		return srv.(MsgServer).UnlockLiquidity(ctx, req.(*MsgUnlockLiquidityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdatePmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePmtpParams)
+func _Msg_UpdateRewardsParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRewardsParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRewardsParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/UpdateRewardsParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRewardsParams(ctx, req.(*MsgUpdateRewardsParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddRewardPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddRewardPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddRewardPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/AddRewardPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddRewardPeriod(ctx, req.(*MsgAddRewardPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModifyPmtpRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModifyPmtpRates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyPmtpRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/ModifyPmtpRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyPmtpRates(ctx, req.(*MsgModifyPmtpRates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePmtpParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePmtpParams)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2517,6 +3368,60 @@ func _Msg_UpdateSwapFeeParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/ClaimRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/TransferLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLiquidity(ctx, req.(*MsgTransferLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRemovalRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRemovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRemovalRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.clp.v1.Msg/CancelRemovalRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRemovalRequest(ctx, req.(*MsgCancelRemovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sifnode.clp.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2541,6 +3446,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Swap",
 			Handler:    _Msg_Swap_Handler,
 		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
 		{
 			MethodName: "DecommissionPool",
 			Handler:    _Msg_DecommissionPool_Handler,
@@ -2593,6 +3510,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSwapFeeParams",
 			Handler:    _Msg_UpdateSwapFeeParams_Handler,
 		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "TransferLiquidity",
+			Handler:    _Msg_TransferLiquidity_Handler,
+		},
+		{
+			MethodName: "CancelRemovalRequest",
+			Handler:    _Msg_CancelRemovalRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sifnode/clp/v1/tx.proto",
@@ -2753,6 +3682,51 @@ func (m *MsgRemoveLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.RemovalRequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemovalRequestId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnitsBurned.Size()
+		i -= size
+		if _, err := m.UnitsBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -2828,6 +3802,51 @@ func (m *MsgRemoveLiquidityUnitsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.RemovalRequestId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemovalRequestId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnitsBurned.Size()
+		i -= size
+		if _, err := m.UnitsBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -2913,6 +3932,36 @@ func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnitsMinted.Size()
+		i -= size
+		if _, err := m.UnitsMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -2998,6 +4047,36 @@ func (m *MsgAddLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ExternalAssetAmount.Size()
+		i -= size
+		if _, err := m.ExternalAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NativeAssetAmount.Size()
+		i -= size
+		if _, err := m.NativeAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnitsMinted.Size()
+		i -= size
+		if _, err := m.UnitsMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -3247,35 +4326,106 @@ func (m *MsgSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgDecommissionPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return dAtA[:n], nil
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityFee.Size()
+		i -= size
+		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDecommissionPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDecommissionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.MinReceivingAmount.Size()
+		i -= size
+		if _, err := m.MinReceivingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -3287,7 +4437,7 @@ func (m *MsgDecommissionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDecommissionPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3297,20 +4447,64 @@ func (m *MsgDecommissionPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDecommissionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDecommissionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityFee.Size()
+		i -= size
+		if _, err := m.LiquidityFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ReceivedAmount.Size()
+		i -= size
+		if _, err := m.ReceivedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnlockLiquidityRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3320,29 +4514,56 @@ func (m *MsgUnlockLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnlockLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnlockLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
-		size := m.Units.Size()
+		size := m.TargetPrice.Size()
 		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
+	dAtA[i] = 0x2a
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ReceivedAsset != nil {
 		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3362,7 +4583,7 @@ func (m *MsgUnlockLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnlockLiquidityResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3372,20 +4593,25 @@ func (m *MsgUnlockLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnlockLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnlockLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRewardsParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3395,23 +4621,18 @@ func (m *MsgUpdateRewardsParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRewardsParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRewardsParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LiquidityRemovalCancelPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalCancelPeriod))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LiquidityRemovalLockPeriod != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalLockPeriod))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -3425,7 +4646,7 @@ func (m *MsgUpdateRewardsParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRewardsParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3435,12 +4656,12 @@ func (m *MsgUpdateRewardsParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRewardsParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRewardsParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3448,7 +4669,7 @@ func (m *MsgUpdateRewardsParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddRewardPeriodRequest) Marshal() (dAtA []byte, err error) {
+func (m *MsgDecommissionPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3458,29 +4679,22 @@ func (m *MsgAddRewardPeriodRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddRewardPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDecommissionPool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddRewardPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDecommissionPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardPeriods) > 0 {
-		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -3492,7 +4706,7 @@ func (m *MsgAddRewardPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDecommissionPoolResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3502,12 +4716,12 @@ func (m *MsgAddRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDecommissionPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDecommissionPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3515,7 +4729,7 @@ func (m *MsgAddRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetSymmetryThreshold) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnlockLiquidityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3525,36 +4739,38 @@ func (m *MsgSetSymmetryThreshold) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetSymmetryThreshold) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnlockLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetSymmetryThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnlockLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Ratio.Size()
+		size := m.Units.Size()
 		i -= size
-		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size := m.Threshold.Size()
-		i -= size
-		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
 		copy(dAtA[i:], m.Signer)
@@ -3565,7 +4781,7 @@ func (m *MsgSetSymmetryThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetSymmetryThresholdResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUnlockLiquidityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3575,12 +4791,12 @@ func (m *MsgSetSymmetryThresholdResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetSymmetryThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUnlockLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetSymmetryThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUnlockLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3588,7 +4804,7 @@ func (m *MsgSetSymmetryThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelUnlock) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateRewardsParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3598,33 +4814,236 @@ func (m *MsgCancelUnlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelUnlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateRewardsParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateRewardsParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Units.Size()
-		i -= size
-		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if m.LiquidityRemovalCancelPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalCancelPeriod))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.ExternalAsset != nil {
-		{
-			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	if m.LiquidityRemovalLockPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LiquidityRemovalLockPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRewardsParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRewardsParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRewardsParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewardPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewardPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewardPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddRewardPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddRewardPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddRewardPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSymmetryThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSymmetryThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSymmetryThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSymmetryThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSymmetryThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSymmetryThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUnlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
@@ -3683,6 +5102,16 @@ func (m *RemovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.Msg != nil {
 		{
 			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
@@ -3796,6 +5225,11 @@ func (m *MsgUpdateLiquidityProtectionParams) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.TwapWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.IsActive {
 		i--
 		if m.IsActive {
@@ -4005,26 +5439,253 @@ func (m *MsgUpdateSwapFeeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateStakingRewardParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Units.Size()
+		i -= size
+		if _, err := m.Units.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemovalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemovalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemovalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExternalAsset != nil {
+		{
+			size, err := m.ExternalAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRemovalRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRemovalRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRemovalRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateStakingRewardParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.Minter.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -4069,6 +5730,18 @@ func (m *MsgRemoveLiquidityResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.UnitsBurned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Queued {
+		n += 2
+	}
+	if m.RemovalRequestId != 0 {
+		n += 1 + sovTx(uint64(m.RemovalRequestId))
+	}
 	return n
 }
 
@@ -4097,6 +5770,18 @@ func (m *MsgRemoveLiquidityUnitsResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.UnitsBurned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Queued {
+		n += 2
+	}
+	if m.RemovalRequestId != 0 {
+		n += 1 + sovTx(uint64(m.RemovalRequestId))
+	}
 	return n
 }
 
@@ -4127,6 +5812,12 @@ func (m *MsgCreatePoolResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.UnitsMinted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -4157,6 +5848,12 @@ func (m *MsgAddLiquidityResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.UnitsMinted.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.NativeAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExternalAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -4259,10 +5956,22 @@ func (m *MsgSwapResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgDecommissionPool) Size() (n int) {
+func (m *MsgSwapRoute) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4272,25 +5981,134 @@ func (m *MsgDecommissionPool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinReceivingAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgDecommissionPoolResponse) Size() (n int) {
+func (m *MsgSwapRouteResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgUnlockLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	l = m.ReceivedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDecommissionPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDecommissionPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlockLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -4441,6 +6259,8 @@ func (m *RemovalRequest) Size() (n int) {
 		l = m.Msg.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Units.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -4490,6 +6310,9 @@ func (m *MsgUpdateLiquidityProtectionParams) Size() (n int) {
 	if m.IsActive {
 		n += 2
 	}
+	if m.TwapWindow != 0 {
+		n += 1 + sovTx(uint64(m.TwapWindow))
+	}
 	return n
 }
 
@@ -4560,6 +6383,95 @@ func (m *MsgUpdateSwapFeeParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgClaimRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Units.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelRemovalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExternalAsset != nil {
+		l = m.ExternalAsset.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelRemovalRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4597,7 +6509,2262 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitsBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalRequestId", wireType)
+			}
+			m.RemovalRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalRequestId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitsBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalRequestId", wireType)
+			}
+			m.RemovalRequestId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalRequestId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitsMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitsMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyPmtpRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyPmtpRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPolicy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EndPolicy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodGovernanceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PmtpPeriodGovernanceRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEpochLength", wireType)
+			}
+			m.PmtpPeriodEpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+			}
+			m.PmtpPeriodStartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodStartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			}
+			m.PmtpPeriodEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PmtpPeriodEndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4625,11 +8792,13 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4657,13 +8826,13 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4691,60 +8860,44 @@ func (m *MsgUpdateStakingRewardParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pools = append(m.Pools, &Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStakingRewardParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4766,7 +8919,7 @@ func (m *MsgUpdateStakingRewardParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4789,10 +8942,10 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4829,7 +8982,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4856,16 +9009,14 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assets = append(m.Assets, &Asset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4893,13 +9044,13 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WBasisPoints.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asymmetry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4927,7 +9078,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asymmetry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4952,57 +9103,7 @@ func (m *MsgRemoveLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveLiquidityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5025,15 +9126,15 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnits: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5061,13 +9162,15 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.ReceivedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5077,31 +9180,29 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawUnits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5129,60 +9230,44 @@ func (m *MsgRemoveLiquidityUnits) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WithdrawUnits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Pools = append(m.Pools, &Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveLiquidityUnitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5204,7 +9289,7 @@ func (m *MsgRemoveLiquidityUnitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5227,17 +9312,49 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5247,27 +9364,31 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5294,16 +9415,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
 			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5331,13 +9452,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5365,10 +9486,29 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5390,7 +9530,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5413,12 +9553,31 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5440,7 +9599,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5463,10 +9622,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidity: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5502,80 +9661,10 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeAssetAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NativeAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAssetAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5585,26 +9674,11 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ExternalAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5626,7 +9700,7 @@ func (m *MsgAddLiquidity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5649,10 +9723,10 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5676,7 +9750,7 @@ func (m *MsgAddLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5699,10 +9773,10 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyPmtpRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyPmtpRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5739,73 +9813,9 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunningRate = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5815,12 +9825,24 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EndPolicy = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5842,7 +9864,7 @@ func (m *MsgModifyPmtpRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5865,10 +9887,10 @@ func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyPmtpRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5892,7 +9914,7 @@ func (m *MsgModifyPmtpRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5915,10 +9937,10 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5955,9 +9977,9 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodGovernanceRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5967,29 +9989,33 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PmtpPeriodGovernanceRate = string(dAtA[iNdEx:postIndex])
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEpochLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			m.PmtpPeriodEpochLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5999,49 +10025,26 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PmtpPeriodEpochLength |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodStartBlock", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.PmtpPeriodStartBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PmtpPeriodStartBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PmtpPeriodEndBlock", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PmtpPeriodEndBlock = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PmtpPeriodEndBlock |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6063,7 +10066,7 @@ func (m *MsgUpdatePmtpParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6086,10 +10089,10 @@ func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePmtpParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6113,7 +10116,7 @@ func (m *MsgUpdatePmtpParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwap) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6136,10 +10139,10 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6175,10 +10178,10 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalLockPeriod", wireType)
 			}
-			var msglen int
+			m.LiquidityRemovalLockPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6188,103 +10191,16 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LiquidityRemovalLockPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SentAsset == nil {
-				m.SentAsset = &Asset{}
-			}
-			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReceivedAsset == nil {
-				m.ReceivedAsset = &Asset{}
-			}
-			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReceivingAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalCancelPeriod", wireType)
 			}
-			var stringLen uint64
+			m.LiquidityRemovalCancelPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6294,26 +10210,11 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LiquidityRemovalCancelPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinReceivingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6335,7 +10236,7 @@ func (m *MsgSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,10 +10259,10 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6385,7 +10286,7 @@ func (m *MsgSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6408,10 +10309,10 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6448,9 +10349,9 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6460,23 +10361,25 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.RewardPeriods = append(m.RewardPeriods, &RewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6499,7 +10402,7 @@ func (m *MsgDecommissionPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRewardPeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6522,10 +10425,10 @@ func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDecommissionPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6549,7 +10452,7 @@ func (m *MsgDecommissionPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6572,10 +10475,10 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSymmetryThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSymmetryThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6612,9 +10515,9 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6624,31 +10527,29 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6676,7 +10577,7 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6701,7 +10602,7 @@ func (m *MsgUnlockLiquidityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSymmetryThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6724,10 +10625,10 @@ func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSymmetryThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlockLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSymmetryThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6751,7 +10652,7 @@ func (m *MsgUnlockLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6774,10 +10675,10 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6813,10 +10714,10 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalLockPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			m.LiquidityRemovalLockPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6826,16 +10727,33 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityRemovalLockPeriod |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRemovalCancelPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			m.LiquidityRemovalCancelPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6845,11 +10763,26 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityRemovalCancelPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6871,7 +10804,7 @@ func (m *MsgUpdateRewardsParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6894,10 +10827,10 @@ func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRewardsParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6921,7 +10854,7 @@ func (m *MsgUpdateRewardsParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6944,15 +10877,34 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemovalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6980,11 +10932,13 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7011,61 +10965,47 @@ func (m *MsgAddRewardPeriodRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardPeriods = append(m.RewardPeriods, &RewardPeriod{})
-			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &MsgRemoveLiquidity{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddRewardPeriodResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRewardPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7087,7 +11027,7 @@ func (m *MsgAddRewardPeriodResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7102,55 +11042,23 @@ func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSymmetryThreshold: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSymmetryThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7178,13 +11086,11 @@ func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRowanLiquidityThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7212,7 +11118,7 @@ func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentRowanLiquidityThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7237,7 +11143,7 @@ func (m *MsgSetSymmetryThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSymmetryThresholdResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgModifyLiquidityProtectionRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7260,10 +11166,10 @@ func (m *MsgSetSymmetryThresholdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSymmetryThresholdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSymmetryThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7287,7 +11193,7 @@ func (m *MsgSetSymmetryThresholdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7310,10 +11216,10 @@ func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7350,9 +11256,9 @@ func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRowanLiquidityThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7362,31 +11268,48 @@ func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalAsset == nil {
-				m.ExternalAsset = &Asset{}
-			}
-			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxRowanLiquidityThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRowanLiquidityThresholdAsset", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7414,10 +11337,47 @@ func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxRowanLiquidityThresholdAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsActive = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7439,7 +11399,7 @@ func (m *MsgCancelUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelUnlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLiquidityProtectionParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7462,10 +11422,10 @@ func (m *MsgCancelUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7489,7 +11449,7 @@ func (m *MsgCancelUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7512,34 +11472,15 @@ func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemovalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7567,13 +11508,11 @@ func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7600,10 +11539,8 @@ func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgRemoveLiquidity{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DistributionPeriods = append(m.DistributionPeriods, &ProviderDistributionPeriod{})
+			if err := m.DistributionPeriods[len(m.DistributionPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7628,7 +11565,7 @@ func (m *RemovalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
+func (m *MsgAddProviderDistributionPeriodResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7651,10 +11588,60 @@ func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRates: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7691,7 +11678,7 @@ func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRowanLiquidityThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSwapFeeRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7719,7 +11706,41 @@ func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentRowanLiquidityThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DefaultSwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenParams = append(m.TokenParams, &SwapFeeTokenParams{})
+			if err := m.TokenParams[len(m.TokenParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7744,7 +11765,7 @@ func (m *MsgModifyLiquidityProtectionRates) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModifyLiquidityProtectionRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSwapFeeParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7767,10 +11788,10 @@ func (m *MsgModifyLiquidityProtectionRatesResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModifyLiquidityProtectionRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7794,7 +11815,7 @@ func (m *MsgModifyLiquidityProtectionRatesResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7817,10 +11838,10 @@ func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7857,9 +11878,9 @@ func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRowanLiquidityThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7869,97 +11890,28 @@ func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxRowanLiquidityThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
-			}
-			m.EpochLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochLength |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRowanLiquidityThresholdAsset", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MaxRowanLiquidityThresholdAsset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsActive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsActive = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7981,7 +11933,7 @@ func (m *MsgUpdateLiquidityProtectionParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateLiquidityProtectionParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8004,12 +11956,46 @@ func (m *MsgUpdateLiquidityProtectionParamsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateLiquidityProtectionParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8031,7 +12017,7 @@ func (m *MsgUpdateLiquidityProtectionParamsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLiquidity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8054,10 +12040,10 @@ func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLiquidity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8094,7 +12080,7 @@ func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8121,8 +12107,76 @@ func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionPeriods = append(m.DistributionPeriods, &ProviderDistributionPeriod{})
-			if err := m.DistributionPeriods[len(m.DistributionPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Units", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Units.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8147,7 +12201,7 @@ func (m *MsgAddProviderDistributionPeriodRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProviderDistributionPeriodResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferLiquidityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8170,10 +12224,10 @@ func (m *MsgAddProviderDistributionPeriodResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferLiquidityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProviderDistributionPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8197,7 +12251,7 @@ func (m *MsgAddProviderDistributionPeriodResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemovalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8220,10 +12274,10 @@ func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemovalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemovalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8260,9 +12314,9 @@ func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSwapFeeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalAsset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8272,31 +12326,33 @@ func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultSwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExternalAsset == nil {
+				m.ExternalAsset = &Asset{}
+			}
+			if err := m.ExternalAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8306,26 +12362,11 @@ func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenParams = append(m.TokenParams, &SwapFeeTokenParams{})
-			if err := m.TokenParams[len(m.TokenParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8347,7 +12388,7 @@ func (m *MsgUpdateSwapFeeParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSwapFeeParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRemovalRequestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8370,10 +12411,10 @@ func (m *MsgUpdateSwapFeeParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRemovalRequestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSwapFeeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRemovalRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -87,6 +87,11 @@ type Pool struct {
 	UnsettledNativeLiabilities     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,16,opt,name=unsettled_native_liabilities,json=unsettledNativeLiabilities,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"unsettled_native_liabilities"`
 	BlockInterestNative            github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,17,opt,name=block_interest_native,json=blockInterestNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"block_interest_native"`
 	BlockInterestExternal          github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,18,opt,name=block_interest_external,json=blockInterestExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"block_interest_external"`
+	// cumulative rowan rewarded per pool unit, liquidity providers claim the
+	// growth since their checkpoint
+	RewardPerUnit *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=reward_per_unit,json=rewardPerUnit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_unit,omitempty" yaml:"reward_per_unit"`
+	// rowan held by the module for liquidity providers until they claim it
+	UnclaimedRewards *github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,20,opt,name=unclaimed_rewards,json=unclaimedRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"unclaimed_rewards,omitempty" yaml:"unclaimed_rewards"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
@@ -141,6 +146,8 @@ type LiquidityProvider struct {
 	LiquidityProviderUnits   github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=liquidity_provider_units,json=liquidityProviderUnits,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"liquidity_provider_units" yaml:"liquidity_provider_units"`
 	LiquidityProviderAddress string                                  `protobuf:"bytes,3,opt,name=liquidity_provider_address,json=liquidityProviderAddress,proto3" json:"liquidity_provider_address,omitempty"`
 	Unlocks                  []*LiquidityUnlock                      `protobuf:"bytes,4,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
+	// pool reward_per_unit at the last reward claim
+	RewardPerUnitCheckpoint *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_per_unit_checkpoint,json=rewardPerUnitCheckpoint,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_per_unit_checkpoint,omitempty" yaml:"reward_per_unit_checkpoint"`
 }
 
 func (m *LiquidityProvider) Reset()         { *m = LiquidityProvider{} }
@@ -519,6 +526,155 @@ func (m *RemovalQueue) GetStartHeight() int64 {
 	return 0
 }
 
+// LimitOrder escrows sent_amount of sent_asset until a swap of it pays at
+// least target_price, quoted in whole received_asset per whole sent_asset,
+// or the order expires after expiry_height. An order that failed to execute
+// is not tried again before retry_height.
+type LimitOrder struct {
+	Id            uint64                                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner         string                                  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	SentAsset     *Asset                                  `protobuf:"bytes,3,opt,name=sent_asset,json=sentAsset,proto3" json:"sent_asset,omitempty"`
+	ReceivedAsset *Asset                                  `protobuf:"bytes,4,opt,name=received_asset,json=receivedAsset,proto3" json:"received_asset,omitempty"`
+	SentAmount    github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=sent_amount,json=sentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"sent_amount"`
+	TargetPrice   github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price"`
+	ExpiryHeight  int64                                   `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	RetryHeight   int64                                   `protobuf:"varint,8,opt,name=retry_height,json=retryHeight,proto3" json:"retry_height,omitempty"`
+}
+
+func (m *LimitOrder) Reset()         { *m = LimitOrder{} }
+func (m *LimitOrder) String() string { return proto.CompactTextString(m) }
+func (*LimitOrder) ProtoMessage()    {}
+func (*LimitOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{9}
+}
+func (m *LimitOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrder.Merge(m, src)
+}
+func (m *LimitOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrder proto.InternalMessageInfo
+
+func (m *LimitOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LimitOrder) GetSentAsset() *Asset {
+	if m != nil {
+		return m.SentAsset
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetReceivedAsset() *Asset {
+	if m != nil {
+		return m.ReceivedAsset
+	}
+	return nil
+}
+
+func (m *LimitOrder) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetRetryHeight() int64 {
+	if m != nil {
+		return m.RetryHeight
+	}
+	return 0
+}
+
+// PriceAccumulator is a snapshot of a pool's cumulative prices taken at the
+// beginning of a block. Cumulative prices grow by the previous snapshot's spot
+// price for every block elapsed since it was taken.
+type PriceAccumulator struct {
+	Symbol                  string                                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height                  int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	CumulativePriceNative   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=cumulative_price_native,json=cumulativePriceNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price_native"`
+	CumulativePriceExternal github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price_external,json=cumulativePriceExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price_external"`
+	PriceNative             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price_native,json=priceNative,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_native"`
+	PriceExternal           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price_external,json=priceExternal,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_external"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a09f92a67752e669, []int{10}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
+func (m *PriceAccumulator) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PriceAccumulator) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Asset)(nil), "sifnode.clp.v1.Asset")
 	proto.RegisterType((*Pool)(nil), "sifnode.clp.v1.Pool")
@@ -529,82 +685,102 @@ func init() {
 	proto.RegisterType((*LiquidityProviderData)(nil), "sifnode.clp.v1.LiquidityProviderData")
 	proto.RegisterType((*EventPolicy)(nil), "sifnode.clp.v1.EventPolicy")
 	proto.RegisterType((*RemovalQueue)(nil), "sifnode.clp.v1.RemovalQueue")
+	proto.RegisterType((*LimitOrder)(nil), "sifnode.clp.v1.LimitOrder")
+	proto.RegisterType((*PriceAccumulator)(nil), "sifnode.clp.v1.PriceAccumulator")
 }
 
 func init() { proto.RegisterFile("sifnode/clp/v1/types.proto", fileDescriptor_a09f92a67752e669) }
 
 var fileDescriptor_a09f92a67752e669 = []byte{
-	// 1109 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x73, 0x1b, 0x35,
-	0x18, 0xce, 0xc6, 0x4d, 0x8a, 0x5f, 0xc7, 0x4e, 0xad, 0x26, 0xed, 0x92, 0x52, 0x3b, 0x5d, 0x06,
-	0x9a, 0x19, 0x06, 0x9b, 0x04, 0x38, 0xc0, 0xf4, 0x92, 0x0f, 0x03, 0x65, 0x32, 0x19, 0xb3, 0x21,
-	0x65, 0x26, 0x07, 0x76, 0xe4, 0x5d, 0x35, 0xd6, 0x54, 0x5e, 0x6d, 0x56, 0x5a, 0xb7, 0x3e, 0xc1,
-	0x89, 0x13, 0x07, 0x4e, 0xfc, 0x01, 0x4e, 0xfc, 0x93, 0x1c, 0xcb, 0x8d, 0xe9, 0x21, 0xc3, 0x24,
-	0xfc, 0x82, 0xfe, 0x02, 0x66, 0x25, 0xad, 0x3f, 0x62, 0xb7, 0xc4, 0x3e, 0xd9, 0xfb, 0xea, 0x79,
-	0x9f, 0xe7, 0xd1, 0xab, 0x77, 0x25, 0x2d, 0xac, 0x09, 0xfa, 0x34, 0xe4, 0x01, 0xa9, 0xfb, 0x2c,
-	0xaa, 0x77, 0x37, 0xeb, 0xb2, 0x17, 0x11, 0x51, 0x8b, 0x62, 0x2e, 0x39, 0x2a, 0x99, 0xb1, 0x9a,
-	0xcf, 0xa2, 0x5a, 0x77, 0x73, 0x6d, 0xe5, 0x84, 0x9f, 0x70, 0x35, 0x54, 0x4f, 0xff, 0x69, 0x94,
-	0x53, 0x85, 0x85, 0x6d, 0x21, 0x88, 0x44, 0x77, 0x60, 0x51, 0xf4, 0x3a, 0x2d, 0xce, 0x6c, 0x6b,
-	0xdd, 0xda, 0xc8, 0xbb, 0xe6, 0xc9, 0xf9, 0xb7, 0x04, 0x37, 0x9a, 0x9c, 0x33, 0xf4, 0x08, 0x4a,
-	0xe4, 0x85, 0x24, 0x71, 0x88, 0x99, 0x87, 0xd3, 0x14, 0x05, 0x2c, 0x6c, 0xad, 0xd6, 0x46, 0x85,
-	0x6a, 0x8a, 0xcf, 0x2d, 0x66, 0x60, 0x4d, 0xff, 0xb3, 0x05, 0x2b, 0x21, 0x96, 0xb4, 0x4b, 0x74,
-	0xb2, 0xd7, 0xc2, 0x0c, 0x87, 0x3e, 0xb1, 0xe7, 0x53, 0xb5, 0x9d, 0x83, 0xb3, 0xf3, 0xea, 0xdc,
-	0xab, 0xf3, 0xea, 0xc3, 0x13, 0x2a, 0xdb, 0x49, 0xab, 0xe6, 0xf3, 0x4e, 0xdd, 0xe7, 0xa2, 0xc3,
-	0x85, 0xf9, 0xf9, 0x58, 0x04, 0xcf, 0xcc, 0xf4, 0x8e, 0x68, 0x28, 0x5f, 0x9f, 0x57, 0xef, 0xf5,
-	0x70, 0x87, 0x7d, 0xe9, 0x4c, 0x22, 0x75, 0x5c, 0xa4, 0xc3, 0x4a, 0x7b, 0x47, 0x07, 0xd1, 0x2f,
-	0x16, 0xdc, 0x19, 0x9d, 0x41, 0xdf, 0x44, 0x4e, 0x99, 0x68, 0x4e, 0x6f, 0xe2, 0xbe, 0x36, 0x31,
-	0x99, 0xd6, 0x71, 0x57, 0x46, 0x8a, 0x90, 0x19, 0xf1, 0x01, 0x22, 0xce, 0x99, 0x97, 0x84, 0x54,
-	0x0a, 0xfb, 0x86, 0xd2, 0xde, 0x9b, 0x5e, 0xbb, 0xac, 0xb5, 0x07, 0x54, 0x8e, 0x9b, 0x4f, 0x1f,
-	0x8e, 0xd2, 0xff, 0x48, 0x40, 0x59, 0x3c, 0xc7, 0x91, 0x17, 0xc5, 0xd4, 0x27, 0x9e, 0x2e, 0x87,
-	0xbd, 0xa0, 0xb4, 0xbe, 0x7e, 0x75, 0x5e, 0xfd, 0xf0, 0x1a, 0x3a, 0x7b, 0xc4, 0x7f, 0x7d, 0x5e,
-	0x7d, 0x57, 0xcb, 0x8c, 0x91, 0xad, 0x3b, 0xee, 0x72, 0x1a, 0x6c, 0xa6, 0xb1, 0x03, 0x15, 0x42,
-	0x3d, 0xb8, 0x3d, 0x84, 0xcb, 0x26, 0x6f, 0x2f, 0x2a, 0xd9, 0xc7, 0x53, 0xc9, 0xde, 0x1b, 0x93,
-	0xcd, 0xe8, 0xd6, 0x1d, 0xb7, 0xdc, 0x17, 0x6e, 0x98, 0x20, 0xfa, 0xc3, 0x82, 0xf5, 0x98, 0x3c,
-	0xc7, 0x71, 0xe0, 0x45, 0x24, 0xa6, 0x3c, 0x30, 0x36, 0xbd, 0x80, 0x0a, 0x19, 0xd3, 0x56, 0x22,
-	0x49, 0x60, 0xdf, 0x54, 0x46, 0x8e, 0xa7, 0xaf, 0xf5, 0x43, 0xed, 0xe6, 0xff, 0x04, 0x1c, 0xf7,
-	0xbe, 0x86, 0x34, 0x15, 0x42, 0x57, 0x65, 0x6f, 0x30, 0x8e, 0x5a, 0xd0, 0x6f, 0x09, 0x8f, 0x51,
-	0xdc, 0xa2, 0x8c, 0x4a, 0x4a, 0x84, 0xfd, 0x8e, 0x32, 0x56, 0x9f, 0xd2, 0x98, 0x7b, 0x3b, 0x23,
-	0xdb, 0x1f, 0x70, 0xa1, 0x63, 0xb8, 0xd5, 0xd7, 0xf0, 0x13, 0x21, 0x79, 0xd0, 0xb3, 0xf3, 0xb3,
-	0xf1, 0x2f, 0x67, 0x44, 0xbb, 0x9a, 0x07, 0xfd, 0x08, 0xe6, 0xcd, 0x1a, 0x71, 0x0f, 0xb3, 0xb1,
-	0x97, 0x35, 0xd5, 0xb0, 0xf7, 0x27, 0x50, 0x32, 0xfc, 0x99, 0xf3, 0xc2, 0x6c, 0xdc, 0x45, 0x4d,
-	0x93, 0xf9, 0xfe, 0x0a, 0x16, 0xdb, 0x04, 0x33, 0xd9, 0xb6, 0x97, 0x14, 0x5f, 0xcd, 0xf0, 0x5d,
-	0xb3, 0x1f, 0x5d, 0x93, 0x8d, 0x0e, 0xa1, 0x48, 0x43, 0x49, 0x62, 0x22, 0xa4, 0x17, 0x63, 0x49,
-	0xec, 0xe2, 0x4c, 0x74, 0x4b, 0x19, 0x89, 0x8b, 0x25, 0x41, 0xdf, 0x82, 0xc3, 0xb0, 0x90, 0x5e,
-	0x9b, 0xd0, 0x93, 0xb6, 0xf4, 0x46, 0x04, 0x3c, 0x9f, 0x77, 0x22, 0xd5, 0xbb, 0xa5, 0x75, 0x6b,
-	0x23, 0xe7, 0x56, 0x52, 0xe4, 0x37, 0x0a, 0xf8, 0x78, 0x88, 0x63, 0xd7, 0xa0, 0x50, 0x02, 0x95,
-	0x24, 0x14, 0x44, 0x4a, 0x46, 0x02, 0x6f, 0x62, 0xab, 0x2d, 0xcf, 0x56, 0xd0, 0xf7, 0xfa, 0xb4,
-	0x8d, 0x09, 0x3d, 0x77, 0x0a, 0x83, 0x71, 0x6f, 0x42, 0x87, 0xdc, 0x9a, 0x4d, 0x74, 0xad, 0x4f,
-	0x7a, 0x30, 0xd6, 0x2a, 0x3e, 0xac, 0xb6, 0x18, 0xf7, 0x9f, 0x0d, 0xea, 0x65, 0x36, 0xb9, 0xf2,
-	0x8c, 0xef, 0x92, 0x62, 0xcb, 0x8a, 0x6a, 0x36, 0xb4, 0x13, 0xb8, 0x7b, 0x45, 0xa4, 0xbf, 0xa9,
-	0xa1, 0xd9, 0x64, 0x56, 0x47, 0x64, 0xb2, 0x5a, 0x3a, 0x67, 0xf3, 0x50, 0xde, 0xa7, 0xa7, 0x09,
-	0x0d, 0xa8, 0xec, 0x35, 0x63, 0xde, 0xa5, 0x01, 0x89, 0xd1, 0x47, 0xb0, 0x70, 0x8d, 0xa3, 0x56,
-	0x63, 0xd0, 0xaf, 0x16, 0xd8, 0x2c, 0xa3, 0xf0, 0x22, 0xc3, 0x61, 0x4e, 0x19, 0x7d, 0xcc, 0xba,
-	0xd3, 0xef, 0x7c, 0x55, 0xbd, 0xf3, 0xbd, 0x89, 0xd8, 0x71, 0xef, 0xb0, 0xab, 0xb6, 0xf5, 0x01,
-	0xf4, 0x08, 0xd6, 0x26, 0x24, 0xe1, 0x20, 0x88, 0x89, 0x10, 0xfa, 0xc4, 0x75, 0xed, 0xb1, 0xdc,
-	0x6d, 0x3d, 0x8e, 0xbe, 0x80, 0x9b, 0x49, 0x98, 0x56, 0x2a, 0x3d, 0x20, 0x73, 0x1b, 0x85, 0xad,
-	0xea, 0xd5, 0xb9, 0xf7, 0xab, 0x75, 0xa4, 0x70, 0x6e, 0x86, 0x77, 0x7e, 0x82, 0xe5, 0x2b, 0x63,
-	0xe8, 0x03, 0x28, 0xc5, 0xe4, 0x34, 0x21, 0xfd, 0x97, 0x4c, 0x15, 0x34, 0xe7, 0x16, 0x4d, 0x54,
-	0xbf, 0x50, 0xa8, 0x01, 0x0b, 0xc3, 0xd5, 0x9a, 0x7a, 0x6d, 0x75, 0xb6, 0x73, 0x04, 0xf9, 0x66,
-	0x47, 0x46, 0x8d, 0x88, 0xfb, 0x6d, 0xf4, 0x3e, 0x14, 0x49, 0xfa, 0xc7, 0xf3, 0x79, 0x92, 0x2e,
-	0xba, 0x51, 0x5e, 0x52, 0xc1, 0x5d, 0x1d, 0x4b, 0x41, 0xba, 0xcd, 0x32, 0xd0, 0xbc, 0x06, 0xa9,
-	0xa0, 0x01, 0x39, 0x5b, 0x90, 0xff, 0xa1, 0x4d, 0x25, 0xd9, 0xa7, 0x42, 0xa6, 0x33, 0xea, 0x62,
-	0x46, 0x03, 0x2c, 0x79, 0xec, 0x31, 0x2a, 0xd2, 0x19, 0xe5, 0x36, 0xf2, 0x6e, 0xb1, 0x1f, 0x4d,
-	0x61, 0xce, 0x5f, 0x16, 0xac, 0x8e, 0xb5, 0xd5, 0x1e, 0x96, 0x18, 0x35, 0x01, 0x8d, 0x2f, 0x8f,
-	0xe9, 0xb3, 0x07, 0x6f, 0xac, 0x75, 0x46, 0xe1, 0x96, 0xc7, 0x56, 0x0e, 0x7d, 0xf2, 0xb6, 0x1b,
-	0xde, 0xc4, 0x1b, 0xd9, 0x67, 0x6f, 0xbf, 0x90, 0x4d, 0xbe, 0x3e, 0x39, 0xbf, 0x5b, 0x50, 0x68,
-	0x74, 0x49, 0x28, 0x9b, 0x9c, 0x51, 0xbf, 0x87, 0xee, 0x03, 0x90, 0xf4, 0xd1, 0x4b, 0x57, 0xc2,
-	0xdc, 0x5e, 0xf3, 0x2a, 0xf2, 0x7d, 0x2f, 0x22, 0xe8, 0x73, 0xb8, 0x1b, 0x75, 0x64, 0x94, 0x1d,
-	0xda, 0x42, 0xe2, 0x58, 0x7a, 0xaa, 0xb0, 0xc6, 0xd9, 0x4a, 0x3a, 0xac, 0x0f, 0xec, 0xc3, 0x74,
-	0x70, 0x47, 0xb5, 0xcc, 0x26, 0xac, 0x0e, 0xa7, 0x91, 0x30, 0x30, 0x49, 0xda, 0x1a, 0x1a, 0x24,
-	0x35, 0xc2, 0x40, 0xa5, 0x38, 0x7f, 0x5a, 0xb0, 0xe4, 0x92, 0x0e, 0xef, 0x62, 0xf6, 0x5d, 0x42,
-	0x12, 0x82, 0x56, 0x60, 0x41, 0x2d, 0xa8, 0x59, 0x73, 0xfd, 0x80, 0x4a, 0x30, 0x4f, 0x03, 0xb3,
-	0xc2, 0xf3, 0x34, 0x40, 0x0f, 0x60, 0x49, 0x9b, 0x32, 0xad, 0x99, 0x53, 0x23, 0x05, 0x15, 0x33,
-	0x8d, 0xd9, 0x84, 0x82, 0xe4, 0x12, 0x33, 0xaf, 0x8b, 0x59, 0x42, 0xcc, 0x95, 0x71, 0xea, 0xf6,
-	0x04, 0xc5, 0xf1, 0x24, 0xa5, 0xd8, 0xd9, 0x3e, 0xbb, 0xa8, 0x58, 0x2f, 0x2f, 0x2a, 0xd6, 0x3f,
-	0x17, 0x15, 0xeb, 0xb7, 0xcb, 0xca, 0xdc, 0xcb, 0xcb, 0xca, 0xdc, 0xdf, 0x97, 0x95, 0xb9, 0xe3,
-	0x61, 0xba, 0x43, 0xfa, 0xd4, 0x6f, 0x63, 0x1a, 0xd6, 0xb3, 0xef, 0x8c, 0x17, 0xea, 0x4b, 0x43,
-	0x71, 0xb6, 0x16, 0xd5, 0x17, 0xc4, 0xa7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x77, 0x31, 0xad,
-	0x76, 0x85, 0x0c, 0x00, 0x00,
+	// 1408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xb6, 0x2c, 0xdb, 0x89, 0x8e, 0x2e, 0xb6, 0x26, 0xb2, 0xcd, 0x38, 0x7f, 0x24, 0x9b, 0x3f,
+	0xda, 0x18, 0x28, 0x2a, 0x35, 0x69, 0xba, 0x68, 0x91, 0x8d, 0x6f, 0x6d, 0x53, 0x18, 0x89, 0xc2,
+	0xd4, 0x29, 0x90, 0x45, 0x09, 0x8a, 0x9c, 0x58, 0xd3, 0x8c, 0x38, 0x0c, 0x39, 0x54, 0xa2, 0x55,
+	0xbb, 0xea, 0xaa, 0x8b, 0x02, 0x05, 0xfa, 0x02, 0x5d, 0xf5, 0x2d, 0xba, 0xcc, 0x32, 0x5d, 0xb5,
+	0xc8, 0xc2, 0x28, 0x92, 0x37, 0xc8, 0x13, 0x14, 0x73, 0x21, 0x75, 0x8d, 0x1b, 0x6a, 0x25, 0xf2,
+	0xcc, 0x99, 0xef, 0xfb, 0x66, 0xce, 0x99, 0xc3, 0x33, 0x82, 0xad, 0x88, 0x3c, 0xf2, 0x99, 0x87,
+	0x5b, 0x2e, 0x0d, 0x5a, 0xfd, 0xeb, 0x2d, 0x3e, 0x08, 0x70, 0xd4, 0x0c, 0x42, 0xc6, 0x19, 0xaa,
+	0xe8, 0xb1, 0xa6, 0x4b, 0x83, 0x66, 0xff, 0xfa, 0x56, 0xed, 0x94, 0x9d, 0x32, 0x39, 0xd4, 0x12,
+	0x4f, 0xca, 0xcb, 0x6c, 0xc0, 0xf2, 0x5e, 0x14, 0x61, 0x8e, 0x36, 0x60, 0x25, 0x1a, 0xf4, 0x3a,
+	0x8c, 0x1a, 0xb9, 0xed, 0xdc, 0x6e, 0xc1, 0xd2, 0x6f, 0xe6, 0xf3, 0x35, 0x58, 0x6a, 0x33, 0x46,
+	0xd1, 0x2d, 0xa8, 0xe0, 0x67, 0x1c, 0x87, 0xbe, 0x43, 0x6d, 0x47, 0x4c, 0x91, 0x8e, 0xc5, 0x1b,
+	0xeb, 0xcd, 0x71, 0xa2, 0xa6, 0xc4, 0xb3, 0xca, 0x89, 0xb3, 0x82, 0xff, 0x21, 0x07, 0x35, 0xdf,
+	0xe1, 0xa4, 0x8f, 0xd5, 0x64, 0xbb, 0xe3, 0x50, 0xc7, 0x77, 0xb1, 0xb1, 0x28, 0xd8, 0xf6, 0xef,
+	0x3c, 0x3f, 0x6b, 0x2c, 0xbc, 0x3c, 0x6b, 0x5c, 0x3b, 0x25, 0xbc, 0x1b, 0x77, 0x9a, 0x2e, 0xeb,
+	0xb5, 0x5c, 0x16, 0xf5, 0x58, 0xa4, 0x7f, 0x3e, 0x8c, 0xbc, 0xc7, 0x7a, 0x79, 0x27, 0xc4, 0xe7,
+	0x6f, 0xce, 0x1a, 0x57, 0x06, 0x4e, 0x8f, 0x7e, 0x66, 0xce, 0x02, 0x35, 0x2d, 0xa4, 0xcc, 0x92,
+	0x7b, 0x5f, 0x19, 0xd1, 0x8f, 0x39, 0xd8, 0x18, 0x5f, 0x41, 0x2a, 0x22, 0x2f, 0x45, 0xb4, 0xb3,
+	0x8b, 0xb8, 0xaa, 0x44, 0xcc, 0x86, 0x35, 0xad, 0xda, 0xd8, 0x26, 0x24, 0x42, 0x5c, 0x80, 0x80,
+	0x31, 0x6a, 0xc7, 0x3e, 0xe1, 0x91, 0xb1, 0x24, 0xb9, 0x0f, 0xb3, 0x73, 0x57, 0x15, 0xf7, 0x10,
+	0xca, 0xb4, 0x0a, 0xe2, 0xe5, 0x44, 0x3c, 0xa3, 0x08, 0xaa, 0xd1, 0x53, 0x27, 0xb0, 0x83, 0x90,
+	0xb8, 0xd8, 0x56, 0xdb, 0x61, 0x2c, 0x4b, 0xae, 0x2f, 0x5e, 0x9e, 0x35, 0xde, 0x7f, 0x07, 0x9e,
+	0x43, 0xec, 0xbe, 0x39, 0x6b, 0x5c, 0x56, 0x34, 0x53, 0x60, 0xdb, 0xa6, 0xb5, 0x2a, 0x8c, 0x6d,
+	0x61, 0xbb, 0x23, 0x4d, 0x68, 0x00, 0x97, 0x46, 0xfc, 0x92, 0xc5, 0x1b, 0x2b, 0x92, 0xf6, 0x76,
+	0x26, 0xda, 0x2b, 0x53, 0xb4, 0x09, 0xdc, 0xb6, 0x69, 0x55, 0x53, 0xe2, 0x23, 0x6d, 0x44, 0xbf,
+	0xe5, 0x60, 0x3b, 0xc4, 0x4f, 0x9d, 0xd0, 0xb3, 0x03, 0x1c, 0x12, 0xe6, 0x69, 0x99, 0xb6, 0x47,
+	0x22, 0x1e, 0x92, 0x4e, 0xcc, 0xb1, 0x67, 0x5c, 0x90, 0x42, 0x1e, 0x66, 0xdf, 0xeb, 0x6b, 0x4a,
+	0xcd, 0x7f, 0x11, 0x98, 0xd6, 0x55, 0xe5, 0xd2, 0x96, 0x1e, 0x6a, 0x57, 0x0e, 0x87, 0xe3, 0xa8,
+	0x03, 0x69, 0x4a, 0xd8, 0x94, 0x38, 0x1d, 0x42, 0x09, 0x27, 0x38, 0x32, 0x2e, 0x4a, 0x61, 0xad,
+	0x8c, 0xc2, 0xac, 0x4b, 0x09, 0xd8, 0xf1, 0x10, 0x0b, 0x3d, 0x84, 0xb5, 0x94, 0xc3, 0x8d, 0x23,
+	0xce, 0xbc, 0x81, 0x51, 0x98, 0x0f, 0x7f, 0x35, 0x01, 0x3a, 0x50, 0x38, 0xe8, 0x5b, 0xd0, 0x27,
+	0x6b, 0x4c, 0x3d, 0xcc, 0x87, 0x5e, 0x55, 0x50, 0xa3, 0xda, 0x1f, 0x40, 0x45, 0xe3, 0x27, 0xca,
+	0x8b, 0xf3, 0x61, 0x97, 0x15, 0x4c, 0xa2, 0xfb, 0x73, 0x58, 0xe9, 0x62, 0x87, 0xf2, 0xae, 0x51,
+	0x92, 0x78, 0x4d, 0x8d, 0xf7, 0x8e, 0xf9, 0x68, 0xe9, 0xd9, 0xe8, 0x3e, 0x94, 0x89, 0xcf, 0x71,
+	0x88, 0x23, 0x6e, 0x87, 0x0e, 0xc7, 0x46, 0x79, 0x2e, 0xb8, 0x52, 0x02, 0x62, 0x39, 0x1c, 0xa3,
+	0xaf, 0xc0, 0xa4, 0x4e, 0xc4, 0xed, 0x2e, 0x26, 0xa7, 0x5d, 0x6e, 0x8f, 0x11, 0xd8, 0x2e, 0xeb,
+	0x05, 0x32, 0x77, 0x2b, 0xdb, 0xb9, 0xdd, 0xbc, 0x55, 0x17, 0x9e, 0x5f, 0x4a, 0xc7, 0xdb, 0x23,
+	0x18, 0x07, 0xda, 0x0b, 0xc5, 0x50, 0x8f, 0xfd, 0x08, 0x73, 0x4e, 0xb1, 0x67, 0xcf, 0x4c, 0xb5,
+	0xd5, 0xf9, 0x36, 0xf4, 0x7f, 0x29, 0xec, 0xd1, 0x8c, 0x9c, 0x7b, 0x02, 0xc3, 0x71, 0x7b, 0x46,
+	0x86, 0xac, 0xcd, 0x47, 0xba, 0x95, 0x82, 0xde, 0x99, 0x4a, 0x15, 0x17, 0xd6, 0x3b, 0x94, 0xb9,
+	0x8f, 0x87, 0xfb, 0xa5, 0x8b, 0x5c, 0x75, 0xce, 0xb3, 0x24, 0xd1, 0x92, 0x4d, 0xd5, 0x05, 0xed,
+	0x14, 0x36, 0x27, 0x48, 0xd2, 0xa2, 0x86, 0xe6, 0xa3, 0x59, 0x1f, 0xa3, 0x49, 0xcb, 0x17, 0x85,
+	0xd5, 0x61, 0x71, 0x91, 0xe5, 0xdc, 0xb8, 0xa4, 0x3e, 0x0c, 0x99, 0xaa, 0xe6, 0xc6, 0x64, 0x9d,
+	0x92, 0x50, 0xa6, 0x55, 0x4e, 0xcb, 0x92, 0xf8, 0x3a, 0x20, 0x0e, 0xd5, 0xd8, 0x77, 0xa9, 0x43,
+	0x7a, 0xd8, 0xb3, 0xd5, 0x50, 0x64, 0xd4, 0xd2, 0x8f, 0x43, 0x86, 0xc2, 0x68, 0x28, 0xc2, 0x29,
+	0x34, 0xd3, 0x5a, 0x4b, 0x6d, 0x96, 0x36, 0xfd, 0x95, 0x87, 0xea, 0x31, 0x79, 0x12, 0x13, 0x8f,
+	0xf0, 0x41, 0x3b, 0x64, 0x7d, 0xe2, 0xe1, 0x10, 0x7d, 0x00, 0xcb, 0xef, 0xd0, 0x4e, 0x28, 0x1f,
+	0xf4, 0x53, 0x0e, 0x0c, 0x9a, 0x40, 0xd8, 0x81, 0xc6, 0xd0, 0x5f, 0x52, 0xd5, 0x4a, 0x58, 0xd9,
+	0xab, 0x7b, 0x43, 0x2d, 0xe2, 0x6d, 0xc0, 0xa6, 0xb5, 0x41, 0x27, 0x65, 0xab, 0x8f, 0xec, 0x2d,
+	0xd8, 0x9a, 0x31, 0xc9, 0xf1, 0xbc, 0x10, 0x47, 0x91, 0xea, 0x2a, 0x2c, 0x63, 0x6a, 0xee, 0x9e,
+	0x1a, 0x47, 0x9f, 0xc2, 0x85, 0xd8, 0x17, 0xd9, 0x20, 0x9a, 0x80, 0xfc, 0x6e, 0xf1, 0x46, 0x63,
+	0x72, 0xed, 0xe9, 0x6e, 0x9d, 0x48, 0x3f, 0x2b, 0xf1, 0x17, 0xfb, 0xb0, 0x35, 0x11, 0x64, 0xdb,
+	0xed, 0x62, 0xf7, 0x71, 0xc0, 0x88, 0xcf, 0xf5, 0x77, 0xfe, 0x6e, 0xa6, 0xd4, 0xd9, 0x99, 0x99,
+	0x3a, 0x23, 0xa8, 0xa6, 0xb5, 0x39, 0x96, 0x45, 0x07, 0xc3, 0x91, 0xef, 0x61, 0x75, 0x42, 0x2a,
+	0x7a, 0x0f, 0x2a, 0x21, 0x7e, 0x12, 0xe3, 0xb4, 0xae, 0xc9, 0xf8, 0xe6, 0xad, 0xb2, 0xb6, 0xaa,
+	0x1a, 0x86, 0x8e, 0x60, 0x79, 0x34, 0x78, 0x99, 0x8f, 0x93, 0x9a, 0x6d, 0x9e, 0x40, 0xa1, 0xdd,
+	0xe3, 0xc1, 0x51, 0xc0, 0xdc, 0x2e, 0xfa, 0x3f, 0x94, 0xb1, 0x78, 0xb0, 0x5d, 0x16, 0x8b, 0x73,
+	0xa6, 0x99, 0x4b, 0xd2, 0x78, 0xa0, 0x6c, 0xc2, 0x49, 0x9d, 0xec, 0xc4, 0x69, 0x51, 0x39, 0x49,
+	0xa3, 0x76, 0x32, 0x6f, 0x40, 0xe1, 0x9b, 0x2e, 0xe1, 0xf8, 0x98, 0x44, 0x5c, 0xac, 0xa8, 0xef,
+	0x50, 0xe2, 0x39, 0x9c, 0x85, 0x36, 0x25, 0x91, 0x58, 0x51, 0x7e, 0xb7, 0x60, 0x95, 0x53, 0xab,
+	0x70, 0x33, 0xff, 0xcc, 0xc1, 0xfa, 0x54, 0x96, 0x1f, 0x3a, 0xdc, 0x41, 0x6d, 0x40, 0xd3, 0xd9,
+	0xa2, 0xd3, 0x7e, 0xe7, 0xad, 0xa1, 0x4f, 0x20, 0xac, 0xea, 0x54, 0x22, 0xa1, 0x8f, 0xce, 0x6b,
+	0xaa, 0x67, 0x36, 0xc1, 0x37, 0xcf, 0xef, 0x81, 0x67, 0x77, 0xac, 0xe6, 0xaf, 0x39, 0x28, 0x1e,
+	0xf5, 0xb1, 0xcf, 0xdb, 0x8c, 0x12, 0x77, 0x80, 0xae, 0x02, 0x60, 0xf1, 0x6a, 0x8b, 0x48, 0xe8,
+	0x0b, 0x43, 0x41, 0x5a, 0xbe, 0x1e, 0x04, 0x18, 0x7d, 0x02, 0x9b, 0x41, 0x8f, 0x07, 0x49, 0x9f,
+	0x14, 0x71, 0x27, 0xe4, 0xb6, 0xdc, 0x58, 0xad, 0xac, 0x26, 0x86, 0x55, 0x8f, 0x74, 0x5f, 0x0c,
+	0xee, 0xcb, 0x94, 0xb9, 0x0e, 0xeb, 0xa3, 0xd3, 0xb0, 0xef, 0xe9, 0x49, 0x4a, 0x1a, 0x1a, 0x4e,
+	0x3a, 0xf2, 0x3d, 0x39, 0xc5, 0xfc, 0x3d, 0x07, 0x25, 0x0b, 0xf7, 0x58, 0xdf, 0xa1, 0xf7, 0x62,
+	0x1c, 0x63, 0x54, 0x83, 0x65, 0x19, 0x50, 0x1d, 0x73, 0xf5, 0x82, 0x2a, 0xb0, 0x48, 0x3c, 0x1d,
+	0xe1, 0x45, 0xe2, 0xa1, 0x1d, 0x28, 0x29, 0x51, 0x3a, 0x35, 0xf3, 0x72, 0xa4, 0x28, 0x6d, 0x3a,
+	0x31, 0xdb, 0x50, 0xe4, 0x8c, 0x3b, 0xd4, 0xee, 0x3b, 0x34, 0xc6, 0xc6, 0xd2, 0x7c, 0xe9, 0x09,
+	0x12, 0xe3, 0x81, 0x80, 0x30, 0x7f, 0xc9, 0x03, 0x1c, 0x93, 0x1e, 0xe1, 0x77, 0x43, 0x11, 0x3b,
+	0xa5, 0x49, 0xc8, 0x5c, 0x92, 0x9a, 0x6a, 0xb0, 0xcc, 0x9e, 0xfa, 0x3a, 0x11, 0x0b, 0x96, 0x7a,
+	0x41, 0x37, 0x01, 0x22, 0xb1, 0xd1, 0xaa, 0x44, 0xe6, 0xcf, 0x2b, 0x91, 0x05, 0xe1, 0x28, 0x1f,
+	0xc5, 0x5d, 0x2d, 0xc4, 0x2e, 0x26, 0x7d, 0xec, 0xe9, 0x99, 0x4b, 0xe7, 0xde, 0xd5, 0x12, 0x67,
+	0x35, 0xbb, 0x0d, 0x45, 0xc5, 0xd9, 0x63, 0x71, 0x5a, 0x4c, 0xb2, 0x2f, 0x5d, 0xca, 0x91, 0x10,
+	0xe8, 0x1e, 0x94, 0xb8, 0x13, 0x9e, 0x62, 0xae, 0x5a, 0x79, 0x63, 0x65, 0xae, 0xae, 0xa9, 0xa8,
+	0x30, 0x64, 0xdf, 0x2f, 0x0f, 0xf9, 0xb3, 0x80, 0x84, 0x83, 0x24, 0x86, 0x17, 0xf4, 0x21, 0x97,
+	0x46, 0x1d, 0xc4, 0x1d, 0x28, 0x85, 0x98, 0x0f, 0x7d, 0x2e, 0xaa, 0x38, 0x4b, 0x9b, 0x72, 0x31,
+	0xff, 0xc8, 0xc3, 0x9a, 0x44, 0xdc, 0x73, 0xdd, 0xb8, 0x17, 0x53, 0x71, 0x8e, 0xdf, 0x76, 0x19,
+	0x16, 0x76, 0x8d, 0xa4, 0x72, 0x49, 0xbf, 0xa1, 0x47, 0xb0, 0xa9, 0x27, 0x8b, 0xb3, 0x38, 0x76,
+	0xe5, 0xca, 0xcf, 0xb5, 0xd4, 0xf5, 0x21, 0xdc, 0xe8, 0xfd, 0xea, 0x3b, 0xb8, 0x3c, 0xc5, 0x93,
+	0x36, 0x24, 0x4b, 0x73, 0x31, 0x6d, 0x4e, 0x30, 0xa5, 0x1d, 0xc9, 0x3d, 0x28, 0xcd, 0xb8, 0x3b,
+	0x66, 0x8e, 0x59, 0x30, 0x22, 0xff, 0x04, 0x2a, 0x33, 0x6f, 0x86, 0x59, 0x41, 0xcb, 0xc1, 0xa8,
+	0xd2, 0xfd, 0xbd, 0xe7, 0xaf, 0xea, 0xb9, 0x17, 0xaf, 0xea, 0xb9, 0x7f, 0x5e, 0xd5, 0x73, 0x3f,
+	0xbf, 0xae, 0x2f, 0xbc, 0x78, 0x5d, 0x5f, 0xf8, 0xfb, 0x75, 0x7d, 0xe1, 0xe1, 0x68, 0xb2, 0xde,
+	0x27, 0x8f, 0xdc, 0xae, 0x43, 0xfc, 0x56, 0xf2, 0x9f, 0xc9, 0x33, 0xf9, 0xaf, 0x89, 0x44, 0xed,
+	0xac, 0xc8, 0x7f, 0x43, 0x3e, 0xfe, 0x77, 0x00, 0x43, 0xa3, 0x36, 0x36, 0x51, 0x11, 0x00, 0x00,
 }
 
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -657,6 +833,34 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UnclaimedRewards != nil {
+		{
+			size := m.UnclaimedRewards.Size()
+			i -= size
+			if _, err := m.UnclaimedRewards.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.RewardPerUnit != nil {
+		{
+			size := m.RewardPerUnit.Size()
+			i -= size
+			if _, err := m.RewardPerUnit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	{
 		size := m.BlockInterestExternal.Size()
 		i -= size
@@ -867,6 +1071,18 @@ func (m *LiquidityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardPerUnitCheckpoint != nil {
+		{
+			size := m.RewardPerUnitCheckpoint.Size()
+			i -= size
+			if _, err := m.RewardPerUnitCheckpoint.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Unlocks) > 0 {
 		for iNdEx := len(m.Unlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1157,6 +1373,170 @@ func (m *RemovalQueue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TargetPrice.Size()
+		i -= size
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SentAmount.Size()
+		i -= size
+		if _, err := m.SentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ReceivedAsset != nil {
+		{
+			size, err := m.ReceivedAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SentAsset != nil {
+		{
+			size, err := m.SentAsset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceExternal.Size()
+		i -= size
+		if _, err := m.PriceExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceNative.Size()
+		i -= size
+		if _, err := m.PriceNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CumulativePriceExternal.Size()
+		i -= size
+		if _, err := m.CumulativePriceExternal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CumulativePriceNative.Size()
+		i -= size
+		if _, err := m.CumulativePriceNative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1230,6 +1610,14 @@ func (m *Pool) Size() (n int) {
 	n += 2 + l + sovTypes(uint64(l))
 	l = m.BlockInterestExternal.Size()
 	n += 2 + l + sovTypes(uint64(l))
+	if m.RewardPerUnit != nil {
+		l = m.RewardPerUnit.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.UnclaimedRewards != nil {
+		l = m.UnclaimedRewards.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1255,6 +1643,10 @@ func (m *LiquidityProvider) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.RewardPerUnitCheckpoint != nil {
+		l = m.RewardPerUnitCheckpoint.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1364,22 +1756,80 @@ func (m *RemovalQueue) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Asset) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
+func (m *LimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SentAsset != nil {
+		l = m.SentAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ReceivedAsset != nil {
+		l = m.ReceivedAsset.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.SentAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	if m.RetryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RetryHeight))
+	}
+	return n
+}
+
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.CumulativePriceNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CumulativePriceExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PriceNative.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PriceExternal.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Asset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -2084,6 +2534,78 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardPerUnit = &v
+			if err := m.RewardPerUnit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Uint
+			m.UnclaimedRewards = &v
+			if err := m.UnclaimedRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2270,6 +2792,42 @@ func (m *LiquidityProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUnitCheckpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RewardPerUnitCheckpoint = &v
+			if err := m.RewardPerUnitCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3001,6 +3559,522 @@ func (m *RemovalQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SentAsset == nil {
+				m.SentAsset = &Asset{}
+			}
+			if err := m.SentAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedAsset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceivedAsset == nil {
+				m.ReceivedAsset = &Asset{}
+			}
+			if err := m.ReceivedAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryHeight", wireType)
+			}
+			m.RetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePriceNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePriceExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceNative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceNative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceExternal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceExternal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
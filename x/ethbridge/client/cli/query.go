@@ -89,6 +89,29 @@ func GetPauseStatus() *cobra.Command {
 	return cmd
 }
 
+func GetCmdGetConsensusNeeded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consensus-needed",
+		Short: "Query the proportion of validator power needed for a prophecy to succeed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryConsensusNeededRequest{}
+			res, err := queryClient.GetConsensusNeeded(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdGetBlacklist() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "blacklist",
@@ -117,3 +140,112 @@ func GetCmdGetBlacklist() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdGetOutboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-transfer [nonce]",
+		Short: "Query the outbound transfer with the given nonce",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOutboundTransferRequest{Nonce: nonce}
+			res, err := queryClient.GetOutboundTransfer(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdGetOutboundTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbound-transfers",
+		Short: "Query outbound transfers in nonce order, optionally only those of a sender",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := cmd.Flags().GetString(types.FlagCosmosSender)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryOutboundTransfersRequest{
+				CosmosSender: sender,
+				Pagination:   pageReq,
+			}
+			res, err := queryClient.GetOutboundTransfers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(types.FlagCosmosSender, "", "Only query the outbound transfers of this sender")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "outbound-transfers")
+	return cmd
+}
+
+func GetCmdGetRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Query the bridge rate limits with their usage in the current window",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryRateLimitsRequest{}
+			res, err := queryClient.GetRateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdGetQueuedInboundTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-inbound-transfers",
+		Short: "Query the inbound transfers held back by rate limits",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.QueryQueuedInboundTransfersRequest{}
+			res, err := queryClient.GetQueuedInboundTransfers(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -452,3 +452,183 @@ func GetCmdPause() *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdSetConsensusNeeded() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-consensus-needed [consensus-needed]",
+		Short: "set the proportion of validator power needed for a prophecy to succeed, e.g. 0.7",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			consensusNeeded, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetConsensusNeeded(clientCtx.GetFromAddress(), consensusNeeded)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSetProphecyLifetime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-prophecy-lifetime [expiry-blocks] [retention-blocks]",
+		Short: "set how many blocks after creation pending prophecies fail and finalized prophecies are pruned, 0 disables either",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			expiryBlocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			retentionBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetProphecyLifetime(clientCtx.GetFromAddress(), expiryBlocks, retentionBlocks)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdSetRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-limit [denom] [inbound-limit] [outbound-limit] [window-blocks]",
+		Short: "set how much of a denom can be bridged in and out per window of blocks, 0 leaves a direction unlimited",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			inboundLimit, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return types.ErrInvalidAmount
+			}
+			outboundLimit, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return types.ErrInvalidAmount
+			}
+			windowBlocks, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetRateLimit(clientCtx.GetFromAddress(), args[0], inboundLimit, outboundLimit, windowBlocks)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdConfirmOutboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "confirm-outbound-transfer [nonce]",
+		Short: "confirm as the validator of the --from key that the outbound transfer with the given nonce was relayed to ethereum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			nonce, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConfirmOutboundTransfer(sdk.ValAddress(clientCtx.GetFromAddress()), nonce)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdReleaseQueuedInboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-queued-inbound-transfer [id]",
+		Short: "mint and send a queued inbound transfer regardless of the rate limit of its denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReleaseQueuedInboundTransfer(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCancelQueuedInboundTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-queued-inbound-transfer [id]",
+		Short: "drop a queued inbound transfer without minting it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelQueuedInboundTransfer(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -26,7 +26,12 @@ func GetQueryCmd() *cobra.Command {
 	ethBridgeQueryCmd.AddCommand(
 		cli.GetCmdGetEthBridgeProphecy(),
 		cli.GetCmdGetBlacklist(),
-		cli.GetPauseStatus())
+		cli.GetPauseStatus(),
+		cli.GetCmdGetConsensusNeeded(),
+		cli.GetCmdGetOutboundTransfer(),
+		cli.GetCmdGetOutboundTransfers(),
+		cli.GetCmdGetRateLimits(),
+		cli.GetCmdGetQueuedInboundTransfers())
 
 	return ethBridgeQueryCmd
 }
@@ -52,6 +57,12 @@ func GetTxCmd() *cobra.Command {
 		cli.GetCmdRescueCeth(),
 		cli.GetCmdSetBlacklist(),
 		cli.GetCmdPause(),
+		cli.GetCmdSetConsensusNeeded(),
+		cli.GetCmdSetProphecyLifetime(),
+		cli.GetCmdSetRateLimit(),
+		cli.GetCmdConfirmOutboundTransfer(),
+		cli.GetCmdReleaseQueuedInboundTransfer(),
+		cli.GetCmdCancelQueuedInboundTransfer(),
 	)
 
 	return ethBridgeTxCmd
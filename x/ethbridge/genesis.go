@@ -2,6 +2,7 @@ package ethbridge
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
@@ -29,19 +30,89 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 		}
 	}
 
+	for _, transfer := range data.OutboundTransfers {
+		keeper.SetOutboundTransfer(ctx, *transfer)
+	}
+	keeper.SetLastOutboundNonce(ctx, data.LastOutboundNonce)
+
+	for _, rateLimit := range data.RateLimits {
+		keeper.SetRateLimit(ctx, *rateLimit)
+	}
+	for _, usage := range data.RateLimitUsages {
+		keeper.SetRateLimitUsage(ctx, *usage)
+	}
+	for _, transfer := range data.QueuedInboundTransfers {
+		keeper.SetQueuedInboundTransfer(ctx, *transfer)
+	}
+	keeper.SetLastQueuedInboundID(ctx, data.LastQueuedInboundId)
+
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	peggyTokens := keeper.GetPeggyToken(ctx)
 	receiveAccount := keeper.GetCethReceiverAccount(ctx)
+	transfers := keeper.GetOutboundTransfers(ctx)
+	outboundTransfers := make([]*types.OutboundTransfer, len(transfers))
+	for i := range transfers {
+		outboundTransfers[i] = &transfers[i]
+	}
+	rateLimits := keeper.GetRateLimits(ctx)
+	exportedRateLimits := make([]*types.RateLimit, len(rateLimits))
+	for i := range rateLimits {
+		exportedRateLimits[i] = &rateLimits[i]
+	}
+	usages := keeper.GetRateLimitUsages(ctx)
+	exportedUsages := make([]*types.RateLimitUsage, len(usages))
+	for i := range usages {
+		exportedUsages[i] = &usages[i]
+	}
+	queued := keeper.GetQueuedInboundTransfers(ctx)
+	queuedTransfers := make([]*types.QueuedInboundTransfer, len(queued))
+	for i := range queued {
+		queuedTransfers[i] = &queued[i]
+	}
 
 	return &types.GenesisState{
-		PeggyTokens:        peggyTokens.Tokens,
-		CethReceiveAccount: receiveAccount.String(),
+		PeggyTokens:            peggyTokens.Tokens,
+		CethReceiveAccount:     receiveAccount.String(),
+		OutboundTransfers:      outboundTransfers,
+		LastOutboundNonce:      keeper.GetLastOutboundNonce(ctx),
+		RateLimits:             exportedRateLimits,
+		RateLimitUsages:        exportedUsages,
+		QueuedInboundTransfers: queuedTransfers,
+		LastQueuedInboundId:    keeper.GetLastQueuedInboundID(ctx),
 	}
 }
 
 func ValidateGenesis(data types.GenesisState) error {
+	nonces := make(map[uint64]bool, len(data.OutboundTransfers))
+	for _, transfer := range data.OutboundTransfers {
+		if transfer == nil || transfer.Nonce == 0 || transfer.Nonce > data.LastOutboundNonce || nonces[transfer.Nonce] {
+			return types.ErrInvalidOutboundTransfer
+		}
+		if _, err := sdk.AccAddressFromBech32(transfer.CosmosSender); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidOutboundTransfer, err.Error())
+		}
+		nonces[transfer.Nonce] = true
+	}
+	for _, rateLimit := range data.RateLimits {
+		if rateLimit == nil {
+			return types.ErrInvalidRateLimit
+		}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+	}
+	ids := make(map[uint64]bool, len(data.QueuedInboundTransfers))
+	for _, transfer := range data.QueuedInboundTransfers {
+		if transfer == nil || transfer.Id == 0 || transfer.Id > data.LastQueuedInboundId || ids[transfer.Id] {
+			return sdkerrors.Wrap(types.ErrInvalidRateLimit, "invalid queued inbound transfer")
+		}
+		if err := sdk.ValidateDenom(transfer.Denom); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidRateLimit, err.Error())
+		}
+		ids[transfer.Id] = true
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Sifchain/sifnode/x/ethbridge"
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -38,7 +39,23 @@ func TestInitGenesis(t *testing.T) {
 	assert.Equal(t, len(tokenslist.Tokens), tokenscount)
 	actualReceiver := keeper2.GetCethReceiverAccount(ctx2)
 	assert.Equal(t, receiver, actualReceiver.String())
+	assert.Equal(t, uint64(2), keeper2.GetLastOutboundNonce(ctx2))
+	assert.Equal(t, keeper1.GetOutboundTransfers(ctx1), keeper2.GetOutboundTransfers(ctx2))
+	assert.Equal(t, keeper1.GetRateLimits(ctx1), keeper2.GetRateLimits(ctx2))
+	assert.Equal(t, keeper1.GetRateLimitUsages(ctx1), keeper2.GetRateLimitUsages(ctx2))
+	assert.Equal(t, keeper1.GetQueuedInboundTransfers(ctx1), keeper2.GetQueuedInboundTransfers(ctx2))
+	assert.Equal(t, uint64(1), keeper2.GetLastQueuedInboundID(ctx2))
+	assert.Equal(t, uint64(3), keeper2.AddOutboundTransfer(ctx2, types.ClaimType_CLAIM_TYPE_LOCK, actualReceiver, 1, types.TestEthereumAddress, "rowan", sdk.NewInt(1), sdk.NewInt(1)))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	ctx, keeper := test.CreateTestAppEthBridge(false)
+	CreateState(ctx, keeper, t)
+	state := ethbridge.ExportGenesis(ctx, keeper)
+	assert.NoError(t, ethbridge.ValidateGenesis(*state))
 
+	state.LastOutboundNonce = 1
+	assert.ErrorIs(t, ethbridge.ValidateGenesis(*state), types.ErrInvalidOutboundTransfer)
 }
 
 func CreateState(ctx sdk.Context, keeper ethbridge.Keeper, t *testing.T) (int, string) {
@@ -59,5 +76,14 @@ func CreateState(ctx sdk.Context, keeper ethbridge.Keeper, t *testing.T) (int, s
 	set := keeper.IsCethReceiverAccount(ctx, receiver)
 	assert.True(t, set)
 
+	// Recording outbound transfers
+	keeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_LOCK, receiver, 1, types.TestEthereumAddress, "rowan", sdk.NewInt(10), sdk.NewInt(1))
+	keeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_BURN, receiver, 1, types.TestEthereumAddress, "ceth", sdk.NewInt(20), sdk.NewInt(1))
+
+	// Setting rate limits and queueing an inbound transfer
+	keeper.SetRateLimit(ctx, types.RateLimit{Denom: "ceth", InboundLimit: sdk.NewInt(10), OutboundLimit: sdk.ZeroInt(), WindowBlocks: 10})
+	keeper.SetRateLimitUsage(ctx, types.NewRateLimitUsage("ceth", ctx.BlockHeight()))
+	keeper.QueueInboundTransfer(ctx, receiver, sdk.NewCoin("ceth", sdk.NewInt(20)))
+
 	return tokenscount, receiver.String()
 }
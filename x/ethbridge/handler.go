@@ -41,6 +41,24 @@ func NewHandler(k Keeper) sdk.Handler {
 		case *types.MsgPause:
 			res, err := msgServer.SetPause(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetConsensusNeeded:
+			res, err := msgServer.SetConsensusNeeded(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProphecyLifetime:
+			res, err := msgServer.SetProphecyLifetime(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetRateLimit:
+			res, err := msgServer.SetRateLimit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConfirmOutboundTransfer:
+			res, err := msgServer.ConfirmOutboundTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReleaseQueuedInboundTransfer:
+			res, err := msgServer.ReleaseQueuedInboundTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelQueuedInboundTransfer:
+			res, err := msgServer.CancelQueuedInboundTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			errMsg := fmt.Sprintf("unrecognized ethbridge message type: %v", sdk.MsgTypeURL(msg))
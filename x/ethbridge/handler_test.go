@@ -290,6 +290,7 @@ func TestBurnEthSuccess(t *testing.T) {
 	eventAmount := ""
 	eventSymbol := ""
 	eventCethAmount := sdk.NewInt(0)
+	eventOutboundNonce := ""
 	for _, event := range res.Events {
 		for _, attribute := range event.Attributes {
 			value := string(attribute.Value)
@@ -337,12 +338,15 @@ func TestBurnEthSuccess(t *testing.T) {
 				var ok bool
 				eventCethAmount, ok = sdk.NewIntFromString(value)
 				require.Equal(t, ok, true)
+			case "outbound_nonce":
+				eventOutboundNonce = value
 			default:
 				require.Fail(t, fmt.Sprintf("unrecognized event %s", key))
 			}
 		}
 	}
 	require.Equal(t, eventEthereumChainID, strconv.Itoa(types.TestEthereumChainID))
+	require.Equal(t, eventOutboundNonce, "1")
 	require.Equal(t, eventCosmosSender, senderAddress.String())
 	require.Equal(t, eventCosmosSenderSequence, senderSequence)
 	require.Equal(t, eventEthereumReceiver, ethereumReceiver.String())
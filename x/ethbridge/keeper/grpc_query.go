@@ -8,6 +8,9 @@ import (
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = queryServer{}
@@ -20,6 +23,10 @@ func (srv queryServer) GetPauseStatus(ctx context.Context, _ *types.QueryPauseRe
 	return &types.QueryPauseResponse{IsPaused: srv.Keeper.IsPaused(sdk.UnwrapSDKContext(ctx))}, nil
 }
 
+func (srv queryServer) GetConsensusNeeded(ctx context.Context, _ *types.QueryConsensusNeededRequest) (*types.QueryConsensusNeededResponse, error) {
+	return &types.QueryConsensusNeededResponse{ConsensusNeeded: srv.Keeper.oracleKeeper.GetConsensusNeeded(sdk.UnwrapSDKContext(ctx))}, nil
+}
+
 func (srv queryServer) GetBlacklist(ctx context.Context, _ *types.QueryBlacklistRequest) (*types.QueryBlacklistResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -28,6 +35,66 @@ func (srv queryServer) GetBlacklist(ctx context.Context, _ *types.QueryBlacklist
 	return &types.QueryBlacklistResponse{Addresses: addresses}, nil
 }
 
+func (srv queryServer) GetOutboundTransfer(ctx context.Context, req *types.QueryOutboundTransferRequest) (*types.QueryOutboundTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	transfer, found := srv.Keeper.GetOutboundTransfer(sdk.UnwrapSDKContext(ctx), req.Nonce)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrOutboundTransferNotFound, strconv.FormatUint(req.Nonce, 10))
+	}
+	return &types.QueryOutboundTransferResponse{Transfer: &transfer}, nil
+}
+
+func (srv queryServer) GetOutboundTransfers(ctx context.Context, req *types.QueryOutboundTransfersRequest) (*types.QueryOutboundTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var transfers []types.OutboundTransfer
+	var pageRes *query.PageResponse
+	var err error
+	if req.CosmosSender == "" {
+		transfers, pageRes, err = srv.Keeper.GetOutboundTransfersPaginated(sdkCtx, req.Pagination)
+	} else {
+		sender, addrErr := sdk.AccAddressFromBech32(req.CosmosSender)
+		if addrErr != nil {
+			return nil, status.Error(codes.InvalidArgument, addrErr.Error())
+		}
+		transfers, pageRes, err = srv.Keeper.GetOutboundTransfersBySenderPaginated(sdkCtx, sender, req.Pagination)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryOutboundTransfersResponse{
+		Transfers:         make([]*types.OutboundTransfer, len(transfers)),
+		LastOutboundNonce: srv.Keeper.GetLastOutboundNonce(sdkCtx),
+		Pagination:        pageRes,
+	}
+	for i := range transfers {
+		res.Transfers[i] = &transfers[i]
+	}
+	return res, nil
+}
+
+func (srv queryServer) GetRateLimits(ctx context.Context, _ *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rateLimits := srv.Keeper.GetRateLimits(sdkCtx)
+	statuses := make([]types.RateLimitStatus, len(rateLimits))
+	for i, rateLimit := range rateLimits {
+		statuses[i] = types.RateLimitStatus{
+			RateLimit: rateLimit,
+			Usage:     srv.Keeper.GetCurrentRateLimitUsage(sdkCtx, rateLimit),
+		}
+	}
+	return &types.QueryRateLimitsResponse{RateLimits: statuses}, nil
+}
+
+func (srv queryServer) GetQueuedInboundTransfers(ctx context.Context, _ *types.QueryQueuedInboundTransfersRequest) (*types.QueryQueuedInboundTransfersResponse, error) {
+	transfers := srv.Keeper.GetQueuedInboundTransfers(sdk.UnwrapSDKContext(ctx))
+	return &types.QueryQueuedInboundTransfersResponse{Transfers: transfers}, nil
+}
+
 // NewQueryServer returns an implementation of the ethbridge QueryServer interface,
 // for the provided Keeper.
 func NewQueryServer(keeper Keeper) types.QueryServer {
@@ -53,7 +120,7 @@ func (srv queryServer) EthProphecy(ctx context.Context, req *types.QueryEthProph
 		req.Symbol,
 		types.NewEthereumAddress(req.TokenContractAddress),
 		types.NewEthereumAddress(req.EthereumSender),
-		prophecy.ValidatorClaims,
+		prophecy.Claims,
 		types.CreateEthClaimFromOracleString,
 	)
 	if err != nil {
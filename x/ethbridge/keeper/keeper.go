@@ -19,23 +19,25 @@ const errorMessageKey = "errorMessageKey"
 // Keeper maintains the link to data storage and
 // exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	cdc           codec.BinaryCodec // The wire codec for binary encoding/decoding.
-	accountKeeper types.AccountKeeper
-	bankKeeper    types.BankKeeper
-	oracleKeeper  types.OracleKeeper
-	adminKeeper   types.AdminKeeper
-	storeKey      sdk.StoreKey
+	cdc                 codec.BinaryCodec // The wire codec for binary encoding/decoding.
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	oracleKeeper        types.OracleKeeper
+	adminKeeper         types.AdminKeeper
+	tokenRegistryKeeper types.TokenRegistryKeeper
+	storeKey            sdk.StoreKey
 }
 
 // NewKeeper creates new instances of the oracle Keeper
-func NewKeeper(cdc codec.BinaryCodec, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper, accountKeeper types.AccountKeeper, adminKeeper types.AdminKeeper, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(cdc codec.BinaryCodec, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper, accountKeeper types.AccountKeeper, adminKeeper types.AdminKeeper, tokenRegistryKeeper types.TokenRegistryKeeper, storeKey sdk.StoreKey) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		oracleKeeper:  oracleKeeper,
-		adminKeeper:   adminKeeper,
-		storeKey:      storeKey,
+		cdc:                 cdc,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		oracleKeeper:        oracleKeeper,
+		adminKeeper:         adminKeeper,
+		tokenRegistryKeeper: tokenRegistryKeeper,
+		storeKey:            storeKey,
 	}
 }
 
@@ -66,19 +68,34 @@ func (k Keeper) ProcessSuccessfulClaim(ctx sdk.Context, claim string) error {
 		return err
 	}
 	receiverAddress := oracleClaim.CosmosReceiver
-	var coins sdk.Coins
+	var coin sdk.Coin
 	switch oracleClaim.ClaimType {
 	case types.ClaimType_CLAIM_TYPE_LOCK:
 		symbol := fmt.Sprintf("%v%v", types.PeggedCoinPrefix, oracleClaim.Symbol)
 		k.AddPeggyToken(ctx, symbol)
-		coins = sdk.NewCoins(sdk.NewCoin(symbol, oracleClaim.Amount))
-		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+		coin = sdk.NewCoin(symbol, oracleClaim.Amount)
 	case types.ClaimType_CLAIM_TYPE_BURN:
-		coins = sdk.NewCoins(sdk.NewCoin(oracleClaim.Symbol, oracleClaim.Amount))
-		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+		coin = sdk.NewCoin(oracleClaim.Symbol, oracleClaim.Amount)
 	default:
 		err = types.ErrInvalidClaimType
+		logger.Error("failed to process successful claim.",
+			errorMessageKey, err.Error())
+		return err
+	}
+	// Transfers over the inbound rate limit are held back until the limit allows them,
+	// as are transfers behind the ones already held back
+	if k.hasQueuedInboundTransfers(ctx, coin.Denom) {
+		logger.Info("queueing inbound transfer behind queued transfers.", "Denom", coin.Denom)
+		k.QueueInboundTransfer(ctx, receiverAddress, coin)
+		return nil
+	}
+	if err := k.consumeRateLimit(ctx, coin.Denom, coin.Amount, true); err != nil {
+		logger.Info("queueing inbound transfer over rate limit.", errorMessageKey, err.Error())
+		k.QueueInboundTransfer(ctx, receiverAddress, coin)
+		return nil
 	}
+	coins := sdk.NewCoins(coin)
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to process successful claim.",
 			errorMessageKey, err.Error())
@@ -92,13 +109,21 @@ func (k Keeper) ProcessSuccessfulClaim(ctx sdk.Context, claim string) error {
 	return nil
 }
 
-// ProcessBurn processes the burn of bridged coins from the given sender
-func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *types.MsgBurn) error {
+// ProcessBurn processes the burn of bridged coins from the given sender and records
+// the outbound transfer, returning its nonce
+func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *types.MsgBurn) (uint64, error) {
 	logger := k.Logger(ctx)
 	var coins sdk.Coins
 
 	if k.IsBlacklisted(ctx, msg.EthereumReceiver) {
-		return types.ErrInvalidEthAddress
+		return 0, types.ErrInvalidEthAddress
+	}
+
+	if err := k.checkTransferLimit(ctx, msg.Symbol, msg.Amount); err != nil {
+		return 0, err
+	}
+	if err := k.consumeRateLimit(ctx, msg.Symbol, msg.Amount, false); err != nil {
+		return 0, err
 	}
 
 	if k.IsCethReceiverAccountSet(ctx) {
@@ -107,7 +132,7 @@ func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		if err != nil {
 			logger.Error("failed to send ceth from account to account.",
 				errorMessageKey, err.Error())
-			return err
+			return 0, err
 		}
 		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	} else {
@@ -121,24 +146,33 @@ func (k Keeper) ProcessBurn(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 	if err != nil {
 		logger.Error("failed to send ceth from module to account.",
 			errorMessageKey, err.Error())
-		return err
+		return 0, err
 	}
 	coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to burn locked coin.",
 			errorMessageKey, err.Error())
-		return err
+		return 0, err
 	}
-	return nil
+	nonce := k.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_BURN, cosmosSender, msg.EthereumChainId, msg.EthereumReceiver, msg.Symbol, msg.Amount, msg.CethAmount)
+	return nonce, nil
 }
 
-// ProcessLock processes the lockup of cosmos coins from the given sender
-func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *types.MsgLock) error {
+// ProcessLock processes the lockup of cosmos coins from the given sender and records
+// the outbound transfer, returning its nonce
+func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *types.MsgLock) (uint64, error) {
 	logger := k.Logger(ctx)
 
 	if k.IsBlacklisted(ctx, msg.EthereumReceiver) {
-		return types.ErrInvalidEthAddress
+		return 0, types.ErrInvalidEthAddress
+	}
+
+	if err := k.checkTransferLimit(ctx, msg.Symbol, msg.Amount); err != nil {
+		return 0, err
+	}
+	if err := k.consumeRateLimit(ctx, msg.Symbol, msg.Amount, false); err != nil {
+		return 0, err
 	}
 
 	var coins sdk.Coins
@@ -148,7 +182,7 @@ func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 		if err != nil {
 			logger.Error("failed to send ceth from account to account.",
 				errorMessageKey, err.Error())
-			return err
+			return 0, err
 		}
 		coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	} else {
@@ -157,15 +191,16 @@ func (k Keeper) ProcessLock(ctx sdk.Context, cosmosSender sdk.AccAddress, msg *t
 	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, cosmosSender, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to transfer coin from account to module.", errorMessageKey, err.Error())
-		return err
+		return 0, err
 	}
 	coins = sdk.NewCoins(sdk.NewCoin(msg.Symbol, msg.Amount))
 	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
 	if err != nil {
 		logger.Error("failed to burn burned coin.", errorMessageKey, err.Error())
-		return err
+		return 0, err
 	}
-	return nil
+	nonce := k.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_LOCK, cosmosSender, msg.EthereumChainId, msg.EthereumReceiver, msg.Symbol, msg.Amount, msg.CethAmount)
+	return nonce, nil
 }
 
 // ProcessUpdateWhiteListValidator processes the update whitelist validator from admin
@@ -214,3 +249,13 @@ func (k Keeper) Exists(ctx sdk.Context, key []byte) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(key)
 }
+
+// checkTransferLimit checks an outbound amount against the transfer limit of its registry entry.
+// Denoms missing from the token registry are not limited
+func (k Keeper) checkTransferLimit(ctx sdk.Context, denom string, amount sdk.Int) error {
+	entry, err := k.tokenRegistryKeeper.GetRegistryEntry(ctx, denom)
+	if err != nil {
+		return nil
+	}
+	return entry.CheckTransferLimit(amount)
+}
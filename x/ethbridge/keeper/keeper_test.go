@@ -12,6 +12,7 @@ import (
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 )
 
 var (
@@ -196,8 +197,9 @@ func TestProcessBurn(t *testing.T) {
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err := keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	nonce, err := keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
 
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
 	require.Equal(t, receiverCoins.String(), string(""))
@@ -211,8 +213,9 @@ func TestProcessBurnCeth(t *testing.T) {
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err := keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	nonce, err := keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
 
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
 	require.Equal(t, receiverCoins.String(), string(""))
@@ -226,21 +229,51 @@ func TestProcessLock(t *testing.T) {
 
 	msg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 
-	err := keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	_, err := keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, amount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	nonce, err := keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), nonce)
 
 	receiverCoins = bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
 	require.Equal(t, receiverCoins.String(), string(""))
 
 }
 
+func TestProcessLockAndBurn_TransferLimit(t *testing.T) {
+	ctx, app := test.CreateSimulatorApp(false)
+	bridgeKeeper := app.EthbridgeKeeper
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:         "stake",
+		Decimals:      18,
+		TransferLimit: "5",
+	})
+	coins := sdk.NewCoins(sdk.NewCoin("stake", doubleAmount), sdk.NewCoin(types.CethSymbol, doubleAmount))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+
+	lockMsg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", sdk.NewInt(1))
+	_, err := bridgeKeeper.ProcessLock(ctx, cosmosReceivers[0], &lockMsg)
+	require.ErrorIs(t, err, tokenregistrytypes.ErrTransferLimitExceeded)
+	burnMsg := types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, "stake", sdk.NewInt(1))
+	_, err = bridgeKeeper.ProcessBurn(ctx, cosmosReceivers[0], &burnMsg)
+	require.ErrorIs(t, err, tokenregistrytypes.ErrTransferLimitExceeded)
+
+	lockMsg = types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, sdk.NewInt(5), "stake", sdk.NewInt(1))
+	_, err = bridgeKeeper.ProcessLock(ctx, cosmosReceivers[0], &lockMsg)
+	require.NoError(t, err)
+
+	// denoms missing from the registry are not limited
+	burnMsg = types.NewMsgBurn(1, cosmosReceivers[0], ethereumSender, amount, types.CethSymbol, sdk.NewInt(1))
+	_, err = bridgeKeeper.ProcessBurn(ctx, cosmosReceivers[0], &burnMsg)
+	require.NoError(t, err)
+}
+
 func TestProcessBurnWithReceiver(t *testing.T) {
 	ctx, keeper, bankKeeper, _, oracleKeeper, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
 	cosmosSender, err := sdk.AccAddressFromBech32(types.TestAddress)
@@ -252,7 +285,7 @@ func TestProcessBurnWithReceiver(t *testing.T) {
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	_, err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
 
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
@@ -270,7 +303,7 @@ func TestProcessBurnCethWithReceiver(t *testing.T) {
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
+	_, err = keeper.ProcessBurn(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
 
 	receiverCoins := bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
@@ -288,14 +321,14 @@ func TestProcessLockWithReceiver(t *testing.T) {
 
 	msg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", amount)
 
-	err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	_, err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", amount), sdk.NewCoin(types.CethSymbol, amount))
 	_ = bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 	_ = bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins)
 
-	err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	_, err = keeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
 	require.NoError(t, err)
 
 	receiverCoins = bankKeeper.GetAllBalances(ctx, cosmosReceivers[0])
@@ -40,6 +40,192 @@ func (srv msgServer) SetPause(goCtx context.Context, msg *types.MsgPause) (*type
 	return response, nil
 }
 
+func (srv msgServer) SetConsensusNeeded(goCtx context.Context, msg *types.MsgSetConsensusNeeded) (*types.MsgSetConsensusNeededResponse, error) {
+	response := &types.MsgSetConsensusNeededResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !srv.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_ETHBRIDGE, signer) {
+		return response, types.ErrNotEnoughPermissions
+	}
+	if err := oracletypes.ValidateConsensusNeeded(msg.ConsensusNeeded); err != nil {
+		return response, err
+	}
+
+	srv.Keeper.oracleKeeper.SetConsensusNeeded(ctx, msg.ConsensusNeeded)
+	srv.Keeper.Logger(ctx).Info("sifnode oracle consensus needed updated.", "ConsensusNeeded", msg.ConsensusNeeded.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetConsensusNeeded,
+			sdk.NewAttribute(types.AttributeKeyConsensusNeeded, msg.ConsensusNeeded.String()),
+		),
+	})
+
+	return response, nil
+}
+
+func (srv msgServer) SetProphecyLifetime(goCtx context.Context, msg *types.MsgSetProphecyLifetime) (*types.MsgSetProphecyLifetimeResponse, error) {
+	response := &types.MsgSetProphecyLifetimeResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !srv.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_ETHBRIDGE, signer) {
+		return response, types.ErrNotEnoughPermissions
+	}
+	if err := oracletypes.ValidateProphecyLifetime(msg.ProphecyLifetime); err != nil {
+		return response, err
+	}
+
+	srv.Keeper.oracleKeeper.SetProphecyLifetime(ctx, msg.ProphecyLifetime)
+	srv.Keeper.Logger(ctx).Info("sifnode oracle prophecy lifetime updated.",
+		"ExpiryBlocks", msg.ProphecyLifetime.ExpiryBlocks,
+		"RetentionBlocks", msg.ProphecyLifetime.RetentionBlocks)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetProphecyLifetime,
+			sdk.NewAttribute(types.AttributeKeyExpiryBlocks, strconv.FormatUint(msg.ProphecyLifetime.ExpiryBlocks, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetentionBlocks, strconv.FormatUint(msg.ProphecyLifetime.RetentionBlocks, 10)),
+		),
+	})
+
+	return response, nil
+}
+
+func (srv msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	response := &types.MsgSetRateLimitResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !srv.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_ETHBRIDGE, signer) {
+		return response, types.ErrNotEnoughPermissions
+	}
+
+	srv.Keeper.SetRateLimit(ctx, msg.RateLimit)
+	srv.Keeper.Logger(ctx).Info("sifnode ethbridge rate limit updated.",
+		"Denom", msg.RateLimit.Denom,
+		"InboundLimit", msg.RateLimit.InboundLimit.String(),
+		"OutboundLimit", msg.RateLimit.OutboundLimit.String(),
+		"WindowBlocks", msg.RateLimit.WindowBlocks)
+	return response, nil
+}
+
+func (srv msgServer) ReleaseQueuedInboundTransfer(goCtx context.Context, msg *types.MsgReleaseQueuedInboundTransfer) (*types.MsgReleaseQueuedInboundTransferResponse, error) {
+	response := &types.MsgReleaseQueuedInboundTransferResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !srv.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_ETHBRIDGE, signer) {
+		return response, types.ErrNotEnoughPermissions
+	}
+
+	if err := srv.Keeper.ForceReleaseQueuedInboundTransfer(ctx, msg.Id); err != nil {
+		return response, err
+	}
+	srv.Keeper.Logger(ctx).Info("sifnode ethbridge queued inbound transfer released.", "ID", msg.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+
+	return response, nil
+}
+
+func (srv msgServer) CancelQueuedInboundTransfer(goCtx context.Context, msg *types.MsgCancelQueuedInboundTransfer) (*types.MsgCancelQueuedInboundTransferResponse, error) {
+	response := &types.MsgCancelQueuedInboundTransferResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return response, err
+	}
+	if !srv.adminKeeper.IsAdminAccount(ctx, admintypes.AdminType_ETHBRIDGE, signer) {
+		return response, types.ErrNotEnoughPermissions
+	}
+
+	if err := srv.Keeper.CancelQueuedInboundTransfer(ctx, msg.Id); err != nil {
+		return response, err
+	}
+	srv.Keeper.Logger(ctx).Info("sifnode ethbridge queued inbound transfer cancelled.", "ID", msg.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer),
+	))
+
+	return response, nil
+}
+
+func (srv msgServer) ConfirmOutboundTransfer(goCtx context.Context, msg *types.MsgConfirmOutboundTransfer) (*types.MsgConfirmOutboundTransferResponse, error) {
+	response := &types.MsgConfirmOutboundTransferResponse{}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	logger := srv.Keeper.Logger(ctx)
+	validator, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return response, err
+	}
+
+	status, err := srv.Keeper.ProcessOutboundTransferConfirmation(ctx, validator, msg.Nonce)
+	if err != nil {
+		logger.Error("bridge keeper failed to process outbound transfer confirmation.",
+			errorMessageKey, err.Error())
+		return response, err
+	}
+	logger.Info("sifnode emit confirm outbound transfer event.",
+		"Validator", msg.ValidatorAddress,
+		"OutboundNonce", msg.Nonce,
+		"Status", status.Text.String())
+
+	nonce := strconv.FormatUint(msg.Nonce, 10)
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			types.EventTypeConfirmOutboundTransfer,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOutboundNonce, nonce),
+		),
+		sdk.NewEvent(
+			types.EventTypeProphecyStatus,
+			sdk.NewAttribute(types.AttributeKeyStatus, status.Text.String()),
+		),
+	}
+	if status.Text == oracletypes.StatusText_STATUS_TEXT_SUCCESS {
+		events = events.AppendEvent(sdk.NewEvent(
+			types.EventTypeOutboundTransferStatus,
+			sdk.NewAttribute(types.AttributeKeyOutboundNonce, nonce),
+			sdk.NewAttribute(types.AttributeKeyStatus, types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return response, nil
+}
+
 func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.MsgLockResponse, error) {
 	response := &types.MsgLockResponse{}
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -61,10 +247,12 @@ func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.Msg
 		logger.Error("account is nil.", "CosmosSender", msg.CosmosSender)
 		return response, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
 	}
-	if err := srv.Keeper.ProcessLock(ctx, cosmosSender, msg); err != nil {
+	nonce, err := srv.Keeper.ProcessLock(ctx, cosmosSender, msg)
+	if err != nil {
 		logger.Error("bridge keeper failed to process lock.", errorMessageKey, err.Error())
 		return response, err
 	}
+	response.Nonce = nonce
 	logger.Info("sifnode emit lock event.",
 		"EthereumChainID", strconv.FormatInt(msg.EthereumChainId, 10),
 		"CosmosSender", msg.CosmosSender,
@@ -89,6 +277,7 @@ func (srv msgServer) Lock(goCtx context.Context, msg *types.MsgLock) (*types.Msg
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyOutboundNonce, strconv.FormatUint(nonce, 10)),
 		),
 	})
 
@@ -119,10 +308,12 @@ func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.Msg
 		return response, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.CosmosSender)
 	}
 
-	if err := srv.Keeper.ProcessBurn(ctx, cosmosSender, msg); err != nil {
+	nonce, err := srv.Keeper.ProcessBurn(ctx, cosmosSender, msg)
+	if err != nil {
 		logger.Error("bridge keeper failed to process burn.", errorMessageKey, err.Error())
 		return response, err
 	}
+	response.Nonce = nonce
 
 	logger.Info("sifnode emit burn event.",
 		"EthereumChainID", strconv.FormatInt(msg.EthereumChainId, 10),
@@ -148,6 +339,7 @@ func (srv msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.Msg
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
 			sdk.NewAttribute(types.AttributeKeyCethAmount, msg.CethAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyOutboundNonce, strconv.FormatUint(nonce, 10)),
 		),
 	})
 
@@ -7,6 +7,7 @@ import (
 	ethbriddgeKeeper "github.com/Sifchain/sifnode/x/ethbridge/keeper"
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -258,3 +259,137 @@ func TestSetPauseWithNonAdminFails(t *testing.T) {
 	_, err := msgServer.SetPause(sdk.WrapSDKContext(ctx), &msgPause)
 	require.Error(t, err, types.ErrNotEnoughPermissions)
 }
+
+func TestMsgServer_SetConsensusNeeded(t *testing.T) {
+	ctx, app := test.CreateSimulatorApp(false)
+	addresses, _ := test.CreateTestAddrs(2)
+	admin := addresses[0]
+	nonAdmin := addresses[1]
+	app.AdminKeeper.SetAdminAccount(ctx, &adminTypes.AdminAccount{
+		AdminType:    adminTypes.AdminType_ETHBRIDGE,
+		AdminAddress: admin.String(),
+	})
+	msgServer := ethbriddgeKeeper.NewMsgServerImpl(app.EthbridgeKeeper)
+	queryServer := ethbriddgeKeeper.NewQueryServer(app.EthbridgeKeeper)
+
+	res, err := queryServer.GetConsensusNeeded(sdk.WrapSDKContext(ctx), &types.QueryConsensusNeededRequest{})
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.DefaultConsensusNeeded.String(), res.ConsensusNeeded.String())
+
+	msgNonAdmin := types.NewMsgSetConsensusNeeded(nonAdmin, sdk.NewDecWithPrec(8, 1))
+	_, err = msgServer.SetConsensusNeeded(sdk.WrapSDKContext(ctx), &msgNonAdmin)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+
+	msgInvalid := types.NewMsgSetConsensusNeeded(admin, sdk.NewDecWithPrec(11, 1))
+	_, err = msgServer.SetConsensusNeeded(sdk.WrapSDKContext(ctx), &msgInvalid)
+	require.ErrorIs(t, err, oracletypes.ErrMinimumConsensusNeededInvalid)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgSetConsensusNeeded(admin, sdk.NewDecWithPrec(8, 1))
+	_, err = msgServer.SetConsensusNeeded(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	var emitted string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSetConsensusNeeded {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyConsensusNeeded {
+				emitted = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, "0.800000000000000000", emitted)
+
+	res, err = queryServer.GetConsensusNeeded(sdk.WrapSDKContext(ctx), &types.QueryConsensusNeededRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "0.800000000000000000", res.ConsensusNeeded.String())
+}
+
+func TestMsgServer_SetProphecyLifetime(t *testing.T) {
+	ctx, app := test.CreateSimulatorApp(false)
+	addresses, _ := test.CreateTestAddrs(2)
+	admin := addresses[0]
+	nonAdmin := addresses[1]
+	app.AdminKeeper.SetAdminAccount(ctx, &adminTypes.AdminAccount{
+		AdminType:    adminTypes.AdminType_ETHBRIDGE,
+		AdminAddress: admin.String(),
+	})
+	msgServer := ethbriddgeKeeper.NewMsgServerImpl(app.EthbridgeKeeper)
+
+	msgNonAdmin := types.NewMsgSetProphecyLifetime(nonAdmin, 10, 20)
+	_, err := msgServer.SetProphecyLifetime(sdk.WrapSDKContext(ctx), &msgNonAdmin)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	require.Equal(t, oracletypes.DefaultProphecyLifetime, app.OracleKeeper.GetProphecyLifetime(ctx))
+
+	msgInvalid := types.NewMsgSetProphecyLifetime(admin, 0, 20)
+	_, err = msgServer.SetProphecyLifetime(sdk.WrapSDKContext(ctx), &msgInvalid)
+	require.ErrorIs(t, err, oracletypes.ErrInvalidProphecyLifetime)
+	require.Equal(t, oracletypes.DefaultProphecyLifetime, app.OracleKeeper.GetProphecyLifetime(ctx))
+
+	msg := types.NewMsgSetProphecyLifetime(admin, 10, 20)
+	_, err = msgServer.SetProphecyLifetime(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.ProphecyLifetime{ExpiryBlocks: 10, RetentionBlocks: 20}, app.OracleKeeper.GetProphecyLifetime(ctx))
+}
+
+func TestMsgServer_SetRateLimit(t *testing.T) {
+	ctx, app := test.CreateSimulatorApp(false)
+	addresses, _ := test.CreateTestAddrs(2)
+	admin := addresses[0]
+	nonAdmin := addresses[1]
+	app.AdminKeeper.SetAdminAccount(ctx, &adminTypes.AdminAccount{
+		AdminType:    adminTypes.AdminType_ETHBRIDGE,
+		AdminAddress: admin.String(),
+	})
+	msgServer := ethbriddgeKeeper.NewMsgServerImpl(app.EthbridgeKeeper)
+
+	msgNonAdmin := types.NewMsgSetRateLimit(nonAdmin, "ceth", sdk.NewInt(100), sdk.NewInt(200), 10)
+	_, err := msgServer.SetRateLimit(sdk.WrapSDKContext(ctx), &msgNonAdmin)
+	require.ErrorIs(t, err, types.ErrNotEnoughPermissions)
+	_, found := app.EthbridgeKeeper.GetRateLimit(ctx, "ceth")
+	require.False(t, found)
+
+	msg := types.NewMsgSetRateLimit(admin, "ceth", sdk.NewInt(100), sdk.NewInt(200), 10)
+	_, err = msgServer.SetRateLimit(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	rateLimit, found := app.EthbridgeKeeper.GetRateLimit(ctx, "ceth")
+	require.True(t, found)
+	require.Equal(t, msg.RateLimit, rateLimit)
+}
+
+func TestMsgServer_ConfirmOutboundTransfer(t *testing.T) {
+	ctx, keeper, _, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	msgServer := ethbriddgeKeeper.NewMsgServerImpl(keeper)
+	sender, _ := test.CreateTestAddrs(1)
+	nonce := keeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_BURN, sender[0], 1, types.TestEthereumAddress, "ceth", sdk.NewInt(10), sdk.NewInt(1))
+
+	completedStatus := func(ctx sdk.Context) string {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeOutboundTransferStatus {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyStatus {
+					return string(attr.Value)
+				}
+			}
+		}
+		return ""
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msg := types.NewMsgConfirmOutboundTransfer(validatorAddresses[0], nonce)
+	_, err := msgServer.ConfirmOutboundTransfer(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.Empty(t, completedStatus(ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	msg = types.NewMsgConfirmOutboundTransfer(validatorAddresses[1], nonce)
+	_, err = msgServer.ConfirmOutboundTransfer(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.Equal(t, types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED.String(), completedStatus(ctx))
+	transfer, found := keeper.GetOutboundTransfer(ctx, nonce)
+	require.True(t, found)
+	require.Equal(t, types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED, transfer.Status)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// GetLastOutboundNonce returns the nonce of the latest outbound transfer, 0 if there is none yet
+func (k Keeper) GetLastOutboundNonce(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastOutboundNonceKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastOutboundNonce(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastOutboundNonceKey, sdk.Uint64ToBigEndian(nonce))
+}
+
+// AddOutboundTransfer records a pending outbound transfer under the next nonce and returns it
+func (k Keeper) AddOutboundTransfer(ctx sdk.Context, claimType types.ClaimType, cosmosSender sdk.AccAddress, ethereumChainID int64, ethereumReceiver string, symbol string, amount sdk.Int, cethAmount sdk.Int) uint64 {
+	nonce := k.GetLastOutboundNonce(ctx) + 1
+	var sequence uint64
+	if account := k.accountKeeper.GetAccount(ctx, cosmosSender); account != nil {
+		sequence = account.GetSequence()
+	}
+	k.SetOutboundTransfer(ctx, types.OutboundTransfer{
+		Nonce:                nonce,
+		ClaimType:            claimType,
+		CosmosSender:         cosmosSender.String(),
+		EthereumChainId:      ethereumChainID,
+		EthereumReceiver:     ethereumReceiver,
+		Symbol:               symbol,
+		Amount:               amount,
+		CethAmount:           cethAmount,
+		BlockHeight:          ctx.BlockHeight(),
+		Status:               types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_PENDING,
+		CosmosSenderSequence: sequence,
+	})
+	k.SetLastOutboundNonce(ctx, nonce)
+	return nonce
+}
+
+// SetOutboundTransfer stores an outbound transfer and indexes it under its sender, and under its
+// completion height once completed
+func (k Keeper) SetOutboundTransfer(ctx sdk.Context, transfer types.OutboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOutboundTransferKey(transfer.Nonce), k.cdc.MustMarshal(&transfer))
+	sender := sdk.MustAccAddressFromBech32(transfer.CosmosSender)
+	store.Set(types.GetOutboundTransferBySenderKey(sender, transfer.Nonce), []byte{})
+	if transfer.Status == types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED {
+		store.Set(types.GetCompletedOutboundTransferKey(transfer.CompletedHeight, transfer.Nonce), []byte{})
+	}
+}
+
+// DeleteOutboundTransfer removes an outbound transfer and its indexes
+func (k Keeper) DeleteOutboundTransfer(ctx sdk.Context, transfer types.OutboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutboundTransferKey(transfer.Nonce))
+	sender := sdk.MustAccAddressFromBech32(transfer.CosmosSender)
+	store.Delete(types.GetOutboundTransferBySenderKey(sender, transfer.Nonce))
+	store.Delete(types.GetCompletedOutboundTransferKey(transfer.CompletedHeight, transfer.Nonce))
+}
+
+// PruneCompletedOutboundTransfers deletes the outbound transfers completed OutboundTransferRetentionBlocks
+// ago or earlier, at most MaxOutboundTransfersPrunedPerBlock of them, the rest are pruned in the next blocks
+func (k Keeper) PruneCompletedOutboundTransfers(ctx sdk.Context) {
+	pruneHeight := ctx.BlockHeight() - types.OutboundTransferRetentionBlocks
+	if pruneHeight <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.CompletedOutboundTransferPrefix, types.GetCompletedOutboundTransferKey(pruneHeight+1, 0))
+	var nonces []uint64
+	for ; iterator.Valid() && len(nonces) < types.MaxOutboundTransfersPrunedPerBlock; iterator.Next() {
+		nonces = append(nonces, types.GetNonceFromOutboundTransferKey(iterator.Key()))
+	}
+	iterator.Close()
+	for _, nonce := range nonces {
+		transfer, found := k.GetOutboundTransfer(ctx, nonce)
+		if !found {
+			continue
+		}
+		k.DeleteOutboundTransfer(ctx, transfer)
+	}
+}
+
+// ProcessOutboundTransferConfirmation records a validator's confirmation that it relayed an outbound
+// transfer to ethereum, once the confirmations reach consensus the transfer is completed
+func (k Keeper) ProcessOutboundTransferConfirmation(ctx sdk.Context, validator sdk.ValAddress, nonce uint64) (oracletypes.Status, error) {
+	transfer, found := k.GetOutboundTransfer(ctx, nonce)
+	if !found {
+		return oracletypes.Status{}, types.ErrOutboundTransferNotFound
+	}
+	claim := oracletypes.NewClaim(types.GetOutboundTransferProphecyID(nonce), validator.String(), strconv.FormatUint(nonce, 10))
+	status, err := k.oracleKeeper.ProcessClaim(ctx, claim)
+	if err != nil {
+		return oracletypes.Status{}, err
+	}
+	if status.Text == oracletypes.StatusText_STATUS_TEXT_SUCCESS {
+		transfer.Status = types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED
+		transfer.CompletedHeight = ctx.BlockHeight()
+		k.SetOutboundTransfer(ctx, transfer)
+	}
+	return status, nil
+}
+
+func (k Keeper) GetOutboundTransfer(ctx sdk.Context, nonce uint64) (types.OutboundTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutboundTransferKey(nonce))
+	if bz == nil {
+		return types.OutboundTransfer{}, false
+	}
+	var transfer types.OutboundTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetOutboundTransfers returns all outbound transfers in nonce order
+func (k Keeper) GetOutboundTransfers(ctx sdk.Context) []types.OutboundTransfer {
+	var transfers []types.OutboundTransfer
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OutboundTransferPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.OutboundTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// GetOutboundTransfersPaginated returns a page of outbound transfers in nonce order
+func (k Keeper) GetOutboundTransfersPaginated(ctx sdk.Context, pagination *query.PageRequest) ([]types.OutboundTransfer, *query.PageResponse, error) {
+	var transfers []types.OutboundTransfer
+	transferStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutboundTransferPrefix)
+	pageRes, err := query.Paginate(transferStore, pagination, func(_ []byte, value []byte) error {
+		var transfer types.OutboundTransfer
+		if err := k.cdc.Unmarshal(value, &transfer); err != nil {
+			return err
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	return transfers, pageRes, err
+}
+
+// GetOutboundTransfersBySenderPaginated returns a page of the outbound transfers of a sender in nonce order
+func (k Keeper) GetOutboundTransfersBySenderPaginated(ctx sdk.Context, sender sdk.AccAddress, pagination *query.PageRequest) ([]types.OutboundTransfer, *query.PageResponse, error) {
+	var transfers []types.OutboundTransfer
+	senderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutboundTransferBySenderPrefix(sender))
+	pageRes, err := query.Paginate(senderStore, pagination, func(key []byte, _ []byte) error {
+		transfer, found := k.GetOutboundTransfer(ctx, types.GetNonceFromOutboundTransferKey(key))
+		if !found {
+			return types.ErrOutboundTransferNotFound
+		}
+		transfers = append(transfers, transfer)
+		return nil
+	})
+	return transfers, pageRes, err
+}
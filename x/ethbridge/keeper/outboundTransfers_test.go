@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestOutboundTransfers(t *testing.T) {
+	ctx, app := test.CreateSimulatorApp(false)
+	bridgeKeeper := app.EthbridgeKeeper
+	senders, _ := test.CreateTestAddrs(2)
+	require.Equal(t, uint64(0), bridgeKeeper.GetLastOutboundNonce(ctx))
+
+	ctx = ctx.WithBlockHeight(5)
+	require.Equal(t, uint64(1), bridgeKeeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_LOCK, senders[0], 1, types.TestEthereumAddress, "rowan", sdk.NewInt(10), sdk.NewInt(1)))
+	require.Equal(t, uint64(2), bridgeKeeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_BURN, senders[1], 1, types.TestEthereumAddress, "ceth", sdk.NewInt(20), sdk.NewInt(1)))
+	require.Equal(t, uint64(3), bridgeKeeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_BURN, senders[0], 1, types.TestEthereumAddress, "cusdc", sdk.NewInt(30), sdk.NewInt(1)))
+	require.Equal(t, uint64(3), bridgeKeeper.GetLastOutboundNonce(ctx))
+
+	transfer, found := bridgeKeeper.GetOutboundTransfer(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.OutboundTransfer{
+		Nonce:            2,
+		ClaimType:        types.ClaimType_CLAIM_TYPE_BURN,
+		CosmosSender:     senders[1].String(),
+		EthereumChainId:  1,
+		EthereumReceiver: types.TestEthereumAddress,
+		Symbol:           "ceth",
+		Amount:           sdk.NewInt(20),
+		CethAmount:       sdk.NewInt(1),
+		BlockHeight:      5,
+		Status:           types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_PENDING,
+	}, transfer)
+	_, found = bridgeKeeper.GetOutboundTransfer(ctx, 4)
+	require.False(t, found)
+
+	queryServer := keeper.NewQueryServer(bridgeKeeper)
+	res, err := queryServer.GetOutboundTransfer(sdk.WrapSDKContext(ctx), &types.QueryOutboundTransferRequest{Nonce: 3})
+	require.NoError(t, err)
+	require.Equal(t, "cusdc", res.Transfer.Symbol)
+	_, err = queryServer.GetOutboundTransfer(sdk.WrapSDKContext(ctx), &types.QueryOutboundTransferRequest{Nonce: 4})
+	require.ErrorIs(t, err, types.ErrOutboundTransferNotFound)
+
+	all, err := queryServer.GetOutboundTransfers(sdk.WrapSDKContext(ctx), &types.QueryOutboundTransfersRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), all.LastOutboundNonce)
+	require.Len(t, all.Transfers, 2)
+	require.Equal(t, uint64(1), all.Transfers[0].Nonce)
+	require.Equal(t, uint64(2), all.Transfers[1].Nonce)
+	require.NotNil(t, all.Pagination.NextKey)
+
+	bySender, err := queryServer.GetOutboundTransfers(sdk.WrapSDKContext(ctx), &types.QueryOutboundTransfersRequest{
+		CosmosSender: senders[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, bySender.Transfers, 2)
+	require.Equal(t, uint64(1), bySender.Transfers[0].Nonce)
+	require.Equal(t, uint64(3), bySender.Transfers[1].Nonce)
+}
+
+func TestProcessOutboundTransferConfirmation(t *testing.T) {
+	ctx, bridgeKeeper, _, _, _, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	sender := cosmosReceivers[0]
+	nonce := bridgeKeeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_LOCK, sender, 1, types.TestEthereumAddress, "rowan", sdk.NewInt(10), sdk.NewInt(1))
+
+	_, err := bridgeKeeper.ProcessOutboundTransferConfirmation(ctx, validatorAddresses[0], nonce+1)
+	require.ErrorIs(t, err, types.ErrOutboundTransferNotFound)
+
+	status, err := bridgeKeeper.ProcessOutboundTransferConfirmation(ctx, validatorAddresses[0], nonce)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_PENDING, status.Text)
+	_, found := bridgeKeeper.GetOutboundTransfer(ctx, nonce)
+	require.True(t, found)
+	_, err = bridgeKeeper.ProcessOutboundTransferConfirmation(ctx, validatorAddresses[0], nonce)
+	require.ErrorIs(t, err, oracletypes.ErrDuplicateMessage)
+
+	status, err = bridgeKeeper.ProcessOutboundTransferConfirmation(ctx, validatorAddresses[1], nonce)
+	require.NoError(t, err)
+	require.Equal(t, oracletypes.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+	transfer, found := bridgeKeeper.GetOutboundTransfer(ctx, nonce)
+	require.True(t, found)
+	require.Equal(t, types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED, transfer.Status)
+	require.Equal(t, ctx.BlockHeight(), transfer.CompletedHeight)
+	bySender, _, err := bridgeKeeper.GetOutboundTransfersBySenderPaginated(ctx, sender, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.OutboundTransfer{transfer}, bySender)
+	require.Equal(t, nonce, bridgeKeeper.GetLastOutboundNonce(ctx))
+
+	_, err = bridgeKeeper.ProcessOutboundTransferConfirmation(ctx, validatorAddresses[1], nonce)
+	require.ErrorIs(t, err, oracletypes.ErrProphecyFinalized)
+}
+
+func TestPruneCompletedOutboundTransfers(t *testing.T) {
+	ctx, bridgeKeeper, _, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	sender := cosmosReceivers[0]
+	for i := 0; i < types.MaxOutboundTransfersPrunedPerBlock+2; i++ {
+		bridgeKeeper.AddOutboundTransfer(ctx, types.ClaimType_CLAIM_TYPE_LOCK, sender, 1, types.TestEthereumAddress, "rowan", sdk.NewInt(10), sdk.NewInt(1))
+	}
+	// every transfer but the last one completes
+	for nonce := uint64(1); nonce <= types.MaxOutboundTransfersPrunedPerBlock+1; nonce++ {
+		transfer, found := bridgeKeeper.GetOutboundTransfer(ctx, nonce)
+		require.True(t, found)
+		transfer.Status = types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED
+		transfer.CompletedHeight = 10
+		bridgeKeeper.SetOutboundTransfer(ctx, transfer)
+	}
+
+	bridgeKeeper.PruneCompletedOutboundTransfers(ctx.WithBlockHeight(types.OutboundTransferRetentionBlocks + 9))
+	require.Len(t, bridgeKeeper.GetOutboundTransfers(ctx), types.MaxOutboundTransfersPrunedPerBlock+2)
+
+	// pruning is bounded per block
+	bridgeKeeper.PruneCompletedOutboundTransfers(ctx.WithBlockHeight(types.OutboundTransferRetentionBlocks + 10))
+	require.Len(t, bridgeKeeper.GetOutboundTransfers(ctx), 2)
+	bridgeKeeper.PruneCompletedOutboundTransfers(ctx.WithBlockHeight(types.OutboundTransferRetentionBlocks + 11))
+	transfers := bridgeKeeper.GetOutboundTransfers(ctx)
+	require.Len(t, transfers, 1)
+	require.Equal(t, types.OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_PENDING, transfers[0].Status)
+	bySender, _, err := bridgeKeeper.GetOutboundTransfersBySenderPaginated(ctx, sender, nil)
+	require.NoError(t, err)
+	require.Equal(t, transfers, bySender)
+}
@@ -25,6 +25,8 @@ func NewLegacyQuerier(keeper Keeper, cdc *codec.LegacyAmino) sdk.Querier { //nol
 			return legacyQueryBlacklist(ctx, cdc, req, keeper)
 		case types.QueryPause:
 			return legacyQueryPause(ctx, cdc, req, keeper)
+		case types.QueryConsensusNeeded:
+			return legacyQueryConsensusNeeded(ctx, cdc, req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown ethbridge query endpoint")
 		}
@@ -75,3 +77,16 @@ func legacyQueryPause(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.Reques
 	}
 	return cdc.MarshalJSONIndent(response, "", "  ")
 }
+
+func legacyQueryConsensusNeeded(ctx sdk.Context, cdc *codec.LegacyAmino, query abci.RequestQuery, keeper Keeper) ([]byte, error) { //nolint
+	var req types.QueryConsensusNeededRequest
+	if err := cdc.UnmarshalJSON(query.Data, &req); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrJSONMarshalling, fmt.Sprintf("failed to parse req: %s", err.Error()))
+	}
+	queryServer := NewQueryServer(keeper)
+	response, err := queryServer.GetConsensusNeeded(sdk.WrapSDKContext(ctx), &req)
+	if err != nil {
+		return nil, err
+	}
+	return cdc.MarshalJSONIndent(response, "", "  ")
+}
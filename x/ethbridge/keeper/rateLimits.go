@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetRateLimit stores the rate limit of a denom, an unlimited rate limit removes it
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	if rateLimit.IsUnlimited() {
+		store.Delete(types.GetRateLimitKey(rateLimit.Denom))
+		store.Delete(types.GetRateLimitUsageKey(rateLimit.Denom))
+		return
+	}
+	store.Set(types.GetRateLimitKey(rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitKey(denom))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RateLimitPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+func (k Keeper) SetRateLimitUsage(ctx sdk.Context, usage types.RateLimitUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRateLimitUsageKey(usage.Denom), k.cdc.MustMarshal(&usage))
+}
+
+func (k Keeper) GetRateLimitUsages(ctx sdk.Context) []types.RateLimitUsage {
+	var usages []types.RateLimitUsage
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RateLimitUsagePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.RateLimitUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// GetCurrentRateLimitUsage returns the usage of a rate limit in the window containing the current block,
+// with the amounts of the window before it as the previous amounts
+func (k Keeper) GetCurrentRateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitUsage {
+	windowStart := rateLimit.GetWindowStartHeight(ctx.BlockHeight())
+	current := types.NewRateLimitUsage(rateLimit.Denom, windowStart)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitUsageKey(rateLimit.Denom))
+	if bz == nil {
+		return current
+	}
+	var usage types.RateLimitUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	switch usage.WindowStartHeight {
+	case windowStart:
+		current.InboundAmount = usage.InboundAmount
+		current.OutboundAmount = usage.OutboundAmount
+		if !usage.PreviousInboundAmount.IsNil() {
+			current.PreviousInboundAmount = usage.PreviousInboundAmount
+		}
+		if !usage.PreviousOutboundAmount.IsNil() {
+			current.PreviousOutboundAmount = usage.PreviousOutboundAmount
+		}
+	case windowStart - int64(rateLimit.WindowBlocks):
+		current.PreviousInboundAmount = usage.InboundAmount
+		current.PreviousOutboundAmount = usage.OutboundAmount
+	}
+	return current
+}
+
+// consumeRateLimit adds an amount of a denom to the usage of its rate limit in the current window,
+// failing with ErrRateLimitExceeded if this takes the usage of the sliding window over the limit.
+// An outbound transfer larger than the limit is rejected, it can be split by the sender. An inbound
+// transfer larger than the limit is let through once nothing else was bridged in over the window
+func (k Keeper) consumeRateLimit(ctx sdk.Context, denom string, amount sdk.Int, inbound bool) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom)
+	if !found {
+		return nil
+	}
+	height := ctx.BlockHeight()
+	usage := k.GetCurrentRateLimitUsage(ctx, rateLimit)
+	if inbound {
+		used := rateLimit.GetSlidingAmount(height, usage.InboundAmount, usage.PreviousInboundAmount)
+		oversized := amount.GT(rateLimit.InboundLimit) && used.IsZero()
+		if rateLimit.InboundLimit.IsPositive() && used.Add(amount).GT(rateLimit.InboundLimit) && !oversized {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "inbound %s%s over limit of %s", amount, denom, rateLimit.InboundLimit)
+		}
+		usage.InboundAmount = usage.InboundAmount.Add(amount)
+	} else {
+		if rateLimit.OutboundLimit.IsPositive() && amount.GT(rateLimit.OutboundLimit) {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "outbound %s%s larger than limit of %s", amount, denom, rateLimit.OutboundLimit)
+		}
+		used := rateLimit.GetSlidingAmount(height, usage.OutboundAmount, usage.PreviousOutboundAmount)
+		if rateLimit.OutboundLimit.IsPositive() && used.Add(amount).GT(rateLimit.OutboundLimit) {
+			return sdkerrors.Wrapf(types.ErrRateLimitExceeded, "outbound %s%s over limit of %s", amount, denom, rateLimit.OutboundLimit)
+		}
+		usage.OutboundAmount = usage.OutboundAmount.Add(amount)
+	}
+	k.SetRateLimitUsage(ctx, usage)
+	return nil
+}
+
+// GetLastQueuedInboundID returns the id of the latest queued inbound transfer, 0 if there is none yet
+func (k Keeper) GetLastQueuedInboundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastQueuedInboundIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetLastQueuedInboundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastQueuedInboundIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// QueueInboundTransfer holds back an inbound transfer until the rate limit of its denom allows it
+func (k Keeper) QueueInboundTransfer(ctx sdk.Context, receiver sdk.AccAddress, coin sdk.Coin) uint64 {
+	id := k.GetLastQueuedInboundID(ctx) + 1
+	k.SetQueuedInboundTransfer(ctx, types.QueuedInboundTransfer{
+		Id:             id,
+		CosmosReceiver: receiver.String(),
+		Denom:          coin.Denom,
+		Amount:         coin.Amount,
+		QueuedHeight:   ctx.BlockHeight(),
+	})
+	k.SetLastQueuedInboundID(ctx, id)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeInboundTransferQueued,
+		sdk.NewAttribute(types.AttributeKeyQueuedInboundID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCosmosReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeKeyCoins, coin.String()),
+	))
+	return id
+}
+
+// SetQueuedInboundTransfer stores a queued inbound transfer and, unless it is parked, indexes it under its denom
+func (k Keeper) SetQueuedInboundTransfer(ctx sdk.Context, transfer types.QueuedInboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedInboundTransferKey(transfer.Id), k.cdc.MustMarshal(&transfer))
+	if transfer.Parked {
+		store.Delete(types.GetQueuedInboundTransferByDenomKey(transfer.Denom, transfer.Id))
+	} else {
+		store.Set(types.GetQueuedInboundTransferByDenomKey(transfer.Denom, transfer.Id), []byte{})
+	}
+}
+
+// DeleteQueuedInboundTransfer removes a queued inbound transfer and its denom index
+func (k Keeper) DeleteQueuedInboundTransfer(ctx sdk.Context, transfer types.QueuedInboundTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedInboundTransferKey(transfer.Id))
+	store.Delete(types.GetQueuedInboundTransferByDenomKey(transfer.Denom, transfer.Id))
+}
+
+func (k Keeper) GetQueuedInboundTransfer(ctx sdk.Context, id uint64) (types.QueuedInboundTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedInboundTransferKey(id))
+	if bz == nil {
+		return types.QueuedInboundTransfer{}, false
+	}
+	var transfer types.QueuedInboundTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return transfer, true
+}
+
+// GetQueuedInboundTransfers returns the queued inbound transfers in the order they were queued
+func (k Keeper) GetQueuedInboundTransfers(ctx sdk.Context) []types.QueuedInboundTransfer {
+	var transfers []types.QueuedInboundTransfer
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedInboundTransferPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.QueuedInboundTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// ReleaseQueuedInboundTransfers mints and sends the queued inbound transfers the rate limits now allow.
+// The queue of each denom is released in the order transfers were queued, up to the first transfer
+// that does not fit yet
+func (k Keeper) ReleaseQueuedInboundTransfers(ctx sdk.Context) {
+	denomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedInboundTransferByDenomPrefix)
+	var start []byte
+	for {
+		denomPrefix, found := nextQueuedDenomPrefix(denomStore, start)
+		if !found {
+			return
+		}
+		denom := string(denomPrefix[1:])
+		for {
+			transfer, found := k.getFirstQueuedInboundTransfer(ctx, denom)
+			if !found || !k.releaseQueuedInboundTransfer(ctx, transfer) {
+				break
+			}
+		}
+		start = sdk.PrefixEndBytes(denomPrefix)
+	}
+}
+
+// ForceReleaseQueuedInboundTransfer mints and sends a queued inbound transfer regardless of the rate limit
+// of its denom, the amount still counts towards the usage of the rate limit
+func (k Keeper) ForceReleaseQueuedInboundTransfer(ctx sdk.Context, id uint64) error {
+	transfer, found := k.GetQueuedInboundTransfer(ctx, id)
+	if !found {
+		return types.ErrQueuedInboundNotFound
+	}
+	receiver, err := sdk.AccAddressFromBech32(transfer.CosmosReceiver)
+	if err != nil {
+		return err
+	}
+	if err := k.mintAndSendCoins(ctx, receiver, sdk.NewCoins(sdk.NewCoin(transfer.Denom, transfer.Amount))); err != nil {
+		return err
+	}
+	if rateLimit, found := k.GetRateLimit(ctx, transfer.Denom); found {
+		usage := k.GetCurrentRateLimitUsage(ctx, rateLimit)
+		usage.InboundAmount = usage.InboundAmount.Add(transfer.Amount)
+		k.SetRateLimitUsage(ctx, usage)
+	}
+	k.DeleteQueuedInboundTransfer(ctx, transfer)
+	k.emitQueuedInboundTransferEvent(ctx, types.EventTypeInboundTransferReleased, transfer)
+	return nil
+}
+
+// CancelQueuedInboundTransfer drops a queued inbound transfer without minting it
+func (k Keeper) CancelQueuedInboundTransfer(ctx sdk.Context, id uint64) error {
+	transfer, found := k.GetQueuedInboundTransfer(ctx, id)
+	if !found {
+		return types.ErrQueuedInboundNotFound
+	}
+	k.DeleteQueuedInboundTransfer(ctx, transfer)
+	k.emitQueuedInboundTransferEvent(ctx, types.EventTypeInboundTransferCancelled, transfer)
+	return nil
+}
+
+// hasQueuedInboundTransfers returns true if inbound transfers of the denom are held back
+func (k Keeper) hasQueuedInboundTransfers(ctx sdk.Context, denom string) bool {
+	_, found := k.getFirstQueuedInboundTransfer(ctx, denom)
+	return found
+}
+
+// getFirstQueuedInboundTransfer returns the earliest queued inbound transfer of a denom
+func (k Keeper) getFirstQueuedInboundTransfer(ctx sdk.Context, denom string) (types.QueuedInboundTransfer, bool) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetQueuedInboundTransferByDenomPrefix(denom))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return types.QueuedInboundTransfer{}, false
+	}
+	key := iterator.Key()
+	return k.GetQueuedInboundTransfer(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+}
+
+// releaseQueuedInboundTransfer mints and sends a queued inbound transfer if its rate limit allows it. It
+// returns false if the rate limit holds the transfer back. Transfers that fail to release for any other
+// reason, such as a receiver that cannot receive coins, are parked so the rest of the queue moves on
+func (k Keeper) releaseQueuedInboundTransfer(ctx sdk.Context, transfer types.QueuedInboundTransfer) bool {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.consumeRateLimit(cacheCtx, transfer.Denom, transfer.Amount, true); err != nil {
+		return false
+	}
+	receiver, err := sdk.AccAddressFromBech32(transfer.CosmosReceiver)
+	if err == nil {
+		err = k.mintAndSendCoins(cacheCtx, receiver, sdk.NewCoins(sdk.NewCoin(transfer.Denom, transfer.Amount)))
+	}
+	if err != nil {
+		k.Logger(ctx).Error("failed to release queued inbound transfer, parking it.",
+			"ID", transfer.Id, errorMessageKey, err.Error())
+		transfer.Parked = true
+		k.SetQueuedInboundTransfer(ctx, transfer)
+		k.emitQueuedInboundTransferEvent(ctx, types.EventTypeInboundTransferParked, transfer)
+		return true
+	}
+	write()
+	k.DeleteQueuedInboundTransfer(ctx, transfer)
+	k.emitQueuedInboundTransferEvent(ctx, types.EventTypeInboundTransferReleased, transfer)
+	return true
+}
+
+func (k Keeper) emitQueuedInboundTransferEvent(ctx sdk.Context, eventType string, transfer types.QueuedInboundTransfer) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyQueuedInboundID, strconv.FormatUint(transfer.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCosmosReceiver, transfer.CosmosReceiver),
+		sdk.NewAttribute(types.AttributeKeyCoins, sdk.NewCoin(transfer.Denom, transfer.Amount).String()),
+	))
+}
+
+// nextQueuedDenomPrefix returns the length prefixed denom of the first queued inbound transfer indexed
+// at or after start in the denom index
+func nextQueuedDenomPrefix(denomStore prefix.Store, start []byte) ([]byte, bool) {
+	iterator := denomStore.Iterator(start, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	key := iterator.Key()
+	return key[:1+int(key[0])], true
+}
+
+// mintAndSendCoins mints bridged coins and sends them to their receiver
+func (k Keeper) mintAndSendCoins(ctx sdk.Context, receiver sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins)
+}
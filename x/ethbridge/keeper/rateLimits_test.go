@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/keeper"
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/ethbridge/types"
+)
+
+func TestRateLimit_Outbound(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	ctx = ctx.WithBlockHeight(10)
+	bridgeKeeper.SetRateLimit(ctx, types.RateLimit{
+		Denom:         "stake",
+		InboundLimit:  sdk.ZeroInt(),
+		OutboundLimit: sdk.NewInt(15),
+		WindowBlocks:  5,
+	})
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)), sdk.NewCoin(types.CethSymbol, sdk.NewInt(100)))
+	require.NoError(t, bankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, cosmosReceivers[0], coins))
+
+	msg := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, amount, "stake", sdk.NewInt(1))
+	_, err := bridgeKeeper.ProcessLock(ctx, cosmosReceivers[0], &msg)
+	require.NoError(t, err)
+	_, err = bridgeKeeper.ProcessLock(ctx.WithBlockHeight(14), cosmosReceivers[0], &msg)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	rateLimit, found := bridgeKeeper.GetRateLimit(ctx, "stake")
+	require.True(t, found)
+	usage := bridgeKeeper.GetCurrentRateLimitUsage(ctx.WithBlockHeight(14), rateLimit)
+	require.Equal(t, int64(10), usage.WindowStartHeight)
+	require.Equal(t, amount, usage.OutboundAmount)
+
+	// the previous window still counts in full at the start of the next one
+	_, err = bridgeKeeper.ProcessLock(ctx.WithBlockHeight(15), cosmosReceivers[0], &msg)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// and less as the sliding window moves past it, 3 blocks in only 2/5 of it remains
+	_, err = bridgeKeeper.ProcessLock(ctx.WithBlockHeight(18), cosmosReceivers[0], &msg)
+	require.NoError(t, err)
+	usage = bridgeKeeper.GetCurrentRateLimitUsage(ctx.WithBlockHeight(18), rateLimit)
+	require.Equal(t, int64(15), usage.WindowStartHeight)
+	require.Equal(t, amount, usage.OutboundAmount)
+	require.Equal(t, amount, usage.PreviousOutboundAmount)
+
+	// transfers larger than the limit are rejected even when the window is empty
+	oversized := types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, sdk.NewInt(20), "stake", sdk.NewInt(1))
+	_, err = bridgeKeeper.ProcessLock(ctx.WithBlockHeight(40), cosmosReceivers[0], &oversized)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// other denoms are not limited
+	msg = types.NewMsgLock(1, cosmosReceivers[0], ethereumSender, sdk.NewInt(50), types.CethSymbol, sdk.NewInt(1))
+	_, err = bridgeKeeper.ProcessLock(ctx.WithBlockHeight(15), cosmosReceivers[0], &msg)
+	require.NoError(t, err)
+
+	// setting both limits to zero removes the rate limit
+	bridgeKeeper.SetRateLimit(ctx, types.RateLimit{Denom: "stake", InboundLimit: sdk.ZeroInt(), OutboundLimit: sdk.ZeroInt()})
+	_, found = bridgeKeeper.GetRateLimit(ctx, "stake")
+	require.False(t, found)
+	require.Empty(t, bridgeKeeper.GetRateLimitUsages(ctx))
+}
+
+func TestRateLimit_InboundQueue(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	ctx = ctx.WithBlockHeight(10)
+	bridgeKeeper.SetRateLimit(ctx, types.RateLimit{
+		Denom:         "cstake",
+		InboundLimit:  sdk.NewInt(15),
+		OutboundLimit: sdk.ZeroInt(),
+		WindowBlocks:  5,
+	})
+	claimContent := types.NewOracleClaimContent(cosmosReceivers[0], amount, symbol, tokenContractAddress, types.ClaimType_CLAIM_TYPE_LOCK)
+	claimBytes, err := json.Marshal(claimContent)
+	require.NoError(t, err)
+
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.Equal(t, "10cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+
+	// the second claim goes over the limit and is queued instead of minted
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.Equal(t, "10cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	queued := bridgeKeeper.GetQueuedInboundTransfers(ctx)
+	require.Len(t, queued, 2)
+	require.Equal(t, types.QueuedInboundTransfer{
+		Id:             1,
+		CosmosReceiver: cosmosReceivers[0].String(),
+		Denom:          "cstake",
+		Amount:         amount,
+		QueuedHeight:   10,
+	}, queued[0])
+
+	// nothing is released while the sliding window is still full
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(14))
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(15))
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 2)
+
+	// the sliding window releases what fits, in the order transfers were queued
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(18))
+	require.Equal(t, "20cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	queued = bridgeKeeper.GetQueuedInboundTransfers(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, uint64(2), queued[0].Id)
+
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(20))
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 1)
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(23))
+	require.Equal(t, "30cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	require.Empty(t, bridgeKeeper.GetQueuedInboundTransfers(ctx))
+	require.Equal(t, uint64(2), bridgeKeeper.GetLastQueuedInboundID(ctx))
+}
+
+func TestRateLimit_OversizedInbound(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	ctx = ctx.WithBlockHeight(10)
+	bridgeKeeper.SetRateLimit(ctx, types.RateLimit{
+		Denom:         "cstake",
+		InboundLimit:  sdk.NewInt(15),
+		OutboundLimit: sdk.ZeroInt(),
+		WindowBlocks:  5,
+	})
+	claim := func(amount sdk.Int) string {
+		claimContent := types.NewOracleClaimContent(cosmosReceivers[0], amount, symbol, tokenContractAddress, types.ClaimType_CLAIM_TYPE_LOCK)
+		claimBytes, err := json.Marshal(claimContent)
+		require.NoError(t, err)
+		return string(claimBytes)
+	}
+
+	// a transfer larger than the limit goes through when nothing else was bridged in over the window
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, claim(sdk.NewInt(20))))
+	require.Equal(t, "20cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+
+	// otherwise it is queued, and the transfers after it wait behind it
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, claim(sdk.NewInt(20))))
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx.WithBlockHeight(18), claim(sdk.NewInt(1))))
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 2)
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(18))
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 2)
+
+	// and is released once the sliding window is empty again
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx.WithBlockHeight(20))
+	require.Equal(t, "40cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	queued := bridgeKeeper.GetQueuedInboundTransfers(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, uint64(2), queued[0].Id)
+
+	// the admin can release a queued transfer regardless of the rate limit, or cancel it
+	require.NoError(t, bridgeKeeper.ForceReleaseQueuedInboundTransfer(ctx.WithBlockHeight(20), 2))
+	require.Equal(t, "41cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	rateLimit, _ := bridgeKeeper.GetRateLimit(ctx, "cstake")
+	require.Equal(t, sdk.NewInt(21), bridgeKeeper.GetCurrentRateLimitUsage(ctx.WithBlockHeight(20), rateLimit).InboundAmount)
+	require.ErrorIs(t, bridgeKeeper.ForceReleaseQueuedInboundTransfer(ctx, 2), types.ErrQueuedInboundNotFound)
+
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx.WithBlockHeight(20), claim(sdk.NewInt(5))))
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 1)
+	require.NoError(t, bridgeKeeper.CancelQueuedInboundTransfer(ctx, 3))
+	require.Empty(t, bridgeKeeper.GetQueuedInboundTransfers(ctx))
+	require.Equal(t, "41cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	require.ErrorIs(t, bridgeKeeper.CancelQueuedInboundTransfer(ctx, 3), types.ErrQueuedInboundNotFound)
+}
+
+func TestRateLimit_ParkFailedRelease(t *testing.T) {
+	ctx, bridgeKeeper, bankKeeper, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	coin := sdk.NewCoin("cstake", sdk.NewInt(10))
+	failing := bridgeKeeper.QueueInboundTransfer(ctx, cosmosReceivers[0], coin)
+	bridgeKeeper.QueueInboundTransfer(ctx, cosmosReceivers[0], coin)
+	transfer, found := bridgeKeeper.GetQueuedInboundTransfer(ctx, failing)
+	require.True(t, found)
+	transfer.CosmosReceiver = "invalid"
+	bridgeKeeper.SetQueuedInboundTransfer(ctx, transfer)
+
+	// the transfer that cannot be released is parked and does not hold back the one behind it
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx)
+	require.Equal(t, "10cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+	queued := bridgeKeeper.GetQueuedInboundTransfers(ctx)
+	require.Len(t, queued, 1)
+	require.Equal(t, failing, queued[0].Id)
+	require.True(t, queued[0].Parked)
+	parked := false
+	for _, event := range ctx.EventManager().Events() {
+		parked = parked || event.Type == types.EventTypeInboundTransferParked
+	}
+	require.True(t, parked)
+
+	// new transfers of the denom are not queued behind it
+	claimContent := types.NewOracleClaimContent(cosmosReceivers[0], sdk.NewInt(10), symbol, tokenContractAddress, types.ClaimType_CLAIM_TYPE_LOCK)
+	claimBytes, err := json.Marshal(claimContent)
+	require.NoError(t, err)
+	require.NoError(t, bridgeKeeper.ProcessSuccessfulClaim(ctx, string(claimBytes)))
+	require.Equal(t, "20cstake", bankKeeper.GetAllBalances(ctx, cosmosReceivers[0]).String())
+
+	// parked transfers are left to the admin
+	bridgeKeeper.ReleaseQueuedInboundTransfers(ctx)
+	require.Len(t, bridgeKeeper.GetQueuedInboundTransfers(ctx), 1)
+	require.NoError(t, bridgeKeeper.CancelQueuedInboundTransfer(ctx, failing))
+	require.Empty(t, bridgeKeeper.GetQueuedInboundTransfers(ctx))
+}
+
+func TestQueryServer_RateLimits(t *testing.T) {
+	ctx, bridgeKeeper, _, _, _, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 3}, "")
+	rateLimit := types.RateLimit{
+		Denom:         "cstake",
+		InboundLimit:  sdk.NewInt(15),
+		OutboundLimit: sdk.NewInt(20),
+		WindowBlocks:  5,
+	}
+	bridgeKeeper.SetRateLimit(ctx, rateLimit)
+	bridgeKeeper.QueueInboundTransfer(ctx, cosmosReceivers[0], sdk.NewCoin("cstake", amount))
+	queryServer := keeper.NewQueryServer(bridgeKeeper)
+
+	res, err := queryServer.GetRateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RateLimitStatus{{
+		RateLimit: rateLimit,
+		Usage:     types.NewRateLimitUsage("cstake", ctx.BlockHeight()),
+	}}, res.RateLimits)
+
+	queued, err := queryServer.GetQueuedInboundTransfers(sdk.WrapSDKContext(ctx), &types.QueryQueuedInboundTransfersRequest{})
+	require.NoError(t, err)
+	require.Len(t, queued.Transfers, 1)
+	require.Equal(t, amount, queued.Transfers[0].Amount)
+}
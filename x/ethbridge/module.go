@@ -170,6 +170,8 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // EndBlock returns the end blocker for the ethbridge module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.BridgeKeeper.ReleaseQueuedInboundTransfers(ctx)
+	am.BridgeKeeper.PruneCompletedOutboundTransfers(ctx)
 	return nil
 }
 
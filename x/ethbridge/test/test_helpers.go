@@ -8,6 +8,7 @@ import (
 	"time"
 
 	adminkeeper "github.com/Sifchain/sifnode/x/admin/keeper"
+	tokenregistrykeeper "github.com/Sifchain/sifnode/x/tokenregistry/keeper"
 	admintypes "github.com/Sifchain/sifnode/x/admin/types"
 
 	"strconv"
@@ -65,6 +66,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	keyOracle := sdk.NewKVStoreKey(oracleTypes.StoreKey)
 	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
 	adminKey := sdk.NewKVStoreKey(admintypes.StoreKey)
+	keyTokenRegistry := sdk.NewKVStoreKey(tokenregistryTypes.StoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
@@ -75,6 +77,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyEthBridge, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyTokenRegistry, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, tmproto.Header{ChainID: "foochainid"}, false, nil)
@@ -126,7 +129,8 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	// bankKeeper.SetSupply(ctx, banktypes.NewSupply(totalSupply))
 	stakingKeeper := stakingkeeper.NewKeeper(encCfg.Marshaler, keyStaking, accountKeeper, bankKeeper, paramsKeeper.Subspace(stakingtypes.ModuleName))
 	stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams())
-	oracleKeeper := oraclekeeper.NewKeeper(encCfg.Marshaler, keyOracle, stakingKeeper, consensusNeeded)
+	oracleKeeper := oraclekeeper.NewKeeper(encCfg.Marshaler, keyOracle, stakingKeeper)
+	oracleKeeper.SetConsensusNeeded(ctx, sdk.MustNewDecFromStr(strconv.FormatFloat(consensusNeeded, 'f', -1, 64)))
 	// set module accounts
 	accountKeeper.SetModuleAccount(ctx, bridgeAccount)
 	accountKeeper.SetModuleAccount(ctx, feeCollectorAcc)
@@ -136,7 +140,8 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorAmounts [
 	require.NoError(t, err)
 	err = bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, stakingtypes.NotBondedPoolName, totalSupply)
 	require.NoError(t, err)
-	ethbridgeKeeper := keeper.NewKeeper(encCfg.Marshaler, bankKeeper, oracleKeeper, accountKeeper, adminKeeper, keyEthBridge)
+	tokenRegistryKeeper := tokenregistrykeeper.NewKeeper(encCfg.Marshaler, keyTokenRegistry, adminKeeper)
+	ethbridgeKeeper := keeper.NewKeeper(encCfg.Marshaler, bankKeeper, oracleKeeper, accountKeeper, adminKeeper, tokenRegistryKeeper, keyEthBridge)
 	cethReceiverAccount, _ := sdk.AccAddressFromBech32(types.TestAddress)
 	ethbridgeKeeper.SetCethReceiverAccount(ctx, cethReceiverAccount)
 	// Setup validators
@@ -14,6 +14,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgUpdateCethReceiverAccount{}, "ethbridge/MsgUpdateCethReceiverAccount", nil)
 	cdc.RegisterConcrete(&MsgRescueCeth{}, "ethbridge/MsgRescueCeth", nil)
 	cdc.RegisterConcrete(&MsgSetBlacklist{}, "ethbridge/MsgSetBlacklist", nil)
+	cdc.RegisterConcrete(&MsgSetConsensusNeeded{}, "ethbridge/MsgSetConsensusNeeded", nil)
+	cdc.RegisterConcrete(&MsgSetProphecyLifetime{}, "ethbridge/MsgSetProphecyLifetime", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "ethbridge/MsgSetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgConfirmOutboundTransfer{}, "ethbridge/MsgConfirmOutboundTransfer", nil)
+	cdc.RegisterConcrete(&MsgReleaseQueuedInboundTransfer{}, "ethbridge/MsgReleaseQueuedInboundTransfer", nil)
+	cdc.RegisterConcrete(&MsgCancelQueuedInboundTransfer{}, "ethbridge/MsgCancelQueuedInboundTransfer", nil)
 }
 
 var (
@@ -19,7 +19,12 @@ var (
 	ErrInvalidSymbol          = sdkerrors.Register(ModuleName, 8, "symbol must be 1 character or more")
 	ErrInvalidBurnSymbol      = sdkerrors.Register(ModuleName, 9,
 		fmt.Sprintf("symbol of token to burn must be in the form %v{ethereumSymbol}", PeggedCoinPrefix))
-	ErrCethAmount           = sdkerrors.Register(ModuleName, 10, "not enough ceth provided")
-	ErrNotEnoughPermissions = sdkerrors.Register(ModuleName, 11, "account does not have enough permissions")
-	ErrPaused               = sdkerrors.Register(ModuleName, 12, "transaction is paused")
+	ErrCethAmount               = sdkerrors.Register(ModuleName, 10, "not enough ceth provided")
+	ErrNotEnoughPermissions     = sdkerrors.Register(ModuleName, 11, "account does not have enough permissions")
+	ErrPaused                   = sdkerrors.Register(ModuleName, 12, "transaction is paused")
+	ErrOutboundTransferNotFound = sdkerrors.Register(ModuleName, 13, "outbound transfer not found")
+	ErrInvalidOutboundTransfer  = sdkerrors.Register(ModuleName, 14, "invalid outbound transfer")
+	ErrRateLimitExceeded        = sdkerrors.Register(ModuleName, 15, "bridge rate limit exceeded")
+	ErrInvalidRateLimit         = sdkerrors.Register(ModuleName, 16, "invalid rate limit")
+	ErrQueuedInboundNotFound    = sdkerrors.Register(ModuleName, 17, "queued inbound transfer not found")
 )
@@ -7,6 +7,14 @@ var (
 	EventTypeBurn                     = "burn"
 	EventTypeLock                     = "lock"
 	EventTypeUpdateWhiteListValidator = "update_whitelist_validator"
+	EventTypeInboundTransferQueued    = "inbound_transfer_queued"
+	EventTypeInboundTransferReleased  = "inbound_transfer_released"
+	EventTypeInboundTransferCancelled = "inbound_transfer_cancelled"
+	EventTypeInboundTransferParked    = "inbound_transfer_parked"
+	EventTypeSetConsensusNeeded       = "set_consensus_needed"
+	EventTypeSetProphecyLifetime      = "set_prophecy_lifetime"
+	EventTypeConfirmOutboundTransfer  = "confirm_outbound_transfer"
+	EventTypeOutboundTransferStatus   = "outbound_transfer_status"
 
 	AttributeKeyEthereumSender      = "ethereum_sender"
 	AttributeKeyEthereumSenderNonce = "ethereum_sender_nonce"
@@ -26,6 +34,11 @@ var (
 	AttributeKeyCosmosSender         = "cosmos_sender"
 	AttributeKeyCosmosSenderSequence = "cosmos_sender_sequence"
 	AttributeKeyEthereumReceiver     = "ethereum_receiver"
+	AttributeKeyOutboundNonce        = "outbound_nonce"
+	AttributeKeyQueuedInboundID      = "queued_inbound_id"
+	AttributeKeyConsensusNeeded      = "consensus_needed"
+	AttributeKeyExpiryBlocks         = "expiry_blocks"
+	AttributeKeyRetentionBlocks      = "retention_blocks"
 
 	AttributeValueCategory = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	admintypes "github.com/Sifchain/sifnode/x/admin/types"
 	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
+	tokenregistrytypes "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -30,8 +31,16 @@ type OracleKeeper interface {
 	IsAdminAccount(ctx sdk.Context, cosmosSender sdk.AccAddress) bool
 	GetAdminAccount(ctx sdk.Context) sdk.AccAddress
 	SetAdminAccount(ctx sdk.Context, cosmosSender sdk.AccAddress)
+	GetConsensusNeeded(ctx sdk.Context) sdk.Dec
+	SetConsensusNeeded(ctx sdk.Context, consensusNeeded sdk.Dec)
+	SetProphecyLifetime(ctx sdk.Context, lifetime oracletypes.ProphecyLifetime)
 }
 
 type AdminKeeper interface {
 	IsAdminAccount(ctx sdk.Context, moduleName admintypes.AdminType, adminAccount sdk.AccAddress) bool
 }
+
+// TokenRegistryKeeper defines the expected token registry keeper
+type TokenRegistryKeeper interface {
+	GetRegistryEntry(ctx sdk.Context, denom string) (*tokenregistrytypes.RegistryEntry, error)
+}
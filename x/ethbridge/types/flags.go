@@ -5,4 +5,6 @@ const (
 	FlagEthereumChainID string = "ethereum-chain-id"
 	// FlagTokenContractAddr flag for passing the token contract address field
 	FlagTokenContractAddr string = "token-contract-address"
+	// FlagCosmosSender flag for passing the cosmos sender field
+	FlagCosmosSender string = "cosmos-sender"
 )
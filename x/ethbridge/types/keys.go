@@ -1,5 +1,13 @@
 package types
 
+import (
+	"encoding/binary"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the ethereum bridge module
 	ModuleName = "ethbridge"
@@ -18,6 +26,15 @@ const (
 
 	// ceth symbol
 	CethSymbol = "ceth"
+
+	// OutboundTransferProphecyPrefix prefixes the ids of the prophecies confirming outbound transfers
+	OutboundTransferProphecyPrefix = "outbound_transfer_"
+
+	// OutboundTransferRetentionBlocks is how long completed outbound transfers are kept before being pruned
+	OutboundTransferRetentionBlocks = 201600
+
+	// MaxOutboundTransfersPrunedPerBlock bounds the completed outbound transfers pruned in a block
+	MaxOutboundTransfersPrunedPerBlock = 100
 )
 
 var (
@@ -25,4 +42,72 @@ var (
 	CethReceiverAccountPrefix = []byte{0x01}
 	BlacklistPrefix           = []byte{0x02}
 	PausePrefix               = []byte{0x03}
+	// LastOutboundNonceKey holds the nonce of the latest outbound transfer
+	LastOutboundNonceKey           = []byte{0x04}
+	OutboundTransferPrefix         = []byte{0x05}
+	OutboundTransferBySenderPrefix = []byte{0x06}
+	RateLimitPrefix                = []byte{0x07}
+	RateLimitUsagePrefix           = []byte{0x08}
+	// LastQueuedInboundIDKey holds the id of the latest queued inbound transfer
+	LastQueuedInboundIDKey             = []byte{0x09}
+	QueuedInboundTransferPrefix        = []byte{0x0A}
+	QueuedInboundTransferByDenomPrefix = []byte{0x0B}
+	// CompletedOutboundTransferPrefix indexes completed outbound transfers by completion height for pruning
+	CompletedOutboundTransferPrefix = []byte{0x0C}
 )
+
+// GetRateLimitKey returns the key of the rate limit of a denom
+func GetRateLimitKey(denom string) []byte {
+	return append(RateLimitPrefix, []byte(denom)...)
+}
+
+// GetRateLimitUsageKey returns the key of the rate limit usage of a denom
+func GetRateLimitUsageKey(denom string) []byte {
+	return append(RateLimitUsagePrefix, []byte(denom)...)
+}
+
+// GetQueuedInboundTransferKey returns the key of the queued inbound transfer with the given id
+func GetQueuedInboundTransferKey(id uint64) []byte {
+	return append(QueuedInboundTransferPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedInboundTransferByDenomPrefix returns the prefix indexing the queued inbound transfers of a denom
+func GetQueuedInboundTransferByDenomPrefix(denom string) []byte {
+	return append(QueuedInboundTransferByDenomPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetQueuedInboundTransferByDenomKey returns the key indexing a queued inbound transfer under its denom
+func GetQueuedInboundTransferByDenomKey(denom string, id uint64) []byte {
+	return append(GetQueuedInboundTransferByDenomPrefix(denom), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetOutboundTransferKey returns the key of the outbound transfer with the given nonce
+func GetOutboundTransferKey(nonce uint64) []byte {
+	return append(OutboundTransferPrefix, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetOutboundTransferBySenderPrefix returns the prefix indexing the outbound transfers of a sender
+func GetOutboundTransferBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(OutboundTransferBySenderPrefix, address.MustLengthPrefix(sender)...)
+}
+
+// GetOutboundTransferBySenderKey returns the key indexing an outbound transfer under its sender
+func GetOutboundTransferBySenderKey(sender sdk.AccAddress, nonce uint64) []byte {
+	return append(GetOutboundTransferBySenderPrefix(sender), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetNonceFromOutboundTransferKey returns the nonce at the end of an outbound transfer key
+func GetNonceFromOutboundTransferKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// GetCompletedOutboundTransferKey returns the key indexing an outbound transfer under its completion height
+func GetCompletedOutboundTransferKey(height int64, nonce uint64) []byte {
+	key := append(CompletedOutboundTransferPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetOutboundTransferProphecyID returns the id of the prophecy confirming the outbound transfer with the given nonce
+func GetOutboundTransferProphecyID(nonce uint64) string {
+	return OutboundTransferProphecyPrefix + strconv.FormatUint(nonce, 10)
+}
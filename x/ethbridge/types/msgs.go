@@ -10,6 +10,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 )
 
 const (
@@ -44,6 +46,236 @@ func (msg MsgPause) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+var _ sdk.Msg = &MsgSetConsensusNeeded{}
+
+// NewMsgSetConsensusNeeded is a constructor function for MsgSetConsensusNeeded
+func NewMsgSetConsensusNeeded(signer sdk.AccAddress, consensusNeeded sdk.Dec) MsgSetConsensusNeeded {
+	return MsgSetConsensusNeeded{
+		Signer:          signer.String(),
+		ConsensusNeeded: consensusNeeded,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetConsensusNeeded) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetConsensusNeeded) Type() string { return "set_consensus_needed" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetConsensusNeeded) ValidateBasic() error {
+	if msg.GetSigner() == "" {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return oracletypes.ValidateConsensusNeeded(msg.ConsensusNeeded)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetConsensusNeeded) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetConsensusNeeded) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgSetProphecyLifetime{}
+
+// NewMsgSetProphecyLifetime is a constructor function for MsgSetProphecyLifetime
+func NewMsgSetProphecyLifetime(signer sdk.AccAddress, expiryBlocks, retentionBlocks uint64) MsgSetProphecyLifetime {
+	return MsgSetProphecyLifetime{
+		Signer: signer.String(),
+		ProphecyLifetime: oracletypes.ProphecyLifetime{
+			ExpiryBlocks:    expiryBlocks,
+			RetentionBlocks: retentionBlocks,
+		},
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetProphecyLifetime) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetProphecyLifetime) Type() string { return "set_prophecy_lifetime" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetProphecyLifetime) ValidateBasic() error {
+	if msg.GetSigner() == "" {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return oracletypes.ValidateProphecyLifetime(msg.ProphecyLifetime)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetProphecyLifetime) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetProphecyLifetime) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgSetRateLimit{}
+
+// NewMsgSetRateLimit is a constructor function for MsgSetRateLimit
+func NewMsgSetRateLimit(signer sdk.AccAddress, denom string, inboundLimit, outboundLimit sdk.Int, windowBlocks uint64) MsgSetRateLimit {
+	return MsgSetRateLimit{
+		Signer: signer.String(),
+		RateLimit: RateLimit{
+			Denom:         denom,
+			InboundLimit:  inboundLimit,
+			OutboundLimit: outboundLimit,
+			WindowBlocks:  windowBlocks,
+		},
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetRateLimit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetRateLimit) Type() string { return "set_rate_limit" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	if msg.GetSigner() == "" {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return msg.RateLimit.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgReleaseQueuedInboundTransfer{}
+
+// NewMsgReleaseQueuedInboundTransfer is a constructor function for MsgReleaseQueuedInboundTransfer
+func NewMsgReleaseQueuedInboundTransfer(signer sdk.AccAddress, id uint64) MsgReleaseQueuedInboundTransfer {
+	return MsgReleaseQueuedInboundTransfer{
+		Signer: signer.String(),
+		Id:     id,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgReleaseQueuedInboundTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgReleaseQueuedInboundTransfer) Type() string { return "release_queued_inbound_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgReleaseQueuedInboundTransfer) ValidateBasic() error {
+	if msg.GetSigner() == "" {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.Id == 0 {
+		return ErrQueuedInboundNotFound
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReleaseQueuedInboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgReleaseQueuedInboundTransfer) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgCancelQueuedInboundTransfer{}
+
+// NewMsgCancelQueuedInboundTransfer is a constructor function for MsgCancelQueuedInboundTransfer
+func NewMsgCancelQueuedInboundTransfer(signer sdk.AccAddress, id uint64) MsgCancelQueuedInboundTransfer {
+	return MsgCancelQueuedInboundTransfer{
+		Signer: signer.String(),
+		Id:     id,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelQueuedInboundTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelQueuedInboundTransfer) Type() string { return "cancel_queued_inbound_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelQueuedInboundTransfer) ValidateBasic() error {
+	if msg.GetSigner() == "" {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.Id == 0 {
+		return ErrQueuedInboundNotFound
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelQueuedInboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelQueuedInboundTransfer) GetSigners() []sdk.AccAddress {
+	signer := sdk.MustAccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+var _ sdk.Msg = &MsgConfirmOutboundTransfer{}
+
+// NewMsgConfirmOutboundTransfer is a constructor function for MsgConfirmOutboundTransfer
+func NewMsgConfirmOutboundTransfer(validator sdk.ValAddress, nonce uint64) MsgConfirmOutboundTransfer {
+	return MsgConfirmOutboundTransfer{
+		ValidatorAddress: validator.String(),
+		Nonce:            nonce,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConfirmOutboundTransfer) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConfirmOutboundTransfer) Type() string { return "confirm_outbound_transfer" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConfirmOutboundTransfer) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.ValidatorAddress)
+	}
+	if msg.Nonce == 0 {
+		return ErrInvalidOutboundTransfer
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConfirmOutboundTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConfirmOutboundTransfer) GetSigners() []sdk.AccAddress {
+	validatorAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(validatorAddress)}
+}
+
 // NewMsgLock is a constructor function for MsgLock
 func NewMsgLock(
 	ethereumChainID int64, cosmosSender sdk.AccAddress,
@@ -394,25 +626,23 @@ func MapOracleClaimsToEthBridgeClaims(
 	symbol string,
 	tokenContract EthereumAddress,
 	ethereumSender EthereumAddress,
-	oracleValidatorClaims map[string]string,
+	oracleClaims []oracletypes.ProphecyClaim,
 	f func(int64, EthereumAddress, int64, EthereumAddress, sdk.ValAddress, string) (*EthBridgeClaim, error),
 ) ([]*EthBridgeClaim, error) {
 
-	mappedClaims := make([]*EthBridgeClaim, len(oracleValidatorClaims))
-	i := 0
-	for validatorBech32, validatorClaim := range oracleValidatorClaims {
-		validatorAddress, parseErr := sdk.ValAddressFromBech32(validatorBech32)
+	mappedClaims := make([]*EthBridgeClaim, len(oracleClaims))
+	for i, oracleClaim := range oracleClaims {
+		validatorAddress, parseErr := sdk.ValAddressFromBech32(oracleClaim.ValidatorAddress)
 		if parseErr != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("failed to parse claim: %s", parseErr))
 		}
 
 		mappedClaim, err := f(
-			ethereumChainID, bridgeContract, nonce, ethereumSender, validatorAddress, validatorClaim)
+			ethereumChainID, bridgeContract, nonce, ethereumSender, validatorAddress, oracleClaim.Claim)
 		if err != nil {
 			return nil, err
 		}
 		mappedClaims[i] = mappedClaim
-		i++
 	}
 
 	return mappedClaims, nil
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
 	"github.com/Sifchain/sifnode/x/ethbridge/types"
+	oracletypes "github.com/Sifchain/sifnode/x/oracle/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -56,3 +57,64 @@ func TestPauseMsgEmptySignerReturnsError(t *testing.T) {
 	err := msgPause.ValidateBasic()
 	assert.Error(t, err, sdkerrors.ErrInvalidAddress)
 }
+
+func TestMsgSetConsensusNeededValidateBasic(t *testing.T) {
+	signer := "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd"
+	msg := types.MsgSetConsensusNeeded{Signer: "", ConsensusNeeded: sdk.NewDecWithPrec(7, 1)}
+	assert.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	msg = types.MsgSetConsensusNeeded{Signer: signer, ConsensusNeeded: sdk.ZeroDec()}
+	assert.ErrorIs(t, msg.ValidateBasic(), oracletypes.ErrMinimumConsensusNeededInvalid)
+	msg = types.MsgSetConsensusNeeded{Signer: signer, ConsensusNeeded: sdk.NewDecWithPrec(101, 2)}
+	assert.ErrorIs(t, msg.ValidateBasic(), oracletypes.ErrMinimumConsensusNeededInvalid)
+	msg = types.MsgSetConsensusNeeded{Signer: signer, ConsensusNeeded: sdk.OneDec()}
+	assert.NoError(t, msg.ValidateBasic())
+}
+
+func TestMsgSetProphecyLifetimeValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	msg := types.NewMsgSetProphecyLifetime(signer, 10, 20)
+	assert.NoError(t, msg.ValidateBasic())
+	msg = types.NewMsgSetProphecyLifetime(signer, 0, 20)
+	assert.ErrorIs(t, msg.ValidateBasic(), oracletypes.ErrInvalidProphecyLifetime)
+	msg = types.NewMsgSetProphecyLifetime(signer, 10, 0)
+	assert.ErrorIs(t, msg.ValidateBasic(), oracletypes.ErrInvalidProphecyLifetime)
+}
+
+func TestMsgSetRateLimitValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress("signer______________")
+	msg := types.NewMsgSetRateLimit(signer, "ceth", sdk.NewInt(100), sdk.ZeroInt(), 10)
+	assert.NoError(t, msg.ValidateBasic())
+	msg = types.NewMsgSetRateLimit(signer, "ceth", sdk.ZeroInt(), sdk.ZeroInt(), 0)
+	assert.NoError(t, msg.ValidateBasic())
+	msg = types.NewMsgSetRateLimit(signer, "ceth", sdk.NewInt(100), sdk.ZeroInt(), 0)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRateLimit)
+	msg = types.NewMsgSetRateLimit(signer, "ceth", sdk.NewInt(-1), sdk.ZeroInt(), 10)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRateLimit)
+	msg = types.NewMsgSetRateLimit(signer, "", sdk.NewInt(100), sdk.ZeroInt(), 10)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidRateLimit)
+}
+
+func TestMsgConfirmOutboundTransferValidateBasic(t *testing.T) {
+	validator := sdk.ValAddress(cosmosReceivers[0])
+	msg := types.NewMsgConfirmOutboundTransfer(validator, 1)
+	assert.NoError(t, msg.ValidateBasic())
+	assert.Equal(t, sdk.AccAddress(validator), msg.GetSigners()[0])
+	msg = types.NewMsgConfirmOutboundTransfer(validator, 0)
+	assert.ErrorIs(t, msg.ValidateBasic(), types.ErrInvalidOutboundTransfer)
+	msg = types.MsgConfirmOutboundTransfer{ValidatorAddress: cosmosReceivers[0].String(), Nonce: 1}
+	assert.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestMsgQueuedInboundTransferValidateBasic(t *testing.T) {
+	release := types.NewMsgReleaseQueuedInboundTransfer(cosmosReceivers[0], 1)
+	assert.NoError(t, release.ValidateBasic())
+	assert.Equal(t, cosmosReceivers[0], release.GetSigners()[0])
+	release = types.NewMsgReleaseQueuedInboundTransfer(cosmosReceivers[0], 0)
+	assert.ErrorIs(t, release.ValidateBasic(), types.ErrQueuedInboundNotFound)
+
+	cancel := types.NewMsgCancelQueuedInboundTransfer(cosmosReceivers[0], 1)
+	assert.NoError(t, cancel.ValidateBasic())
+	assert.Equal(t, cosmosReceivers[0], cancel.GetSigners()[0])
+	cancel = types.MsgCancelQueuedInboundTransfer{Id: 1}
+	assert.ErrorIs(t, cancel.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
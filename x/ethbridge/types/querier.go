@@ -6,9 +6,10 @@ import (
 
 // query endpoints supported by the oracle Querier
 const (
-	QueryEthProphecy = "prophecies"
-	QueryBlacklist   = "blacklist"
-	QueryPause       = "pause"
+	QueryEthProphecy     = "prophecies"
+	QueryBlacklist       = "blacklist"
+	QueryPause           = "pause"
+	QueryConsensusNeeded = "consensusNeeded"
 )
 
 // NewQueryEthProphecyRequest creates a new QueryEthProphecyParams
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/Sifchain/sifnode/x/oracle/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -338,556 +340,2351 @@ func (m *QueryPauseResponse) GetIsPaused() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryEthProphecyRequest)(nil), "sifnode.ethbridge.v1.QueryEthProphecyRequest")
-	proto.RegisterType((*QueryEthProphecyResponse)(nil), "sifnode.ethbridge.v1.QueryEthProphecyResponse")
-	proto.RegisterType((*QueryBlacklistRequest)(nil), "sifnode.ethbridge.v1.QueryBlacklistRequest")
-	proto.RegisterType((*QueryBlacklistResponse)(nil), "sifnode.ethbridge.v1.QueryBlacklistResponse")
-	proto.RegisterType((*QueryPauseRequest)(nil), "sifnode.ethbridge.v1.QueryPauseRequest")
-	proto.RegisterType((*QueryPauseResponse)(nil), "sifnode.ethbridge.v1.QueryPauseResponse")
+type QueryConsensusNeededRequest struct {
 }
 
-func init() { proto.RegisterFile("sifnode/ethbridge/v1/query.proto", fileDescriptor_7077edcf9f792b78) }
-
-var fileDescriptor_7077edcf9f792b78 = []byte{
-	// 572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x8f, 0xd2, 0x4e,
-	0x18, 0xc7, 0x29, 0xfc, 0x20, 0xcb, 0xf0, 0x0b, 0x66, 0x47, 0x16, 0x2a, 0x6a, 0x25, 0x8d, 0x09,
-	0xc4, 0x75, 0xdb, 0x80, 0xc6, 0x83, 0xf1, 0x22, 0x9b, 0x0d, 0xf1, 0xb6, 0x96, 0x9b, 0x97, 0xa6,
-	0xb4, 0xb3, 0x74, 0x42, 0xe9, 0xb0, 0x33, 0x53, 0x62, 0xdf, 0x85, 0x77, 0x5f, 0x88, 0x6f, 0x41,
-	0x6f, 0x7b, 0xf4, 0x64, 0x0c, 0xbc, 0x03, 0x5f, 0x81, 0xe9, 0xcc, 0x14, 0x09, 0xe0, 0xba, 0x37,
-	0xfa, 0x3c, 0x9f, 0xe7, 0xdf, 0xf7, 0x19, 0x1e, 0xd0, 0x61, 0xf8, 0x2a, 0x26, 0x01, 0xb2, 0x11,
-	0x0f, 0x27, 0x14, 0x07, 0x53, 0x64, 0x2f, 0xfb, 0xf6, 0x75, 0x82, 0x68, 0x6a, 0x2d, 0x28, 0xe1,
-	0x04, 0x36, 0x14, 0x61, 0x6d, 0x08, 0x6b, 0xd9, 0x6f, 0x37, 0xa6, 0x64, 0x4a, 0x04, 0x60, 0x67,
-	0xbf, 0x24, 0xdb, 0x3e, 0x9c, 0x8d, 0xa7, 0x0b, 0xc4, 0x14, 0xf1, 0x38, 0x27, 0x08, 0xf5, 0xfc,
-	0x68, 0xd7, 0x6d, 0x7e, 0x29, 0x82, 0xd6, 0xfb, 0xac, 0xf8, 0x05, 0x0f, 0x2f, 0x29, 0x59, 0x84,
-	0xc8, 0x4f, 0x1d, 0x74, 0x9d, 0x20, 0xc6, 0xe1, 0x33, 0x70, 0x8c, 0x78, 0x88, 0x28, 0x4a, 0xe6,
-	0xae, 0x1f, 0x7a, 0x38, 0x76, 0x71, 0xa0, 0x6b, 0x1d, 0xad, 0x57, 0x72, 0xee, 0xe5, 0x8e, 0xf3,
-	0xcc, 0xfe, 0x2e, 0x80, 0x3e, 0x68, 0xc9, 0xfa, 0xae, 0x4f, 0x62, 0x4e, 0x3d, 0x9f, 0xbb, 0x5e,
-	0x10, 0x50, 0xc4, 0x98, 0x5e, 0xec, 0x68, 0xbd, 0xea, 0xf0, 0xf4, 0xd7, 0x8f, 0x27, 0xdd, 0xd4,
-	0x9b, 0x47, 0xaf, 0x4d, 0x05, 0x52, 0x34, 0xc5, 0x8c, 0xd3, 0x74, 0x2f, 0xc2, 0x74, 0x4e, 0x24,
-	0x72, 0xae, 0x1c, 0x6f, 0xa5, 0x1d, 0x36, 0x40, 0x39, 0x26, 0xb1, 0x8f, 0xf4, 0x92, 0x68, 0x42,
-	0x7e, 0xc0, 0x26, 0xa8, 0xb0, 0x74, 0x3e, 0x21, 0x91, 0xfe, 0x5f, 0x56, 0xc9, 0x51, 0x5f, 0xf0,
-	0x25, 0x68, 0x72, 0x32, 0x43, 0xf1, 0x7e, 0x47, 0x65, 0xc1, 0x35, 0x84, 0x77, 0xb7, 0x46, 0x17,
-	0x6c, 0x66, 0x73, 0x19, 0x8a, 0x03, 0x44, 0xf5, 0x8a, 0xc0, 0xeb, 0xb9, 0x79, 0x2c, 0xac, 0xe6,
-	0x67, 0x0d, 0xe8, 0xfb, 0xca, 0xb1, 0x05, 0x89, 0x19, 0x82, 0x75, 0x50, 0x54, 0x5a, 0x55, 0x9d,
-	0x22, 0x0e, 0x60, 0x1f, 0x54, 0x18, 0xf7, 0x78, 0x22, 0xd5, 0xa8, 0x0d, 0x1e, 0x58, 0xf9, 0x92,
-	0xe5, 0x5a, 0xac, 0x65, 0xdf, 0x1a, 0x0b, 0xc0, 0x51, 0x20, 0x7c, 0x03, 0x2a, 0x7e, 0xe4, 0xe1,
-	0x39, 0xd3, 0x4b, 0x9d, 0x52, 0xaf, 0x36, 0x78, 0x6a, 0x1d, 0x7a, 0x17, 0xd6, 0x05, 0x0f, 0x87,
-	0x52, 0xac, 0x0c, 0x76, 0x54, 0x8c, 0xd9, 0x02, 0x27, 0xa2, 0xb9, 0x61, 0xe4, 0xf9, 0xb3, 0x08,
-	0x33, 0xae, 0x96, 0x6a, 0xbe, 0x02, 0xcd, 0x5d, 0x87, 0xea, 0xf9, 0x11, 0xa8, 0x2a, 0x81, 0x10,
-	0xd3, 0xb5, 0x4e, 0xa9, 0x57, 0x75, 0xfe, 0x18, 0xcc, 0xfb, 0xe0, 0x58, 0xc4, 0x5d, 0x7a, 0x09,
-	0x43, 0x79, 0xb2, 0x3e, 0x80, 0xdb, 0x46, 0x95, 0xe8, 0x21, 0xa8, 0x62, 0xe6, 0x2e, 0x32, 0x9b,
-	0xd4, 0xe0, 0xc8, 0x39, 0xc2, 0x4c, 0x30, 0xc1, 0xe0, 0x5b, 0x11, 0x94, 0x45, 0x0c, 0x8c, 0x41,
-	0x6d, 0x4b, 0x3a, 0x78, 0x76, 0x78, 0xbe, 0xbf, 0x3c, 0xce, 0xb6, 0x75, 0x57, 0x5c, 0x36, 0x65,
-	0x16, 0xe0, 0x0c, 0xfc, 0x3f, 0x42, 0x7c, 0x33, 0x37, 0x3c, 0xbd, 0x25, 0xc3, 0xae, 0x6c, 0xed,
-	0xe7, 0x77, 0x83, 0x37, 0xc5, 0x7c, 0x50, 0x1f, 0x21, 0x2e, 0x66, 0x96, 0x7b, 0x85, 0xdd, 0x5b,
-	0x32, 0x6c, 0x8b, 0xda, 0xee, 0xfd, 0x1b, 0x94, 0x65, 0x86, 0xa3, 0xaf, 0x2b, 0x43, 0xbb, 0x59,
-	0x19, 0xda, 0xcf, 0x95, 0xa1, 0x7d, 0x5a, 0x1b, 0x85, 0x9b, 0xb5, 0x51, 0xf8, 0xbe, 0x36, 0x0a,
-	0x1f, 0xce, 0xa6, 0x98, 0x87, 0xc9, 0xc4, 0xf2, 0xc9, 0xdc, 0x1e, 0xe3, 0x2b, 0xf1, 0xef, 0xb5,
-	0xf3, 0x4b, 0xf0, 0x71, 0xeb, 0x5a, 0x88, 0x5b, 0x30, 0xa9, 0x88, 0x63, 0xf0, 0xe2, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x82, 0x41, 0xc5, 0x79, 0x9d, 0x04, 0x00, 0x00,
+func (m *QueryConsensusNeededRequest) Reset()         { *m = QueryConsensusNeededRequest{} }
+func (m *QueryConsensusNeededRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusNeededRequest) ProtoMessage()    {}
+func (*QueryConsensusNeededRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{6}
+}
+func (m *QueryConsensusNeededRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusNeededRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusNeededRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusNeededRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusNeededRequest.Merge(m, src)
+}
+func (m *QueryConsensusNeededRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusNeededRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusNeededRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryConsensusNeededRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// EthProphecy queries an EthProphecy
-	EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error)
-	GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error)
-	GetPauseStatus(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+type QueryConsensusNeededResponse struct {
+	ConsensusNeeded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=consensus_needed,json=consensusNeeded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consensus_needed"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryConsensusNeededResponse) Reset()         { *m = QueryConsensusNeededResponse{} }
+func (m *QueryConsensusNeededResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsensusNeededResponse) ProtoMessage()    {}
+func (*QueryConsensusNeededResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{7}
+}
+func (m *QueryConsensusNeededResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsensusNeededResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsensusNeededResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsensusNeededResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsensusNeededResponse.Merge(m, src)
+}
+func (m *QueryConsensusNeededResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsensusNeededResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsensusNeededResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryConsensusNeededResponse proto.InternalMessageInfo
+
+type QueryOutboundTransferRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (c *queryClient) EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error) {
-	out := new(QueryEthProphecyResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/EthProphecy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryOutboundTransferRequest) Reset()         { *m = QueryOutboundTransferRequest{} }
+func (m *QueryOutboundTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransferRequest) ProtoMessage()    {}
+func (*QueryOutboundTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{8}
+}
+func (m *QueryOutboundTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOutboundTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransferRequest.Merge(m, src)
+}
+func (m *QueryOutboundTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransferRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error) {
-	out := new(QueryBlacklistResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetBlacklist", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOutboundTransferRequest proto.InternalMessageInfo
+
+func (m *QueryOutboundTransferRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetPauseStatus(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
-	out := new(QueryPauseResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetPauseStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryOutboundTransferResponse struct {
+	Transfer *OutboundTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (m *QueryOutboundTransferResponse) Reset()         { *m = QueryOutboundTransferResponse{} }
+func (m *QueryOutboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransferResponse) ProtoMessage()    {}
+func (*QueryOutboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{9}
+}
+func (m *QueryOutboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryOutboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransferResponse.Merge(m, src)
+}
+func (m *QueryOutboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransferResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// EthProphecy queries an EthProphecy
-	EthProphecy(context.Context, *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error)
-	GetBlacklist(context.Context, *QueryBlacklistRequest) (*QueryBlacklistResponse, error)
-	GetPauseStatus(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+var xxx_messageInfo_QueryOutboundTransferResponse proto.InternalMessageInfo
+
+func (m *QueryOutboundTransferResponse) GetTransfer() *OutboundTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryOutboundTransfersRequest struct {
+	CosmosSender string             `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) EthProphecy(ctx context.Context, req *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EthProphecy not implemented")
+func (m *QueryOutboundTransfersRequest) Reset()         { *m = QueryOutboundTransfersRequest{} }
+func (m *QueryOutboundTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransfersRequest) ProtoMessage()    {}
+func (*QueryOutboundTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{10}
 }
-func (*UnimplementedQueryServer) GetBlacklist(ctx context.Context, req *QueryBlacklistRequest) (*QueryBlacklistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
+func (m *QueryOutboundTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPauseStatus(ctx context.Context, req *QueryPauseRequest) (*QueryPauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPauseStatus not implemented")
+func (m *QueryOutboundTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryOutboundTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransfersRequest.Merge(m, src)
+}
+func (m *QueryOutboundTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransfersRequest.DiscardUnknown(m)
 }
 
-func _Query_EthProphecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEthProphecyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EthProphecy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Query/EthProphecy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EthProphecy(ctx, req.(*QueryEthProphecyRequest))
+var xxx_messageInfo_QueryOutboundTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryOutboundTransfersRequest) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlacklistRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlacklist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Query/GetBlacklist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlacklist(ctx, req.(*QueryBlacklistRequest))
+func (m *QueryOutboundTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetPauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPauseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPauseStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Query/GetPauseStatus",
+type QueryOutboundTransfersResponse struct {
+	Transfers []*OutboundTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// last_outbound_nonce is the nonce of the latest outbound transfer on chain
+	LastOutboundNonce uint64              `protobuf:"varint,2,opt,name=last_outbound_nonce,json=lastOutboundNonce,proto3" json:"last_outbound_nonce,omitempty"`
+	Pagination        *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOutboundTransfersResponse) Reset()         { *m = QueryOutboundTransfersResponse{} }
+func (m *QueryOutboundTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOutboundTransfersResponse) ProtoMessage()    {}
+func (*QueryOutboundTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{11}
+}
+func (m *QueryOutboundTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOutboundTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOutboundTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPauseStatus(ctx, req.(*QueryPauseRequest))
+}
+func (m *QueryOutboundTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOutboundTransfersResponse.Merge(m, src)
+}
+func (m *QueryOutboundTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOutboundTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOutboundTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOutboundTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryOutboundTransfersResponse) GetTransfers() []*OutboundTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.ethbridge.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EthProphecy",
-			Handler:    _Query_EthProphecy_Handler,
-		},
-		{
-			MethodName: "GetBlacklist",
-			Handler:    _Query_GetBlacklist_Handler,
-		},
-		{
-			MethodName: "GetPauseStatus",
-			Handler:    _Query_GetPauseStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/ethbridge/v1/query.proto",
+func (m *QueryOutboundTransfersResponse) GetLastOutboundNonce() uint64 {
+	if m != nil {
+		return m.LastOutboundNonce
+	}
+	return 0
 }
 
-func (m *QueryEthProphecyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOutboundTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEthProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRateLimitsRequest struct {
 }
 
-func (m *QueryEthProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.TokenContractAddress) > 0 {
-		i -= len(m.TokenContractAddress)
-		copy(dAtA[i:], m.TokenContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContractAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BridgeContractAddress) > 0 {
-		i -= len(m.BridgeContractAddress)
-		copy(dAtA[i:], m.BridgeContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeContractAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{12}
 }
-
-func (m *QueryEthProphecyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEthProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitStatus `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
-func (m *QueryEthProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Claims) > 0 {
-		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{13}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBlacklistRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
 }
-
-func (m *QueryBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlacklistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitStatus {
+	if m != nil {
+		return m.RateLimits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateLimitStatus is a rate limit with its usage in the current window
+type RateLimitStatus struct {
+	RateLimit RateLimit      `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	Usage     RateLimitUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
 }
 
-func (m *QueryBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *RateLimitStatus) Reset()         { *m = RateLimitStatus{} }
+func (m *RateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*RateLimitStatus) ProtoMessage()    {}
+func (*RateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{14}
+}
+func (m *RateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitStatus.Merge(m, src)
+}
+func (m *RateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitStatus.DiscardUnknown(m)
 }
 
-func (m *QueryPauseRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitStatus proto.InternalMessageInfo
+
+func (m *RateLimitStatus) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return dAtA[:n], nil
+	return RateLimit{}
 }
 
-func (m *QueryPauseRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateLimitStatus) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return RateLimitUsage{}
 }
 
-func (m *QueryPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryQueuedInboundTransfersRequest struct {
 }
 
-func (m *QueryPauseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryQueuedInboundTransfersRequest) Reset()         { *m = QueryQueuedInboundTransfersRequest{} }
+func (m *QueryQueuedInboundTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedInboundTransfersRequest) ProtoMessage()    {}
+func (*QueryQueuedInboundTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{15}
+}
+func (m *QueryQueuedInboundTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedInboundTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedInboundTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryQueuedInboundTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedInboundTransfersRequest.Merge(m, src)
+}
+func (m *QueryQueuedInboundTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedInboundTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedInboundTransfersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryQueuedInboundTransfersRequest proto.InternalMessageInfo
+
+type QueryQueuedInboundTransfersResponse struct {
+	Transfers []QueuedInboundTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
 }
 
-func (m *QueryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsPaused {
-		i--
-		if m.IsPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryQueuedInboundTransfersResponse) Reset()         { *m = QueryQueuedInboundTransfersResponse{} }
+func (m *QueryQueuedInboundTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedInboundTransfersResponse) ProtoMessage()    {}
+func (*QueryQueuedInboundTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7077edcf9f792b78, []int{16}
+}
+func (m *QueryQueuedInboundTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedInboundTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedInboundTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQueuedInboundTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedInboundTransfersResponse.Merge(m, src)
+}
+func (m *QueryQueuedInboundTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedInboundTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedInboundTransfersResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryQueuedInboundTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedInboundTransfersResponse) GetTransfers() []QueuedInboundTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryEthProphecyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterType((*QueryEthProphecyRequest)(nil), "sifnode.ethbridge.v1.QueryEthProphecyRequest")
+	proto.RegisterType((*QueryEthProphecyResponse)(nil), "sifnode.ethbridge.v1.QueryEthProphecyResponse")
+	proto.RegisterType((*QueryBlacklistRequest)(nil), "sifnode.ethbridge.v1.QueryBlacklistRequest")
+	proto.RegisterType((*QueryBlacklistResponse)(nil), "sifnode.ethbridge.v1.QueryBlacklistResponse")
+	proto.RegisterType((*QueryPauseRequest)(nil), "sifnode.ethbridge.v1.QueryPauseRequest")
+	proto.RegisterType((*QueryPauseResponse)(nil), "sifnode.ethbridge.v1.QueryPauseResponse")
+	proto.RegisterType((*QueryConsensusNeededRequest)(nil), "sifnode.ethbridge.v1.QueryConsensusNeededRequest")
+	proto.RegisterType((*QueryConsensusNeededResponse)(nil), "sifnode.ethbridge.v1.QueryConsensusNeededResponse")
+	proto.RegisterType((*QueryOutboundTransferRequest)(nil), "sifnode.ethbridge.v1.QueryOutboundTransferRequest")
+	proto.RegisterType((*QueryOutboundTransferResponse)(nil), "sifnode.ethbridge.v1.QueryOutboundTransferResponse")
+	proto.RegisterType((*QueryOutboundTransfersRequest)(nil), "sifnode.ethbridge.v1.QueryOutboundTransfersRequest")
+	proto.RegisterType((*QueryOutboundTransfersResponse)(nil), "sifnode.ethbridge.v1.QueryOutboundTransfersResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "sifnode.ethbridge.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "sifnode.ethbridge.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*RateLimitStatus)(nil), "sifnode.ethbridge.v1.RateLimitStatus")
+	proto.RegisterType((*QueryQueuedInboundTransfersRequest)(nil), "sifnode.ethbridge.v1.QueryQueuedInboundTransfersRequest")
+	proto.RegisterType((*QueryQueuedInboundTransfersResponse)(nil), "sifnode.ethbridge.v1.QueryQueuedInboundTransfersResponse")
+}
+
+func init() { proto.RegisterFile("sifnode/ethbridge/v1/query.proto", fileDescriptor_7077edcf9f792b78) }
+
+var fileDescriptor_7077edcf9f792b78 = []byte{
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
+	0x14, 0xf7, 0xda, 0xe0, 0xe2, 0xe7, 0x04, 0xca, 0xe0, 0x80, 0xb3, 0x09, 0xc6, 0xda, 0x50, 0xb0,
+	0x42, 0x59, 0xcb, 0x0e, 0xaa, 0xda, 0xaa, 0x87, 0xd6, 0x90, 0x5a, 0x91, 0xa2, 0x84, 0x2c, 0xed,
+	0xa1, 0xbd, 0xac, 0xc6, 0xbb, 0x83, 0xbd, 0xc2, 0xde, 0x75, 0x76, 0x66, 0xad, 0x5a, 0x95, 0x2a,
+	0xf5, 0xde, 0x43, 0xae, 0x55, 0x3f, 0x48, 0xbf, 0x42, 0xa4, 0x5e, 0x38, 0x56, 0x3d, 0xd0, 0x0a,
+	0xbe, 0x41, 0x3f, 0x41, 0xb5, 0x33, 0xb3, 0x6b, 0x63, 0xaf, 0x0d, 0xf4, 0x04, 0x7e, 0xef, 0xf7,
+	0x7e, 0xef, 0xcf, 0xfc, 0xe6, 0xed, 0x40, 0x99, 0x3a, 0xa7, 0xae, 0x67, 0x93, 0x2a, 0x61, 0x9d,
+	0x96, 0xef, 0xd8, 0x6d, 0x52, 0x1d, 0xd4, 0xaa, 0x6f, 0x03, 0xe2, 0x0f, 0xf5, 0xbe, 0xef, 0x31,
+	0x0f, 0x15, 0x24, 0x42, 0x8f, 0x11, 0xfa, 0xa0, 0xa6, 0x16, 0xda, 0x5e, 0xdb, 0xe3, 0x80, 0x6a,
+	0xf8, 0x9f, 0xc0, 0xaa, 0x4f, 0x2d, 0x8f, 0xf6, 0x3c, 0x5a, 0x6d, 0x61, 0x4a, 0x04, 0x49, 0x75,
+	0x50, 0x6b, 0x11, 0x86, 0x6b, 0xd5, 0x3e, 0x6e, 0x3b, 0x2e, 0x66, 0x8e, 0xe7, 0x4a, 0x6c, 0x72,
+	0x66, 0x36, 0xec, 0x13, 0x2a, 0x11, 0x9b, 0x11, 0xc2, 0xf3, 0xb1, 0xd5, 0x9d, 0x74, 0x6b, 0xbf,
+	0xa7, 0x61, 0xe3, 0x4d, 0x98, 0xe3, 0x39, 0xeb, 0x1c, 0xfb, 0x5e, 0xbf, 0x43, 0xac, 0xa1, 0x41,
+	0xde, 0x06, 0x84, 0x32, 0xf4, 0x14, 0x56, 0x09, 0xeb, 0x10, 0x9f, 0x04, 0x3d, 0xd3, 0xea, 0x60,
+	0xc7, 0x35, 0x1d, 0xbb, 0xa8, 0x94, 0x95, 0x4a, 0xc6, 0x58, 0x89, 0x1c, 0x87, 0xa1, 0xfd, 0x85,
+	0x8d, 0x2c, 0xd8, 0x10, 0xf9, 0x4d, 0xcb, 0x73, 0x99, 0x8f, 0x2d, 0x66, 0x62, 0xdb, 0xf6, 0x09,
+	0xa5, 0xc5, 0x74, 0x59, 0xa9, 0xe4, 0x1a, 0x7b, 0xff, 0x5e, 0x6c, 0xed, 0x0e, 0x71, 0xaf, 0xfb,
+	0xb9, 0x26, 0x81, 0x3e, 0x69, 0x3b, 0x94, 0xf9, 0xc3, 0xa9, 0x08, 0xcd, 0x78, 0x20, 0x20, 0x87,
+	0xd2, 0xf1, 0x95, 0xb0, 0xa3, 0x02, 0x2c, 0xba, 0x9e, 0x6b, 0x91, 0x62, 0x86, 0x17, 0x21, 0x7e,
+	0xa0, 0x75, 0xc8, 0xd2, 0x61, 0xaf, 0xe5, 0x75, 0x8b, 0x0b, 0x61, 0x26, 0x43, 0xfe, 0x42, 0x07,
+	0xb0, 0xce, 0xbc, 0x33, 0xe2, 0x4e, 0x57, 0xb4, 0xc8, 0x71, 0x05, 0xee, 0x9d, 0xcc, 0xb1, 0x0b,
+	0x71, 0x6f, 0x26, 0x25, 0xae, 0x4d, 0xfc, 0x62, 0x96, 0xc3, 0x97, 0x23, 0xf3, 0x09, 0xb7, 0x6a,
+	0xbf, 0x29, 0x50, 0x9c, 0x9e, 0x1c, 0xed, 0x7b, 0x2e, 0x25, 0x68, 0x19, 0xd2, 0x72, 0x56, 0x39,
+	0x23, 0xed, 0xd8, 0xa8, 0x06, 0x59, 0xca, 0x30, 0x0b, 0xc4, 0x34, 0xf2, 0xf5, 0x87, 0x7a, 0x24,
+	0x08, 0x71, 0x2c, 0xfa, 0xa0, 0xa6, 0x9f, 0x70, 0x80, 0x21, 0x81, 0xe8, 0x0b, 0xc8, 0x5a, 0x5d,
+	0xec, 0xf4, 0x68, 0x31, 0x53, 0xce, 0x54, 0xf2, 0xf5, 0x6d, 0x3d, 0x49, 0x43, 0xfa, 0x73, 0xd6,
+	0x69, 0x88, 0x61, 0x85, 0x60, 0x43, 0xc6, 0x68, 0x1b, 0xf0, 0x80, 0x17, 0xd7, 0xe8, 0x62, 0xeb,
+	0xac, 0xeb, 0x50, 0x26, 0x0f, 0x55, 0xfb, 0x04, 0xd6, 0x27, 0x1d, 0xb2, 0xe6, 0xc7, 0x90, 0x93,
+	0x03, 0x22, 0xb4, 0xa8, 0x94, 0x33, 0x95, 0x9c, 0x31, 0x32, 0x68, 0x6b, 0xb0, 0xca, 0xe3, 0x8e,
+	0x71, 0x40, 0x49, 0x44, 0x56, 0x03, 0x34, 0x6e, 0x94, 0x44, 0x8f, 0x20, 0xe7, 0x50, 0xb3, 0x1f,
+	0xda, 0xc4, 0x0c, 0x96, 0x8c, 0x25, 0x87, 0x72, 0x8c, 0xad, 0x6d, 0xc2, 0x23, 0x1e, 0x72, 0x18,
+	0x42, 0x5d, 0x1a, 0xd0, 0x57, 0x84, 0xd8, 0xc4, 0x8e, 0x18, 0x87, 0xf0, 0x38, 0xd9, 0x2d, 0xb9,
+	0xbf, 0x83, 0x0f, 0xad, 0xc8, 0x65, 0xba, 0xdc, 0x27, 0xc6, 0xdc, 0xd0, 0xdf, 0x5f, 0x6c, 0xa5,
+	0xfe, 0xba, 0xd8, 0xda, 0x69, 0x3b, 0xac, 0x13, 0xb4, 0x74, 0xcb, 0xeb, 0x55, 0xe5, 0x4d, 0x12,
+	0x7f, 0xf6, 0xa9, 0x7d, 0x26, 0xb5, 0x7f, 0x44, 0x2c, 0x63, 0xc5, 0xba, 0x9e, 0x42, 0x3b, 0x90,
+	0xa9, 0x5f, 0x07, 0xac, 0xe5, 0x05, 0xae, 0xfd, 0x8d, 0x8f, 0x5d, 0x7a, 0x4a, 0xfc, 0xe8, 0x3a,
+	0xc4, 0xea, 0x0b, 0xf3, 0x2d, 0x48, 0xf5, 0x69, 0x16, 0x6c, 0xce, 0x88, 0x92, 0x15, 0x37, 0x60,
+	0x89, 0x49, 0x1b, 0x8f, 0xcc, 0xd7, 0x77, 0x92, 0x4f, 0x72, 0x8a, 0x21, 0x8e, 0xd3, 0x7e, 0x51,
+	0x66, 0x64, 0xa1, 0x51, 0x71, 0x4f, 0xe0, 0xbe, 0xe8, 0x32, 0x12, 0xad, 0xd0, 0xde, 0x3d, 0x61,
+	0x14, 0x92, 0x45, 0x5f, 0x03, 0x8c, 0x36, 0x88, 0x54, 0xe2, 0x8e, 0x2e, 0x20, 0x7a, 0xb8, 0x6e,
+	0x74, 0xb1, 0xb3, 0xe4, 0xba, 0xd1, 0x8f, 0x71, 0x3b, 0x3a, 0x6a, 0x63, 0x2c, 0x52, 0xfb, 0x5b,
+	0x81, 0xd2, 0xac, 0x72, 0x64, 0xd7, 0x47, 0x90, 0x8b, 0xaa, 0x17, 0x62, 0xba, 0x7d, 0xdb, 0xa3,
+	0x40, 0xa4, 0xc3, 0x5a, 0x17, 0x53, 0x66, 0x7a, 0x12, 0x63, 0x8a, 0x03, 0x48, 0xf3, 0x03, 0x58,
+	0x0d, 0x5d, 0x51, 0xf4, 0xab, 0xd0, 0x81, 0x9a, 0xd7, 0x1a, 0xcc, 0xf0, 0x06, 0x77, 0x6f, 0x6c,
+	0x50, 0x94, 0x7c, 0xad, 0xc3, 0xa2, 0xbc, 0x25, 0x06, 0x66, 0xe4, 0xa5, 0xd3, 0x73, 0x58, 0x34,
+	0x68, 0xad, 0x0d, 0x1b, 0x53, 0x1e, 0xd9, 0xf3, 0x4b, 0xc8, 0xfb, 0x98, 0x11, 0xb3, 0xcb, 0xcd,
+	0xb2, 0xeb, 0x8f, 0x92, 0xbb, 0x8e, 0xc3, 0xc5, 0xad, 0x6f, 0x2c, 0x84, 0xea, 0x35, 0xc0, 0x8f,
+	0x59, 0xb5, 0x5f, 0x15, 0x58, 0x99, 0x40, 0xa1, 0x23, 0x80, 0x51, 0x06, 0xa9, 0xa6, 0xad, 0x1b,
+	0x12, 0x48, 0xea, 0x5c, 0x4c, 0x8d, 0xbe, 0x84, 0xc5, 0x80, 0xe2, 0x36, 0x91, 0x0a, 0xd8, 0xbe,
+	0x81, 0xe0, 0xdb, 0x10, 0x2b, 0x59, 0x44, 0xa0, 0xb6, 0x0d, 0x1a, 0x1f, 0xc2, 0x9b, 0x80, 0x04,
+	0xc4, 0x7e, 0xe1, 0x26, 0x6a, 0x52, 0x1b, 0xc0, 0x93, 0xb9, 0x28, 0x39, 0xb6, 0xd7, 0xd3, 0x52,
+	0xd9, 0x4b, 0x2e, 0x29, 0x91, 0x28, 0xea, 0x2f, 0xe6, 0xa8, 0xff, 0xf1, 0x01, 0x2c, 0xf2, 0xc4,
+	0xc8, 0x85, 0xfc, 0xd8, 0x76, 0x46, 0xfb, 0x33, 0x69, 0x93, 0xbe, 0x7f, 0xaa, 0x7e, 0x5b, 0xb8,
+	0x68, 0x44, 0x4b, 0xa1, 0x33, 0xb8, 0xd7, 0x24, 0x2c, 0x5e, 0xad, 0x68, 0x6f, 0x0e, 0xc3, 0xe4,
+	0x66, 0x56, 0x3f, 0xbe, 0x1d, 0x38, 0x4e, 0x66, 0xc1, 0x72, 0x93, 0x30, 0xbe, 0x56, 0xa5, 0x3c,
+	0x76, 0xe7, 0x30, 0x8c, 0xef, 0x6d, 0xb5, 0x72, 0x33, 0x50, 0x1e, 0xce, 0x8f, 0x80, 0x9a, 0x84,
+	0x4d, 0x6c, 0x63, 0x54, 0x9b, 0x13, 0x9f, 0xbc, 0xd8, 0xd5, 0xfa, 0x5d, 0x42, 0x64, 0xf2, 0x9f,
+	0x60, 0xad, 0x49, 0xd8, 0xe4, 0x82, 0x40, 0xf3, 0xa8, 0x66, 0x2c, 0x6f, 0xf5, 0xd9, 0x9d, 0x62,
+	0x64, 0xfe, 0x9f, 0x15, 0x28, 0x24, 0x14, 0x40, 0xd1, 0x5d, 0xd8, 0xa2, 0xeb, 0xa0, 0x1e, 0xdc,
+	0x2d, 0x48, 0xd6, 0xd0, 0x85, 0xfb, 0x4d, 0xc2, 0x46, 0xdb, 0x06, 0xcd, 0x93, 0xc9, 0xd4, 0xba,
+	0x52, 0xf7, 0x6f, 0x89, 0x96, 0xd9, 0xde, 0x29, 0xf0, 0xb0, 0x49, 0x58, 0xf2, 0x8d, 0x45, 0x9f,
+	0xce, 0x21, 0x9b, 0xbb, 0x0a, 0xd4, 0xcf, 0xfe, 0x47, 0xa4, 0x28, 0xa9, 0xd1, 0x7c, 0x7f, 0x59,
+	0x52, 0xce, 0x2f, 0x4b, 0xca, 0x3f, 0x97, 0x25, 0xe5, 0xdd, 0x55, 0x29, 0x75, 0x7e, 0x55, 0x4a,
+	0xfd, 0x79, 0x55, 0x4a, 0x7d, 0xbf, 0x3f, 0xf6, 0xa5, 0x3f, 0x71, 0x4e, 0xf9, 0x13, 0xb5, 0x1a,
+	0x3d, 0x77, 0x7f, 0x18, 0x7b, 0x12, 0xf3, 0x8f, 0x7e, 0x2b, 0xcb, 0x5f, 0xbc, 0xcf, 0xfe, 0x1b,
+	0x00, 0x70, 0xad, 0x09, 0x1e, 0xae, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// EthProphecy queries an EthProphecy
+	EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error)
+	GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error)
+	GetPauseStatus(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error)
+	GetConsensusNeeded(ctx context.Context, in *QueryConsensusNeededRequest, opts ...grpc.CallOption) (*QueryConsensusNeededResponse, error)
+	// GetOutboundTransfer queries the outbound transfer with the given nonce
+	GetOutboundTransfer(ctx context.Context, in *QueryOutboundTransferRequest, opts ...grpc.CallOption) (*QueryOutboundTransferResponse, error)
+	// GetOutboundTransfers queries outbound transfers in nonce order, optionally
+	// only those of a single sender
+	GetOutboundTransfers(ctx context.Context, in *QueryOutboundTransfersRequest, opts ...grpc.CallOption) (*QueryOutboundTransfersResponse, error)
+	// GetRateLimits queries the rate limits with their usage in the current
+	// window
+	GetRateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// GetQueuedInboundTransfers queries the inbound transfers held back by rate
+	// limits
+	GetQueuedInboundTransfers(ctx context.Context, in *QueryQueuedInboundTransfersRequest, opts ...grpc.CallOption) (*QueryQueuedInboundTransfersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) EthProphecy(ctx context.Context, in *QueryEthProphecyRequest, opts ...grpc.CallOption) (*QueryEthProphecyResponse, error) {
+	out := new(QueryEthProphecyResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/EthProphecy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.EthereumChainId != 0 {
-		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	return out, nil
+}
+
+func (c *queryClient) GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error) {
+	out := new(QueryBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.BridgeContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetPauseStatus(ctx context.Context, in *QueryPauseRequest, opts ...grpc.CallOption) (*QueryPauseResponse, error) {
+	out := new(QueryPauseResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetPauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	return out, nil
+}
+
+func (c *queryClient) GetConsensusNeeded(ctx context.Context, in *QueryConsensusNeededRequest, opts ...grpc.CallOption) (*QueryConsensusNeededResponse, error) {
+	out := new(QueryConsensusNeededResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetConsensusNeeded", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetOutboundTransfer(ctx context.Context, in *QueryOutboundTransferRequest, opts ...grpc.CallOption) (*QueryOutboundTransferResponse, error) {
+	out := new(QueryOutboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetOutboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TokenContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetOutboundTransfers(ctx context.Context, in *QueryOutboundTransfersRequest, opts ...grpc.CallOption) (*QueryOutboundTransfersResponse, error) {
+	out := new(QueryOutboundTransfersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetOutboundTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) GetRateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEthProphecyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetQueuedInboundTransfers(ctx context.Context, in *QueryQueuedInboundTransfersRequest, opts ...grpc.CallOption) (*QueryQueuedInboundTransfersResponse, error) {
+	out := new(QueryQueuedInboundTransfersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Query/GetQueuedInboundTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// EthProphecy queries an EthProphecy
+	EthProphecy(context.Context, *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error)
+	GetBlacklist(context.Context, *QueryBlacklistRequest) (*QueryBlacklistResponse, error)
+	GetPauseStatus(context.Context, *QueryPauseRequest) (*QueryPauseResponse, error)
+	GetConsensusNeeded(context.Context, *QueryConsensusNeededRequest) (*QueryConsensusNeededResponse, error)
+	// GetOutboundTransfer queries the outbound transfer with the given nonce
+	GetOutboundTransfer(context.Context, *QueryOutboundTransferRequest) (*QueryOutboundTransferResponse, error)
+	// GetOutboundTransfers queries outbound transfers in nonce order, optionally
+	// only those of a single sender
+	GetOutboundTransfers(context.Context, *QueryOutboundTransfersRequest) (*QueryOutboundTransfersResponse, error)
+	// GetRateLimits queries the rate limits with their usage in the current
+	// window
+	GetRateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// GetQueuedInboundTransfers queries the inbound transfers held back by rate
+	// limits
+	GetQueuedInboundTransfers(context.Context, *QueryQueuedInboundTransfersRequest) (*QueryQueuedInboundTransfersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) EthProphecy(ctx context.Context, req *QueryEthProphecyRequest) (*QueryEthProphecyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthProphecy not implemented")
+}
+func (*UnimplementedQueryServer) GetBlacklist(ctx context.Context, req *QueryBlacklistRequest) (*QueryBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
+}
+func (*UnimplementedQueryServer) GetPauseStatus(ctx context.Context, req *QueryPauseRequest) (*QueryPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPauseStatus not implemented")
+}
+func (*UnimplementedQueryServer) GetConsensusNeeded(ctx context.Context, req *QueryConsensusNeededRequest) (*QueryConsensusNeededResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsensusNeeded not implemented")
+}
+func (*UnimplementedQueryServer) GetOutboundTransfer(ctx context.Context, req *QueryOutboundTransferRequest) (*QueryOutboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundTransfer not implemented")
+}
+func (*UnimplementedQueryServer) GetOutboundTransfers(ctx context.Context, req *QueryOutboundTransfersRequest) (*QueryOutboundTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutboundTransfers not implemented")
+}
+func (*UnimplementedQueryServer) GetRateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) GetQueuedInboundTransfers(ctx context.Context, req *QueryQueuedInboundTransfersRequest) (*QueryQueuedInboundTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueuedInboundTransfers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_EthProphecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEthProphecyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).EthProphecy(ctx, in)
 	}
-	if len(m.Claims) > 0 {
-		for _, e := range m.Claims {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/EthProphecy",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthProphecy(ctx, req.(*QueryEthProphecyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlacklistRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlacklist(ctx, req.(*QueryBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlacklistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPauseStatus(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetPauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPauseStatus(ctx, req.(*QueryPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPauseRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetConsensusNeeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsensusNeededRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetConsensusNeeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetConsensusNeeded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetConsensusNeeded(ctx, req.(*QueryConsensusNeededRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPauseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetOutboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.IsPaused {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).GetOutboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetOutboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOutboundTransfer(ctx, req.(*QueryOutboundTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetOutboundTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutboundTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetOutboundTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetOutboundTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOutboundTransfers(ctx, req.(*QueryOutboundTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetQueuedInboundTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedInboundTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetQueuedInboundTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Query/GetQueuedInboundTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetQueuedInboundTransfers(ctx, req.(*QueryQueuedInboundTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.ethbridge.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EthProphecy",
+			Handler:    _Query_EthProphecy_Handler,
+		},
+		{
+			MethodName: "GetBlacklist",
+			Handler:    _Query_GetBlacklist_Handler,
+		},
+		{
+			MethodName: "GetPauseStatus",
+			Handler:    _Query_GetPauseStatus_Handler,
+		},
+		{
+			MethodName: "GetConsensusNeeded",
+			Handler:    _Query_GetConsensusNeeded_Handler,
+		},
+		{
+			MethodName: "GetOutboundTransfer",
+			Handler:    _Query_GetOutboundTransfer_Handler,
+		},
+		{
+			MethodName: "GetOutboundTransfers",
+			Handler:    _Query_GetOutboundTransfers_Handler,
+		},
+		{
+			MethodName: "GetRateLimits",
+			Handler:    _Query_GetRateLimits_Handler,
+		},
+		{
+			MethodName: "GetQueuedInboundTransfers",
+			Handler:    _Query_GetQueuedInboundTransfers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/ethbridge/v1/query.proto",
+}
+
+func (m *QueryEthProphecyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TokenContractAddress) > 0 {
+		i -= len(m.TokenContractAddress)
+		copy(dAtA[i:], m.TokenContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BridgeContractAddress) > 0 {
+		i -= len(m.BridgeContractAddress)
+		copy(dAtA[i:], m.BridgeContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BridgeContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEthProphecyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusNeededRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusNeededRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusNeededRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsensusNeededResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsensusNeededResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsensusNeededResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConsensusNeeded.Size()
+		i -= size
+		if _, err := m.ConsensusNeeded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutboundTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutboundTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutboundTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastOutboundNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastOutboundNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedInboundTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedInboundTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedInboundTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedInboundTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedInboundTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedInboundTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEthProphecyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthereumChainId != 0 {
+		n += 1 + sovQuery(uint64(m.EthereumChainId))
+	}
+	l = len(m.BridgeContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthProphecyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlacklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPauseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsensusNeededRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsensusNeededResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ConsensusNeeded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOutboundTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutboundTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.LastOutboundNonce != 0 {
+		n += 1 + sovQuery(uint64(m.LastOutboundNonce))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueuedInboundTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQueuedInboundTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthProphecyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthProphecyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &types.Status{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, &EthBridgeClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -910,17 +2707,17 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthProphecyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPauseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
 			}
-			m.EthereumChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -930,14 +2727,115 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusNeededRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusNeededRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusNeededRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsensusNeededResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsensusNeededResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsensusNeededResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusNeeded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -965,9 +2863,61 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.ConsensusNeeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -981,16 +2931,66 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1000,27 +3000,81 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transfer == nil {
+				m.Transfer = &OutboundTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutboundTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutboundTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutboundTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1048,13 +3102,13 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1064,23 +3118,27 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1103,7 +3161,7 @@ func (m *QueryEthProphecyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutboundTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1126,17 +3184,17 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEthProphecyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutboundTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEthProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutboundTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1146,29 +3204,31 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Transfers = append(m.Transfers, &OutboundTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutboundNonce", wireType)
 			}
-			var msglen int
+			m.LastOutboundNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1178,31 +3238,14 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastOutboundNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &types.Status{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1229,8 +3272,10 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Claims = append(m.Claims, &EthBridgeClaim{})
-			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1255,7 +3300,7 @@ func (m *QueryEthProphecyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,10 +3323,10 @@ func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlacklistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1305,7 +3350,7 @@ func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,17 +3373,17 @@ func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlacklistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1348,23 +3393,25 @@ func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.RateLimits = append(m.RateLimits, RateLimitStatus{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1387,7 +3434,7 @@ func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
+func (m *RateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1410,10 +3457,126 @@ func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPauseRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPauseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedInboundTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedInboundTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedInboundTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1437,7 +3600,7 @@ func (m *QueryPauseRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedInboundTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1460,17 +3623,17 @@ func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPauseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedInboundTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedInboundTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1480,12 +3643,26 @@ func (m *QueryPauseResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, QueuedInboundTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
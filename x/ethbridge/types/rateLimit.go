@@ -0,0 +1,58 @@
+package types
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks that the rate limit names a denom, has no negative limits and a window
+func (rateLimit RateLimit) Validate() error {
+	if err := sdk.ValidateDenom(rateLimit.Denom); err != nil {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	if rateLimit.InboundLimit.IsNil() || rateLimit.InboundLimit.IsNegative() ||
+		rateLimit.OutboundLimit.IsNil() || rateLimit.OutboundLimit.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "limits must not be negative")
+	}
+	if rateLimit.WindowBlocks == 0 && !rateLimit.IsUnlimited() {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "window must be at least one block")
+	}
+	if rateLimit.WindowBlocks > math.MaxInt64 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "window is too large")
+	}
+	return nil
+}
+
+// GetWindowStartHeight returns the height the window containing the given height starts at,
+// windows start at multiples of the window size
+func (rateLimit RateLimit) GetWindowStartHeight(height int64) int64 {
+	return height - height%int64(rateLimit.WindowBlocks)
+}
+
+// GetSlidingAmount approximates the amount bridged over the window of blocks ending at the given height
+// from the amounts of the current and previous windows, weighting the previous amount by the share of
+// the previous window the sliding window still covers
+func (rateLimit RateLimit) GetSlidingAmount(height int64, current sdk.Int, previous sdk.Int) sdk.Int {
+	window := int64(rateLimit.WindowBlocks)
+	remaining := window - height%window
+	return current.Add(previous.MulRaw(remaining).QuoRaw(window))
+}
+
+// IsUnlimited returns true if the rate limit caps neither direction
+func (rateLimit RateLimit) IsUnlimited() bool {
+	return rateLimit.InboundLimit.IsZero() && rateLimit.OutboundLimit.IsZero()
+}
+
+// NewRateLimitUsage returns an empty usage for a window starting at the given height
+func NewRateLimitUsage(denom string, windowStartHeight int64) RateLimitUsage {
+	return RateLimitUsage{
+		Denom:                  denom,
+		WindowStartHeight:      windowStartHeight,
+		InboundAmount:          sdk.ZeroInt(),
+		OutboundAmount:         sdk.ZeroInt(),
+		PreviousInboundAmount:  sdk.ZeroInt(),
+		PreviousOutboundAmount: sdk.ZeroInt(),
+	}
+}
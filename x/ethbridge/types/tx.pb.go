@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/Sifchain/sifnode/x/oracle/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -189,6 +190,8 @@ func (m *MsgLock) GetEthereumReceiver() string {
 }
 
 type MsgLockResponse struct {
+	// nonce of the outbound transfer recorded for this lock
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgLockResponse) Reset()         { *m = MsgLockResponse{} }
@@ -224,6 +227,13 @@ func (m *MsgLockResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLockResponse proto.InternalMessageInfo
 
+func (m *MsgLockResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // MsgBurn defines a message for burning coins and triggering a related event
 type MsgBurn struct {
 	CosmosSender     string                                 `protobuf:"bytes,1,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
@@ -296,6 +306,8 @@ func (m *MsgBurn) GetEthereumReceiver() string {
 }
 
 type MsgBurnResponse struct {
+	// nonce of the outbound transfer recorded for this burn
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
 func (m *MsgBurnResponse) Reset()         { *m = MsgBurnResponse{} }
@@ -331,6 +343,13 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
+func (m *MsgBurnResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 type MsgCreateEthBridgeClaim struct {
 	EthBridgeClaim *EthBridgeClaim `protobuf:"bytes,1,opt,name=eth_bridge_claim,json=ethBridgeClaim,proto3" json:"eth_bridge_claim,omitempty" yaml:"eth_bridge_claim"`
 }
@@ -773,1235 +792,3641 @@ func (m *MsgSetBlacklistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBlacklistResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPause)(nil), "sifnode.ethbridge.v1.MsgPause")
-	proto.RegisterType((*MsgPauseResponse)(nil), "sifnode.ethbridge.v1.MsgPauseResponse")
-	proto.RegisterType((*MsgLock)(nil), "sifnode.ethbridge.v1.MsgLock")
-	proto.RegisterType((*MsgLockResponse)(nil), "sifnode.ethbridge.v1.MsgLockResponse")
-	proto.RegisterType((*MsgBurn)(nil), "sifnode.ethbridge.v1.MsgBurn")
-	proto.RegisterType((*MsgBurnResponse)(nil), "sifnode.ethbridge.v1.MsgBurnResponse")
-	proto.RegisterType((*MsgCreateEthBridgeClaim)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaim")
-	proto.RegisterType((*MsgCreateEthBridgeClaimResponse)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaimResponse")
-	proto.RegisterType((*MsgUpdateWhiteListValidator)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidator")
-	proto.RegisterType((*MsgUpdateWhiteListValidatorResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidatorResponse")
-	proto.RegisterType((*MsgUpdateCethReceiverAccount)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccount")
-	proto.RegisterType((*MsgUpdateCethReceiverAccountResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccountResponse")
-	proto.RegisterType((*MsgRescueCeth)(nil), "sifnode.ethbridge.v1.MsgRescueCeth")
-	proto.RegisterType((*MsgRescueCethResponse)(nil), "sifnode.ethbridge.v1.MsgRescueCethResponse")
-	proto.RegisterType((*MsgSetBlacklist)(nil), "sifnode.ethbridge.v1.MsgSetBlacklist")
-	proto.RegisterType((*MsgSetBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgSetBlacklistResponse")
+// MsgSetConsensusNeeded sets the proportion of whitelisted validator power
+// needed for a prophecy to succeed
+type MsgSetConsensusNeeded struct {
+	Signer          string                                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ConsensusNeeded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=consensus_needed,json=consensusNeeded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consensus_needed" yaml:"consensus_needed"`
 }
 
-func init() { proto.RegisterFile("sifnode/ethbridge/v1/tx.proto", fileDescriptor_44d60f3dabe1980f) }
-
-var fileDescriptor_44d60f3dabe1980f = []byte{
-	// 909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x37, 0x25, 0x34, 0x6f, 0xb7, 0xff, 0x86, 0x54, 0x75, 0xdd, 0x6e, 0x1c, 0xbc, 0xbb,
-	0xa5, 0x08, 0x35, 0x51, 0x83, 0x38, 0xb0, 0x12, 0x82, 0x75, 0x40, 0x50, 0xa9, 0x11, 0x68, 0x0a,
-	0xac, 0xc4, 0x01, 0xcb, 0xb5, 0xa7, 0x8e, 0xd5, 0xc4, 0x8e, 0x3c, 0x93, 0xb2, 0x95, 0x38, 0x23,
-	0x24, 0x2e, 0x7c, 0x12, 0x3e, 0x47, 0x8f, 0xab, 0x9e, 0x10, 0x87, 0x08, 0xb5, 0xdf, 0x20, 0x9f,
-	0x00, 0x79, 0xc6, 0x9e, 0x38, 0xaa, 0x1d, 0x12, 0x71, 0xe1, 0xb0, 0xa7, 0xd8, 0xef, 0xfd, 0x7e,
-	0xbf, 0x79, 0xcf, 0xef, 0x4f, 0x06, 0x1e, 0x53, 0xff, 0x3c, 0x08, 0x5d, 0xd2, 0x24, 0xac, 0x7b,
-	0x16, 0xf9, 0xae, 0x47, 0x9a, 0x97, 0x47, 0x4d, 0xf6, 0xaa, 0x31, 0x88, 0x42, 0x16, 0xa2, 0x6a,
-	0xe2, 0x6e, 0x48, 0x77, 0xe3, 0xf2, 0x48, 0xab, 0x7a, 0xa1, 0x17, 0x72, 0x40, 0x33, 0x7e, 0x12,
-	0x58, 0xad, 0x9e, 0x2f, 0x75, 0x35, 0x20, 0x54, 0x20, 0x0c, 0x0c, 0x2b, 0x1d, 0xea, 0x7d, 0x63,
-	0x0f, 0x29, 0x41, 0xef, 0x43, 0x99, 0xfa, 0x5e, 0x40, 0x22, 0x55, 0xa9, 0x2b, 0x07, 0x15, 0x73,
-	0x73, 0x3c, 0xd2, 0x57, 0xaf, 0xec, 0x7e, 0xef, 0xb9, 0x21, 0xec, 0x06, 0x4e, 0x00, 0x68, 0x17,
-	0x2a, 0x3e, 0xb5, 0x06, 0x31, 0xcd, 0x55, 0x1f, 0xd4, 0x95, 0x83, 0x15, 0xbc, 0xe2, 0x53, 0x2e,
-	0xe3, 0x1a, 0x08, 0x36, 0x52, 0x4d, 0x4c, 0xe8, 0x20, 0x0c, 0x28, 0x31, 0xfe, 0x28, 0xc1, 0xdb,
-	0x1d, 0xea, 0x9d, 0x84, 0xce, 0x05, 0x7a, 0x02, 0xab, 0x4e, 0x48, 0xfb, 0x21, 0xb5, 0x28, 0x09,
-	0xdc, 0xf4, 0x38, 0xfc, 0x48, 0x18, 0x4f, 0xb9, 0x0d, 0xbd, 0x84, 0xb2, 0xdd, 0x0f, 0x87, 0x01,
-	0xe3, 0xf2, 0x15, 0xf3, 0xd3, 0xeb, 0x91, 0xbe, 0xf4, 0xd7, 0x48, 0xdf, 0xf7, 0x7c, 0xd6, 0x1d,
-	0x9e, 0x35, 0x9c, 0xb0, 0xdf, 0x14, 0x84, 0xe4, 0xe7, 0x90, 0xba, 0x17, 0x49, 0x6a, 0xc7, 0x01,
-	0x9b, 0x84, 0x2e, 0x54, 0x0c, 0x9c, 0xc8, 0xf1, 0x2c, 0xaf, 0xfa, 0x67, 0x61, 0x4f, 0x2d, 0xdd,
-	0xcb, 0x92, 0xdb, 0xe3, 0x2c, 0xf9, 0x03, 0xfa, 0x0a, 0x36, 0x09, 0xeb, 0x92, 0x88, 0x0c, 0xfb,
-	0x96, 0xd3, 0xb5, 0xfd, 0xc0, 0xf2, 0x5d, 0x75, 0xb9, 0xae, 0x1c, 0x94, 0xcc, 0xbd, 0xf1, 0x48,
-	0x57, 0x05, 0xeb, 0x1e, 0xc4, 0xc0, 0xeb, 0xa9, 0xad, 0x1d, 0x9b, 0x8e, 0x5d, 0x74, 0x9c, 0x51,
-	0x8a, 0x88, 0x43, 0xfc, 0x4b, 0x12, 0xa9, 0x6f, 0xf1, 0xf3, 0xf3, 0x94, 0x52, 0x88, 0x81, 0x37,
-	0x52, 0x1b, 0x4e, 0x4c, 0x88, 0xc0, 0x43, 0x87, 0xb0, 0xae, 0x95, 0x7c, 0x9d, 0x32, 0x17, 0xf9,
-	0x7c, 0xe1, 0xaf, 0x83, 0xc4, 0x91, 0x19, 0x29, 0x03, 0x43, 0xfc, 0xf6, 0x42, 0xbc, 0x6c, 0xc2,
-	0x7a, 0x52, 0x2f, 0x59, 0xc3, 0x6b, 0x51, 0x43, 0x73, 0x18, 0x05, 0xe8, 0x93, 0xdc, 0x1a, 0x9a,
-	0xea, 0x78, 0xa4, 0x57, 0x13, 0xe5, 0xac, 0xdb, 0x78, 0x53, 0xdd, 0xff, 0x61, 0x75, 0xe3, 0x4a,
-	0xca, 0xea, 0xfe, 0xa2, 0xc0, 0x76, 0x87, 0x7a, 0xed, 0x88, 0xd8, 0x8c, 0x7c, 0xc1, 0xba, 0x26,
-	0xdf, 0x17, 0xed, 0x9e, 0xed, 0xf7, 0xd1, 0x05, 0xc4, 0x91, 0x5a, 0x62, 0x85, 0x58, 0x4e, 0x6c,
-	0xe3, 0x05, 0x7f, 0xd8, 0x7a, 0xda, 0xc8, 0x5b, 0x47, 0x8d, 0x69, 0xbe, 0xb9, 0x3b, 0x1e, 0xe9,
-	0xdb, 0xf2, 0x2b, 0x4c, 0xe9, 0x18, 0x78, 0x8d, 0x4c, 0x81, 0x8d, 0x77, 0x41, 0x2f, 0x88, 0x43,
-	0xc6, 0x7a, 0xa3, 0xc0, 0x6e, 0x87, 0x7a, 0xdf, 0x0d, 0x5c, 0x9b, 0x91, 0x97, 0x5d, 0x9f, 0x91,
-	0x13, 0x9f, 0xb2, 0xef, 0xed, 0x9e, 0xef, 0xda, 0x2c, 0x8c, 0xfe, 0x6b, 0x77, 0xb6, 0xa0, 0x72,
-	0x99, 0x6a, 0x25, 0x0d, 0x5a, 0x1d, 0x8f, 0xf4, 0x0d, 0x41, 0x95, 0x2e, 0x03, 0x4f, 0x60, 0xe8,
-	0x33, 0x58, 0x0b, 0x07, 0x24, 0xb2, 0x99, 0x1f, 0x06, 0x56, 0x5c, 0x8a, 0xa4, 0x01, 0x77, 0xc6,
-	0x23, 0x7d, 0x4b, 0x10, 0xa7, 0xfd, 0x06, 0x5e, 0x95, 0x86, 0x6f, 0xe3, 0xf7, 0x67, 0xf0, 0x64,
-	0x46, 0x4e, 0x32, 0xf7, 0x9f, 0x60, 0x4f, 0xc2, 0xda, 0x84, 0x75, 0xd3, 0xd6, 0x79, 0xe1, 0x38,
-	0x7c, 0x02, 0xe6, 0xda, 0xae, 0x2d, 0xd8, 0xe2, 0xbd, 0x91, 0xb6, 0xa2, 0x65, 0x0b, 0xb6, 0xc8,
-	0x16, 0xbf, 0xe3, 0xdc, 0x17, 0x36, 0xf6, 0xe1, 0xe9, 0xac, 0x83, 0x27, 0xab, 0x5e, 0x81, 0xd5,
-	0x0e, 0xf5, 0x30, 0xa1, 0xce, 0x90, 0x03, 0xe7, 0x0b, 0xe9, 0x3d, 0x58, 0x4f, 0x40, 0x72, 0x84,
-	0x44, 0x30, 0x6b, 0xc2, 0x2c, 0x47, 0xe4, 0xeb, 0xe9, 0x11, 0x11, 0x9f, 0xb9, 0xb1, 0xd8, 0x88,
-	0x4c, 0x0d, 0xc3, 0x36, 0x6c, 0x4d, 0xc5, 0x2b, 0x33, 0x69, 0xf3, 0x29, 0x39, 0x25, 0xcc, 0xec,
-	0xd9, 0xce, 0x45, 0xcf, 0xa7, 0x0c, 0x21, 0x58, 0x3e, 0x8f, 0xc2, 0x7e, 0x92, 0x01, 0x7f, 0x46,
-	0x7b, 0x50, 0xb1, 0x5d, 0x37, 0x22, 0x94, 0x12, 0xaa, 0x3e, 0xa8, 0x97, 0x0e, 0x2a, 0x78, 0x62,
-	0x30, 0x76, 0xf8, 0x58, 0x65, 0x45, 0x52, 0xfd, 0xd6, 0x4d, 0x19, 0x4a, 0x1d, 0xea, 0xa1, 0x13,
-	0x58, 0xe6, 0x7f, 0x8c, 0x8f, 0xf3, 0x87, 0x29, 0xd9, 0xc3, 0xda, 0xb3, 0x99, 0xee, 0x54, 0x35,
-	0x56, 0xe3, 0x2b, 0xba, 0x58, 0x2d, 0x76, 0xcf, 0x50, 0xcb, 0xae, 0x05, 0xf4, 0x33, 0x54, 0x73,
-	0x57, 0xc2, 0x61, 0x21, 0x3d, 0x0f, 0xae, 0x7d, 0xb4, 0x10, 0x5c, 0x9e, 0xfe, 0xab, 0x02, 0x6a,
-	0xe1, 0x94, 0x1f, 0x15, 0x6a, 0x16, 0x51, 0xb4, 0x8f, 0x17, 0xa6, 0xc8, 0x50, 0x7e, 0x53, 0x60,
-	0xa7, 0x78, 0xea, 0x5a, 0xff, 0x22, 0x9c, 0xc3, 0xd1, 0x9e, 0x2f, 0xce, 0x91, 0xd1, 0xfc, 0x08,
-	0x90, 0x1d, 0xb0, 0x42, 0xa5, 0x09, 0x48, 0xfb, 0x60, 0x0e, 0x90, 0xd4, 0x77, 0xe1, 0xd1, 0x54,
-	0xdf, 0x17, 0x77, 0x4b, 0x16, 0xa6, 0x1d, 0xce, 0x05, 0x93, 0xa7, 0x60, 0x58, 0x39, 0x25, 0x4c,
-	0xdc, 0x3e, 0x6b, 0x85, 0x54, 0xee, 0xd7, 0xf6, 0x67, 0xfb, 0x53, 0x4d, 0xf3, 0xcb, 0xeb, 0xdb,
-	0x9a, 0xf2, 0xfa, 0xb6, 0xa6, 0xfc, 0x7d, 0x5b, 0x53, 0x7e, 0xbf, 0xab, 0x2d, 0xbd, 0xbe, 0xab,
-	0x2d, 0xfd, 0x79, 0x57, 0x5b, 0xfa, 0xe1, 0x30, 0xb3, 0x1b, 0x4e, 0xfd, 0x73, 0xfe, 0x87, 0xde,
-	0x4c, 0x6f, 0xc8, 0xaf, 0x32, 0x77, 0x64, 0xbe, 0x26, 0xce, 0xca, 0xfc, 0x86, 0xfc, 0xe1, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xd5, 0xfe, 0x5a, 0x90, 0x0b, 0x00, 0x00,
+func (m *MsgSetConsensusNeeded) Reset()         { *m = MsgSetConsensusNeeded{} }
+func (m *MsgSetConsensusNeeded) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsensusNeeded) ProtoMessage()    {}
+func (*MsgSetConsensusNeeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{16}
+}
+func (m *MsgSetConsensusNeeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsensusNeeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsensusNeeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsensusNeeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsensusNeeded.Merge(m, src)
+}
+func (m *MsgSetConsensusNeeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsensusNeeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsensusNeeded.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetConsensusNeeded proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetConsensusNeeded) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
-	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
-	CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error)
-	UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error)
-	UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error)
-	RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error)
-	SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error)
-	SetPause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+type MsgSetConsensusNeededResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetConsensusNeededResponse) Reset()         { *m = MsgSetConsensusNeededResponse{} }
+func (m *MsgSetConsensusNeededResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetConsensusNeededResponse) ProtoMessage()    {}
+func (*MsgSetConsensusNeededResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{17}
+}
+func (m *MsgSetConsensusNeededResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetConsensusNeededResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetConsensusNeededResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetConsensusNeededResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetConsensusNeededResponse.Merge(m, src)
+}
+func (m *MsgSetConsensusNeededResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetConsensusNeededResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetConsensusNeededResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetConsensusNeededResponse proto.InternalMessageInfo
+
+// MsgSetProphecyLifetime sets when pending prophecies expire and finalized
+// prophecies are pruned
+type MsgSetProphecyLifetime struct {
+	Signer           string                 `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	ProphecyLifetime types.ProphecyLifetime `protobuf:"bytes,2,opt,name=prophecy_lifetime,json=prophecyLifetime,proto3" json:"prophecy_lifetime"`
 }
 
-func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
-	out := new(MsgLockResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Lock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetProphecyLifetime) Reset()         { *m = MsgSetProphecyLifetime{} }
+func (m *MsgSetProphecyLifetime) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProphecyLifetime) ProtoMessage()    {}
+func (*MsgSetProphecyLifetime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{18}
+}
+func (m *MsgSetProphecyLifetime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProphecyLifetime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProphecyLifetime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetProphecyLifetime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProphecyLifetime.Merge(m, src)
+}
+func (m *MsgSetProphecyLifetime) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProphecyLifetime) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProphecyLifetime.DiscardUnknown(m)
 }
 
-func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
-	out := new(MsgBurnResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Burn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetProphecyLifetime proto.InternalMessageInfo
+
+func (m *MsgSetProphecyLifetime) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error) {
-	out := new(MsgCreateEthBridgeClaimResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetProphecyLifetime) GetProphecyLifetime() types.ProphecyLifetime {
+	if m != nil {
+		return m.ProphecyLifetime
 	}
-	return out, nil
+	return types.ProphecyLifetime{}
 }
 
-func (c *msgClient) UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error) {
-	out := new(MsgUpdateWhiteListValidatorResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetProphecyLifetimeResponse struct {
 }
 
-func (c *msgClient) UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error) {
-	out := new(MsgUpdateCethReceiverAccountResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetProphecyLifetimeResponse) Reset()         { *m = MsgSetProphecyLifetimeResponse{} }
+func (m *MsgSetProphecyLifetimeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetProphecyLifetimeResponse) ProtoMessage()    {}
+func (*MsgSetProphecyLifetimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{19}
+}
+func (m *MsgSetProphecyLifetimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetProphecyLifetimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetProphecyLifetimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetProphecyLifetimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetProphecyLifetimeResponse.Merge(m, src)
+}
+func (m *MsgSetProphecyLifetimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetProphecyLifetimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetProphecyLifetimeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error) {
-	out := new(MsgRescueCethResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RescueCeth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetProphecyLifetimeResponse proto.InternalMessageInfo
+
+// MsgSetRateLimit sets the inbound and outbound caps of a denom, setting both
+// limits to zero removes the rate limit
+type MsgSetRateLimit struct {
+	Signer    string    `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	RateLimit RateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
 }
 
-func (c *msgClient) SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error) {
-	out := new(MsgSetBlacklistResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetBlacklist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRateLimit) Reset()         { *m = MsgSetRateLimit{} }
+func (m *MsgSetRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimit) ProtoMessage()    {}
+func (*MsgSetRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{20}
+}
+func (m *MsgSetRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimit.Merge(m, src)
+}
+func (m *MsgSetRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimit.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetPause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
-	out := new(MsgPauseResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetPause", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetRateLimit proto.InternalMessageInfo
+
+func (m *MsgSetRateLimit) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
-	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
-	CreateEthBridgeClaim(context.Context, *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error)
-	UpdateWhiteListValidator(context.Context, *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error)
-	UpdateCethReceiverAccount(context.Context, *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error)
-	RescueCeth(context.Context, *MsgRescueCeth) (*MsgRescueCethResponse, error)
-	SetBlacklist(context.Context, *MsgSetBlacklist) (*MsgSetBlacklistResponse, error)
-	SetPause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+func (m *MsgSetRateLimit) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgSetRateLimitResponse struct {
 }
 
-func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
-}
-func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
-}
-func (*UnimplementedMsgServer) CreateEthBridgeClaim(ctx context.Context, req *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateEthBridgeClaim not implemented")
+func (m *MsgSetRateLimitResponse) Reset()         { *m = MsgSetRateLimitResponse{} }
+func (m *MsgSetRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{21}
 }
-func (*UnimplementedMsgServer) UpdateWhiteListValidator(ctx context.Context, req *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhiteListValidator not implemented")
+func (m *MsgSetRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateCethReceiverAccount(ctx context.Context, req *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCethReceiverAccount not implemented")
+func (m *MsgSetRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) RescueCeth(ctx context.Context, req *MsgRescueCeth) (*MsgRescueCethResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RescueCeth not implemented")
+func (m *MsgSetRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SetBlacklist(ctx context.Context, req *MsgSetBlacklist) (*MsgSetBlacklistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBlacklist not implemented")
+func (m *MsgSetRateLimitResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SetPause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPause not implemented")
+func (m *MsgSetRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetRateLimitResponse proto.InternalMessageInfo
+
+// MsgConfirmOutboundTransfer is sent by a whitelisted validator once it has
+// relayed the outbound transfer with the given nonce to ethereum, the transfer
+// completes when the confirmations reach consensus
+type MsgConfirmOutboundTransfer struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	Nonce            uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
 }
 
-func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgLock)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgConfirmOutboundTransfer) Reset()         { *m = MsgConfirmOutboundTransfer{} }
+func (m *MsgConfirmOutboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmOutboundTransfer) ProtoMessage()    {}
+func (*MsgConfirmOutboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{22}
+}
+func (m *MsgConfirmOutboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmOutboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmOutboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Lock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/Lock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
-	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBurn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Burn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/Burn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConfirmOutboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmOutboundTransfer.Merge(m, src)
 }
-
-func _Msg_CreateEthBridgeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateEthBridgeClaim)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateEthBridgeClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateEthBridgeClaim(ctx, req.(*MsgCreateEthBridgeClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConfirmOutboundTransfer) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_UpdateWhiteListValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateWhiteListValidator)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateWhiteListValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateWhiteListValidator(ctx, req.(*MsgUpdateWhiteListValidator))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgConfirmOutboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmOutboundTransfer.DiscardUnknown(m)
 }
 
-func _Msg_UpdateCethReceiverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCethReceiverAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, req.(*MsgUpdateCethReceiverAccount))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgConfirmOutboundTransfer proto.InternalMessageInfo
 
-func _Msg_RescueCeth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRescueCeth)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RescueCeth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/RescueCeth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RescueCeth(ctx, req.(*MsgRescueCeth))
+func (m *MsgConfirmOutboundTransfer) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBlacklist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBlacklist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/SetBlacklist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBlacklist(ctx, req.(*MsgSetBlacklist))
+func (m *MsgConfirmOutboundTransfer) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPause)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetPause(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/sifnode.ethbridge.v1.Msg/SetPause",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetPause(ctx, req.(*MsgPause))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgConfirmOutboundTransferResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "sifnode.ethbridge.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Lock",
-			Handler:    _Msg_Lock_Handler,
-		},
-		{
-			MethodName: "Burn",
-			Handler:    _Msg_Burn_Handler,
-		},
-		{
-			MethodName: "CreateEthBridgeClaim",
-			Handler:    _Msg_CreateEthBridgeClaim_Handler,
-		},
-		{
-			MethodName: "UpdateWhiteListValidator",
-			Handler:    _Msg_UpdateWhiteListValidator_Handler,
-		},
-		{
-			MethodName: "UpdateCethReceiverAccount",
-			Handler:    _Msg_UpdateCethReceiverAccount_Handler,
-		},
-		{
-			MethodName: "RescueCeth",
-			Handler:    _Msg_RescueCeth_Handler,
-		},
-		{
-			MethodName: "SetBlacklist",
-			Handler:    _Msg_SetBlacklist_Handler,
-		},
-		{
-			MethodName: "SetPause",
-			Handler:    _Msg_SetPause_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sifnode/ethbridge/v1/tx.proto",
+func (m *MsgConfirmOutboundTransferResponse) Reset()         { *m = MsgConfirmOutboundTransferResponse{} }
+func (m *MsgConfirmOutboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmOutboundTransferResponse) ProtoMessage()    {}
+func (*MsgConfirmOutboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{23}
 }
-
-func (m *MsgPause) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConfirmOutboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmOutboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmOutboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgConfirmOutboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmOutboundTransferResponse.Merge(m, src)
+}
+func (m *MsgConfirmOutboundTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmOutboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmOutboundTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgConfirmOutboundTransferResponse proto.InternalMessageInfo
+
+// MsgReleaseQueuedInboundTransfer mints and sends a queued inbound transfer
+// regardless of the rate limit of its denom
+type MsgReleaseQueuedInboundTransfer struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 }
 
-func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsPaused {
-		i--
-		if m.IsPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *MsgReleaseQueuedInboundTransfer) Reset()         { *m = MsgReleaseQueuedInboundTransfer{} }
+func (m *MsgReleaseQueuedInboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedInboundTransfer) ProtoMessage()    {}
+func (*MsgReleaseQueuedInboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{24}
+}
+func (m *MsgReleaseQueuedInboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseQueuedInboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQueuedInboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReleaseQueuedInboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedInboundTransfer.Merge(m, src)
+}
+func (m *MsgReleaseQueuedInboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseQueuedInboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedInboundTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseQueuedInboundTransfer proto.InternalMessageInfo
+
+func (m *MsgReleaseQueuedInboundTransfer) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReleaseQueuedInboundTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgReleaseQueuedInboundTransferResponse struct {
 }
 
-func (m *MsgLock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReleaseQueuedInboundTransferResponse) Reset() {
+	*m = MsgReleaseQueuedInboundTransferResponse{}
 }
-
-func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReleaseQueuedInboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedInboundTransferResponse) ProtoMessage()    {}
+func (*MsgReleaseQueuedInboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{25}
 }
-
-func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.EthereumReceiver) > 0 {
-		i -= len(m.EthereumReceiver)
-		copy(dAtA[i:], m.EthereumReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgReleaseQueuedInboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseQueuedInboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQueuedInboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgReleaseQueuedInboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedInboundTransferResponse.Merge(m, src)
 }
-
-func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReleaseQueuedInboundTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReleaseQueuedInboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedInboundTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgReleaseQueuedInboundTransferResponse proto.InternalMessageInfo
 
-func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelQueuedInboundTransfer drops a queued inbound transfer without
+// minting it
+type MsgCancelQueuedInboundTransfer struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty" yaml:"signer"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 }
 
-func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.EthereumReceiver) > 0 {
-		i -= len(m.EthereumReceiver)
-		copy(dAtA[i:], m.EthereumReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.EthereumChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCancelQueuedInboundTransfer) Reset()         { *m = MsgCancelQueuedInboundTransfer{} }
+func (m *MsgCancelQueuedInboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedInboundTransfer) ProtoMessage()    {}
+func (*MsgCancelQueuedInboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{26}
+}
+func (m *MsgCancelQueuedInboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedInboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedInboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelQueuedInboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedInboundTransfer.Merge(m, src)
 }
-
-func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelQueuedInboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelQueuedInboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedInboundTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelQueuedInboundTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelQueuedInboundTransfer) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-func (m *MsgCreateEthBridgeClaim) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelQueuedInboundTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateEthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelQueuedInboundTransferResponse struct {
 }
 
-func (m *MsgCreateEthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthBridgeClaim != nil {
-		{
-			size, err := m.EthBridgeClaim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCancelQueuedInboundTransferResponse) Reset() {
+	*m = MsgCancelQueuedInboundTransferResponse{}
+}
+func (m *MsgCancelQueuedInboundTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelQueuedInboundTransferResponse) ProtoMessage()    {}
+func (*MsgCancelQueuedInboundTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44d60f3dabe1980f, []int{27}
+}
+func (m *MsgCancelQueuedInboundTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelQueuedInboundTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelQueuedInboundTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCreateEthBridgeClaimResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelQueuedInboundTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelQueuedInboundTransferResponse.Merge(m, src)
 }
-
-func (m *MsgCreateEthBridgeClaimResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelQueuedInboundTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCreateEthBridgeClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelQueuedInboundTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelQueuedInboundTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateWhiteListValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgCancelQueuedInboundTransferResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateWhiteListValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgPause)(nil), "sifnode.ethbridge.v1.MsgPause")
+	proto.RegisterType((*MsgPauseResponse)(nil), "sifnode.ethbridge.v1.MsgPauseResponse")
+	proto.RegisterType((*MsgLock)(nil), "sifnode.ethbridge.v1.MsgLock")
+	proto.RegisterType((*MsgLockResponse)(nil), "sifnode.ethbridge.v1.MsgLockResponse")
+	proto.RegisterType((*MsgBurn)(nil), "sifnode.ethbridge.v1.MsgBurn")
+	proto.RegisterType((*MsgBurnResponse)(nil), "sifnode.ethbridge.v1.MsgBurnResponse")
+	proto.RegisterType((*MsgCreateEthBridgeClaim)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaim")
+	proto.RegisterType((*MsgCreateEthBridgeClaimResponse)(nil), "sifnode.ethbridge.v1.MsgCreateEthBridgeClaimResponse")
+	proto.RegisterType((*MsgUpdateWhiteListValidator)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidator")
+	proto.RegisterType((*MsgUpdateWhiteListValidatorResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateWhiteListValidatorResponse")
+	proto.RegisterType((*MsgUpdateCethReceiverAccount)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccount")
+	proto.RegisterType((*MsgUpdateCethReceiverAccountResponse)(nil), "sifnode.ethbridge.v1.MsgUpdateCethReceiverAccountResponse")
+	proto.RegisterType((*MsgRescueCeth)(nil), "sifnode.ethbridge.v1.MsgRescueCeth")
+	proto.RegisterType((*MsgRescueCethResponse)(nil), "sifnode.ethbridge.v1.MsgRescueCethResponse")
+	proto.RegisterType((*MsgSetBlacklist)(nil), "sifnode.ethbridge.v1.MsgSetBlacklist")
+	proto.RegisterType((*MsgSetBlacklistResponse)(nil), "sifnode.ethbridge.v1.MsgSetBlacklistResponse")
+	proto.RegisterType((*MsgSetConsensusNeeded)(nil), "sifnode.ethbridge.v1.MsgSetConsensusNeeded")
+	proto.RegisterType((*MsgSetConsensusNeededResponse)(nil), "sifnode.ethbridge.v1.MsgSetConsensusNeededResponse")
+	proto.RegisterType((*MsgSetProphecyLifetime)(nil), "sifnode.ethbridge.v1.MsgSetProphecyLifetime")
+	proto.RegisterType((*MsgSetProphecyLifetimeResponse)(nil), "sifnode.ethbridge.v1.MsgSetProphecyLifetimeResponse")
+	proto.RegisterType((*MsgSetRateLimit)(nil), "sifnode.ethbridge.v1.MsgSetRateLimit")
+	proto.RegisterType((*MsgSetRateLimitResponse)(nil), "sifnode.ethbridge.v1.MsgSetRateLimitResponse")
+	proto.RegisterType((*MsgConfirmOutboundTransfer)(nil), "sifnode.ethbridge.v1.MsgConfirmOutboundTransfer")
+	proto.RegisterType((*MsgConfirmOutboundTransferResponse)(nil), "sifnode.ethbridge.v1.MsgConfirmOutboundTransferResponse")
+	proto.RegisterType((*MsgReleaseQueuedInboundTransfer)(nil), "sifnode.ethbridge.v1.MsgReleaseQueuedInboundTransfer")
+	proto.RegisterType((*MsgReleaseQueuedInboundTransferResponse)(nil), "sifnode.ethbridge.v1.MsgReleaseQueuedInboundTransferResponse")
+	proto.RegisterType((*MsgCancelQueuedInboundTransfer)(nil), "sifnode.ethbridge.v1.MsgCancelQueuedInboundTransfer")
+	proto.RegisterType((*MsgCancelQueuedInboundTransferResponse)(nil), "sifnode.ethbridge.v1.MsgCancelQueuedInboundTransferResponse")
 }
 
-func (m *MsgUpdateWhiteListValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperationType) > 0 {
-		i -= len(m.OperationType)
-		copy(dAtA[i:], m.OperationType)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperationType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("sifnode/ethbridge/v1/tx.proto", fileDescriptor_44d60f3dabe1980f) }
+
+var fileDescriptor_44d60f3dabe1980f = []byte{
+	// 1326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xdf, 0x6e, 0xe3, 0xc4,
+	0x17, 0xae, 0xdb, 0x6c, 0x7f, 0xcd, 0xd9, 0x76, 0x9b, 0xf5, 0x66, 0x7f, 0xcd, 0xba, 0x6d, 0x1c,
+	0xa6, 0x7f, 0x57, 0x4b, 0x13, 0x9a, 0x65, 0x25, 0x58, 0xb1, 0x82, 0x26, 0x45, 0x50, 0xa9, 0x61,
+	0x17, 0x77, 0xd9, 0x95, 0xb8, 0xc0, 0x72, 0xed, 0x49, 0x62, 0x9a, 0xd8, 0x91, 0x67, 0x52, 0xb6,
+	0x12, 0x57, 0x48, 0x20, 0x24, 0x2e, 0x00, 0x89, 0x3b, 0xde, 0x81, 0x6b, 0x1e, 0xa1, 0x97, 0x2b,
+	0xae, 0x10, 0x17, 0x11, 0x6a, 0xdf, 0x20, 0x4f, 0x80, 0x3c, 0xb6, 0x27, 0x7f, 0x6a, 0xbb, 0xf5,
+	0x22, 0x24, 0x2e, 0xb8, 0x8a, 0x7d, 0xe6, 0xfb, 0xce, 0xf9, 0x66, 0xce, 0x99, 0x39, 0x13, 0xc3,
+	0x32, 0x31, 0xeb, 0x96, 0x6d, 0xe0, 0x12, 0xa6, 0xcd, 0x43, 0xc7, 0x34, 0x1a, 0xb8, 0x74, 0xbc,
+	0x5d, 0xa2, 0x2f, 0x8a, 0x1d, 0xc7, 0xa6, 0xb6, 0x98, 0xf5, 0x87, 0x8b, 0x7c, 0xb8, 0x78, 0xbc,
+	0x2d, 0x65, 0x1b, 0x76, 0xc3, 0x66, 0x80, 0x92, 0xfb, 0xe4, 0x61, 0xa5, 0x42, 0xb8, 0xab, 0x93,
+	0x0e, 0x26, 0x3e, 0x82, 0x07, 0xb3, 0x1d, 0x4d, 0x6f, 0x8d, 0x0f, 0x23, 0x05, 0x66, 0x6a, 0xa4,
+	0xf1, 0x44, 0xeb, 0x12, 0x2c, 0xde, 0x85, 0x69, 0x62, 0x36, 0x2c, 0xec, 0xe4, 0x84, 0x82, 0xb0,
+	0x99, 0xae, 0xdc, 0xec, 0xf7, 0xe4, 0xb9, 0x13, 0xad, 0xdd, 0x7a, 0x88, 0x3c, 0x3b, 0x52, 0x7c,
+	0x80, 0xb8, 0x08, 0x69, 0x93, 0xa8, 0x1d, 0x97, 0x66, 0xe4, 0x26, 0x0b, 0xc2, 0xe6, 0x8c, 0x32,
+	0x63, 0x12, 0xe6, 0xc6, 0x40, 0x22, 0x64, 0x02, 0x9f, 0x0a, 0x26, 0x1d, 0xdb, 0x22, 0x18, 0xfd,
+	0x32, 0x05, 0xff, 0xab, 0x91, 0xc6, 0xbe, 0xad, 0x1f, 0x89, 0x2b, 0x30, 0xa7, 0xdb, 0xa4, 0x6d,
+	0x13, 0x95, 0x60, 0xcb, 0x08, 0xc2, 0x29, 0xb3, 0x9e, 0xf1, 0x80, 0xd9, 0xc4, 0xe7, 0x30, 0xad,
+	0xb5, 0xed, 0xae, 0x45, 0x99, 0xfb, 0x74, 0xe5, 0xdd, 0xd3, 0x9e, 0x3c, 0xf1, 0x47, 0x4f, 0x5e,
+	0x6f, 0x98, 0xb4, 0xd9, 0x3d, 0x2c, 0xea, 0x76, 0xbb, 0xe4, 0x11, 0xfc, 0x9f, 0x2d, 0x62, 0x1c,
+	0xf9, 0x53, 0xdb, 0xb3, 0xe8, 0x40, 0xba, 0xe7, 0x05, 0x29, 0xbe, 0x3b, 0x36, 0xcb, 0x93, 0xf6,
+	0xa1, 0xdd, 0xca, 0x4d, 0x5d, 0x98, 0x25, 0xb3, 0xbb, 0xb3, 0x64, 0x0f, 0xe2, 0x87, 0x70, 0x13,
+	0xd3, 0x26, 0x76, 0x70, 0xb7, 0xad, 0xea, 0x4d, 0xcd, 0xb4, 0x54, 0xd3, 0xc8, 0xa5, 0x0a, 0xc2,
+	0xe6, 0x54, 0x65, 0xa9, 0xdf, 0x93, 0x73, 0x1e, 0xeb, 0x02, 0x04, 0x29, 0xf3, 0x81, 0xad, 0xea,
+	0x9a, 0xf6, 0x0c, 0x71, 0x6f, 0xc8, 0x93, 0x83, 0x75, 0x6c, 0x1e, 0x63, 0x27, 0x77, 0x8d, 0xc5,
+	0x0f, 0xf3, 0x14, 0x40, 0x90, 0x92, 0x09, 0x6c, 0x8a, 0x6f, 0x12, 0x31, 0x5c, 0xd7, 0x31, 0x6d,
+	0xaa, 0xfe, 0xea, 0x4c, 0x33, 0x27, 0xbb, 0x89, 0x57, 0x47, 0xf4, 0x42, 0x0e, 0xb9, 0x42, 0x0a,
+	0xb8, 0x6f, 0x3b, 0xde, 0xcb, 0x06, 0xcc, 0xfb, 0xf9, 0x0a, 0x72, 0x28, 0x66, 0xe1, 0x9a, 0x65,
+	0x5b, 0x3a, 0x66, 0xf9, 0x4a, 0x29, 0xde, 0x0b, 0x3a, 0xf5, 0x32, 0x5b, 0xe9, 0x3a, 0x96, 0xf8,
+	0x28, 0x34, 0xb3, 0x95, 0x5c, 0xbf, 0x27, 0x67, 0xfd, 0x78, 0xc3, 0xc3, 0xe8, 0xbf, 0x9c, 0xff,
+	0x0b, 0x73, 0xee, 0x66, 0xf2, 0x92, 0x9c, 0x7f, 0x23, 0xc0, 0x42, 0x8d, 0x34, 0xaa, 0x0e, 0xd6,
+	0x28, 0x7e, 0x9f, 0x36, 0x2b, 0xec, 0xe8, 0xa9, 0xb6, 0x34, 0xb3, 0x2d, 0x1e, 0x81, 0xab, 0x5f,
+	0xf5, 0x4e, 0x23, 0x55, 0x77, 0x6d, 0x8c, 0x7c, 0xbd, 0xbc, 0x5a, 0x0c, 0x3b, 0xd9, 0x8a, 0xa3,
+	0xfc, 0xca, 0x62, 0xbf, 0x27, 0x2f, 0xf0, 0xb5, 0x19, 0xf1, 0x83, 0x94, 0x1b, 0x78, 0x04, 0x8c,
+	0x5e, 0x03, 0x39, 0x42, 0x07, 0x3f, 0x79, 0x7e, 0x13, 0x60, 0xb1, 0x46, 0x1a, 0x9f, 0x74, 0x0c,
+	0x8d, 0xe2, 0xe7, 0x4d, 0x93, 0xe2, 0x7d, 0x93, 0xd0, 0x67, 0x5a, 0xcb, 0x34, 0x34, 0x6a, 0x3b,
+	0x7f, 0xb7, 0x66, 0xcb, 0x90, 0x3e, 0x0e, 0x7c, 0xf9, 0x65, 0x9b, 0xed, 0xf7, 0xe4, 0x8c, 0x47,
+	0xe5, 0x43, 0x48, 0x19, 0xc0, 0xc4, 0xf7, 0xe0, 0x86, 0xdd, 0xc1, 0x8e, 0x46, 0x4d, 0xdb, 0x52,
+	0xdd, 0x04, 0xf9, 0x65, 0x79, 0xa7, 0xdf, 0x93, 0x6f, 0x7b, 0xc4, 0xd1, 0x71, 0xa4, 0xcc, 0x71,
+	0xc3, 0x53, 0xf7, 0x7d, 0x0d, 0x56, 0x62, 0xe6, 0xc4, 0xe7, 0xfe, 0x05, 0x2c, 0x71, 0x58, 0x15,
+	0xd3, 0x66, 0x50, 0x50, 0x3b, 0xba, 0xce, 0xf6, 0xc5, 0x95, 0x4e, 0xe2, 0x32, 0xdc, 0x66, 0x15,
+	0x13, 0x14, 0xa8, 0xaa, 0x79, 0x6c, 0x6f, 0xb6, 0xca, 0x2d, 0xfd, 0xa2, 0x63, 0xb4, 0x0e, 0xab,
+	0x71, 0x81, 0x07, 0x6d, 0x41, 0x80, 0xb9, 0x1a, 0x69, 0x28, 0x98, 0xe8, 0x5d, 0x06, 0xbc, 0x9a,
+	0xa4, 0x0d, 0x98, 0xf7, 0x41, 0x7c, 0x63, 0x79, 0x62, 0x6e, 0x78, 0x66, 0xbe, 0x71, 0x1e, 0x8f,
+	0x6e, 0x1c, 0x6f, 0x99, 0x8b, 0xc9, 0x36, 0xce, 0xc8, 0x16, 0x59, 0x80, 0xdb, 0x23, 0x7a, 0xf9,
+	0x4c, 0xaa, 0x6c, 0xef, 0x1c, 0x60, 0x5a, 0x69, 0x69, 0xfa, 0x51, 0xcb, 0x24, 0x54, 0x14, 0x21,
+	0x55, 0x77, 0xec, 0xb6, 0x3f, 0x03, 0xf6, 0x2c, 0x2e, 0x41, 0x5a, 0x33, 0x0c, 0x07, 0x13, 0x82,
+	0x49, 0x6e, 0xb2, 0x30, 0xb5, 0x99, 0x56, 0x06, 0x06, 0x74, 0x07, 0x16, 0xc6, 0x9c, 0x70, 0xff,
+	0xbf, 0x0a, 0x2c, 0xf2, 0x01, 0xa6, 0x55, 0xf7, 0xdd, 0x22, 0x5d, 0xf2, 0x11, 0xc6, 0x06, 0x36,
+	0x92, 0xb4, 0x6d, 0x0a, 0x19, 0x3d, 0x60, 0xab, 0x16, 0xa3, 0xfb, 0x35, 0xbb, 0x97, 0x60, 0x4d,
+	0x76, 0xb1, 0x3e, 0xd8, 0xa3, 0xe3, 0xfe, 0x90, 0x32, 0xaf, 0x8f, 0x0a, 0x44, 0x32, 0x2c, 0x87,
+	0x2a, 0xe7, 0x73, 0xfb, 0x59, 0x80, 0xff, 0x7b, 0x88, 0x27, 0x8e, 0xdd, 0x69, 0x62, 0xfd, 0x64,
+	0xdf, 0xac, 0x63, 0x6a, 0xb6, 0x13, 0xdd, 0x49, 0x9e, 0xc1, 0xcd, 0x8e, 0x4f, 0x57, 0x5b, 0x3e,
+	0x9f, 0xcd, 0xee, 0x7a, 0x79, 0x85, 0x9f, 0x3c, 0xde, 0x2d, 0xc8, 0x3d, 0x76, 0xc6, 0x43, 0x55,
+	0x52, 0xee, 0x12, 0x28, 0x99, 0xce, 0x98, 0x1d, 0x15, 0x20, 0x1f, 0x2e, 0x8e, 0xeb, 0xff, 0x4a,
+	0x08, 0x92, 0xaf, 0x68, 0xee, 0x56, 0x6c, 0x9b, 0x34, 0x89, 0xf0, 0x5d, 0x00, 0x47, 0xa3, 0x58,
+	0x6d, 0xb9, 0x44, 0x5f, 0xb1, 0x1c, 0x7e, 0x56, 0x72, 0xff, 0xbe, 0xda, 0xb4, 0x13, 0x18, 0x06,
+	0xb5, 0xc3, 0x31, 0x5c, 0xdf, 0xf7, 0x02, 0x48, 0xee, 0x31, 0x69, 0x5b, 0x75, 0xd3, 0x69, 0x3f,
+	0xee, 0xd2, 0x43, 0xbb, 0x6b, 0x19, 0x4f, 0x1d, 0xcd, 0x22, 0x75, 0xec, 0xb8, 0x8d, 0x8a, 0x9f,
+	0x4d, 0xaa, 0x5f, 0x8c, 0x39, 0x61, 0xbc, 0x51, 0x5d, 0x80, 0x20, 0x25, 0xc3, 0x6d, 0x3b, 0x9e,
+	0x49, 0x5c, 0x0f, 0xda, 0x85, 0x3b, 0x8b, 0x54, 0x25, 0xd3, 0xef, 0xc9, 0xb3, 0x1e, 0x9d, 0x99,
+	0x51, 0xd0, 0x40, 0x56, 0x01, 0x45, 0x0b, 0xe2, 0xba, 0x8f, 0xd8, 0xe9, 0xae, 0xe0, 0x16, 0xd6,
+	0x08, 0xfe, 0xb8, 0x8b, 0xbb, 0xd8, 0xd8, 0xb3, 0x46, 0xb5, 0x27, 0x58, 0xe6, 0x65, 0x98, 0x34,
+	0x0d, 0x5f, 0xd8, 0x5c, 0xbf, 0x27, 0xa7, 0x3d, 0x98, 0xdb, 0xbb, 0x27, 0x4d, 0x03, 0xdd, 0x85,
+	0x8d, 0x4b, 0x82, 0x71, 0x5d, 0x9f, 0xb3, 0x8a, 0xa8, 0x6a, 0x96, 0x8e, 0x5b, 0xff, 0xb4, 0xac,
+	0x4d, 0x58, 0x8f, 0x8f, 0x15, 0xa8, 0x2a, 0xf7, 0x66, 0x61, 0xaa, 0x46, 0x1a, 0xe2, 0x3e, 0xa4,
+	0xd8, 0x35, 0x7b, 0x39, 0xbc, 0x84, 0xfc, 0x5b, 0x9d, 0xb4, 0x16, 0x3b, 0xcc, 0x2f, 0x00, 0xfb,
+	0x90, 0x62, 0x57, 0xbb, 0x68, 0x6f, 0xee, 0xb0, 0xb4, 0x16, 0x3b, 0xcc, 0xbd, 0x7d, 0x09, 0xd9,
+	0xd0, 0x4b, 0xc3, 0x56, 0x24, 0x3d, 0x0c, 0x2e, 0x3d, 0x48, 0x04, 0xe7, 0xd1, 0xbf, 0x15, 0x20,
+	0x17, 0x79, 0x0f, 0xd8, 0x8e, 0xf4, 0x19, 0x45, 0x91, 0xde, 0x4e, 0x4c, 0xe1, 0x52, 0xbe, 0x13,
+	0xe0, 0x4e, 0x74, 0x5f, 0x2e, 0x5f, 0xe2, 0x38, 0x84, 0x23, 0x3d, 0x4c, 0xce, 0xe1, 0x6a, 0x3e,
+	0x03, 0x18, 0x6e, 0xc1, 0x91, 0x9e, 0x06, 0x20, 0xe9, 0xde, 0x15, 0x40, 0xdc, 0xbf, 0x01, 0xb3,
+	0x23, 0x9d, 0x31, 0xba, 0x5a, 0x86, 0x61, 0xd2, 0xd6, 0x95, 0x60, 0x3c, 0x8a, 0x02, 0x33, 0xee,
+	0x29, 0xcd, 0xfe, 0xcb, 0xe6, 0x23, 0xa9, 0x6c, 0x5c, 0x5a, 0x8f, 0x1f, 0xe7, 0x3e, 0x8f, 0x41,
+	0x0c, 0x69, 0xb9, 0xf7, 0xe2, 0x84, 0x8d, 0x81, 0xa5, 0xfb, 0x09, 0xc0, 0x3c, 0xee, 0x09, 0xdc,
+	0x0a, 0x6b, 0x87, 0xaf, 0xc7, 0xf9, 0x1a, 0x47, 0x4b, 0x6f, 0x26, 0x41, 0x8f, 0x25, 0x6b, 0xd0,
+	0xc9, 0x62, 0x93, 0xc5, 0x61, 0xd2, 0xd6, 0x95, 0x60, 0x3c, 0xca, 0xd7, 0x02, 0x2c, 0x44, 0x35,
+	0xa4, 0x37, 0xa2, 0xb7, 0x77, 0x38, 0x43, 0x7a, 0x2b, 0x29, 0x83, 0xeb, 0xf8, 0x49, 0x80, 0xa5,
+	0xd8, 0x0e, 0xf3, 0x20, 0xa6, 0xd0, 0xa3, 0x69, 0xd2, 0xa3, 0x57, 0xa2, 0x71, 0x59, 0x3f, 0x0a,
+	0xb0, 0x18, 0xd7, 0x60, 0xa2, 0x53, 0x1b, 0xc3, 0x92, 0xde, 0x79, 0x15, 0x56, 0xa0, 0xa9, 0xf2,
+	0xc1, 0xe9, 0x59, 0x5e, 0x78, 0x79, 0x96, 0x17, 0xfe, 0x3c, 0xcb, 0x0b, 0x3f, 0x9c, 0xe7, 0x27,
+	0x5e, 0x9e, 0xe7, 0x27, 0x7e, 0x3f, 0xcf, 0x4f, 0x7c, 0xba, 0x35, 0x74, 0x6b, 0x3c, 0x30, 0xeb,
+	0xec, 0x4f, 0x71, 0x29, 0xf8, 0xf0, 0xf4, 0x62, 0xe8, 0xe3, 0x14, 0xbb, 0x40, 0x1e, 0x4e, 0xb3,
+	0x6f, 0x4f, 0xf7, 0xff, 0x1a, 0x00, 0x91, 0x8c, 0x06, 0x0f, 0x09, 0x13, 0x00, 0x00,
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
+	CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error)
+	UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error)
+	UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error)
+	RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error)
+	SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error)
+	SetPause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	SetConsensusNeeded(ctx context.Context, in *MsgSetConsensusNeeded, opts ...grpc.CallOption) (*MsgSetConsensusNeededResponse, error)
+	SetProphecyLifetime(ctx context.Context, in *MsgSetProphecyLifetime, opts ...grpc.CallOption) (*MsgSetProphecyLifetimeResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	ConfirmOutboundTransfer(ctx context.Context, in *MsgConfirmOutboundTransfer, opts ...grpc.CallOption) (*MsgConfirmOutboundTransferResponse, error)
+	ReleaseQueuedInboundTransfer(ctx context.Context, in *MsgReleaseQueuedInboundTransfer, opts ...grpc.CallOption) (*MsgReleaseQueuedInboundTransferResponse, error)
+	CancelQueuedInboundTransfer(ctx context.Context, in *MsgCancelQueuedInboundTransfer, opts ...grpc.CallOption) (*MsgCancelQueuedInboundTransferResponse, error)
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgUpdateCethReceiverAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
+	out := new(MsgLockResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Lock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateCethReceiverAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/Burn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateCethReceiverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CethReceiverAccount) > 0 {
-		i -= len(m.CethReceiverAccount)
-		copy(dAtA[i:], m.CethReceiverAccount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CethReceiverAccount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) CreateEthBridgeClaim(ctx context.Context, in *MsgCreateEthBridgeClaim, opts ...grpc.CallOption) (*MsgCreateEthBridgeClaimResponse, error) {
+	out := new(MsgCreateEthBridgeClaimResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateWhiteListValidator(ctx context.Context, in *MsgUpdateWhiteListValidator, opts ...grpc.CallOption) (*MsgUpdateWhiteListValidatorResponse, error) {
+	out := new(MsgUpdateWhiteListValidatorResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateCethReceiverAccount(ctx context.Context, in *MsgUpdateCethReceiverAccount, opts ...grpc.CallOption) (*MsgUpdateCethReceiverAccountResponse, error) {
+	out := new(MsgUpdateCethReceiverAccountResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RescueCeth(ctx context.Context, in *MsgRescueCeth, opts ...grpc.CallOption) (*MsgRescueCethResponse, error) {
+	out := new(MsgRescueCethResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/RescueCeth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRescueCeth) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetBlacklist(ctx context.Context, in *MsgSetBlacklist, opts ...grpc.CallOption) (*MsgSetBlacklistResponse, error) {
+	out := new(MsgSetBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetBlacklist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRescueCeth) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetPause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRescueCeth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CethAmount.Size()
-		i -= size
-		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CosmosSender) > 0 {
-		i -= len(m.CosmosSender)
-		copy(dAtA[i:], m.CosmosSender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetConsensusNeeded(ctx context.Context, in *MsgSetConsensusNeeded, opts ...grpc.CallOption) (*MsgSetConsensusNeededResponse, error) {
+	out := new(MsgSetConsensusNeededResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetConsensusNeeded", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgRescueCethResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetProphecyLifetime(ctx context.Context, in *MsgSetProphecyLifetime, opts ...grpc.CallOption) (*MsgSetProphecyLifetimeResponse, error) {
+	out := new(MsgSetProphecyLifetimeResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetProphecyLifetime", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgRescueCethResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/SetRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgRescueCethResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ConfirmOutboundTransfer(ctx context.Context, in *MsgConfirmOutboundTransfer, opts ...grpc.CallOption) (*MsgConfirmOutboundTransferResponse, error) {
+	out := new(MsgConfirmOutboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/ConfirmOutboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetBlacklist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReleaseQueuedInboundTransfer(ctx context.Context, in *MsgReleaseQueuedInboundTransfer, opts ...grpc.CallOption) (*MsgReleaseQueuedInboundTransferResponse, error) {
+	out := new(MsgReleaseQueuedInboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/ReleaseQueuedInboundTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSetBlacklist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CancelQueuedInboundTransfer(ctx context.Context, in *MsgCancelQueuedInboundTransfer, opts ...grpc.CallOption) (*MsgCancelQueuedInboundTransferResponse, error) {
+	out := new(MsgCancelQueuedInboundTransferResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.ethbridge.v1.Msg/CancelQueuedInboundTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSetBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
+	CreateEthBridgeClaim(context.Context, *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error)
+	UpdateWhiteListValidator(context.Context, *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error)
+	UpdateCethReceiverAccount(context.Context, *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error)
+	RescueCeth(context.Context, *MsgRescueCeth) (*MsgRescueCethResponse, error)
+	SetBlacklist(context.Context, *MsgSetBlacklist) (*MsgSetBlacklistResponse, error)
+	SetPause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	SetConsensusNeeded(context.Context, *MsgSetConsensusNeeded) (*MsgSetConsensusNeededResponse, error)
+	SetProphecyLifetime(context.Context, *MsgSetProphecyLifetime) (*MsgSetProphecyLifetimeResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	ConfirmOutboundTransfer(context.Context, *MsgConfirmOutboundTransfer) (*MsgConfirmOutboundTransferResponse, error)
+	ReleaseQueuedInboundTransfer(context.Context, *MsgReleaseQueuedInboundTransfer) (*MsgReleaseQueuedInboundTransferResponse, error)
+	CancelQueuedInboundTransfer(context.Context, *MsgCancelQueuedInboundTransfer) (*MsgCancelQueuedInboundTransferResponse, error)
 }
 
-func (m *MsgSetBlacklistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgSetBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (*UnimplementedMsgServer) Burn(ctx context.Context, req *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
+func (*UnimplementedMsgServer) CreateEthBridgeClaim(ctx context.Context, req *MsgCreateEthBridgeClaim) (*MsgCreateEthBridgeClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEthBridgeClaim not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWhiteListValidator(ctx context.Context, req *MsgUpdateWhiteListValidator) (*MsgUpdateWhiteListValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWhiteListValidator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCethReceiverAccount(ctx context.Context, req *MsgUpdateCethReceiverAccount) (*MsgUpdateCethReceiverAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCethReceiverAccount not implemented")
+}
+func (*UnimplementedMsgServer) RescueCeth(ctx context.Context, req *MsgRescueCeth) (*MsgRescueCethResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescueCeth not implemented")
+}
+func (*UnimplementedMsgServer) SetBlacklist(ctx context.Context, req *MsgSetBlacklist) (*MsgSetBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlacklist not implemented")
+}
+func (*UnimplementedMsgServer) SetPause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPause not implemented")
+}
+func (*UnimplementedMsgServer) SetConsensusNeeded(ctx context.Context, req *MsgSetConsensusNeeded) (*MsgSetConsensusNeededResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConsensusNeeded not implemented")
+}
+func (*UnimplementedMsgServer) SetProphecyLifetime(ctx context.Context, req *MsgSetProphecyLifetime) (*MsgSetProphecyLifetimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProphecyLifetime not implemented")
+}
+func (*UnimplementedMsgServer) SetRateLimit(ctx context.Context, req *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmOutboundTransfer(ctx context.Context, req *MsgConfirmOutboundTransfer) (*MsgConfirmOutboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOutboundTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseQueuedInboundTransfer(ctx context.Context, req *MsgReleaseQueuedInboundTransfer) (*MsgReleaseQueuedInboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQueuedInboundTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelQueuedInboundTransfer(ctx context.Context, req *MsgCancelQueuedInboundTransfer) (*MsgCancelQueuedInboundTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQueuedInboundTransfer not implemented")
 }
 
-func (m *MsgSetBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgPause) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).Lock(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/Lock",
 	}
-	if m.IsPaused {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPauseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/Burn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_CreateEthBridgeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEthBridgeClaim)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEthBridgeClaim(ctx, in)
 	}
-	if m.EthereumChainId != 0 {
-		n += 1 + sovTx(uint64(m.EthereumChainId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/CreateEthBridgeClaim",
 	}
-	l = len(m.EthereumReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEthBridgeClaim(ctx, req.(*MsgCreateEthBridgeClaim))
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgLockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateWhiteListValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWhiteListValidator)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWhiteListValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateWhiteListValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWhiteListValidator(ctx, req.(*MsgUpdateWhiteListValidator))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurn) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_UpdateCethReceiverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCethReceiverAccount)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, in)
 	}
-	if m.EthereumChainId != 0 {
-		n += 1 + sovTx(uint64(m.EthereumChainId))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/UpdateCethReceiverAccount",
 	}
-	l = len(m.EthereumReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCethReceiverAccount(ctx, req.(*MsgUpdateCethReceiverAccount))
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RescueCeth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRescueCeth)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreateEthBridgeClaim) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).RescueCeth(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.EthBridgeClaim != nil {
-		l = m.EthBridgeClaim.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/RescueCeth",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescueCeth(ctx, req.(*MsgRescueCeth))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateEthBridgeClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlacklist)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlacklist(ctx, req.(*MsgSetBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateWhiteListValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetPause(ctx, in)
 	}
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetPause",
 	}
-	l = len(m.OperationType)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPause(ctx, req.(*MsgPause))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateWhiteListValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetConsensusNeeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetConsensusNeeded)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetConsensusNeeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetConsensusNeeded",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetConsensusNeeded(ctx, req.(*MsgSetConsensusNeeded))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCethReceiverAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetProphecyLifetime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProphecyLifetime)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetProphecyLifetime(ctx, in)
 	}
-	l = len(m.CethReceiverAccount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetProphecyLifetime",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProphecyLifetime(ctx, req.(*MsgSetProphecyLifetime))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateCethReceiverAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/SetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRescueCeth) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ConfirmOutboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmOutboundTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.CosmosSender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmOutboundTransfer(ctx, in)
 	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/ConfirmOutboundTransfer",
 	}
-	l = m.CethAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmOutboundTransfer(ctx, req.(*MsgConfirmOutboundTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRescueCethResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReleaseQueuedInboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseQueuedInboundTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseQueuedInboundTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/ReleaseQueuedInboundTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseQueuedInboundTransfer(ctx, req.(*MsgReleaseQueuedInboundTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetBlacklist) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelQueuedInboundTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQueuedInboundTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQueuedInboundTransfer(ctx, in)
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.ethbridge.v1.Msg/CancelQueuedInboundTransfer",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQueuedInboundTransfer(ctx, req.(*MsgCancelQueuedInboundTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetBlacklistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.ethbridge.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lock",
+			Handler:    _Msg_Lock_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
+		{
+			MethodName: "CreateEthBridgeClaim",
+			Handler:    _Msg_CreateEthBridgeClaim_Handler,
+		},
+		{
+			MethodName: "UpdateWhiteListValidator",
+			Handler:    _Msg_UpdateWhiteListValidator_Handler,
+		},
+		{
+			MethodName: "UpdateCethReceiverAccount",
+			Handler:    _Msg_UpdateCethReceiverAccount_Handler,
+		},
+		{
+			MethodName: "RescueCeth",
+			Handler:    _Msg_RescueCeth_Handler,
+		},
+		{
+			MethodName: "SetBlacklist",
+			Handler:    _Msg_SetBlacklist_Handler,
+		},
+		{
+			MethodName: "SetPause",
+			Handler:    _Msg_SetPause_Handler,
+		},
+		{
+			MethodName: "SetConsensusNeeded",
+			Handler:    _Msg_SetConsensusNeeded_Handler,
+		},
+		{
+			MethodName: "SetProphecyLifetime",
+			Handler:    _Msg_SetProphecyLifetime_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "ConfirmOutboundTransfer",
+			Handler:    _Msg_ConfirmOutboundTransfer_Handler,
+		},
+		{
+			MethodName: "ReleaseQueuedInboundTransfer",
+			Handler:    _Msg_ReleaseQueuedInboundTransfer_Handler,
+		},
+		{
+			MethodName: "CancelQueuedInboundTransfer",
+			Handler:    _Msg_CancelQueuedInboundTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/ethbridge/v1/tx.proto",
+}
+
+func (m *MsgPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthBridgeClaim != nil {
+		{
+			size, err := m.EthBridgeClaim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhiteListValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhiteListValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhiteListValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperationType) > 0 {
+		i -= len(m.OperationType)
+		copy(dAtA[i:], m.OperationType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCethReceiverAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCethReceiverAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCethReceiverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CethReceiverAccount) > 0 {
+		i -= len(m.CethReceiverAccount)
+		copy(dAtA[i:], m.CethReceiverAccount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CethReceiverAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescueCeth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescueCeth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescueCeth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRescueCethResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRescueCethResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRescueCethResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConsensusNeeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConsensusNeeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConsensusNeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConsensusNeeded.Size()
+		i -= size
+		if _, err := m.ConsensusNeeded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetConsensusNeededResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetConsensusNeededResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetConsensusNeededResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProphecyLifetime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProphecyLifetime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProphecyLifetime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProphecyLifetime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetProphecyLifetimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetProphecyLifetimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetProphecyLifetimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmOutboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmOutboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmOutboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmOutboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmOutboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmOutboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseQueuedInboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedInboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseQueuedInboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedInboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedInboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedInboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedInboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelQueuedInboundTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelQueuedInboundTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelQueuedInboundTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTx(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgCreateEthBridgeClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EthBridgeClaim != nil {
+		l = m.EthBridgeClaim.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateEthBridgeClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWhiteListValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperationType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateWhiteListValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateCethReceiverAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CethReceiverAccount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCethReceiverAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRescueCeth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRescueCethResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetConsensusNeeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ConsensusNeeded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetConsensusNeededResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetProphecyLifetime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProphecyLifetime.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetProphecyLifetimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmOutboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgConfirmOutboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseQueuedInboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgReleaseQueuedInboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelQueuedInboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelQueuedInboundTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBridgeClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EthBridgeClaim == nil {
+				m.EthBridgeClaim = &EthBridgeClaim{}
+			}
+			if err := m.EthBridgeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgPause) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2024,15 +4449,15 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2060,13 +4485,13 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiverAccount", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2076,12 +4501,24 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CethReceiverAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2103,7 +4540,7 @@ func (m *MsgPause) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2126,10 +4563,10 @@ func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2153,7 +4590,7 @@ func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLock) Unmarshal(dAtA []byte) error {
+func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2176,10 +4613,10 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRescueCeth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRescueCeth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2216,7 +4653,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2244,94 +4681,9 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
-			}
-			m.EthereumChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
 			}
@@ -2386,7 +4738,7 @@ func (m *MsgLock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2409,10 +4761,10 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRescueCethResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRescueCethResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2436,7 +4788,7 @@ func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2459,15 +4811,15 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBlacklist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2495,11 +4847,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2527,64 +4879,111 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetConsensusNeeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			m.EthereumChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthereumChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetConsensusNeeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetConsensusNeeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2612,11 +5011,11 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusNeeded", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2644,7 +5043,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsensusNeeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2669,7 +5068,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetConsensusNeededResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +5091,10 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetConsensusNeededResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetConsensusNeededResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +5118,7 @@ func (m *MsgBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProphecyLifetime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +5141,47 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProphecyLifetime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProphecyLifetime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthBridgeClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyLifetime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2777,10 +5208,7 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EthBridgeClaim == nil {
-				m.EthBridgeClaim = &EthBridgeClaim{}
-			}
-			if err := m.EthBridgeClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProphecyLifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2805,7 +5233,7 @@ func (m *MsgCreateEthBridgeClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetProphecyLifetimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2828,10 +5256,10 @@ func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetProphecyLifetimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateEthBridgeClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetProphecyLifetimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2855,7 +5283,7 @@ func (m *MsgCreateEthBridgeClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2878,15 +5306,15 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2914,13 +5342,13 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2930,55 +5358,24 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OperationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3001,7 +5398,7 @@ func (m *MsgUpdateWhiteListValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3024,10 +5421,10 @@ func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateWhiteListValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3051,7 +5448,7 @@ func (m *MsgUpdateWhiteListValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmOutboundTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3074,15 +5471,15 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmOutboundTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmOutboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3110,13 +5507,13 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiverAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3126,24 +5523,11 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CethReceiverAccount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3165,7 +5549,7 @@ func (m *MsgUpdateCethReceiverAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmOutboundTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,10 +5572,10 @@ func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmOutboundTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCethReceiverAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmOutboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3215,7 +5599,7 @@ func (m *MsgUpdateCethReceiverAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseQueuedInboundTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,15 +5622,15 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRescueCeth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseQueuedInboundTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRescueCeth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseQueuedInboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3274,45 +5658,13 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3322,26 +5674,11 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3363,7 +5700,7 @@ func (m *MsgRescueCeth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseQueuedInboundTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3386,10 +5723,10 @@ func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRescueCethResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseQueuedInboundTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRescueCethResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseQueuedInboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3413,7 +5750,7 @@ func (m *MsgRescueCethResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelQueuedInboundTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3436,15 +5773,15 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlacklist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelQueuedInboundTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelQueuedInboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3472,13 +5809,13 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3488,24 +5825,11 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3527,7 +5851,7 @@ func (m *MsgSetBlacklist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelQueuedInboundTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3550,10 +5874,10 @@ func (m *MsgSetBlacklistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBlacklistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelQueuedInboundTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelQueuedInboundTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17,6 +17,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateCethReceiverAccount{},
 		&MsgRescueCeth{},
 		&MsgSetBlacklist{},
+		&MsgSetConsensusNeeded{},
+		&MsgSetProphecyLifetime{},
+		&MsgSetRateLimit{},
+		&MsgConfirmOutboundTransfer{},
+		&MsgReleaseQueuedInboundTransfer{},
+		&MsgCancelQueuedInboundTransfer{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -56,6 +56,40 @@ func (ClaimType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_4cb34f678c9ed59f, []int{0}
 }
 
+// Outbound transfer status enum
+type OutboundTransferStatus int32
+
+const (
+	// Unspecified outbound transfer status
+	OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_UNSPECIFIED OutboundTransferStatus = 0
+	// Pending outbound transfer status, waiting to be relayed to ethereum
+	OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_PENDING OutboundTransferStatus = 1
+	// Completed outbound transfer status, relayed to ethereum and confirmed by
+	// the validators, completed transfers are pruned from the store after
+	// OutboundTransferRetentionBlocks
+	OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_COMPLETED OutboundTransferStatus = 2
+)
+
+var OutboundTransferStatus_name = map[int32]string{
+	0: "OUTBOUND_TRANSFER_STATUS_UNSPECIFIED",
+	1: "OUTBOUND_TRANSFER_STATUS_PENDING",
+	2: "OUTBOUND_TRANSFER_STATUS_COMPLETED",
+}
+
+var OutboundTransferStatus_value = map[string]int32{
+	"OUTBOUND_TRANSFER_STATUS_UNSPECIFIED": 0,
+	"OUTBOUND_TRANSFER_STATUS_PENDING":     1,
+	"OUTBOUND_TRANSFER_STATUS_COMPLETED":   2,
+}
+
+func (x OutboundTransferStatus) String() string {
+	return proto.EnumName(OutboundTransferStatus_name, int32(x))
+}
+
+func (OutboundTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{1}
+}
+
 // EthBridgeClaim is a structure that contains all the data for a particular
 // bridge claim
 type EthBridgeClaim struct {
@@ -218,8 +252,14 @@ func (m *PeggyTokens) GetTokens() []string {
 
 // GenesisState for ethbridge
 type GenesisState struct {
-	CethReceiveAccount string   `protobuf:"bytes,1,opt,name=ceth_receive_account,json=cethReceiveAccount,proto3" json:"ceth_receive_account,omitempty"`
-	PeggyTokens        []string `protobuf:"bytes,2,rep,name=peggy_tokens,json=peggyTokens,proto3" json:"peggy_tokens,omitempty"`
+	CethReceiveAccount     string                   `protobuf:"bytes,1,opt,name=ceth_receive_account,json=cethReceiveAccount,proto3" json:"ceth_receive_account,omitempty"`
+	PeggyTokens            []string                 `protobuf:"bytes,2,rep,name=peggy_tokens,json=peggyTokens,proto3" json:"peggy_tokens,omitempty"`
+	OutboundTransfers      []*OutboundTransfer      `protobuf:"bytes,3,rep,name=outbound_transfers,json=outboundTransfers,proto3" json:"outbound_transfers,omitempty"`
+	LastOutboundNonce      uint64                   `protobuf:"varint,4,opt,name=last_outbound_nonce,json=lastOutboundNonce,proto3" json:"last_outbound_nonce,omitempty"`
+	RateLimits             []*RateLimit             `protobuf:"bytes,5,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	RateLimitUsages        []*RateLimitUsage        `protobuf:"bytes,6,rep,name=rate_limit_usages,json=rateLimitUsages,proto3" json:"rate_limit_usages,omitempty"`
+	QueuedInboundTransfers []*QueuedInboundTransfer `protobuf:"bytes,7,rep,name=queued_inbound_transfers,json=queuedInboundTransfers,proto3" json:"queued_inbound_transfers,omitempty"`
+	LastQueuedInboundId    uint64                   `protobuf:"varint,8,opt,name=last_queued_inbound_id,json=lastQueuedInboundId,proto3" json:"last_queued_inbound_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -269,6 +309,48 @@ func (m *GenesisState) GetPeggyTokens() []string {
 	return nil
 }
 
+func (m *GenesisState) GetOutboundTransfers() []*OutboundTransfer {
+	if m != nil {
+		return m.OutboundTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastOutboundNonce() uint64 {
+	if m != nil {
+		return m.LastOutboundNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRateLimits() []*RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitUsages() []*RateLimitUsage {
+	if m != nil {
+		return m.RateLimitUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedInboundTransfers() []*QueuedInboundTransfer {
+	if m != nil {
+		return m.QueuedInboundTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastQueuedInboundId() uint64 {
+	if m != nil {
+		return m.LastQueuedInboundId
+	}
+	return 0
+}
+
 type Pause struct {
 	IsPaused bool `protobuf:"varint,1,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
 }
@@ -313,59 +395,433 @@ func (m *Pause) GetIsPaused() bool {
 	return false
 }
 
+// OutboundTransfer is the on chain record of a lock or burn waiting to be
+// relayed to ethereum
+type OutboundTransfer struct {
+	// nonce is assigned from a single sequence shared by locks and burns
+	Nonce            uint64                                 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty" yaml:"nonce"`
+	ClaimType        ClaimType                              `protobuf:"varint,2,opt,name=claim_type,json=claimType,proto3,enum=sifnode.ethbridge.v1.ClaimType" json:"claim_type,omitempty" yaml:"claim_type"`
+	CosmosSender     string                                 `protobuf:"bytes,3,opt,name=cosmos_sender,json=cosmosSender,proto3" json:"cosmos_sender,omitempty" yaml:"cosmos_sender"`
+	EthereumChainId  int64                                  `protobuf:"varint,4,opt,name=ethereum_chain_id,json=ethereumChainId,proto3" json:"ethereum_chain_id,omitempty" yaml:"ethereum_chain_id"`
+	EthereumReceiver string                                 `protobuf:"bytes,5,opt,name=ethereum_receiver,json=ethereumReceiver,proto3" json:"ethereum_receiver,omitempty" yaml:"ethereum_receiver"`
+	Symbol           string                                 `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	CethAmount       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=ceth_amount,json=cethAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ceth_amount" yaml:"ceth_amount"`
+	BlockHeight      int64                                  `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Status           OutboundTransferStatus                 `protobuf:"varint,10,opt,name=status,proto3,enum=sifnode.ethbridge.v1.OutboundTransferStatus" json:"status,omitempty" yaml:"status"`
+	// cosmos_sender_sequence is the account sequence of the sender, relayed to
+	// ethereum alongside the sender to identify the transfer
+	CosmosSenderSequence uint64 `protobuf:"varint,11,opt,name=cosmos_sender_sequence,json=cosmosSenderSequence,proto3" json:"cosmos_sender_sequence,omitempty" yaml:"cosmos_sender_sequence"`
+	// completed_height is the block the transfer was completed at, completed
+	// transfers are pruned OutboundTransferRetentionBlocks after it
+	CompletedHeight int64 `protobuf:"varint,12,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty" yaml:"completed_height"`
+}
+
+func (m *OutboundTransfer) Reset()         { *m = OutboundTransfer{} }
+func (m *OutboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*OutboundTransfer) ProtoMessage()    {}
+func (*OutboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{4}
+}
+func (m *OutboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutboundTransfer.Merge(m, src)
+}
+func (m *OutboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutboundTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutboundTransfer proto.InternalMessageInfo
+
+func (m *OutboundTransfer) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *OutboundTransfer) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ClaimType_CLAIM_TYPE_UNSPECIFIED
+}
+
+func (m *OutboundTransfer) GetCosmosSender() string {
+	if m != nil {
+		return m.CosmosSender
+	}
+	return ""
+}
+
+func (m *OutboundTransfer) GetEthereumChainId() int64 {
+	if m != nil {
+		return m.EthereumChainId
+	}
+	return 0
+}
+
+func (m *OutboundTransfer) GetEthereumReceiver() string {
+	if m != nil {
+		return m.EthereumReceiver
+	}
+	return ""
+}
+
+func (m *OutboundTransfer) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *OutboundTransfer) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *OutboundTransfer) GetStatus() OutboundTransferStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OutboundTransferStatus_OUTBOUND_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (m *OutboundTransfer) GetCosmosSenderSequence() uint64 {
+	if m != nil {
+		return m.CosmosSenderSequence
+	}
+	return 0
+}
+
+func (m *OutboundTransfer) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+// RateLimit caps how much of a denom can be bridged in and out over a sliding
+// window of blocks. A zero limit leaves that direction unlimited
+type RateLimit struct {
+	Denom         string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	InboundLimit  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=inbound_limit,json=inboundLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inbound_limit" yaml:"inbound_limit"`
+	OutboundLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=outbound_limit,json=outboundLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outbound_limit" yaml:"outbound_limit"`
+	WindowBlocks  uint64                                 `protobuf:"varint,4,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{5}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimit) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// RateLimitUsage is the amount of a denom bridged in and out in the current
+// window, windows start at multiples of the window size. The amounts of the
+// previous window are kept to approximate the usage of the sliding window
+type RateLimitUsage struct {
+	Denom                  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	WindowStartHeight      int64                                  `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty" yaml:"window_start_height"`
+	InboundAmount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inbound_amount,json=inboundAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inbound_amount" yaml:"inbound_amount"`
+	OutboundAmount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outbound_amount,json=outboundAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outbound_amount" yaml:"outbound_amount"`
+	PreviousInboundAmount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=previous_inbound_amount,json=previousInboundAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_inbound_amount" yaml:"previous_inbound_amount"`
+	PreviousOutboundAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=previous_outbound_amount,json=previousOutboundAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previous_outbound_amount" yaml:"previous_outbound_amount"`
+}
+
+func (m *RateLimitUsage) Reset()         { *m = RateLimitUsage{} }
+func (m *RateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*RateLimitUsage) ProtoMessage()    {}
+func (*RateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{6}
+}
+func (m *RateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitUsage.Merge(m, src)
+}
+func (m *RateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitUsage proto.InternalMessageInfo
+
+func (m *RateLimitUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitUsage) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+// QueuedInboundTransfer is a successful claim held back by the inbound rate
+// limit of its denom, released once the limit allows it. Transfers failing to
+// release for another reason are parked, out of the way of the rest of the
+// queue, until an admin releases or cancels them
+type QueuedInboundTransfer struct {
+	Id             uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	CosmosReceiver string                                 `protobuf:"bytes,2,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty" yaml:"cosmos_receiver"`
+	Denom          string                                 `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Amount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	QueuedHeight   int64                                  `protobuf:"varint,5,opt,name=queued_height,json=queuedHeight,proto3" json:"queued_height,omitempty" yaml:"queued_height"`
+	Parked         bool                                   `protobuf:"varint,6,opt,name=parked,proto3" json:"parked,omitempty" yaml:"parked"`
+}
+
+func (m *QueuedInboundTransfer) Reset()         { *m = QueuedInboundTransfer{} }
+func (m *QueuedInboundTransfer) String() string { return proto.CompactTextString(m) }
+func (*QueuedInboundTransfer) ProtoMessage()    {}
+func (*QueuedInboundTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cb34f678c9ed59f, []int{7}
+}
+func (m *QueuedInboundTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedInboundTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedInboundTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedInboundTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedInboundTransfer.Merge(m, src)
+}
+func (m *QueuedInboundTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedInboundTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedInboundTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedInboundTransfer proto.InternalMessageInfo
+
+func (m *QueuedInboundTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedInboundTransfer) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *QueuedInboundTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueuedInboundTransfer) GetQueuedHeight() int64 {
+	if m != nil {
+		return m.QueuedHeight
+	}
+	return 0
+}
+
+func (m *QueuedInboundTransfer) GetParked() bool {
+	if m != nil {
+		return m.Parked
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("sifnode.ethbridge.v1.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterEnum("sifnode.ethbridge.v1.OutboundTransferStatus", OutboundTransferStatus_name, OutboundTransferStatus_value)
 	proto.RegisterType((*EthBridgeClaim)(nil), "sifnode.ethbridge.v1.EthBridgeClaim")
 	proto.RegisterType((*PeggyTokens)(nil), "sifnode.ethbridge.v1.PeggyTokens")
 	proto.RegisterType((*GenesisState)(nil), "sifnode.ethbridge.v1.GenesisState")
 	proto.RegisterType((*Pause)(nil), "sifnode.ethbridge.v1.Pause")
+	proto.RegisterType((*OutboundTransfer)(nil), "sifnode.ethbridge.v1.OutboundTransfer")
+	proto.RegisterType((*RateLimit)(nil), "sifnode.ethbridge.v1.RateLimit")
+	proto.RegisterType((*RateLimitUsage)(nil), "sifnode.ethbridge.v1.RateLimitUsage")
+	proto.RegisterType((*QueuedInboundTransfer)(nil), "sifnode.ethbridge.v1.QueuedInboundTransfer")
 }
 
 func init() { proto.RegisterFile("sifnode/ethbridge/v1/types.proto", fileDescriptor_4cb34f678c9ed59f) }
 
 var fileDescriptor_4cb34f678c9ed59f = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0x6e, 0x36, 0x5a, 0x16, 0x6f, 0x74, 0x9d, 0x29, 0x25, 0x1a, 0x90, 0x74, 0x16, 0x4c, 0x05,
-	0x69, 0x2d, 0x83, 0x1b, 0x07, 0xd0, 0x1a, 0xca, 0xa8, 0x18, 0xa3, 0xb8, 0x9b, 0x26, 0x76, 0x89,
-	0x52, 0xc7, 0x6b, 0xa3, 0x35, 0x71, 0x15, 0xbb, 0x15, 0x7d, 0x0b, 0x1e, 0x6b, 0xc7, 0x1d, 0x11,
-	0x87, 0x08, 0x6d, 0x6f, 0xd0, 0x27, 0x40, 0xb1, 0xd3, 0xae, 0xea, 0xc6, 0x29, 0xce, 0xf7, 0x7d,
-	0xfe, 0x7e, 0xfe, 0xfd, 0xb1, 0x41, 0x99, 0xfb, 0x67, 0x21, 0xf3, 0x68, 0x8d, 0x8a, 0x5e, 0x27,
-	0xf2, 0xbd, 0x2e, 0xad, 0x8d, 0x76, 0x6b, 0x62, 0x3c, 0xa0, 0xbc, 0x3a, 0x88, 0x98, 0x60, 0xb0,
-	0x98, 0x2a, 0xaa, 0x33, 0x45, 0x75, 0xb4, 0xbb, 0x59, 0xec, 0xb2, 0x2e, 0x93, 0x82, 0x5a, 0xb2,
-	0x52, 0x5a, 0x74, 0x99, 0x05, 0xf9, 0x86, 0xe8, 0xd5, 0xa5, 0xcc, 0xee, 0xbb, 0x7e, 0x00, 0x3f,
-	0x83, 0x0d, 0x2a, 0x7a, 0x34, 0xa2, 0xc3, 0xc0, 0x21, 0x3d, 0xd7, 0x0f, 0x1d, 0xdf, 0x33, 0xb4,
-	0xb2, 0x56, 0x59, 0xae, 0x3f, 0x9d, 0xc4, 0x96, 0x31, 0x76, 0x83, 0xfe, 0x3b, 0x74, 0x4b, 0x82,
-	0xf0, 0xfa, 0x14, 0xb3, 0x13, 0xa8, 0xe9, 0xc1, 0x53, 0xf0, 0x58, 0xc5, 0x77, 0x08, 0x0b, 0x45,
-	0xe4, 0x12, 0xe1, 0xb8, 0x9e, 0x17, 0x51, 0xce, 0x8d, 0xa5, 0xb2, 0x56, 0xd1, 0xeb, 0x68, 0x12,
-	0x5b, 0xa6, 0xf2, 0xfb, 0x8f, 0x10, 0xe1, 0x47, 0x8a, 0xb1, 0x53, 0x62, 0x4f, 0xe1, 0x70, 0x1b,
-	0x64, 0x43, 0x16, 0x12, 0x6a, 0x2c, 0xcb, 0x93, 0x15, 0x26, 0xb1, 0xb5, 0xa6, 0x9c, 0x24, 0x8c,
-	0xb0, 0xa2, 0xe1, 0x4b, 0x90, 0xe3, 0xe3, 0xa0, 0xc3, 0xfa, 0xc6, 0x3d, 0x19, 0x72, 0x63, 0x12,
-	0x5b, 0x0f, 0x94, 0x50, 0xe1, 0x08, 0xa7, 0x02, 0x78, 0x02, 0x4a, 0x82, 0x9d, 0xd3, 0xf0, 0xf6,
-	0x69, 0xb3, 0x72, 0xeb, 0xd6, 0x24, 0xb6, 0x9e, 0xa9, 0xad, 0x77, 0xeb, 0x10, 0x2e, 0x4a, 0x62,
-	0xf1, 0xac, 0x36, 0x98, 0x95, 0xc6, 0xe1, 0x34, 0xf4, 0x68, 0x64, 0xe4, 0xa4, 0xe3, 0xe6, 0x24,
-	0xb6, 0x4a, 0x0b, 0xf5, 0x54, 0x02, 0x84, 0xf3, 0x53, 0xa4, 0x2d, 0x81, 0xc4, 0x84, 0x30, 0x1e,
-	0x30, 0xee, 0x44, 0x94, 0x50, 0x7f, 0x44, 0x23, 0xe3, 0xfe, 0xa2, 0xc9, 0x82, 0x00, 0xe1, 0xbc,
-	0x42, 0x70, 0x0a, 0xc0, 0x26, 0xd8, 0x18, 0xb9, 0x7d, 0xdf, 0x73, 0x05, 0x8b, 0x66, 0xd9, 0xad,
-	0x48, 0x9b, 0xb9, 0xde, 0xde, 0x92, 0x20, 0x5c, 0x98, 0x61, 0xd3, 0xa4, 0x4e, 0x40, 0xce, 0x0d,
-	0xd8, 0x30, 0x14, 0x86, 0x2e, 0xf7, 0x7f, 0xb8, 0x88, 0xad, 0xcc, 0x9f, 0xd8, 0xda, 0xee, 0xfa,
-	0xa2, 0x37, 0xec, 0x54, 0x09, 0x0b, 0x6a, 0x2a, 0x7a, 0xfa, 0xd9, 0xe1, 0xde, 0x79, 0x3a, 0xa7,
-	0xcd, 0x50, 0xdc, 0xb4, 0x41, 0xb9, 0x20, 0x9c, 0xda, 0xc1, 0xf7, 0x00, 0x90, 0x64, 0x10, 0x9d,
-	0x44, 0x6b, 0x80, 0xb2, 0x56, 0xc9, 0xbf, 0xb1, 0xaa, 0x77, 0xcd, 0x74, 0x55, 0x0e, 0xec, 0xd1,
-	0x78, 0x40, 0xb1, 0x4e, 0xa6, 0x4b, 0xf4, 0x02, 0xac, 0xb6, 0x68, 0xb7, 0x3b, 0x3e, 0x4a, 0x5a,
-	0xc1, 0x61, 0x09, 0xe4, 0x64, 0x53, 0xb8, 0xa1, 0x95, 0x97, 0x2b, 0x3a, 0x4e, 0xff, 0x10, 0x01,
-	0x6b, 0xfb, 0x34, 0xa4, 0xdc, 0xe7, 0x6d, 0xe1, 0x0a, 0x0a, 0x5f, 0x83, 0x22, 0xa1, 0xa2, 0x37,
-	0x2d, 0x9e, 0xe3, 0x12, 0x22, 0xb3, 0x4b, 0x26, 0x5f, 0xc7, 0x30, 0xe1, 0xd2, 0x32, 0xee, 0x29,
-	0x06, 0x6e, 0x81, 0xb5, 0x41, 0x12, 0xc8, 0x49, 0xfd, 0x97, 0xa4, 0xff, 0xea, 0xe0, 0x26, 0x38,
-	0x7a, 0x0e, 0xb2, 0x2d, 0x77, 0xc8, 0x29, 0x7c, 0x02, 0x74, 0x9f, 0x3b, 0x83, 0x64, 0xad, 0x2e,
-	0xd3, 0x0a, 0x5e, 0xf1, 0xb9, 0xe4, 0xbc, 0x57, 0xdf, 0x81, 0x3e, 0xcb, 0x04, 0x6e, 0x82, 0x92,
-	0x7d, 0xb0, 0xd7, 0xfc, 0xea, 0x1c, 0xfd, 0x68, 0x35, 0x9c, 0xe3, 0xc3, 0x76, 0xab, 0x61, 0x37,
-	0x3f, 0x35, 0x1b, 0x1f, 0x0b, 0x19, 0xf8, 0x10, 0xac, 0xcf, 0x71, 0xf5, 0x63, 0x7c, 0x58, 0xd0,
-	0x16, 0xc0, 0x83, 0x6f, 0xf6, 0x97, 0xc2, 0x52, 0x7d, 0xff, 0xe2, 0xca, 0xd4, 0x2e, 0xaf, 0x4c,
-	0xed, 0xef, 0x95, 0xa9, 0xfd, 0xba, 0x36, 0x33, 0x97, 0xd7, 0x66, 0xe6, 0xf7, 0xb5, 0x99, 0x39,
-	0xdd, 0x99, 0xeb, 0x4f, 0xdb, 0x3f, 0x93, 0xd7, 0xb7, 0x36, 0x7d, 0x53, 0x7e, 0xce, 0xbd, 0x2a,
-	0xb2, 0x55, 0x9d, 0x9c, 0x7c, 0x27, 0xde, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xb2, 0xce,
-	0x21, 0x77, 0x04, 0x00, 0x00,
+	// 1423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
+	0x17, 0xb6, 0xae, 0xb6, 0x46, 0x17, 0x4b, 0xb4, 0x2d, 0x13, 0xce, 0x1f, 0x51, 0x19, 0xf8, 0x37,
+	0x9c, 0xb4, 0x91, 0x9b, 0x64, 0x17, 0x20, 0x6d, 0x2d, 0x59, 0x76, 0x84, 0x3a, 0xb2, 0x32, 0x92,
+	0x10, 0x34, 0x1b, 0x82, 0x26, 0x27, 0x12, 0x61, 0x89, 0x94, 0x39, 0x94, 0x53, 0xef, 0x8b, 0xa2,
+	0x40, 0x37, 0x59, 0xf7, 0x89, 0xb2, 0x6b, 0x96, 0x45, 0x17, 0x44, 0x91, 0x00, 0x7d, 0x00, 0x3d,
+	0x41, 0xc1, 0x99, 0x21, 0x4d, 0xd2, 0x72, 0x13, 0xb5, 0x59, 0x89, 0x73, 0xce, 0xc7, 0xef, 0x70,
+	0xce, 0xe5, 0x9b, 0x11, 0xa8, 0x12, 0xfd, 0x95, 0x61, 0x6a, 0x78, 0x0f, 0xdb, 0xc3, 0x53, 0x4b,
+	0xd7, 0x06, 0x78, 0xef, 0xe2, 0xc1, 0x9e, 0x7d, 0x39, 0xc1, 0xa4, 0x36, 0xb1, 0x4c, 0xdb, 0x14,
+	0xd6, 0x39, 0xa2, 0xe6, 0x23, 0x6a, 0x17, 0x0f, 0xb6, 0xd6, 0x07, 0xe6, 0xc0, 0xa4, 0x80, 0x3d,
+	0xf7, 0x89, 0x61, 0xe1, 0xbb, 0x14, 0x28, 0x34, 0xed, 0x61, 0x9d, 0xc2, 0x1a, 0x23, 0x45, 0x1f,
+	0x0b, 0x4f, 0x41, 0x09, 0xdb, 0x43, 0x6c, 0xe1, 0xe9, 0x58, 0x56, 0x87, 0x8a, 0x6e, 0xc8, 0xba,
+	0x26, 0xc6, 0xaa, 0xb1, 0xdd, 0x44, 0xfd, 0x7f, 0x33, 0x47, 0x12, 0x2f, 0x95, 0xf1, 0xe8, 0x31,
+	0xbc, 0x06, 0x81, 0x68, 0xd5, 0xb3, 0x35, 0x5c, 0x53, 0x4b, 0x13, 0x5e, 0x82, 0x4d, 0x16, 0x5f,
+	0x56, 0x4d, 0xc3, 0xb6, 0x14, 0xd5, 0x96, 0x15, 0x4d, 0xb3, 0x30, 0x21, 0x62, 0xbc, 0x1a, 0xdb,
+	0xcd, 0xd4, 0xe1, 0xcc, 0x91, 0x2a, 0x8c, 0xef, 0x06, 0x20, 0x44, 0x1b, 0xcc, 0xd3, 0xe0, 0x8e,
+	0x7d, 0x66, 0x17, 0x76, 0x40, 0xca, 0x30, 0x0d, 0x15, 0x8b, 0x09, 0xfa, 0x65, 0xc5, 0x99, 0x23,
+	0xe5, 0x18, 0x13, 0x35, 0x43, 0xc4, 0xdc, 0xc2, 0x5d, 0x90, 0x26, 0x97, 0xe3, 0x53, 0x73, 0x24,
+	0x26, 0x69, 0xc8, 0xd2, 0xcc, 0x91, 0xf2, 0x0c, 0xc8, 0xec, 0x10, 0x71, 0x80, 0xf0, 0x02, 0x94,
+	0x6d, 0xf3, 0x0c, 0x1b, 0xd7, 0xbf, 0x36, 0x45, 0x5f, 0xbd, 0x33, 0x73, 0xa4, 0xdb, 0xec, 0xd5,
+	0xf9, 0x38, 0x88, 0xd6, 0xa9, 0x23, 0xfa, 0xad, 0x0d, 0xe0, 0xa7, 0x46, 0x26, 0xd8, 0xd0, 0xb0,
+	0x25, 0xa6, 0x29, 0xe3, 0xd6, 0xcc, 0x91, 0xca, 0x91, 0x7c, 0x32, 0x00, 0x44, 0x05, 0xcf, 0xd2,
+	0xa5, 0x06, 0x97, 0x44, 0x35, 0xc9, 0xd8, 0x24, 0xb2, 0x85, 0x55, 0xac, 0x5f, 0x60, 0x4b, 0x5c,
+	0x8e, 0x92, 0x44, 0x00, 0x10, 0x15, 0x98, 0x05, 0x71, 0x83, 0xd0, 0x02, 0xa5, 0x0b, 0x65, 0xa4,
+	0x6b, 0x8a, 0x6d, 0x5a, 0xfe, 0xee, 0x56, 0x28, 0x4d, 0xa0, 0xb6, 0xd7, 0x20, 0x10, 0x15, 0x7d,
+	0x9b, 0xb7, 0xa9, 0x17, 0x20, 0xad, 0x8c, 0xcd, 0xa9, 0x61, 0x8b, 0x19, 0xfa, 0xfe, 0x37, 0x6f,
+	0x1d, 0x69, 0xe9, 0x0f, 0x47, 0xda, 0x19, 0xe8, 0xf6, 0x70, 0x7a, 0x5a, 0x53, 0xcd, 0xf1, 0x1e,
+	0x8b, 0xce, 0x7f, 0xee, 0x13, 0xed, 0x8c, 0xf7, 0x69, 0xcb, 0xb0, 0xaf, 0xca, 0xc0, 0x58, 0x20,
+	0xe2, 0x74, 0xc2, 0xd7, 0x00, 0xa8, 0x6e, 0x23, 0xca, 0x2e, 0x56, 0x04, 0xd5, 0xd8, 0x6e, 0xe1,
+	0xa1, 0x54, 0x9b, 0xd7, 0xd3, 0x35, 0xda, 0xb0, 0xbd, 0xcb, 0x09, 0x46, 0x19, 0xd5, 0x7b, 0x84,
+	0xff, 0x07, 0xd9, 0x0e, 0x1e, 0x0c, 0x2e, 0x7b, 0x6e, 0x29, 0x88, 0x50, 0x06, 0x69, 0x5a, 0x14,
+	0x22, 0xc6, 0xaa, 0x89, 0xdd, 0x0c, 0xe2, 0x2b, 0xf8, 0x6b, 0x12, 0xe4, 0x8e, 0xb0, 0x81, 0x89,
+	0x4e, 0xba, 0xb6, 0x62, 0x63, 0xe1, 0x2b, 0xb0, 0xae, 0x62, 0x7b, 0xe8, 0x65, 0x4f, 0x56, 0x54,
+	0x95, 0x6e, 0xcf, 0x6d, 0xfd, 0x0c, 0x12, 0x5c, 0x1f, 0xcf, 0xe3, 0x3e, 0xf3, 0x08, 0x77, 0x40,
+	0x6e, 0xe2, 0x46, 0x92, 0x79, 0x80, 0x38, 0x0d, 0x90, 0x9d, 0x04, 0xa2, 0xf7, 0x81, 0x60, 0x4e,
+	0xed, 0x53, 0x73, 0x6a, 0x68, 0xb2, 0x6d, 0x29, 0x06, 0x79, 0x85, 0x2d, 0x22, 0x26, 0xaa, 0x89,
+	0xdd, 0xec, 0xc3, 0x9d, 0xf9, 0x9b, 0x3a, 0xe1, 0xf8, 0x1e, 0x87, 0xa3, 0x92, 0x19, 0xb1, 0x10,
+	0xa1, 0x06, 0xd6, 0x46, 0x0a, 0xb1, 0x65, 0x9f, 0x9b, 0xcd, 0x82, 0xdb, 0xe2, 0x49, 0x54, 0x72,
+	0x5d, 0x1e, 0x4b, 0x9b, 0x4e, 0xc1, 0xb7, 0x20, 0x6b, 0x29, 0x36, 0x96, 0x47, 0xfa, 0x58, 0xb7,
+	0xdd, 0x7e, 0x76, 0xe3, 0xdf, 0x90, 0x54, 0xa4, 0xd8, 0xf8, 0xd8, 0xc5, 0x21, 0x60, 0x79, 0x8f,
+	0x44, 0xe8, 0x80, 0xd2, 0x15, 0x83, 0x3c, 0x25, 0xca, 0x00, 0x13, 0x31, 0x4d, 0x79, 0xb6, 0x3f,
+	0xc2, 0xd3, 0x77, 0xc1, 0x68, 0xd5, 0x0a, 0xad, 0x89, 0x80, 0x81, 0x78, 0x3e, 0xc5, 0x53, 0xac,
+	0xc9, 0xba, 0x11, 0x4d, 0xd0, 0x32, 0x25, 0xfe, 0x62, 0x3e, 0xf1, 0x73, 0xfa, 0x56, 0xcb, 0x08,
+	0x67, 0xa9, 0x7c, 0x3e, 0xcf, 0x4c, 0x84, 0x47, 0xa0, 0x4c, 0x53, 0x15, 0x89, 0xa5, 0x6b, 0xb4,
+	0xef, 0x93, 0x88, 0x26, 0x32, 0x44, 0xd9, 0xd2, 0xe0, 0x36, 0x48, 0x75, 0x94, 0x29, 0xc1, 0xc2,
+	0x2d, 0x90, 0xd1, 0x89, 0x3c, 0x71, 0x9f, 0x99, 0x08, 0xae, 0xa0, 0x15, 0x9d, 0x50, 0x9f, 0x06,
+	0x7f, 0x5a, 0x06, 0xc5, 0x68, 0xb5, 0xae, 0x84, 0xc9, 0x45, 0x27, 0x6f, 0x16, 0xa6, 0x7e, 0xa8,
+	0xcd, 0xe3, 0x9f, 0xd4, 0xe6, 0xf5, 0x8d, 0x99, 0x23, 0x95, 0xf8, 0xac, 0xfb, 0x2f, 0xc3, 0x40,
+	0xf7, 0x0b, 0x4f, 0x40, 0x9e, 0xab, 0x00, 0x57, 0x9a, 0x04, 0x9d, 0x4e, 0x71, 0xe6, 0x48, 0xeb,
+	0x21, 0x91, 0xf0, 0x74, 0x26, 0xc7, 0xd6, 0x5c, 0x65, 0xe6, 0x8a, 0x7f, 0xf2, 0xdf, 0x88, 0x7f,
+	0x2b, 0xc0, 0xe4, 0x2b, 0x56, 0x2a, 0x2a, 0x35, 0xd7, 0x20, 0x10, 0x15, 0x3d, 0x9b, 0xaf, 0x5a,
+	0x57, 0x1a, 0x9e, 0xfe, 0xb8, 0x86, 0x7b, 0xaa, 0xb4, 0xfc, 0x79, 0x55, 0x09, 0x83, 0x2c, 0x55,
+	0x07, 0xce, 0xce, 0x34, 0xf3, 0x60, 0x61, 0x76, 0x81, 0xd7, 0xe0, 0x8a, 0x0a, 0x22, 0xe0, 0xae,
+	0xf6, 0x59, 0x98, 0xc7, 0x20, 0x77, 0x3a, 0x32, 0xd5, 0x33, 0x79, 0x88, 0xf5, 0xc1, 0x90, 0x69,
+	0x6b, 0xa2, 0xbe, 0x39, 0x73, 0xa4, 0x35, 0x7e, 0x4e, 0x06, 0xbc, 0x10, 0x65, 0xe9, 0xf2, 0x29,
+	0x5d, 0xb9, 0x7b, 0x27, 0xb6, 0x62, 0x4f, 0x09, 0x17, 0xcd, 0x2f, 0x3f, 0x4d, 0x5f, 0xba, 0xf4,
+	0x9d, 0x50, 0x52, 0xa9, 0xc5, 0x4d, 0x2a, 0x7d, 0x70, 0x0f, 0xc6, 0x50, 0xd3, 0xc8, 0x04, 0x9f,
+	0x4f, 0xb1, 0xdb, 0xe3, 0x59, 0xda, 0xe3, 0x81, 0x83, 0x71, 0x3e, 0x0e, 0xa2, 0xf5, 0x60, 0x97,
+	0x75, 0xb9, 0x59, 0x38, 0x04, 0x45, 0xd5, 0x1c, 0x4f, 0x46, 0xd8, 0xc6, 0x9a, 0xb7, 0xe3, 0x1c,
+	0xdd, 0xf1, 0xad, 0x99, 0x23, 0x6d, 0x7a, 0x94, 0x61, 0x04, 0x44, 0xab, 0xbe, 0x89, 0xed, 0x1c,
+	0xfe, 0x16, 0x07, 0x19, 0x5f, 0x6e, 0xdc, 0x09, 0xd4, 0xb0, 0x61, 0x8e, 0x99, 0x72, 0x07, 0x27,
+	0x90, 0x9a, 0x21, 0x62, 0x6e, 0xe1, 0x0c, 0xe4, 0x3d, 0x35, 0xa0, 0xaa, 0xc6, 0x2f, 0x25, 0x87,
+	0x0b, 0x17, 0x95, 0x0f, 0x56, 0x88, 0x0c, 0xa2, 0x1c, 0x5f, 0xb3, 0x8f, 0x32, 0x40, 0xc1, 0x17,
+	0x6b, 0x16, 0x8d, 0x0d, 0xe6, 0xd1, 0xc2, 0xd1, 0x36, 0x58, 0xb4, 0x30, 0x1b, 0x44, 0x79, 0xcf,
+	0xc0, 0xe2, 0x3d, 0x01, 0xf9, 0xd7, 0xba, 0xa1, 0x99, 0xaf, 0x65, 0xda, 0x22, 0x84, 0x9d, 0x0d,
+	0x41, 0x1d, 0x08, 0xb9, 0x21, 0xca, 0xb1, 0x75, 0x9d, 0x2d, 0x7f, 0x4c, 0x81, 0x42, 0x58, 0xc0,
+	0x3f, 0x39, 0xad, 0x6d, 0xb0, 0xc6, 0xa9, 0x89, 0xad, 0x58, 0xb6, 0x57, 0xd7, 0x38, 0xad, 0x6b,
+	0x65, 0xe6, 0x48, 0x5b, 0xa1, 0xf8, 0x41, 0x10, 0x44, 0x25, 0x66, 0xed, 0xba, 0x46, 0xde, 0xd6,
+	0x06, 0x28, 0x78, 0x99, 0xe5, 0xc3, 0xf7, 0x1f, 0x33, 0x17, 0x66, 0x83, 0xc8, 0xeb, 0x02, 0x3e,
+	0x82, 0xe7, 0x60, 0xd5, 0xcf, 0x2d, 0x0f, 0xc8, 0xae, 0x8e, 0x4f, 0x17, 0x0e, 0x58, 0x8e, 0x94,
+	0xca, 0x8b, 0xe8, 0xb7, 0x02, 0x0f, 0xf9, 0x73, 0x0c, 0x6c, 0x4e, 0x2c, 0x7c, 0xa1, 0x9b, 0x53,
+	0x22, 0x47, 0x36, 0xcb, 0x24, 0xb3, 0xb3, 0x70, 0x6c, 0x7e, 0xaf, 0xbe, 0x81, 0x16, 0xa2, 0x0d,
+	0xcf, 0xd3, 0x0a, 0xed, 0xfe, 0x97, 0x18, 0x10, 0xfd, 0x77, 0xa2, 0x79, 0x60, 0xf2, 0xfb, 0x7c,
+	0xe1, 0x6f, 0x91, 0x22, 0xdf, 0x72, 0x2d, 0x21, 0x65, 0xcf, 0x75, 0x12, 0x4a, 0x0c, 0xfc, 0x2b,
+	0x0e, 0x36, 0xe6, 0x1e, 0xf7, 0xc2, 0x6d, 0x10, 0xe7, 0x7f, 0x4b, 0x92, 0xf5, 0xfc, 0xcc, 0x91,
+	0x32, 0xbc, 0xb6, 0x1a, 0x44, 0x71, 0x5d, 0x9b, 0x77, 0x5b, 0x8e, 0x2f, 0x7c, 0x5b, 0xf6, 0x3b,
+	0x3e, 0xf1, 0xcf, 0x1d, 0x7f, 0x75, 0xe8, 0x24, 0x3f, 0xef, 0xa1, 0xf3, 0x04, 0xe4, 0xf9, 0xb5,
+	0x85, 0x0f, 0x51, 0x8a, 0x0e, 0x51, 0x60, 0x88, 0x43, 0x6e, 0x88, 0x72, 0x6c, 0xcd, 0x27, 0xe7,
+	0x2e, 0x48, 0x4f, 0x14, 0xeb, 0x0c, 0x6b, 0xb4, 0x70, 0x2b, 0x41, 0x89, 0x67, 0x76, 0x88, 0x38,
+	0xe0, 0xde, 0x73, 0x90, 0xf1, 0x6f, 0x19, 0xc2, 0x16, 0x28, 0x37, 0x8e, 0xf7, 0x5b, 0xcf, 0xe4,
+	0xde, 0xf7, 0x9d, 0xa6, 0xdc, 0x6f, 0x77, 0x3b, 0xcd, 0x46, 0xeb, 0xb0, 0xd5, 0x3c, 0x28, 0x2e,
+	0x09, 0x6b, 0x60, 0x35, 0xe0, 0xab, 0xf7, 0x51, 0xbb, 0x18, 0x8b, 0x18, 0x8f, 0x4f, 0x1a, 0xdf,
+	0x15, 0xe3, 0xf7, 0xde, 0xc4, 0x40, 0x79, 0xfe, 0x59, 0x23, 0xec, 0x82, 0xed, 0x93, 0x7e, 0xaf,
+	0x7e, 0xd2, 0x6f, 0x1f, 0xc8, 0x3d, 0xb4, 0xdf, 0xee, 0x1e, 0x36, 0x91, 0xdc, 0xed, 0xed, 0xf7,
+	0xfa, 0xdd, 0x48, 0xb8, 0x6d, 0x50, 0xbd, 0x11, 0xd9, 0x69, 0xb6, 0x0f, 0x5a, 0xed, 0xa3, 0x62,
+	0x4c, 0xd8, 0x01, 0xf0, 0x46, 0x54, 0xe3, 0xe4, 0x59, 0xe7, 0xb8, 0xd9, 0x6b, 0x1e, 0x14, 0xe3,
+	0xf5, 0xa3, 0xb7, 0xef, 0x2b, 0xb1, 0x77, 0xef, 0x2b, 0xb1, 0x3f, 0xdf, 0x57, 0x62, 0x6f, 0x3e,
+	0x54, 0x96, 0xde, 0x7d, 0xa8, 0x2c, 0xfd, 0xfe, 0xa1, 0xb2, 0xf4, 0xf2, 0x7e, 0xa0, 0x54, 0x5d,
+	0xfd, 0x15, 0xbd, 0xd7, 0xec, 0x79, 0xff, 0xb4, 0x7f, 0x08, 0xfc, 0xd7, 0xa6, 0x55, 0x3b, 0x4d,
+	0xd3, 0x7f, 0xcf, 0x8f, 0xfe, 0x1e, 0x00, 0x0d, 0xf2, 0x3a, 0xdd, 0x8d, 0x0f, 0x00, 0x00,
 }
 
 func (m *EthBridgeClaim) Marshal() (dAtA []byte, err error) {
@@ -510,6 +966,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastQueuedInboundId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastQueuedInboundId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.QueuedInboundTransfers) > 0 {
+		for iNdEx := len(m.QueuedInboundTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedInboundTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for iNdEx := len(m.RateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.LastOutboundNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastOutboundNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OutboundTransfers) > 0 {
+		for iNdEx := len(m.OutboundTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PeggyTokens) > 0 {
 		for iNdEx := len(m.PeggyTokens) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PeggyTokens[iNdEx])
@@ -562,12 +1084,308 @@ func (m *Pause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *OutboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CosmosSenderSequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosSenderSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CethAmount.Size()
+		i -= size
+		if _, err := m.CethAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EthereumReceiver) > 0 {
+		i -= len(m.EthereumReceiver)
+		copy(dAtA[i:], m.EthereumReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EthereumChainId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CosmosSender) > 0 {
+		i -= len(m.CosmosSender)
+		copy(dAtA[i:], m.CosmosSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosSender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.OutboundLimit.Size()
+		i -= size
+		if _, err := m.OutboundLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InboundLimit.Size()
+		i -= size
+		if _, err := m.InboundLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PreviousOutboundAmount.Size()
+		i -= size
+		if _, err := m.PreviousOutboundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PreviousInboundAmount.Size()
+		i -= size
+		if _, err := m.PreviousInboundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OutboundAmount.Size()
+		i -= size
+		if _, err := m.OutboundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InboundAmount.Size()
+		i -= size
+		if _, err := m.InboundAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedInboundTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedInboundTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedInboundTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Parked {
+		i--
+		if m.Parked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QueuedHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.QueuedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -648,6 +1466,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if len(m.OutboundTransfers) > 0 {
+		for _, e := range m.OutboundTransfers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastOutboundNonce != 0 {
+		n += 1 + sovTypes(uint64(m.LastOutboundNonce))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for _, e := range m.RateLimitUsages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.QueuedInboundTransfers) > 0 {
+		for _, e := range m.QueuedInboundTransfers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.LastQueuedInboundId != 0 {
+		n += 1 + sovTypes(uint64(m.LastQueuedInboundId))
+	}
 	return n
 }
 
@@ -663,30 +1511,148 @@ func (m *Pause) Size() (n int) {
 	return n
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+func (m *OutboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimType))
+	}
+	l = len(m.CosmosSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EthereumChainId != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumChainId))
+	}
+	l = len(m.EthereumReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CethAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.CosmosSenderSequence != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosSenderSequence))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InboundLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OutboundLimit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *RateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartHeight))
+	}
+	l = m.InboundAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.OutboundAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PreviousInboundAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.PreviousOutboundAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QueuedInboundTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.QueuedHeight != 0 {
+		n += 1 + sovTypes(uint64(m.QueuedHeight))
+	}
+	if m.Parked {
+		n += 2
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
 		}
 		fieldNum := int32(wire >> 3)
@@ -744,33 +1710,1252 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeggyTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeggyTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiveAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CethReceiveAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggyTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeggyTokens = append(m.PeggyTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTransfers = append(m.OutboundTransfers, &OutboundTransfer{})
+			if err := m.OutboundTransfers[len(m.OutboundTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOutboundNonce", wireType)
+			}
+			m.LastOutboundNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastOutboundNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, &RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitUsages = append(m.RateLimitUsages, &RateLimitUsage{})
+			if err := m.RateLimitUsages[len(m.RateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedInboundTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedInboundTransfers = append(m.QueuedInboundTransfers, &QueuedInboundTransfer{})
+			if err := m.QueuedInboundTransfers[len(m.QueuedInboundTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastQueuedInboundId", wireType)
+			}
+			m.LastQueuedInboundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastQueuedInboundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutboundTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutboundTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			m.ClaimType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumChainId", wireType)
+			}
+			m.EthereumChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CethAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CethAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OutboundTransferStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosSenderSequence", wireType)
+			}
+			m.CosmosSenderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosSenderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InboundLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutboundLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -798,13 +2983,13 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContractAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
 			}
-			var stringLen uint64
+			m.WindowStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -814,27 +2999,14 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowStartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -862,11 +3034,13 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			if err := m.InboundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -894,11 +3068,13 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			if err := m.OutboundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInboundAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -926,11 +3102,13 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.PreviousInboundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOutboundAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -958,29 +3136,10 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousOutboundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
-			}
-			m.ClaimType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClaimType |= ClaimType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1002,7 +3161,7 @@ func (m *EthBridgeClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
+func (m *QueuedInboundTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +3184,34 @@ func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeggyTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedInboundTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeggyTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedInboundTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,61 +3239,11 @@ func (m *PeggyTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CethReceiveAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,11 +3271,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CethReceiveAccount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeggyTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,61 +3303,32 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeggyTokens = append(m.PeggyTokens, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pause) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.QueuedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pause: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pause: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parked", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1246,7 +3345,7 @@ func (m *Pause) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			m.Parked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
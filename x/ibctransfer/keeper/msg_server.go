@@ -32,8 +32,7 @@ var _ sdktransfertypes.MsgServer = msgServer{}
 // Transfer defines a rpc handler method for MsgTransfer.
 func (srv msgServer) Transfer(goCtx context.Context, msg *sdktransfertypes.MsgTransfer) (*sdktransfertypes.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	registry := srv.tokenRegistryKeeper.GetRegistry(ctx)
-	registryEntry, err := srv.tokenRegistryKeeper.GetEntry(registry, msg.Token.Denom)
+	registryEntry, err := srv.tokenRegistryKeeper.GetRegistryEntry(ctx, msg.Token.Denom)
 	if err != nil {
 		return nil, sdkerrors.Wrap(tokenregistrytypes.ErrPermissionDenied, "denom is not whitelisted")
 	}
@@ -48,6 +47,9 @@ func (srv msgServer) Transfer(goCtx context.Context, msg *sdktransfertypes.MsgTr
 	if msg.Token.Amount.LTE(sdk.NewInt(0)) {
 		return nil, types.ErrAmountTooLowToConvert
 	}
+	if err := registryEntry.CheckTransferLimit(msg.Token.Amount); err != nil {
+		return nil, err
+	}
 
 	return srv.sdkMsgServer.Transfer(goCtx, msg)
 }
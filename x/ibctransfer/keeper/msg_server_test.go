@@ -40,6 +40,12 @@ func TestMsgServer_Transfer(t *testing.T) {
 		Decimals:    18,
 		Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_IBCEXPORT},
 	})
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:         "cusdc",
+		Decimals:      6,
+		TransferLimit: "100",
+		Permissions:   []tokenregistrytypes.Permission{tokenregistrytypes.Permission_IBCEXPORT},
+	})
 	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
 		Denom:                "ceth",
 		Decimals:             18,
@@ -134,6 +140,23 @@ func TestMsgServer_Transfer(t *testing.T) {
 			setupBankKeeperCalls: func() {},
 			setupMsgServerCalls:  func() {},
 		},
+		{
+			name:       "transfer over the transfer limit",
+			err:        tokenregistrytypes.ErrTransferLimitExceeded,
+			bankKeeper: bankKeeper,
+			msgSrv:     msgSrv,
+			msg: sdktransfertypes.NewMsgTransfer(
+				"transfer",
+				"channel-0",
+				sdk.NewCoin("cusdc", sdk.NewInt(101)),
+				addrs[0].String(),
+				addrs[1].String(),
+				clienttypes.NewHeight(0, 0),
+				0,
+			),
+			setupBankKeeperCalls: func() {},
+			setupMsgServerCalls:  func() {},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
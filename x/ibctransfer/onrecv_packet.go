@@ -27,6 +27,20 @@ func OnRecvPacketWhitelistConvert(
 		acknowledgement := channeltypes.NewErrorAcknowledgement(err.Error())
 		return acknowledgement
 	}
+	// Reject transfers over the transfer limit of the denom before anything is minted or unescrowed
+	if err := checkRecvTransferLimit(ctx, whitelistKeeper, packet, data); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				transfertypes.EventTypePacket,
+				sdk.NewAttribute(sdk.AttributeKeyModule, transfertypes.ModuleName),
+				sdk.NewAttribute(transfertypes.AttributeKeyReceiver, data.Receiver),
+				sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+				sdk.NewAttribute(transfertypes.AttributeKeyAmount, data.Amount),
+				sdk.NewAttribute(transfertypes.AttributeKeyAckSuccess, fmt.Sprintf("%t", false)),
+			),
+		)
+		return channeltypes.NewErrorAcknowledgement(err.Error())
+	}
 	err := sdkTransferKeeper.OnRecvPacket(ctx, packet, data)
 	if err != nil {
 		acknowledgement := channeltypes.NewErrorAcknowledgement(err.Error())
@@ -37,8 +51,7 @@ func OnRecvPacketWhitelistConvert(
 	// For a native token that has been returned, this will just be a base_denom,
 	// which will be on the whitelist.
 	mintedDenom := helpers.GetMintedDenomFromPacket(packet, data)
-	registry := whitelistKeeper.GetRegistry(ctx)
-	mintedDenomEntry, err := whitelistKeeper.GetEntry(registry, mintedDenom)
+	mintedDenomEntry, err := whitelistKeeper.GetRegistryEntry(ctx, mintedDenom)
 	if err != nil || !helpers.IsRecvPacketAllowed(ctx, whitelistKeeper, packet, data, mintedDenomEntry) {
 		acknowledgement := channeltypes.NewErrorAcknowledgement(
 			sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "denom not whitelisted").Error(),
@@ -56,7 +69,7 @@ func OnRecvPacketWhitelistConvert(
 		return acknowledgement
 	}
 	// TODO Add entries fpr Non-X versions of tokens to tokenRegistry
-	convertToDenomEntry, err := whitelistKeeper.GetEntry(registry, mintedDenomEntry.UnitDenom)
+	convertToDenomEntry, err := whitelistKeeper.GetRegistryEntry(ctx, mintedDenomEntry.UnitDenom)
 	if err == nil && convertToDenomEntry.Decimals > 0 && mintedDenomEntry.Decimals > 0 && convertToDenomEntry.Decimals > mintedDenomEntry.Decimals {
 		err = helpers.ExecConvForIncomingCoins(ctx, bankKeeper, mintedDenomEntry, convertToDenomEntry, packet, data)
 		// Revert, although this may cause packet to be relayed again.
@@ -81,3 +94,18 @@ func OnRecvPacketWhitelistConvert(
 	acknowledgement := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 	return acknowledgement
 }
+
+// checkRecvTransferLimit checks the amount of an incoming packet against the transfer limit
+// of the denom it will be minted or unescrowed as. Denoms missing from the registry are
+// rejected later by the whitelist check
+func checkRecvTransferLimit(ctx sdk.Context, whitelistKeeper tokenregistrytypes.Keeper, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	entry, err := whitelistKeeper.GetRegistryEntry(ctx, helpers.GetMintedDenomFromPacket(packet, data))
+	if err != nil {
+		return nil
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", data.Amount)
+	}
+	return entry.CheckTransferLimit(amount)
+}
@@ -2,7 +2,10 @@ package ibctransfer_test
 
 import (
 	app2 "github.com/Sifchain/sifnode/app"
+	"github.com/Sifchain/sifnode/x/ibctransfer"
 	sctransfertypes "github.com/Sifchain/sifnode/x/ibctransfer/types"
+	sctransfermocks "github.com/Sifchain/sifnode/x/ibctransfer/types/mocks"
+	"github.com/golang/mock/gomock"
 	"testing"
 
 	"github.com/Sifchain/sifnode/x/ethbridge/test"
@@ -257,6 +260,35 @@ func TestOnRecvPacketV2(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOnRecvPacketTransferLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// the sdk transfer keeper is never called, nothing is minted or unescrowed
+	sdkTransferKeeper := sctransfermocks.NewMockSDKTransferKeeper(ctrl)
+	addrs, _ := test.CreateTestAddrs(1)
+	app, ctx, _ := tokenregistrytest.CreateTestApp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:         "rowan",
+		Decimals:      18,
+		TransferLimit: "100",
+		Permissions:   []tokenregistrytypes.Permission{tokenregistrytypes.Permission_IBCIMPORT},
+	})
+	data := transfertypes.FungibleTokenPacketData{
+		Denom:    "transfer/channel-0/rowan",
+		Receiver: addrs[0].String(),
+		Amount:   "101",
+	}
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-0",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-1",
+		Data:               transfertypes.ModuleCdc.MustMarshalJSON(&data),
+	}
+	ack := ibctransfer.OnRecvPacketWhitelistConvert(ctx, sdkTransferKeeper, app.TokenRegistryKeeper, app.BankKeeper, packet)
+	require.False(t, ack.Success())
+	require.Contains(t, ack.GetError(), tokenregistrytypes.ErrTransferLimitExceeded.Error())
+}
+
 func TestIsRecvPacketReturning(t *testing.T) {
 	packet := channeltypes.Packet{
 		SourcePort:         "transfer",
@@ -29,6 +29,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdSQParams(),
 		GetCmdQueryWhitelist(),
 		GetCmdQueryIsWhitelist(),
+		GetCmdQueryTriggers(),
 	)
 	return cmd
 }
@@ -269,3 +270,35 @@ func GetCmdQueryIsWhitelist() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryTriggers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "triggers",
+		Short: "query positions with a stop loss or take profit price",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetTriggers(context.Background(), &types.TriggersRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "triggers")
+	return cmd
+}
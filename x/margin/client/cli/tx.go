@@ -24,6 +24,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetOpenCmd(),
 		GetCloseCmd(),
+		GetPartialCloseCmd(),
+		GetAddCollateralCmd(),
+		GetWithdrawCollateralCmd(),
+		GetUpdateTriggersCmd(),
 		GetForceCloseCmd(),
 		GetUpdateParamsCmd(),
 		GetUpdatePoolsCmd(),
@@ -81,6 +85,11 @@ func GetOpenCmd() *cobra.Command {
 				return err
 			}
 
+			stopLossPrice, takeProfitPrice, err := readTriggerPriceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgOpen{
 				Signer:           signer.String(),
 				CollateralAsset:  collateralAsset,
@@ -88,6 +97,8 @@ func GetOpenCmd() *cobra.Command {
 				BorrowAsset:      borrowAsset,
 				Position:         positionEnum,
 				Leverage:         leverageDec,
+				StopLossPrice:    stopLossPrice,
+				TakeProfitPrice:  takeProfitPrice,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -98,6 +109,8 @@ func GetOpenCmd() *cobra.Command {
 	cmd.Flags().String("borrow_asset", "", "symbol of asset")
 	cmd.Flags().String("position", "", "type of position")
 	cmd.Flags().String("leverage", "", "leverage of position")
+	cmd.Flags().String("stop_loss_price", "", "external asset price at which the position is closed at a loss")
+	cmd.Flags().String("take_profit_price", "", "external asset price at which the position is closed at a profit")
 	_ = cmd.MarkFlagRequired("collateral_amount")
 	_ = cmd.MarkFlagRequired("collateral_asset")
 	_ = cmd.MarkFlagRequired("borrow_asset")
@@ -142,6 +155,194 @@ func GetCloseCmd() *cobra.Command {
 	return cmd
 }
 
+func GetPartialCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-close",
+		Short: "Partially close margin position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			id, err := cmd.Flags().GetUint64("id")
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString("amount")
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPartialClose{
+				Signer: signer.String(),
+				Id:     id,
+				Amount: sdk.NewUintFromString(amount),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("id", 0, "id of the position")
+	cmd.Flags().String("amount", "0", "amount of custody asset to close")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("amount")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetAddCollateralCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-collateral",
+		Short: "Add collateral to margin position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			id, err := cmd.Flags().GetUint64("id")
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString("amount")
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddCollateral{
+				Signer: signer.String(),
+				Id:     id,
+				Amount: sdk.NewUintFromString(amount),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("id", 0, "id of the position")
+	cmd.Flags().String("amount", "0", "amount of collateral asset to add")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("amount")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetWithdrawCollateralCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-collateral",
+		Short: "Withdraw collateral from margin position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			id, err := cmd.Flags().GetUint64("id")
+			if err != nil {
+				return err
+			}
+
+			amount, err := cmd.Flags().GetString("amount")
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgWithdrawCollateral{
+				Signer: signer.String(),
+				Id:     id,
+				Amount: sdk.NewUintFromString(amount),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("id", 0, "id of the position")
+	cmd.Flags().String("amount", "0", "amount of collateral asset to withdraw")
+	_ = cmd.MarkFlagRequired("id")
+	_ = cmd.MarkFlagRequired("amount")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetUpdateTriggersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-triggers",
+		Short: "Set or clear the stop loss and take profit prices of a margin position",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if signer == nil {
+				return errors.New("signer address is missing")
+			}
+
+			id, err := cmd.Flags().GetUint64("id")
+			if err != nil {
+				return err
+			}
+
+			stopLossPrice, takeProfitPrice, err := readTriggerPriceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateTriggers{
+				Signer:          signer.String(),
+				Id:              id,
+				StopLossPrice:   stopLossPrice,
+				TakeProfitPrice: takeProfitPrice,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Uint64("id", 0, "id of the position")
+	cmd.Flags().String("stop_loss_price", "", "external asset price at which the position is closed at a loss, empty to clear")
+	cmd.Flags().String("take_profit_price", "", "external asset price at which the position is closed at a profit, empty to clear")
+	_ = cmd.MarkFlagRequired("id")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readTriggerPriceFlags(cmd *cobra.Command) (*sdk.Dec, *sdk.Dec, error) {
+	var prices [2]*sdk.Dec
+	for i, name := range []string{"stop_loss_price", "take_profit_price"} {
+		value, err := cmd.Flags().GetString(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value == "" {
+			continue
+		}
+		price, err := sdk.NewDecFromStr(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		prices[i] = &price
+	}
+	return prices[0], prices[1], nil
+}
+
 func GetForceCloseCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "force-close",
@@ -220,6 +421,9 @@ func GetUpdateParamsCmd() *cobra.Command {
 					SqModifier:                               sdk.MustNewDecFromStr(viper.GetString("sq-modifier")),
 					SafetyFactor:                             sdk.MustNewDecFromStr(viper.GetString("safety-factor")),
 					WhitelistingEnabled:                      viper.GetBool("whitelisting-enabled"),
+					TwapWindow:                               viper.GetUint64("twap-window"),
+					MaxTriggersPerBlock:                      viper.GetUint64("max-triggers-per-block"),
+					MinTriggerCollateralValue:                sdk.NewUintFromString(viper.GetString("min-trigger-collateral-value")),
 				},
 			}
 
@@ -245,6 +449,9 @@ func GetUpdateParamsCmd() *cobra.Command {
 	cmd.Flags().String("sq-modifier", "", "the modifier value for the removal queue's sq formula")
 	cmd.Flags().String("safety-factor", "", "the safety factor used in liquidation ratio")
 	cmd.Flags().Bool("whitelisting-enabled", false, "Enable whitelisting")
+	cmd.Flags().Uint64("twap-window", 0, "number of blocks to average pool prices over for position health (0 to use the swap output)")
+	cmd.Flags().Uint64("max-triggers-per-block", 100, "most positions whose stop loss and take profit prices are checked per block")
+	cmd.Flags().String("min-trigger-collateral-value", "10000000000000000000", "least rowan the collateral of a position must be worth to set a stop loss or take profit price")
 	_ = cmd.MarkFlagRequired("leverage-max")
 	_ = cmd.MarkFlagRequired("interest-rate-max")
 	_ = cmd.MarkFlagRequired("interest-rate-min")
@@ -22,6 +22,18 @@ func registerTxRoutes(cliCtx client.Context, r *mux.Router) {
 		"/margin/close",
 		closeHandler(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/margin/partialClose",
+		partialCloseHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/margin/addCollateral",
+		addCollateralHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/margin/withdrawCollateral",
+		withdrawCollateralHandler(cliCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		"/margin/forceClose",
 		forceCloseHandler(cliCtx),
@@ -46,6 +58,22 @@ type (
 
 	}
 
+	PartialCloseReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Signer  string       `json:"signer"` // User who is trying to close margin position
+		// nolint:revive
+		Id     uint64   `json:"id"`     // Id of the mtp
+		Amount sdk.Uint `json:"amount"` // Amount of custody to close
+	}
+
+	CollateralReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+		Signer  string       `json:"signer"` // User who owns the margin position
+		// nolint:revive
+		Id     uint64   `json:"id"`     // Id of the mtp
+		Amount sdk.Uint `json:"amount"` // Amount of collateral to add or withdraw
+	}
+
 	ForceCloseReq struct {
 		BaseReq    rest.BaseReq `json:"base_req"`
 		Signer     string       `json:"signer"`      // User who is trying to close margin position
@@ -127,6 +155,111 @@ func closeHandler(cliCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func partialCloseHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PartialCloseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.MsgPartialClose{
+			Signer: signer.String(),
+			Id:     req.Id,
+			Amount: req.Amount,
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
+	}
+}
+
+func addCollateralHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CollateralReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.MsgAddCollateral{
+			Signer: signer.String(),
+			Id:     req.Id,
+			Amount: req.Amount,
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
+	}
+}
+
+func withdrawCollateralHandler(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CollateralReq
+		if !rest.ReadRESTReq(w, r, cliCtx.LegacyAmino, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.MsgWithdrawCollateral{
+			Signer: signer.String(),
+			Id:     req.Id,
+			Amount: req.Amount,
+		}
+
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, req.BaseReq, &msg)
+	}
+}
+
 func forceCloseHandler(cliCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req ForceCloseReq
@@ -5,12 +5,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/errors"
 
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
 	clptypes "github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/Sifchain/sifnode/x/margin/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	k.ProcessTriggers(ctx)
+
 	//check if epoch has passed then execute
 	epochLength := k.GetEpochLength(ctx)
 	epochPosition := GetEpochPosition(ctx, epochLength)
@@ -63,14 +66,15 @@ func BeginBlockerProcessMTP(ctx sdk.Context, k Keeper, mtp *types.MTP, pool *clp
 
 	nativeAsset := types.GetSettlementAsset()
 
-	if types.StringCompare(mtp.CollateralAsset, nativeAsset) { // custody is external, payment is custody
-		pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
-	} else { // custody is native, payment is custody
+	if types.StringCompare(mtp.CustodyAsset, nativeAsset) { // custody is native, payment is custody
 		pool.BlockInterestNative = pool.BlockInterestNative.Add(finalInterestPayment)
+	} else { // custody is external, payment is custody
+		pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
 	}
 
 	_ = k.SetMTP(ctx, mtp)
-	repayAmount, err := k.ForceCloseLong(ctx, mtp, pool, false, true)
+
+	repayAmount, err := k.ForceCloseMTP(ctx, mtp, pool, false, true)
 
 	if err == nil {
 		// Emit event if position was closed
@@ -80,3 +84,60 @@ func BeginBlockerProcessMTP(ctx sdk.Context, k Keeper, mtp *types.MTP, pool *clp
 	}
 
 }
+
+// ProcessTriggers closes the mtps whose stop loss or take profit price is
+// reached by the current spot price of its pool external asset. Each block
+// checks at most the MaxTriggersPerBlock param of mtps, resuming after the last one checked.
+func (k Keeper) ProcessTriggers(ctx sdk.Context) {
+	maxTriggers := k.GetMaxTriggersPerBlock(ctx)
+	if maxTriggers == 0 {
+		return
+	}
+	mtps := k.GetNextMTPsWithTriggers(ctx, int(maxTriggers))
+	if len(mtps) == 0 {
+		return
+	}
+	pmtpCurrentRunningRate := k.ClpKeeper().GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+	for _, mtp := range mtps {
+		BeginBlockerProcessTrigger(ctx, k, mtp, pmtpCurrentRunningRate)
+	}
+}
+
+func BeginBlockerProcessTrigger(ctx sdk.Context, k Keeper, mtp *types.MTP, pmtpCurrentRunningRate sdk.Dec) {
+	defer func() {
+		if r := recover(); r != nil {
+			if msg, ok := r.(string); ok {
+				ctx.Logger().Error(msg)
+			}
+		}
+	}()
+	pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error fetching pool for mtp: %s", mtp.String())).Error())
+		return
+	}
+	decimalsExternal, err := k.ClpKeeper().GetAssetDecimals(ctx, *pool.ExternalAsset)
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error fetching decimals for mtp: %s", mtp.String())).Error())
+		return
+	}
+	price, err := clpkeeper.CalcSpotPriceExternal(&pool, decimalsExternal, pmtpCurrentRunningRate)
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, fmt.Sprintf("error computing spot price for mtp: %s", mtp.String())).Error())
+		return
+	}
+	trigger := mtp.ReachedTrigger(price)
+	if trigger == "" {
+		return
+	}
+
+	// close in a cached context so a failing close does not leave partial state behind
+	cacheCtx, write := ctx.CacheContext()
+	repayAmount, err := k.TriggerClose(cacheCtx, mtp, &pool)
+	if err != nil {
+		ctx.Logger().Error(errors.Wrap(err, "error executing trigger close").Error())
+		return
+	}
+	write()
+	k.EmitTriggerClose(ctx, mtp, repayAmount, trigger, price)
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
+	marginkeeper "github.com/Sifchain/sifnode/x/margin/keeper"
 	"github.com/Sifchain/sifnode/x/margin/test"
 	"github.com/Sifchain/sifnode/x/margin/types"
 )
@@ -30,3 +32,165 @@ func TestKeeper_BeginBlocker(t *testing.T) {
 		marginKeeper.BeginBlocker(ctx)
 	})
 }
+
+func TestKeeper_BeginBlockerShort(t *testing.T) {
+	table := []struct {
+		name                       string
+		safetyFactor               sdk.Dec
+		incrementalPaymentsEnabled bool
+		closed                     bool
+	}{
+		{
+			name:         "healthy short position accrues unpaid interest",
+			safetyFactor: sdk.MustNewDecFromStr("1.05"),
+		},
+		{
+			name:                       "healthy short position pays interest from custody",
+			safetyFactor:               sdk.MustNewDecFromStr("1.05"),
+			incrementalPaymentsEnabled: true,
+		},
+		{
+			name:         "unhealthy short position is force closed",
+			safetyFactor: sdk.NewDec(100),
+			closed:       true,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, _ := setupOpenMTP(t, types.Position_SHORT)
+			marginKeeper := app.MarginKeeper
+
+			params := marginKeeper.GetParams(ctx)
+			params.SafetyFactor = tt.safetyFactor
+			params.IncrementalInterestPaymentEnabled = tt.incrementalPaymentsEnabled
+			marginKeeper.SetParams(ctx, &params)
+
+			openMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			openPool, err := marginKeeper.ClpKeeper().GetPool(ctx, openMTP.ExternalAsset())
+			require.NoError(t, err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			marginKeeper.BeginBlocker(ctx)
+
+			var forceClosed bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventForceClose {
+					forceClosed = true
+				}
+			}
+			require.Equal(t, tt.closed, forceClosed)
+
+			mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			pool, poolErr := marginKeeper.ClpKeeper().GetPool(ctx, openMTP.ExternalAsset())
+			require.NoError(t, poolErr)
+			if tt.closed {
+				require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+				require.Equal(t, sdk.ZeroUint(), pool.NativeCustody)
+				require.Equal(t, sdk.ZeroUint(), pool.ExternalLiabilities)
+				return
+			}
+			require.NoError(t, err)
+
+			// interest accrues on the borrowed external asset at the recomputed pool rate
+			interest := marginkeeper.CalcMTPInterestLiabilities(&openMTP, pool.InterestRate, 0, 0)
+			require.False(t, interest.IsZero())
+			if tt.incrementalPaymentsEnabled {
+				require.Equal(t, interest, mtp.InterestPaidCollateral)
+				require.False(t, mtp.InterestPaidCustody.IsZero())
+				require.Equal(t, sdk.ZeroUint(), mtp.InterestUnpaidCollateral)
+				require.Equal(t, openMTP.CustodyAmount.Sub(mtp.InterestPaidCustody), mtp.CustodyAmount)
+				require.Equal(t, openPool.NativeCustody.Sub(mtp.InterestPaidCustody), pool.NativeCustody)
+				require.False(t, pool.BlockInterestNative.IsZero())
+				require.Equal(t, sdk.ZeroUint(), pool.BlockInterestExternal)
+			} else {
+				require.Equal(t, interest, mtp.InterestUnpaidCollateral)
+				require.Equal(t, sdk.ZeroUint(), mtp.InterestPaidCollateral)
+				require.Equal(t, openMTP.CustodyAmount, mtp.CustodyAmount)
+			}
+			require.Equal(t, openMTP.Liabilities, pool.ExternalLiabilities)
+		})
+	}
+}
+
+func TestKeeper_ProcessTriggers(t *testing.T) {
+	table := []struct {
+		name            string
+		position        types.Position
+		stopLossPrice   sdk.Dec
+		takeProfitPrice sdk.Dec
+		trigger         string
+	}{
+		{
+			name:            "long take profit reached",
+			position:        types.Position_LONG,
+			stopLossPrice:   sdk.MustNewDecFromStr("0.1"),
+			takeProfitPrice: sdk.MustNewDecFromStr("0.5"),
+			trigger:         types.TriggerTakeProfit,
+		},
+		{
+			name:            "long stop loss reached",
+			position:        types.Position_LONG,
+			stopLossPrice:   sdk.MustNewDecFromStr("2"),
+			takeProfitPrice: sdk.MustNewDecFromStr("3"),
+			trigger:         types.TriggerStopLoss,
+		},
+		{
+			name:            "short stop loss reached",
+			position:        types.Position_SHORT,
+			stopLossPrice:   sdk.MustNewDecFromStr("0.5"),
+			takeProfitPrice: sdk.MustNewDecFromStr("0.1"),
+			trigger:         types.TriggerStopLoss,
+		},
+		{
+			name:            "no trigger reached",
+			position:        types.Position_SHORT,
+			stopLossPrice:   sdk.MustNewDecFromStr("2"),
+			takeProfitPrice: sdk.MustNewDecFromStr("0.5"),
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, msgServer := setupOpenMTP(t, tt.position)
+			marginKeeper := app.MarginKeeper
+
+			_, err := msgServer.UpdateTriggers(sdk.WrapSDKContext(ctx), &types.MsgUpdateTriggers{
+				Signer:          signer.String(),
+				Id:              1,
+				StopLossPrice:   &tt.stopLossPrice,
+				TakeProfitPrice: &tt.takeProfitPrice,
+			})
+			require.NoError(t, err)
+
+			triggers, _, err := marginKeeper.GetMTPsWithTriggers(ctx, nil)
+			require.NoError(t, err)
+			require.Len(t, triggers, 1)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			marginKeeper.ProcessTriggers(ctx)
+
+			_, err = marginKeeper.GetMTP(ctx, signer.String(), 1)
+			var trigger string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTriggerClose {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == "trigger" {
+						trigger = string(attr.Value)
+					}
+				}
+			}
+			require.Equal(t, tt.trigger, trigger)
+			if tt.trigger == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+			}
+		})
+	}
+}
@@ -27,29 +27,13 @@ func (k msgServer) AdminClose(goCtx context.Context, msg *types.MsgAdminClose) (
 		return nil, err
 	}
 
-	var repayAmount sdk.Uint
-	switch mtpToClose.Position {
-	case types.Position_LONG:
-		var pool clptypes.Pool
-
-		nativeAsset := types.GetSettlementAsset()
-		if types.StringCompare(mtpToClose.CollateralAsset, nativeAsset) {
-			pool, err = k.ClpKeeper().GetPool(ctx, mtpToClose.CustodyAsset)
-			if err != nil {
-				return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.CustodyAsset)
-			}
-		} else {
-			pool, err = k.ClpKeeper().GetPool(ctx, mtpToClose.CollateralAsset)
-			if err != nil {
-				return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.CollateralAsset)
-			}
-		}
-		repayAmount, err = k.ForceCloseLong(ctx, &mtpToClose, &pool, true, msg.TakeMarginFund)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, mtpToClose.Position.String())
+	pool, err := k.ClpKeeper().GetPool(ctx, mtpToClose.ExternalAsset())
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.ExternalAsset())
+	}
+	repayAmount, err := k.ForceCloseMTP(ctx, &mtpToClose, &pool, true, msg.TakeMarginFund)
+	if err != nil {
+		return nil, err
 	}
 
 	k.EmitAdminClose(ctx, &mtpToClose, repayAmount, msg.Signer)
@@ -32,3 +32,16 @@ func CalcMTPInterestLiabilities(mtp *types.MTP, interestRate sdk.Dec, epochPosit
 
 	return interestNewUint
 }
+
+func CalcMTPLeverage(mtp *types.MTP) sdk.Dec {
+	if mtp.CollateralAmount.IsZero() {
+		return mtp.Leverage
+	}
+	collateral := sdk.NewDecFromBigInt(mtp.CollateralAmount.BigInt())
+	// short custody is held in the collateral asset
+	if mtp.Position == types.Position_SHORT {
+		return sdk.NewDecFromBigInt(mtp.CustodyAmount.BigInt()).Quo(collateral)
+	}
+	// long liabilities are denominated in the collateral asset
+	return sdk.NewDecFromBigInt(mtp.Liabilities.BigInt()).Quo(collateral).Add(sdk.OneDec())
+}
@@ -28,6 +28,63 @@ func (k Keeper) EmitForceClose(ctx sdk.Context, mtp *types.MTP, repayAmount sdk.
 	))
 }
 
+func (k Keeper) EmitTriggerClose(ctx sdk.Context, mtp *types.MTP, repayAmount sdk.Uint, trigger string, price sdk.Dec) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTriggerClose,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("collateral_asset", mtp.CollateralAsset),
+		sdk.NewAttribute("collateral_amount", mtp.CollateralAmount.String()),
+		sdk.NewAttribute("custody_asset", mtp.CustodyAsset),
+		sdk.NewAttribute("custody_amount", mtp.CustodyAmount.String()),
+		sdk.NewAttribute("repay_amount", repayAmount.String()),
+		sdk.NewAttribute("leverage", mtp.Leverage.String()),
+		sdk.NewAttribute("liabilities", mtp.Liabilities.String()),
+		sdk.NewAttribute("interest_paid_collateral", mtp.InterestPaidCollateral.String()),
+		sdk.NewAttribute("interest_paid_custody", mtp.InterestPaidCustody.String()),
+		sdk.NewAttribute("interest_unpaid_collateral", mtp.InterestUnpaidCollateral.String()),
+		sdk.NewAttribute("health", mtp.MtpHealth.String()),
+		sdk.NewAttribute("trigger", trigger),
+		sdk.NewAttribute("price", price.String()),
+	))
+}
+
+func (k Keeper) EmitPartialCloseEvent(ctx sdk.Context, mtp *types.MTP, closeAmount sdk.Uint, repayAmount sdk.Uint) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventPartialClose,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("collateral_asset", mtp.CollateralAsset),
+		sdk.NewAttribute("collateral_amount", mtp.CollateralAmount.String()),
+		sdk.NewAttribute("custody_asset", mtp.CustodyAsset),
+		sdk.NewAttribute("custody_amount", mtp.CustodyAmount.String()),
+		sdk.NewAttribute("close_amount", closeAmount.String()),
+		sdk.NewAttribute("repay_amount", repayAmount.String()),
+		sdk.NewAttribute("leverage", mtp.Leverage.String()),
+		sdk.NewAttribute("liabilities", mtp.Liabilities.String()),
+		sdk.NewAttribute("interest_paid_collateral", mtp.InterestPaidCollateral.String()),
+		sdk.NewAttribute("interest_paid_custody", mtp.InterestPaidCustody.String()),
+		sdk.NewAttribute("interest_unpaid_collateral", mtp.InterestUnpaidCollateral.String()),
+		sdk.NewAttribute("health", mtp.MtpHealth.String()),
+	))
+}
+
+func (k Keeper) EmitUpdateTriggers(ctx sdk.Context, mtp *types.MTP) {
+	stopLossPrice, takeProfitPrice := "", ""
+	if mtp.StopLossPrice != nil {
+		stopLossPrice = mtp.StopLossPrice.String()
+	}
+	if mtp.TakeProfitPrice != nil {
+		takeProfitPrice = mtp.TakeProfitPrice.String()
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventUpdateTriggers,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("stop_loss_price", stopLossPrice),
+		sdk.NewAttribute("take_profit_price", takeProfitPrice),
+	))
+}
+
 func (k Keeper) EmitAdminClose(ctx sdk.Context, mtp *types.MTP, repayAmount sdk.Uint, closer string) {
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventAdminClose,
 		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
@@ -33,6 +33,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			SqModifier:                               k.GetSqModifier(ctx),
 			SafetyFactor:                             k.GetSafetyFactor(ctx),
 			RowanCollateralEnabled:                   k.IsRowanCollateralEnabled(ctx),
+			TwapWindow:                               k.GetTwapWindow(ctx),
+			MaxTriggersPerBlock:                      k.GetMaxTriggersPerBlock(ctx),
+			MinTriggerCollateralValue:                k.GetMinTriggerCollateralValue(ctx),
 		},
 	}
 }
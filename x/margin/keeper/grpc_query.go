@@ -116,3 +116,19 @@ func (srv queryServer) IsWhitelisted(ctx context.Context, request *types.IsWhite
 		IsWhitelisted: srv.keeper.IsWhitelisted(sdk.UnwrapSDKContext(ctx), request.Address),
 	}, nil
 }
+
+func (srv queryServer) GetTriggers(ctx context.Context, request *types.TriggersRequest) (*types.TriggersResponse, error) {
+	if request.Pagination != nil && request.Pagination.Limit > MaxPageLimit {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("page size greater than max %d", MaxPageLimit))
+	}
+
+	mtps, page, err := srv.keeper.GetMTPsWithTriggers(sdk.UnwrapSDKContext(ctx), request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TriggersResponse{
+		Mtps:       mtps,
+		Pagination: page,
+	}, nil
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	adminkeeper "github.com/Sifchain/sifnode/x/admin/keeper"
+	clpkeeper "github.com/Sifchain/sifnode/x/clp/keeper"
 	clptypes "github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/Sifchain/sifnode/x/margin/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -93,6 +94,13 @@ func (k Keeper) SetMTP(ctx sdk.Context, mtp *types.MTP) error {
 	}
 	key := types.GetMTPKey(mtp.Address, mtp.Id)
 	store.Set(key, k.cdc.MustMarshal(mtp))
+	// keep the trigger index in sync so the begin blocker only visits mtps with triggers
+	triggerKey := types.GetMTPTriggerKey(mtp.Address, mtp.Id)
+	if mtp.HasTriggers() {
+		store.Set(triggerKey, key)
+	} else {
+		store.Delete(triggerKey)
+	}
 	return nil
 }
 
@@ -168,7 +176,7 @@ func (k Keeper) GetMTPsForPool(ctx sdk.Context, asset string, pagination *query.
 	pageRes, err := query.FilteredPaginate(mtpStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var mtp types.MTP
 		k.cdc.MustUnmarshal(value, &mtp)
-		if accumulate && (types.StringCompare(mtp.CustodyAsset, asset) || types.StringCompare(mtp.CollateralAsset, asset)) {
+		if accumulate && (types.StringCompare(mtp.CustodyAsset, asset) || types.StringCompare(mtp.CollateralAsset, asset) || types.StringCompare(mtp.LiabilitiesAsset, asset)) {
 			mtps = append(mtps, &mtp)
 			return true, nil
 		}
@@ -208,6 +216,89 @@ func (k Keeper) GetMTPsForAddress(ctx sdk.Context, mtpAddress sdk.Address, pagin
 	return mtps, pageRes, nil
 }
 
+func (k Keeper) GetMTPsWithTriggers(ctx sdk.Context, pagination *query.PageRequest) ([]*types.MTP, *query.PageResponse, error) {
+	var mtps []*types.MTP
+
+	store := ctx.KVStore(k.storeKey)
+	triggerStore := prefix.NewStore(store, types.MTPTriggerPrefix)
+
+	if pagination == nil {
+		pagination = &query.PageRequest{
+			Limit: math.MaxUint64 - 1,
+		}
+	}
+
+	pageRes, err := query.Paginate(triggerStore, pagination, func(key []byte, value []byte) error {
+		var mtp types.MTP
+		bz := store.Get(value)
+		if bz == nil {
+			return types.ErrMTPDoesNotExist
+		}
+		k.cdc.MustUnmarshal(bz, &mtp)
+		mtps = append(mtps, &mtp)
+		return nil
+	})
+
+	return mtps, pageRes, err
+}
+
+// GetNextMTPsWithTriggers returns up to limit of the mtps with triggers indexed after the trigger cursor and moves
+// the cursor past them. Once the end of the index is reached the cursor is cleared so the next call starts over.
+func (k Keeper) GetNextMTPsWithTriggers(ctx sdk.Context, limit int) []*types.MTP {
+	store := ctx.KVStore(k.storeKey)
+	start := types.MTPTriggerPrefix
+	if cursor := store.Get(types.MTPTriggerCursorKey); cursor != nil {
+		// the first key after the cursor
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	it := store.Iterator(start, sdk.PrefixEndBytes(types.MTPTriggerPrefix))
+	var mtps []*types.MTP
+	var last []byte
+	for ; it.Valid() && len(mtps) < limit; it.Next() {
+		last = append([]byte{}, it.Key()...)
+		bz := store.Get(it.Value())
+		if bz == nil {
+			continue
+		}
+		var mtp types.MTP
+		k.cdc.MustUnmarshal(bz, &mtp)
+		mtps = append(mtps, &mtp)
+	}
+	more := it.Valid()
+	it.Close()
+	if more {
+		store.Set(types.MTPTriggerCursorKey, last)
+	} else {
+		store.Delete(types.MTPTriggerCursorKey)
+	}
+	return mtps
+}
+
+// ValidateTriggerPositionSize returns an error if the mtp has a stop loss or take profit price set but its collateral
+// is worth less than the MinTriggerCollateralValue param in rowan, as every mtp with triggers weighs on the BeginBlocker
+func (k Keeper) ValidateTriggerPositionSize(ctx sdk.Context, mtp *types.MTP) error {
+	if !mtp.HasTriggers() {
+		return nil
+	}
+	value := mtp.CollateralAmount
+	if !types.StringCompare(mtp.CollateralAsset, types.GetSettlementAsset()) {
+		pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+		if err != nil {
+			return err
+		}
+		_, priceExternal, err := clpkeeper.CalcPoolPrices(&pool, k.ClpKeeper().GetPmtpRateParams(ctx).PmtpCurrentRunningRate)
+		if err != nil {
+			return err
+		}
+		value = clpkeeper.CalcRowanValue(mtp.CollateralAmount, priceExternal)
+	}
+	minValue := k.GetMinTriggerCollateralValue(ctx)
+	if value.LT(minValue) {
+		return sdkerrors.Wrapf(types.ErrTriggerPositionTooSmall, "collateral worth %s rowan, must be worth at least %s", value, minValue)
+	}
+	return nil
+}
+
 func (k Keeper) DestroyMTP(ctx sdk.Context, mtpAddress string, id uint64) error {
 	key := types.GetMTPKey(mtpAddress, id)
 	store := ctx.KVStore(k.storeKey)
@@ -215,6 +306,7 @@ func (k Keeper) DestroyMTP(ctx sdk.Context, mtpAddress string, id uint64) error
 		return types.ErrMTPDoesNotExist
 	}
 	store.Delete(key)
+	store.Delete(types.GetMTPTriggerKey(mtpAddress, id))
 	// decrement open mtp count
 	openCount := k.GetOpenMTPCount(ctx)
 	openCount--
@@ -249,6 +341,55 @@ func (k Keeper) CLPSwap(ctx sdk.Context, sentAmount sdk.Uint, to string, pool cl
 	return swapResult, nil
 }
 
+// CLPValue returns what sentAmount is worth in the to asset at the pool's current depth without charging the
+// swap fee, for valuing an amount that is not actually swapped.
+func (k Keeper) CLPValue(ctx sdk.Context, sentAmount sdk.Uint, to string, pool clptypes.Pool) (sdk.Uint, error) {
+	X, Y, toRowan, _ := pool.ExtractValues(ToAsset(to))
+	Xincl, Yincl := pool.ExtractDebt(X, Y, toRowan)
+
+	pmtpCurrentRunningRate := k.ClpKeeper().GetPmtpRateParams(ctx).PmtpCurrentRunningRate
+
+	value, _ := clpkeeper.CalcSwapResult(toRowan, Xincl, sentAmount, Yincl, pmtpCurrentRunningRate, sdk.ZeroDec())
+	if value.GTE(Y) {
+		return sdk.Uint{}, clptypes.ErrNotEnoughAssetTokens
+	}
+	if value.IsZero() {
+		return sdk.Uint{}, clptypes.ErrAmountTooLow
+	}
+	return value, nil
+}
+
+// SellCustody sells custodyAmount of the mtp custody for the asset the mtp owes to repay owe. It returns the amount
+// repaid and the amount returned to the owner in the collateral asset. Long positions sell all of it and return what
+// the sale raised above owe. Short positions hold their collateral asset in custody, so they only sell enough of it
+// to buy back owe and return the rest directly, paying the swap fee once.
+func (k Keeper) SellCustody(ctx sdk.Context, mtp types.MTP, pool clptypes.Pool, custodyAmount sdk.Uint, owe sdk.Uint) (sdk.Uint, sdk.Uint, error) {
+	if mtp.Position == types.Position_SHORT {
+		if owe.IsZero() {
+			return sdk.ZeroUint(), custodyAmount, nil
+		}
+		marginEnabled := k.IsPoolEnabled(ctx, pool.ExternalAsset.Symbol)
+		sellAmount, err := k.ClpKeeper().CLPCalcSwapSentAmount(ctx, owe, ToAsset(mtp.OwedAsset()), pool, marginEnabled)
+		if err == nil && sellAmount.LT(custodyAmount) {
+			repayAmount, err := k.CLPSwap(ctx, sellAmount, mtp.OwedAsset(), pool)
+			if err != nil {
+				return sdk.ZeroUint(), sdk.ZeroUint(), err
+			}
+			return repayAmount, custodyAmount.Sub(sellAmount), nil
+		}
+		// the custody cannot buy back all of owe, all of it goes to repaying what it can
+	}
+
+	repayAmount, err := k.CLPSwap(ctx, custodyAmount, mtp.OwedAsset(), pool)
+	if err != nil {
+		return sdk.ZeroUint(), sdk.ZeroUint(), err
+	}
+	if mtp.Position == types.Position_LONG && repayAmount.GT(owe) {
+		return repayAmount, repayAmount.Sub(owe), nil
+	}
+	return repayAmount, sdk.ZeroUint(), nil
+}
+
 func (k Keeper) Borrow(ctx sdk.Context, collateralAsset string, collateralAmount sdk.Uint, custodyAmount sdk.Uint, mtp *types.MTP, pool *clptypes.Pool, eta sdk.Dec) error {
 	mtpAddress, err := sdk.AccAddressFromBech32(mtp.Address)
 	if err != nil {
@@ -262,10 +403,19 @@ func (k Keeper) Borrow(ctx sdk.Context, collateralAsset string, collateralAmount
 
 	collateralAmountDec := sdk.NewDecFromBigInt(collateralAmount.BigInt())
 	liabilitiesDec := collateralAmountDec.Mul(eta)
+	liabilities := sdk.NewUintFromBigInt(liabilitiesDec.TruncateInt().BigInt())
+
+	if mtp.Position == types.Position_SHORT {
+		// short positions owe the borrowed asset, valued at the current pool price
+		liabilities, err = k.CLPValue(ctx, liabilities, mtp.LiabilitiesAsset, *pool)
+		if err != nil {
+			return err
+		}
+	}
 
 	mtp.CollateralAmount = mtp.CollateralAmount.Add(collateralAmount)
 
-	mtp.Liabilities = mtp.Liabilities.Add(sdk.NewUintFromBigInt(liabilitiesDec.TruncateInt().BigInt()))
+	mtp.Liabilities = mtp.Liabilities.Add(liabilities)
 	mtp.CustodyAmount = mtp.CustodyAmount.Add(custodyAmount)
 	mtp.Leverage = eta.Add(sdk.OneDec())
 
@@ -288,9 +438,13 @@ func (k Keeper) Borrow(ctx sdk.Context, collateralAsset string, collateralAmount
 
 	if types.StringCompare(mtp.CollateralAsset, nativeAsset) { // collateral is native
 		pool.NativeAssetBalance = pool.NativeAssetBalance.Add(collateralAmount)
-		pool.NativeLiabilities = pool.NativeLiabilities.Add(mtp.Liabilities)
 	} else { // collateral is external
 		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Add(collateralAmount)
+	}
+
+	if types.StringCompare(mtp.OwedAsset(), nativeAsset) { // liabilities are native
+		pool.NativeLiabilities = pool.NativeLiabilities.Add(mtp.Liabilities)
+	} else { // liabilities are external
 		pool.ExternalLiabilities = pool.ExternalLiabilities.Add(mtp.Liabilities)
 	}
 	err = k.ClpKeeper().SetPool(ctx, pool)
@@ -336,7 +490,7 @@ func (k Keeper) UpdateMTPHealth(ctx sdk.Context, mtp types.MTP, pool clptypes.Po
 		xl = xl.Add(mtp.InterestUnpaidCollateral)
 	}
 
-	C, err := k.CLPSwap(ctx, mtp.CustodyAmount, mtp.CollateralAsset, pool)
+	C, err := k.CustodyValue(ctx, mtp, pool)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
@@ -346,6 +500,27 @@ func (k Keeper) UpdateMTPHealth(ctx sdk.Context, mtp types.MTP, pool clptypes.Po
 	return lr, nil
 }
 
+// CustodyValue returns the value of the MTP custody in its owed asset. When a TWAP
+// window is set the custody is valued at the pool's TWAP, which cannot be moved
+// within a single block, otherwise at the pool's current swap output.
+func (k Keeper) CustodyValue(ctx sdk.Context, mtp types.MTP, pool clptypes.Pool) (sdk.Uint, error) {
+	twapWindow := k.GetTwapWindow(ctx)
+	if twapWindow > 0 && !types.StringCompare(mtp.CustodyAsset, mtp.OwedAsset()) {
+		twapNative, twapExternal, err := k.ClpKeeper().GetTwapForWindow(ctx, pool.ExternalAsset.Symbol, twapWindow)
+		// fall back to the swap output until the pool has enough price history
+		if err == nil {
+			price := twapExternal
+			if types.StringCompare(mtp.CustodyAsset, types.GetSettlementAsset()) {
+				price = twapNative
+			}
+			value := price.MulInt(sdk.NewIntFromBigInt(mtp.CustodyAmount.BigInt())).TruncateInt()
+			return sdk.NewUintFromBigInt(value.BigInt()), nil
+		}
+	}
+
+	return k.CLPSwap(ctx, mtp.CustodyAmount, mtp.OwedAsset(), pool)
+}
+
 func (k Keeper) TakeInCustody(ctx sdk.Context, mtp types.MTP, pool *clptypes.Pool) error {
 	nativeAsset := types.GetSettlementAsset()
 
@@ -374,9 +549,9 @@ func (k Keeper) TakeOutCustody(ctx sdk.Context, mtp types.MTP, pool *clptypes.Po
 	return k.ClpKeeper().SetPool(ctx, pool)
 }
 
-func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repayAmount sdk.Uint, takeFundPayment bool) error {
+func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repayAmount sdk.Uint, returnAmount sdk.Uint, takeFundPayment bool) error {
 	// nolint:staticcheck,ineffassign
-	returnAmount, debtP, debtI := sdk.ZeroUint(), sdk.ZeroUint(), sdk.ZeroUint()
+	debtP, debtI := sdk.ZeroUint(), sdk.ZeroUint()
 	Liabilities := mtp.Liabilities
 	InterestUnpaidCollateral := mtp.InterestUnpaidCollateral
 
@@ -401,7 +576,6 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repa
 		debtI = Liabilities.Add(InterestUnpaidCollateral).Sub(have)
 	} else {
 		// can afford both
-		returnAmount = have.Sub(Liabilities).Sub(InterestUnpaidCollateral)
 		debtP = sdk.ZeroUint()
 		debtI = sdk.ZeroUint()
 	}
@@ -440,10 +614,14 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repa
 
 	if types.StringCompare(mtp.CollateralAsset, nativeAsset) {
 		pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(returnAmount)
+	} else {
+		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(returnAmount)
+	}
+
+	if types.StringCompare(mtp.OwedAsset(), nativeAsset) {
 		pool.NativeLiabilities = pool.NativeLiabilities.Sub(mtp.Liabilities)
 		pool.UnsettledNativeLiabilities = pool.UnsettledNativeLiabilities.Add(debtI).Add(debtP)
 	} else {
-		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(returnAmount)
 		pool.ExternalLiabilities = pool.ExternalLiabilities.Sub(mtp.Liabilities)
 		pool.UnsettledExternalLiabilities = pool.UnsettledExternalLiabilities.Add(debtI).Add(debtP)
 	}
@@ -455,6 +633,214 @@ func (k Keeper) Repay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repa
 	return k.ClpKeeper().SetPool(ctx, pool)
 }
 
+// PartialRepay settles the share of the mtp debt matching closeAmount of its
+// custody with repayAmount, sends returnAmount back to the owner and keeps the
+// remainder of the position open.
+func (k Keeper) PartialRepay(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, repayAmount sdk.Uint, returnAmount sdk.Uint, closeAmount sdk.Uint) error {
+	if closeAmount.IsZero() || closeAmount.GTE(mtp.CustodyAmount) {
+		return sdkerrors.Wrap(types.ErrInvalidCloseSize, closeAmount.String())
+	}
+
+	// liabilities, unpaid interest and collateral are released pro-rata to the custody closed
+	Liabilities := mtp.Liabilities.Mul(closeAmount).Quo(mtp.CustodyAmount)
+	InterestUnpaidCollateral := mtp.InterestUnpaidCollateral.Mul(closeAmount).Quo(mtp.CustodyAmount)
+	collateralAmount := mtp.CollateralAmount.Mul(closeAmount).Quo(mtp.CustodyAmount)
+
+	debtP, debtI := sdk.ZeroUint(), sdk.ZeroUint()
+	have := repayAmount
+	owe := Liabilities.Add(InterestUnpaidCollateral)
+
+	if have.LT(Liabilities) {
+		//can't afford principle liability
+		returnAmount = sdk.ZeroUint()
+		debtP = Liabilities.Sub(have)
+		debtI = InterestUnpaidCollateral
+	} else if have.LT(owe) {
+		// v principle liability; x excess liability
+		returnAmount = sdk.ZeroUint()
+		debtI = owe.Sub(have)
+	}
+
+	var err error
+	if !returnAmount.IsZero() {
+		returnCoins := sdk.NewCoins(sdk.NewCoin(mtp.CollateralAsset, sdk.NewIntFromBigInt(returnAmount.BigInt())))
+		addr, err := sdk.AccAddressFromBech32(mtp.Address)
+		if err != nil {
+			return err
+		}
+		err = k.BankKeeper().SendCoinsFromModuleToAccount(ctx, clptypes.ModuleName, addr, returnCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	nativeAsset := types.GetSettlementAsset()
+
+	if types.StringCompare(mtp.CollateralAsset, nativeAsset) {
+		pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(returnAmount)
+	} else {
+		pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(returnAmount)
+	}
+
+	if types.StringCompare(mtp.OwedAsset(), nativeAsset) {
+		pool.NativeLiabilities = pool.NativeLiabilities.Sub(Liabilities)
+		pool.UnsettledNativeLiabilities = pool.UnsettledNativeLiabilities.Add(debtI).Add(debtP)
+	} else {
+		pool.ExternalLiabilities = pool.ExternalLiabilities.Sub(Liabilities)
+		pool.UnsettledExternalLiabilities = pool.UnsettledExternalLiabilities.Add(debtI).Add(debtP)
+	}
+
+	mtp.Liabilities = mtp.Liabilities.Sub(Liabilities)
+	mtp.InterestUnpaidCollateral = mtp.InterestUnpaidCollateral.Sub(InterestUnpaidCollateral)
+	mtp.CollateralAmount = mtp.CollateralAmount.Sub(collateralAmount)
+	mtp.CustodyAmount = mtp.CustodyAmount.Sub(closeAmount)
+	mtp.Leverage = CalcMTPLeverage(mtp)
+
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, *mtp, *pool)
+	if err != nil {
+		return err
+	}
+
+	err = k.ClpKeeper().SetPool(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	return k.SetMTP(ctx, mtp)
+}
+
+// AddCollateralToMTP tops up the mtp collateral. Long positions use it to pay
+// down liabilities, short positions hold it in custody. A long position cannot
+// pay off all of its liabilities this way, as an mtp without liabilities has no
+// health and would be force closed; it should be closed instead.
+func (k Keeper) AddCollateralToMTP(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, amount sdk.Uint) error {
+	if mtp.Position == types.Position_LONG && amount.GTE(mtp.Liabilities) {
+		return sdkerrors.Wrap(types.ErrInvalidCollateralAmount, amount.String())
+	}
+
+	mtpAddress, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return err
+	}
+	collateralCoins := sdk.NewCoins(sdk.NewCoin(mtp.CollateralAsset, sdk.NewIntFromBigInt(amount.BigInt())))
+	err = k.BankKeeper().SendCoinsFromAccountToModule(ctx, mtpAddress, clptypes.ModuleName, collateralCoins)
+	if err != nil {
+		return err
+	}
+
+	nativeAsset := types.GetSettlementAsset()
+	isNative := types.StringCompare(mtp.CollateralAsset, nativeAsset)
+
+	mtp.CollateralAmount = mtp.CollateralAmount.Add(amount)
+	switch mtp.Position {
+	case types.Position_LONG:
+		mtp.Liabilities = mtp.Liabilities.Sub(amount)
+		if isNative {
+			pool.NativeAssetBalance = pool.NativeAssetBalance.Add(amount)
+			pool.NativeLiabilities = pool.NativeLiabilities.Sub(amount)
+		} else {
+			pool.ExternalAssetBalance = pool.ExternalAssetBalance.Add(amount)
+			pool.ExternalLiabilities = pool.ExternalLiabilities.Sub(amount)
+		}
+	case types.Position_SHORT:
+		mtp.CustodyAmount = mtp.CustodyAmount.Add(amount)
+		if isNative {
+			pool.NativeCustody = pool.NativeCustody.Add(amount)
+		} else {
+			pool.ExternalCustody = pool.ExternalCustody.Add(amount)
+		}
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidPosition, mtp.Position.String())
+	}
+	mtp.Leverage = CalcMTPLeverage(mtp)
+
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, *mtp, *pool)
+	if err != nil {
+		return err
+	}
+
+	err = k.ClpKeeper().SetPool(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	return k.SetMTP(ctx, mtp)
+}
+
+// WithdrawCollateralFromMTP releases part of the mtp collateral back to its owner,
+// refusing any withdrawal that would leave the mtp health below the safety factor.
+func (k Keeper) WithdrawCollateralFromMTP(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, amount sdk.Uint) error {
+	if amount.GTE(mtp.CollateralAmount) {
+		return sdkerrors.Wrap(types.ErrInvalidCollateralAmount, amount.String())
+	}
+
+	nativeAsset := types.GetSettlementAsset()
+	isNative := types.StringCompare(mtp.CollateralAsset, nativeAsset)
+
+	mtp.CollateralAmount = mtp.CollateralAmount.Sub(amount)
+	switch mtp.Position {
+	case types.Position_LONG:
+		// the withdrawn collateral is borrowed from the pool
+		if isNative {
+			if amount.GT(pool.NativeAssetBalance) {
+				return types.ErrBorrowTooHigh
+			}
+			pool.NativeAssetBalance = pool.NativeAssetBalance.Sub(amount)
+			pool.NativeLiabilities = pool.NativeLiabilities.Add(amount)
+		} else {
+			if amount.GT(pool.ExternalAssetBalance) {
+				return types.ErrBorrowTooHigh
+			}
+			pool.ExternalAssetBalance = pool.ExternalAssetBalance.Sub(amount)
+			pool.ExternalLiabilities = pool.ExternalLiabilities.Add(amount)
+		}
+		mtp.Liabilities = mtp.Liabilities.Add(amount)
+	case types.Position_SHORT:
+		if amount.GTE(mtp.CustodyAmount) {
+			return sdkerrors.Wrap(types.ErrInvalidCollateralAmount, amount.String())
+		}
+		mtp.CustodyAmount = mtp.CustodyAmount.Sub(amount)
+		if isNative {
+			pool.NativeCustody = pool.NativeCustody.Sub(amount)
+		} else {
+			pool.ExternalCustody = pool.ExternalCustody.Sub(amount)
+		}
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidPosition, mtp.Position.String())
+	}
+	mtp.Leverage = CalcMTPLeverage(mtp)
+
+	var err error
+	mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, *mtp, *pool)
+	if err != nil {
+		return err
+	}
+	if mtp.MtpHealth.LTE(k.GetSafetyFactor(ctx)) {
+		return types.ErrMTPUnhealthy
+	}
+	err = k.ValidateTriggerPositionSize(ctx, mtp)
+	if err != nil {
+		return err
+	}
+
+	mtpAddress, err := sdk.AccAddressFromBech32(mtp.Address)
+	if err != nil {
+		return err
+	}
+	collateralCoins := sdk.NewCoins(sdk.NewCoin(mtp.CollateralAsset, sdk.NewIntFromBigInt(amount.BigInt())))
+	err = k.BankKeeper().SendCoinsFromModuleToAccount(ctx, clptypes.ModuleName, mtpAddress, collateralCoins)
+	if err != nil {
+		return err
+	}
+
+	err = k.ClpKeeper().SetPool(ctx, pool)
+	if err != nil {
+		return err
+	}
+
+	return k.SetMTP(ctx, mtp)
+}
+
 func (k Keeper) HandleInterestPayment(ctx sdk.Context, interestPayment sdk.Uint, mtp *types.MTP, pool *clptypes.Pool) sdk.Uint {
 	incrementalInterestPaymentEnabled := k.GetIncrementalInterestPaymentEnabled(ctx)
 	// if incremental payment on, pay interest
@@ -489,7 +875,7 @@ func (k Keeper) IncrementalInterestPayment(ctx sdk.Context, interestPayment sdk.
 	// edge case, not enough custody to cover payment
 	if interestPaymentCustody.GT(mtp.CustodyAmount) {
 		// swap custody amount to collateral for updating interest unpaid
-		custodyAmountCollateral, err := k.CLPSwap(ctx, mtp.CustodyAmount, mtp.CollateralAsset, *pool) // may need spot price here to not deduct fee
+		custodyAmountCollateral, err := k.CLPSwap(ctx, mtp.CustodyAmount, mtp.OwedAsset(), *pool) // may need spot price here to not deduct fee
 		if err != nil {
 			return sdk.ZeroUint(), err
 		}
@@ -686,7 +1072,12 @@ func GetEpochPosition(ctx sdk.Context, epochLength int64) int64 {
 	return currentHeight % epochLength
 }
 
-func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, isAdminClose bool, takeFundPayment bool) (sdk.Uint, error) {
+// ForceCloseMTP closes the mtp by selling its custody for the asset it owes and repaying its liabilities.
+// Unless isAdminClose is set the mtp is only closed once its health falls to the safety factor.
+func (k Keeper) ForceCloseMTP(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool, isAdminClose bool, takeFundPayment bool) (sdk.Uint, error) {
+	if mtp.Position != types.Position_LONG && mtp.Position != types.Position_SHORT {
+		return sdk.ZeroUint(), sdkerrors.Wrap(types.ErrInvalidPosition, mtp.Position.String())
+	}
 
 	// check MTP health against threshold
 	safetyFactor := k.GetSafetyFactor(ctx)
@@ -702,10 +1093,10 @@ func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *clptypes.P
 
 		nativeAsset := types.GetSettlementAsset()
 
-		if types.StringCompare(mtp.CollateralAsset, nativeAsset) { // custody is external, payment is custody
-			pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
-		} else { // custody is native, payment is custody
+		if types.StringCompare(mtp.CustodyAsset, nativeAsset) { // custody is native, payment is custody
 			pool.BlockInterestNative = pool.BlockInterestNative.Add(finalInterestPayment)
+		} else { // custody is external, payment is custody
+			pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
 		}
 
 		mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, *mtp, *pool)
@@ -722,12 +1113,12 @@ func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *clptypes.P
 		return sdk.ZeroUint(), err
 	}
 
-	repayAmount, err := k.CLPSwap(ctx, mtp.CustodyAmount, mtp.CollateralAsset, *pool)
+	repayAmount, returnAmount, err := k.SellCustody(ctx, *mtp, *pool, mtp.CustodyAmount, mtp.Liabilities.Add(mtp.InterestUnpaidCollateral))
 	if err != nil {
 		return sdk.ZeroUint(), err
 	}
 
-	err = k.Repay(ctx, mtp, pool, repayAmount, takeFundPayment)
+	err = k.Repay(ctx, mtp, pool, repayAmount, returnAmount, takeFundPayment)
 	if err != nil {
 		return sdk.ZeroUint(), err
 	}
@@ -735,6 +1126,12 @@ func (k Keeper) ForceCloseLong(ctx sdk.Context, mtp *types.MTP, pool *clptypes.P
 	return repayAmount, nil
 }
 
+// TriggerClose closes the mtp regardless of its health once one of its
+// stop loss or take profit prices is reached.
+func (k Keeper) TriggerClose(ctx sdk.Context, mtp *types.MTP, pool *clptypes.Pool) (sdk.Uint, error) {
+	return k.ForceCloseMTP(ctx, mtp, pool, true, false)
+}
+
 func (k Keeper) TakeFundPayment(ctx sdk.Context, returnAmount sdk.Uint, returnAsset string, takePercentage sdk.Dec, fundAddr sdk.AccAddress) (sdk.Uint, error) {
 	returnAmountDec := sdk.NewDecFromBigInt(returnAmount.BigInt())
 	takeAmount := sdk.NewUintFromBigInt(takePercentage.Mul(returnAmountDec).TruncateInt().BigInt())
@@ -50,9 +50,14 @@ func TestKeeper_SetMTP(t *testing.T) {
 			name: "define asset and address with long position",
 			mtp:  types.MTP{CollateralAsset: "xxx", Address: "xxx", Position: types.Position_LONG},
 		},
+		{
+			name:      "define asset and address with short position but no liabilities asset",
+			mtp:       types.MTP{CollateralAsset: "xxx", Address: "xxx", Position: types.Position_SHORT},
+			errString: errors.New("no liabilities asset specified: mtp invalid"),
+		},
 		{
 			name: "define asset and address with short position",
-			mtp:  types.MTP{CollateralAsset: "xxx", Address: "xxx", Position: types.Position_SHORT},
+			mtp:  types.MTP{CollateralAsset: "xxx", Address: "xxx", Position: types.Position_SHORT, LiabilitiesAsset: "rowan"},
 		},
 	}
 
@@ -118,6 +123,70 @@ func TestKeeper_GetMTPs(t *testing.T) {
 	}
 }
 
+func TestKeeper_GetMTPsWithTriggers(t *testing.T) {
+	ctx, app, marginKeeper := initKeeper(t)
+	withTriggers := addMTPKey(t, ctx, app, marginKeeper, "rowan", "xxx", "key1", types.Position_LONG, 1, sdk.NewDec(20))
+	withoutTriggers := addMTPKey(t, ctx, app, marginKeeper, "rowan", "xxx", "key2", types.Position_LONG, 1, sdk.NewDec(20))
+	stopLossPrice := sdk.MustNewDecFromStr("0.5")
+	withTriggers.StopLossPrice = &stopLossPrice
+	require.NoError(t, marginKeeper.SetMTP(ctx, &withTriggers))
+	require.NoError(t, marginKeeper.SetMTP(ctx, &withoutTriggers))
+
+	got, _, err := marginKeeper.GetMTPsWithTriggers(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*types.MTP{&withTriggers}, got)
+
+	// clearing the triggers removes the mtp from the index
+	withTriggers.StopLossPrice = nil
+	require.NoError(t, marginKeeper.SetMTP(ctx, &withTriggers))
+	got, _, err = marginKeeper.GetMTPsWithTriggers(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+
+	// destroying the mtp removes it from the index
+	withTriggers.StopLossPrice = &stopLossPrice
+	require.NoError(t, marginKeeper.SetMTP(ctx, &withTriggers))
+	require.NoError(t, marginKeeper.DestroyMTP(ctx, withTriggers.Address, withTriggers.Id))
+	got, _, err = marginKeeper.GetMTPsWithTriggers(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestKeeper_GetNextMTPsWithTriggers(t *testing.T) {
+	ctx, app, marginKeeper := initKeeper(t)
+	stopLossPrice := sdk.MustNewDecFromStr("0.5")
+	maxTriggers := int(marginKeeper.GetMaxTriggersPerBlock(ctx))
+	count := maxTriggers + 5
+	for i := 1; i <= count; i++ {
+		mtp := addMTPKey(t, ctx, app, marginKeeper, "rowan", "xxx", "key", types.Position_LONG, uint64(i), sdk.NewDec(20))
+		mtp.StopLossPrice = &stopLossPrice
+		require.NoError(t, marginKeeper.SetMTP(ctx, &mtp))
+	}
+
+	// each call resumes after the last mtp returned and starts over once all of them were returned
+	got := marginKeeper.GetNextMTPsWithTriggers(ctx, maxTriggers)
+	require.Len(t, got, maxTriggers)
+	require.Equal(t, uint64(1), got[0].Id)
+	got = marginKeeper.GetNextMTPsWithTriggers(ctx, maxTriggers)
+	require.Len(t, got, 5)
+	require.Equal(t, uint64(maxTriggers+1), got[0].Id)
+	got = marginKeeper.GetNextMTPsWithTriggers(ctx, maxTriggers)
+	require.Len(t, got, maxTriggers)
+	require.Equal(t, uint64(1), got[0].Id)
+}
+
+func TestKeeper_ValidateTriggerPositionSize(t *testing.T) {
+	ctx, app, marginKeeper := initKeeper(t)
+	mtp := addMTPKey(t, ctx, app, marginKeeper, "rowan", "xxx", "key", types.Position_LONG, 1, sdk.NewDec(20))
+	require.NoError(t, marginKeeper.ValidateTriggerPositionSize(ctx, &mtp))
+
+	stopLossPrice := sdk.MustNewDecFromStr("0.5")
+	mtp.StopLossPrice = &stopLossPrice
+	require.ErrorIs(t, marginKeeper.ValidateTriggerPositionSize(ctx, &mtp), types.ErrTriggerPositionTooSmall)
+	mtp.CollateralAmount = marginKeeper.GetMinTriggerCollateralValue(ctx)
+	require.NoError(t, marginKeeper.ValidateTriggerPositionSize(ctx, &mtp))
+}
+
 func TestKeeper_DestroyMTP(t *testing.T) {
 	t.Run("key does not exist", func(t *testing.T) {
 		ctx, _, marginKeeper := initKeeper(t)
@@ -502,6 +571,18 @@ func TestKeeper_UpdateMTPHealth(t *testing.T) {
 			health:                   sdk.NewDec(1),
 			position:                 types.Position_LONG,
 		},
+		{
+			name:                     "short position values custody in the borrowed asset",
+			denom:                    "rowan",
+			decimals:                 18,
+			to:                       "rowan",
+			collateralAmount:         sdk.NewUint(1000),
+			custodyAmount:            sdk.NewUint(2000),
+			liabilities:              sdk.NewUint(1000),
+			interestUnpaidCollateral: sdk.NewUint(0),
+			health:                   sdk.NewDec(1),
+			position:                 types.Position_SHORT,
+		},
 		{
 			name:                     "mtp invalid",
 			denom:                    "rowan",
@@ -556,6 +637,71 @@ func TestKeeper_UpdateMTPHealth(t *testing.T) {
 	}
 }
 
+func TestKeeper_CustodyValueTwap(t *testing.T) {
+	ctx, app, marginKeeper := initKeeper(t)
+	pool := clptypes.Pool{
+		ExternalAsset:                &clptypes.Asset{Symbol: "xxx"},
+		NativeAssetBalance:           sdk.NewUint(1000),
+		ExternalAssetBalance:         sdk.NewUint(2000),
+		NativeLiabilities:            sdk.ZeroUint(),
+		ExternalLiabilities:          sdk.ZeroUint(),
+		NativeCustody:                sdk.ZeroUint(),
+		ExternalCustody:              sdk.ZeroUint(),
+		UnsettledExternalLiabilities: sdk.ZeroUint(),
+		UnsettledNativeLiabilities:   sdk.ZeroUint(),
+		BlockInterestExternal:        sdk.ZeroUint(),
+		BlockInterestNative:          sdk.ZeroUint(),
+		PoolUnits:                    sdk.NewUint(1),
+		Health:                       sdk.NewDec(1),
+	}
+	require.NoError(t, app.ClpKeeper.SetPool(ctx, &pool))
+	app.ClpKeeper.SetPmtpCurrentRunningRate(ctx, sdk.ZeroDec())
+	for height := int64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.ClpKeeper.RecordPriceAccumulators(ctx)
+	}
+
+	params := marginKeeper.GetParams(ctx)
+	params.TwapWindow = 2
+	marginKeeper.SetParams(ctx, &params)
+
+	custodyValueTests := []struct {
+		name          string
+		mtp           types.MTP
+		expectedValue sdk.Uint
+	}{
+		{
+			name: "long position values external custody in native asset",
+			mtp: types.MTP{
+				CollateralAsset: "rowan",
+				CustodyAsset:    "xxx",
+				CustodyAmount:   sdk.NewUint(1000),
+				Position:        types.Position_LONG,
+			},
+			expectedValue: sdk.NewUint(500),
+		},
+		{
+			name: "short position values native custody in external asset",
+			mtp: types.MTP{
+				CollateralAsset:  "rowan",
+				CustodyAsset:     "rowan",
+				LiabilitiesAsset: "xxx",
+				CustodyAmount:    sdk.NewUint(1000),
+				Position:         types.Position_SHORT,
+			},
+			expectedValue: sdk.NewUint(2000),
+		},
+	}
+	for _, tt := range custodyValueTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := marginKeeper.CustodyValue(ctx, tt.mtp, pool)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedValue.String(), value.String())
+		})
+	}
+}
+
 func TestKeeper_TakeInCustody(t *testing.T) {
 	asset := clptypes.Asset{Symbol: "rowan"}
 
@@ -823,7 +969,11 @@ func TestKeeper_Repay(t *testing.T) {
 				mtp.Address = tt.overrideAddress
 			}
 
-			got := marginKeeper.Repay(ctx, &mtp, &pool, tt.repayAmount, false)
+			returnAmount := sdk.ZeroUint()
+			if owe := tt.liabilities.Add(tt.interestUnpaidCollateral); tt.repayAmount.GT(owe) {
+				returnAmount = tt.repayAmount.Sub(owe)
+			}
+			got := marginKeeper.Repay(ctx, &mtp, &pool, tt.repayAmount, returnAmount, false)
 
 			if tt.errString != nil {
 				require.EqualError(t, got, tt.errString.Error())
@@ -845,6 +995,65 @@ func TestKeeper_Repay(t *testing.T) {
 	}
 }
 
+func TestKeeper_ForceCloseMTPShort(t *testing.T) {
+	table := []struct {
+		name         string
+		health       sdk.Dec
+		isAdminClose bool
+		err          error
+	}{
+		{
+			name:   "healthy short position is not closed",
+			health: sdk.NewDec(20),
+			err:    types.ErrMTPHealthy,
+		},
+		{
+			name:   "unhealthy short position is closed",
+			health: sdk.NewDecWithPrec(1, 2),
+		},
+		{
+			name:         "admin closure does not check health",
+			health:       sdk.NewDec(20),
+			isAdminClose: true,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, _ := setupOpenMTP(t, types.Position_SHORT)
+			marginKeeper := app.MarginKeeper
+			nativeAsset := clptypes.NativeSymbol
+
+			mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			mtp.MtpHealth = tt.health
+			pool, err := marginKeeper.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+			require.NoError(t, err)
+			openBalance := app.BankKeeper.GetBalance(ctx, signer, nativeAsset)
+
+			repayAmount, err := marginKeeper.ForceCloseMTP(ctx, &mtp, &pool, tt.isAdminClose, true)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				_, err = marginKeeper.GetMTP(ctx, signer.String(), 1)
+				require.NoError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, repayAmount.GTE(mtp.Liabilities))
+
+			_, err = marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.ErrorIs(t, err, types.ErrMTPDoesNotExist)
+
+			pool, err = marginKeeper.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+			require.NoError(t, err)
+			require.Equal(t, sdk.ZeroUint(), pool.NativeCustody)
+			require.Equal(t, sdk.ZeroUint(), pool.ExternalLiabilities)
+			require.True(t, app.BankKeeper.GetBalance(ctx, signer, nativeAsset).Amount.GT(openBalance.Amount))
+		})
+	}
+}
+
 func TestKeeper_CheckMinLiabilities(t *testing.T) {
 	ctx, _, marginKeeper := initKeeper(t)
 	params := marginKeeper.GetParams(ctx)
@@ -1063,7 +1272,7 @@ func initKeeper(t testing.TB) (sdk.Context, *sifapp.SifchainApp, types.Keeper) {
 	require.NotNil(t, marginKeeper)
 	return ctx, app, marginKeeper
 }
-func addMTPKey(t testing.TB, ctx sdk.Context, app *sifapp.SifchainApp, marginKeeper types.Keeper, collateralAsset string, custodyAsset string, address string, position types.Position, id uint64, health sdk.Dec) types.MTP {
+func addMTPKey(t testing.TB, ctx sdk.Context, app *sifapp.SifchainApp, marginKeeper types.Keeper, collateralAsset string, borrowAsset string, address string, position types.Position, id uint64, health sdk.Dec) types.MTP {
 	storeKey := app.GetKey(types.StoreKey)
 	store := ctx.KVStore(storeKey)
 	key := types.GetMTPKey(address, id)
@@ -1077,12 +1286,16 @@ func addMTPKey(t testing.TB, ctx sdk.Context, app *sifapp.SifchainApp, marginKee
 		InterestPaidCustody:      sdk.ZeroUint(),
 		InterestUnpaidCollateral: sdk.NewUint(1000),
 		CollateralAmount:         sdk.NewUint(1000),
-		CustodyAsset:             custodyAsset,
+		CustodyAsset:             borrowAsset,
 		CustodyAmount:            sdk.NewUint(1000),
 		Leverage:                 sdk.NewDec(10),
 		MtpHealth:                health,
 		Position:                 position,
 	}
+	if position == types.Position_SHORT {
+		newMTP.CustodyAsset = collateralAsset
+		newMTP.LiabilitiesAsset = borrowAsset
+	}
 
 	store.Set(key, types.ModuleCdc.MustMarshal(&newMTP))
 
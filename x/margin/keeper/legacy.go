@@ -35,6 +35,18 @@ func NewLegacyHandler(k types.Keeper) sdk.Handler {
 		case *types.MsgClose:
 			res, err := msgServer.Close(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPartialClose:
+			res, err := msgServer.PartialClose(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddCollateral:
+			res, err := msgServer.AddCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawCollateral:
+			res, err := msgServer.WithdrawCollateral(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateTriggers:
+			res, err := msgServer.UpdateTriggers(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgForceClose:
 			res, err := msgServer.ForceClose(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"github.com/Sifchain/sifnode/x/margin/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,3 +22,15 @@ func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
 
 	return nil
 }
+
+func (m Migrator) MigrateToVer3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+
+	defaults := types.DefaultGenesis().Params
+	params.MaxTriggersPerBlock = defaults.MaxTriggersPerBlock
+	params.MinTriggerCollateralValue = defaults.MinTriggerCollateralValue
+
+	m.keeper.SetParams(ctx, &params)
+
+	return nil
+}
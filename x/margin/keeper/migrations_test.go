@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/Sifchain/sifnode/x/margin/keeper"
+	"github.com/Sifchain/sifnode/x/margin/test"
+	"github.com/Sifchain/sifnode/x/margin/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator_MigrateToVer3(t *testing.T) {
+	ctx, app := test.CreateTestAppMargin(false)
+	marginKeeper := app.MarginKeeper
+
+	// params stored before the trigger params existed
+	params := marginKeeper.GetParams(ctx)
+	params.MaxTriggersPerBlock = 0
+	params.MinTriggerCollateralValue = sdk.Uint{}
+	params.TwapWindow = 2
+	marginKeeper.SetParams(ctx, &params)
+	require.Equal(t, sdk.ZeroUint(), marginKeeper.GetMinTriggerCollateralValue(ctx))
+
+	require.NoError(t, keeper.NewMigrator(marginKeeper).MigrateToVer3(ctx))
+
+	defaults := types.DefaultGenesis().Params
+	require.Equal(t, defaults.MaxTriggersPerBlock, marginKeeper.GetMaxTriggersPerBlock(ctx))
+	require.Equal(t, defaults.MinTriggerCollateralValue, marginKeeper.GetMinTriggerCollateralValue(ctx))
+	require.Equal(t, uint64(2), marginKeeper.GetTwapWindow(ctx))
+}
@@ -65,10 +65,32 @@ func (k msgServer) Open(goCtx context.Context, msg *types.MsgOpen) (*types.MsgOp
 		if err != nil {
 			return nil, err
 		}
+	case types.Position_SHORT:
+		mtp, err = k.OpenShort(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, msg.Position.String())
 	}
 
+	if msg.StopLossPrice != nil || msg.TakeProfitPrice != nil {
+		mtp.StopLossPrice = msg.StopLossPrice
+		mtp.TakeProfitPrice = msg.TakeProfitPrice
+		err = mtp.ValidateTriggers()
+		if err != nil {
+			return nil, err
+		}
+		err = k.ValidateTriggerPositionSize(ctx, mtp)
+		if err != nil {
+			return nil, err
+		}
+		err = k.SetMTP(ctx, mtp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventOpen,
 		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
 		sdk.NewAttribute("position", mtp.Position.String()),
@@ -96,16 +118,9 @@ func (k msgServer) Close(goCtx context.Context, msg *types.MsgClose) (*types.Msg
 		return nil, err
 	}
 
-	var closedMtp *types.MTP
-	var repayAmount sdk.Uint
-	switch mtp.Position {
-	case types.Position_LONG:
-		closedMtp, repayAmount, err = k.CloseLong(ctx, msg)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, mtp.Position.String())
+	closedMtp, repayAmount, err := k.CloseMTP(ctx, msg)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventClose,
@@ -128,6 +143,192 @@ func (k msgServer) Close(goCtx context.Context, msg *types.MsgClose) (*types.Msg
 	return &types.MsgCloseResponse{}, nil
 }
 
+func (k msgServer) PartialClose(goCtx context.Context, msg *types.MsgPartialClose) (*types.MsgPartialCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mtp, err := k.GetMTP(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !types.IsValidPosition(mtp.Position) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, mtp.Position.String())
+	}
+	if msg.Amount.GTE(mtp.CustodyAmount) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCloseSize, msg.Amount.String())
+	}
+
+	pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.ExternalAsset())
+	}
+
+	nativeAsset := types.GetSettlementAsset()
+	safetyFactor := k.GetSafetyFactor(ctx)
+
+	// close in a cached context so a close leaving the mtp unhealthy does not leave partial state behind
+	cacheCtx, write := ctx.CacheContext()
+
+	epochLength := k.GetEpochLength(cacheCtx)
+	epochPosition := GetEpochPosition(cacheCtx, epochLength)
+	if epochPosition > 0 {
+		interestPayment := CalcMTPInterestLiabilities(&mtp, pool.InterestRate, epochPosition, epochLength)
+
+		finalInterestPayment := k.HandleInterestPayment(cacheCtx, interestPayment, &mtp, &pool)
+
+		if types.StringCompare(mtp.CustodyAsset, nativeAsset) { // custody is native, payment is custody
+			pool.BlockInterestNative = pool.BlockInterestNative.Add(finalInterestPayment)
+		} else { // custody is external, payment is custody
+			pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
+		}
+	}
+
+	mtp.MtpHealth, err = k.UpdateMTPHealth(cacheCtx, mtp, pool)
+	if err != nil {
+		return nil, err
+	}
+	openHealth := mtp.MtpHealth
+
+	closedCustody := mtp
+	closedCustody.CustodyAmount = msg.Amount
+	err = k.TakeOutCustody(cacheCtx, closedCustody, &pool)
+	if err != nil {
+		return nil, err
+	}
+
+	// the liabilities and unpaid interest released with the closed custody, as PartialRepay settles them
+	owe := mtp.Liabilities.Mul(msg.Amount).Quo(mtp.CustodyAmount).Add(mtp.InterestUnpaidCollateral.Mul(msg.Amount).Quo(mtp.CustodyAmount))
+	repayAmount, returnAmount, err := k.SellCustody(cacheCtx, mtp, pool, msg.Amount, owe)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.PartialRepay(cacheCtx, &mtp, &pool, repayAmount, returnAmount, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	// a close cannot take a healthy mtp below the safety factor, while mtps already below it can still be
+	// de-risked as long as the close does not lower their health further
+	if openHealth.GT(safetyFactor) {
+		if mtp.MtpHealth.LTE(safetyFactor) {
+			return nil, types.ErrMTPUnhealthy
+		}
+	} else if mtp.MtpHealth.LT(openHealth) {
+		return nil, types.ErrMTPUnhealthy
+	}
+	// the remaining collateral must still be large enough for the stop loss and take profit prices
+	err = k.ValidateTriggerPositionSize(cacheCtx, &mtp)
+	if err != nil {
+		return nil, err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	k.EmitPartialCloseEvent(ctx, &mtp, msg.Amount, repayAmount)
+
+	return &types.MsgPartialCloseResponse{}, nil
+}
+
+func (k msgServer) AddCollateral(goCtx context.Context, msg *types.MsgAddCollateral) (*types.MsgAddCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mtp, err := k.GetMTP(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.ExternalAsset())
+	}
+
+	err = k.AddCollateralToMTP(ctx, &mtp, &pool, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventAddCollateral,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("collateral_asset", mtp.CollateralAsset),
+		sdk.NewAttribute("collateral_amount", mtp.CollateralAmount.String()),
+		sdk.NewAttribute("added_collateral", msg.Amount.String()),
+		sdk.NewAttribute("custody_asset", mtp.CustodyAsset),
+		sdk.NewAttribute("custody_amount", mtp.CustodyAmount.String()),
+		sdk.NewAttribute("leverage", mtp.Leverage.String()),
+		sdk.NewAttribute("liabilities", mtp.Liabilities.String()),
+		sdk.NewAttribute("health", mtp.MtpHealth.String()),
+	))
+
+	return &types.MsgAddCollateralResponse{}, nil
+}
+
+func (k msgServer) WithdrawCollateral(goCtx context.Context, msg *types.MsgWithdrawCollateral) (*types.MsgWithdrawCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mtp, err := k.GetMTP(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.ExternalAsset())
+	}
+
+	err = k.WithdrawCollateralFromMTP(ctx, &mtp, &pool, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventWithdrawCollateral,
+		sdk.NewAttribute("id", strconv.FormatInt(int64(mtp.Id), 10)),
+		sdk.NewAttribute("position", mtp.Position.String()),
+		sdk.NewAttribute("address", mtp.Address),
+		sdk.NewAttribute("collateral_asset", mtp.CollateralAsset),
+		sdk.NewAttribute("collateral_amount", mtp.CollateralAmount.String()),
+		sdk.NewAttribute("withdrawn_collateral", msg.Amount.String()),
+		sdk.NewAttribute("custody_asset", mtp.CustodyAsset),
+		sdk.NewAttribute("custody_amount", mtp.CustodyAmount.String()),
+		sdk.NewAttribute("leverage", mtp.Leverage.String()),
+		sdk.NewAttribute("liabilities", mtp.Liabilities.String()),
+		sdk.NewAttribute("health", mtp.MtpHealth.String()),
+	))
+
+	return &types.MsgWithdrawCollateralResponse{}, nil
+}
+
+func (k msgServer) UpdateTriggers(goCtx context.Context, msg *types.MsgUpdateTriggers) (*types.MsgUpdateTriggersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mtp, err := k.GetMTP(ctx, msg.Signer, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	mtp.StopLossPrice = msg.StopLossPrice
+	mtp.TakeProfitPrice = msg.TakeProfitPrice
+	err = mtp.ValidateTriggers()
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ValidateTriggerPositionSize(ctx, &mtp)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.SetMTP(ctx, &mtp)
+	if err != nil {
+		return nil, err
+	}
+
+	k.EmitUpdateTriggers(ctx, &mtp)
+
+	return &types.MsgUpdateTriggersResponse{}, nil
+}
+
 func (k msgServer) OpenLong(ctx sdk.Context, msg *types.MsgOpen) (*types.MTP, error) {
 	maxLeverage := k.GetMaxLeverageParam(ctx)
 	leverage := sdk.MinDec(msg.Leverage, maxLeverage)
@@ -235,73 +436,138 @@ func (k msgServer) OpenLong(ctx sdk.Context, msg *types.MsgOpen) (*types.MTP, er
 	return mtp, nil
 }
 
-func (k msgServer) CloseLong(ctx sdk.Context, msg *types.MsgClose) (*types.MTP, sdk.Uint, error) {
+func (k msgServer) CloseMTP(ctx sdk.Context, msg *types.MsgClose) (*types.MTP, sdk.Uint, error) {
 	mtp, err := k.GetMTP(ctx, msg.Signer, msg.Id)
 	if err != nil {
 		return nil, sdk.ZeroUint(), err
 	}
 
-	var pool clptypes.Pool
+	pool, err := k.ClpKeeper().GetPool(ctx, mtp.ExternalAsset())
+	if err != nil {
+		return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.ExternalAsset())
+	}
+
+	// owners close their mtps the way health and trigger closes do, regardless of health
+	repayAmount, err := k.ForceCloseMTP(ctx, &mtp, &pool, true, false)
+	if err != nil {
+		return nil, sdk.ZeroUint(), err
+	}
+
+	// if types.StringCompare(mtp.CollateralAsset, nativeAsset) {
+	// 	res, stop := k.ClpKeeper().SingleExternalBalanceModuleAccountCheck(mtp.CustodyAsset)(ctx)
+	// 	if stop {
+	// 		return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrBalanceModuleAccountCheck, res)
+	// 	}
+	// } else {
+	// 	res, stop := k.ClpKeeper().SingleExternalBalanceModuleAccountCheck(mtp.CollateralAsset)(ctx)
+	// 	if stop {
+	// 		return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrBalanceModuleAccountCheck, res)
+	// 	}
+	// }
+
+	return &mtp, repayAmount, nil
+}
+
+func (k msgServer) OpenShort(ctx sdk.Context, msg *types.MsgOpen) (*types.MTP, error) {
+	maxLeverage := k.GetMaxLeverageParam(ctx)
+	leverage := sdk.MinDec(msg.Leverage, maxLeverage)
+	eta := leverage.Sub(sdk.OneDec())
+
+	collateralAmount := msg.CollateralAmount
+
+	collateralAmountDec := sdk.NewDecFromBigInt(msg.CollateralAmount.BigInt())
+
+	mtp := types.NewMTP(msg.Signer, msg.CollateralAsset, msg.BorrowAsset, msg.Position, leverage)
 
 	nativeAsset := types.GetSettlementAsset()
-	if types.StringCompare(mtp.CollateralAsset, nativeAsset) {
-		pool, err = k.ClpKeeper().GetPool(ctx, mtp.CustodyAsset)
-		if err != nil {
-			return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.CustodyAsset)
+
+	if !k.IsRowanCollateralEnabled(ctx) && types.StringCompare(msg.CollateralAsset, nativeAsset) {
+		return nil, sdkerrors.Wrap(types.ErrRowanAsCollateralNotAllowed, nativeAsset)
+	}
+
+	externalAsset := mtp.ExternalAsset()
+
+	pool, err := k.ClpKeeper().GetPool(ctx, externalAsset)
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, externalAsset)
+	}
+
+	if !k.IsPoolEnabled(ctx, externalAsset) {
+		return nil, sdkerrors.Wrap(types.ErrMTPDisabled, externalAsset)
+	}
+
+	borrowValueDec := collateralAmountDec.Mul(eta)
+
+	borrowValue := sdk.NewUintFromBigInt(borrowValueDec.TruncateInt().BigInt())
+
+	// amount of the borrowed asset worth eta times the collateral, the swap fee is only charged when it is sold
+	liabilities, err := k.CLPValue(ctx, borrowValue, msg.BorrowAsset, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	if types.StringCompare(msg.BorrowAsset, nativeAsset) {
+		if liabilities.GT(pool.NativeAssetBalance) {
+			return nil, sdkerrors.Wrap(types.ErrBorrowTooHigh, liabilities.String())
 		}
 	} else {
-		pool, err = k.ClpKeeper().GetPool(ctx, mtp.CollateralAsset)
-		if err != nil {
-			return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtp.CollateralAsset)
+		if liabilities.GT(pool.ExternalAssetBalance) {
+			return nil, sdkerrors.Wrap(types.ErrBorrowTooHigh, liabilities.String())
 		}
 	}
 
-	epochLength := k.GetEpochLength(ctx)
-	epochPosition := GetEpochPosition(ctx, epochLength)
-	if epochPosition > 0 {
-		interestPayment := CalcMTPInterestLiabilities(&mtp, pool.InterestRate, epochPosition, epochLength)
+	// check if liabilities large enough for interest payments
+	err = k.CheckMinLiabilities(ctx, liabilities, sdk.OneDec(), pool, mtp.CustodyAsset)
+	if err != nil {
+		return nil, err
+	}
 
-		finalInterestPayment := k.HandleInterestPayment(ctx, interestPayment, &mtp, &pool)
+	// sell the borrowed asset, the proceeds are held in custody with the collateral
+	proceeds, err := k.CLPSwap(ctx, liabilities, mtp.CustodyAsset, pool)
+	if err != nil {
+		return nil, err
+	}
 
-		if types.StringCompare(mtp.CollateralAsset, nativeAsset) { // custody is external, payment is custody
-			pool.BlockInterestExternal = pool.BlockInterestExternal.Add(finalInterestPayment)
-		} else { // custody is native, payment is custody
-			pool.BlockInterestNative = pool.BlockInterestNative.Add(finalInterestPayment)
+	if types.StringCompare(mtp.CustodyAsset, nativeAsset) {
+		if proceeds.GT(pool.NativeAssetBalance) {
+			return nil, sdkerrors.Wrap(types.ErrCustodyTooHigh, proceeds.String())
 		}
-
-		mtp.MtpHealth, err = k.UpdateMTPHealth(ctx, mtp, pool)
-		if err != nil {
-			return nil, sdk.ZeroUint(), err
+	} else {
+		if proceeds.GT(pool.ExternalAssetBalance) {
+			return nil, sdkerrors.Wrap(types.ErrCustodyTooHigh, proceeds.String())
 		}
 	}
 
-	err = k.TakeOutCustody(ctx, mtp, &pool)
+	custodyAmount := collateralAmount.Add(proceeds)
+
+	err = k.Borrow(ctx, msg.CollateralAsset, collateralAmount, custodyAmount, mtp, &pool, eta)
 	if err != nil {
-		return nil, sdk.ZeroUint(), err
+		return nil, err
 	}
-	repayAmount, err := k.CLPSwap(ctx, mtp.CustodyAmount, mtp.CollateralAsset, pool)
+
+	err = k.UpdatePoolHealth(ctx, &pool)
 	if err != nil {
-		return nil, sdk.ZeroUint(), err
+		return nil, err
 	}
 
-	err = k.Repay(ctx, &mtp, &pool, repayAmount, false)
+	err = k.TakeInCustody(ctx, *mtp, &pool)
 	if err != nil {
-		return nil, sdk.ZeroUint(), err
+		return nil, err
 	}
 
-	// if types.StringCompare(mtp.CollateralAsset, nativeAsset) {
-	// 	res, stop := k.ClpKeeper().SingleExternalBalanceModuleAccountCheck(mtp.CustodyAsset)(ctx)
-	// 	if stop {
-	// 		return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrBalanceModuleAccountCheck, res)
-	// 	}
-	// } else {
-	// 	res, stop := k.ClpKeeper().SingleExternalBalanceModuleAccountCheck(mtp.CollateralAsset)(ctx)
-	// 	if stop {
-	// 		return nil, sdk.ZeroUint(), sdkerrors.Wrap(clptypes.ErrBalanceModuleAccountCheck, res)
-	// 	}
-	// }
+	safetyFactor := k.GetSafetyFactor(ctx)
 
-	return &mtp, repayAmount, nil
+	lr, err := k.UpdateMTPHealth(ctx, *mtp, pool)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if lr.LTE(safetyFactor) {
+		return nil, types.ErrMTPUnhealthy
+	}
+
+	return mtp, nil
 }
 
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
@@ -408,29 +674,13 @@ func (k msgServer) ForceClose(goCtx context.Context, msg *types.MsgForceClose) (
 		return nil, err
 	}
 
-	var repayAmount sdk.Uint
-	switch mtpToClose.Position {
-	case types.Position_LONG:
-		var pool clptypes.Pool
-
-		nativeAsset := types.GetSettlementAsset()
-		if types.StringCompare(mtpToClose.CollateralAsset, nativeAsset) {
-			pool, err = k.ClpKeeper().GetPool(ctx, mtpToClose.CustodyAsset)
-			if err != nil {
-				return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.CustodyAsset)
-			}
-		} else {
-			pool, err = k.ClpKeeper().GetPool(ctx, mtpToClose.CollateralAsset)
-			if err != nil {
-				return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.CollateralAsset)
-			}
-		}
-		repayAmount, err = k.Keeper.ForceCloseLong(ctx, &mtpToClose, &pool, true, false)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, sdkerrors.Wrap(types.ErrInvalidPosition, mtpToClose.Position.String())
+	pool, err := k.ClpKeeper().GetPool(ctx, mtpToClose.ExternalAsset())
+	if err != nil {
+		return nil, sdkerrors.Wrap(clptypes.ErrPoolDoesNotExist, mtpToClose.ExternalAsset())
+	}
+	repayAmount, err := k.Keeper.ForceCloseMTP(ctx, &mtpToClose, &pool, true, false)
+	if err != nil {
+		return nil, err
 	}
 
 	k.EmitAdminClose(ctx, &mtpToClose, repayAmount, msg.Signer)
@@ -339,7 +339,7 @@ func TestKeeper_Close(t *testing.T) {
 			// errString:     errors.New("external balance mismatch in pool xxx (module: 1000000000000 != pool: 1000001000): Balance of module account check failed"),
 		},
 		{
-			name: "mtp position invalid",
+			name: "account funded short position",
 			msgClose: types.MsgClose{
 				Signer: "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v",
 				Id:     1,
@@ -353,7 +353,24 @@ func TestKeeper_Close(t *testing.T) {
 			token:         "xxx",
 			poolEnabled:   true,
 			fundedAccount: true,
-			errString:     errors.New("SHORT: mtp position invalid"),
+			err:           nil,
+		},
+		{
+			name: "mtp position invalid",
+			msgClose: types.MsgClose{
+				Signer: "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v",
+				Id:     1,
+			},
+			msgOpen: types.MsgOpen{
+				CollateralAsset: "rowan",
+				BorrowAsset:     "xxx",
+				Position:        types.Position_UNSPECIFIED,
+			},
+			poolAsset:     "xxx",
+			token:         "xxx",
+			poolEnabled:   true,
+			fundedAccount: true,
+			errString:     errors.New("UNSPECIFIED: mtp position invalid"),
 		},
 	}
 
@@ -617,14 +634,34 @@ func TestKeeper_ForceClose(t *testing.T) {
 			msgOpen: types.MsgOpen{
 				CollateralAsset: "rowan",
 				BorrowAsset:     "xxx",
-				Position:        types.Position_SHORT,
+				Position:        types.Position_UNSPECIFIED,
 			},
 			health:        sdk.NewDec(20),
 			poolAsset:     "xxx",
 			token:         "xxx",
 			poolEnabled:   true,
 			fundedAccount: true,
-			errString:     errors.New("SHORT: mtp position invalid"),
+			errString:     errors.New("UNSPECIFIED: mtp position invalid"),
+		},
+		{
+			name: "account funded short position",
+			msgForceClose: types.MsgForceClose{
+				Signer:     "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v",
+				MtpAddress: "sif1azpar20ck9lpys89r8x7zc8yu0qzgvtp48ng5v",
+				Id:         1,
+			},
+			msgOpen: types.MsgOpen{
+				CollateralAsset: "rowan",
+				BorrowAsset:     "xxx",
+				Position:        types.Position_SHORT,
+			},
+			health:        sdk.NewDecWithPrec(1, 2),
+			poolAsset:     "xxx",
+			token:         "xxx",
+			poolEnabled:   true,
+			fundedAccount: true,
+			// the short position is closed through ForceCloseMTP and removed from the store
+			err2: types.ErrMTPDoesNotExist,
 		},
 		{
 			name: "admin closure does not check health",
@@ -791,7 +828,7 @@ func TestKeeper_ForceClose(t *testing.T) {
 			}
 
 			if !tt.mtpCreateDisabled {
-				addMTPKey(t, ctx, app, marginKeeper, tt.msgOpen.CollateralAsset, tt.msgOpen.BorrowAsset, signer, tt.msgOpen.Position, 1, sdk.NewDec(20))
+				addMTPKey(t, ctx, app, marginKeeper, tt.msgOpen.CollateralAsset, tt.msgOpen.BorrowAsset, signer, tt.msgOpen.Position, 1, tt.health)
 			}
 
 			_, got := msgServer.ForceClose(sdk.WrapSDKContext(ctx), &msg)
@@ -1049,6 +1086,227 @@ func TestKeeper_OpenClose(t *testing.T) {
 	}
 }
 
+func TestKeeper_OpenCloseShort(t *testing.T) {
+	table := []struct {
+		name          string
+		externalAsset string
+		err           error
+		errString     error
+	}{
+		{
+			name:          "one round open/close short position",
+			externalAsset: "xxx",
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app := test.CreateTestAppMargin(false)
+			marginKeeper := app.MarginKeeper
+
+			app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+				Denom:       tt.externalAsset,
+				Decimals:    18,
+				Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+			})
+
+			params := types.Params{
+				LeverageMax:                              sdk.NewDec(2),
+				InterestRateMax:                          sdk.NewDec(1),
+				InterestRateMin:                          sdk.NewDecWithPrec(1, 1),
+				InterestRateIncrease:                     sdk.NewDecWithPrec(1, 1),
+				InterestRateDecrease:                     sdk.NewDecWithPrec(1, 1),
+				HealthGainFactor:                         sdk.NewDecWithPrec(1, 2),
+				EpochLength:                              0,
+				RemovalQueueThreshold:                    sdk.ZeroDec(),
+				ForceCloseFundPercentage:                 sdk.NewDecWithPrec(1, 1),
+				ForceCloseFundAddress:                    "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd",
+				IncrementalInterestPaymentFundPercentage: sdk.NewDecWithPrec(1, 1),
+				IncrementalInterestPaymentFundAddress:    "sif1syavy2npfyt9tcncdtsdzf7kny9lh777yqc2nd",
+				IncrementalInterestPaymentEnabled:        false,
+				PoolOpenThreshold:                        sdk.NewDecWithPrec(1, 1),
+				MaxOpenPositions:                         10000,
+				SqModifier:                               sdk.MustNewDecFromStr("10000000000000000000000000"),
+				SafetyFactor:                             sdk.MustNewDecFromStr("1.05"),
+				Pools:                                    []string{tt.externalAsset},
+				RowanCollateralEnabled:                   true,
+			}
+			expectedGenesis := types.GenesisState{Params: &params}
+			marginKeeper.InitGenesis(ctx, expectedGenesis)
+			genesis := marginKeeper.ExportGenesis(ctx)
+			require.Equal(t, expectedGenesis, *genesis)
+
+			msgServer := keeper.NewMsgServerImpl(marginKeeper)
+
+			nativeAsset := clptypes.NativeSymbol
+			externalAsset := clptypes.Asset{Symbol: tt.externalAsset}
+
+			SwapPriceNative := sdk.ZeroDec()
+			SwapPriceExternal := sdk.ZeroDec()
+
+			pool := clptypes.Pool{
+				ExternalAsset:                 &externalAsset,
+				NativeAssetBalance:            sdk.NewUintFromString("100000000000000000000000000"),
+				ExternalAssetBalance:          sdk.NewUintFromString("100000000000000000000000000"),
+				UnsettledExternalLiabilities:  sdk.ZeroUint(),
+				UnsettledNativeLiabilities:    sdk.ZeroUint(),
+				BlockInterestExternal:         sdk.ZeroUint(),
+				BlockInterestNative:           sdk.ZeroUint(),
+				NativeCustody:                 sdk.ZeroUint(),
+				ExternalCustody:               sdk.ZeroUint(),
+				NativeLiabilities:             sdk.ZeroUint(),
+				ExternalLiabilities:           sdk.ZeroUint(),
+				PoolUnits:                     sdk.ZeroUint(),
+				Health:                        sdk.OneDec(),
+				InterestRate:                  sdk.NewDecWithPrec(1, 1),
+				SwapPriceNative:               &SwapPriceNative,
+				SwapPriceExternal:             &SwapPriceExternal,
+				RewardPeriodNativeDistributed: sdk.ZeroUint(),
+			}
+
+			marginKeeper.SetEnabledPools(ctx, []string{tt.externalAsset})
+			// nolint:errcheck
+			marginKeeper.ClpKeeper().SetPool(ctx, &pool)
+
+			nativeCoin := sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("100000000000000000000000000")))
+			externalCoin := sdk.NewCoin(tt.externalAsset, sdk.Int(sdk.NewUintFromString("100000000000000000000000000")))
+			err := app.BankKeeper.MintCoins(ctx, clptypes.ModuleName, sdk.NewCoins(nativeCoin, externalCoin))
+			require.Nil(t, err)
+
+			clpAccount := app.AccountKeeper.GetModuleAccount(ctx, clptypes.ModuleName)
+
+			nativeCoinOk := app.ClpKeeper.HasBalance(ctx, clpAccount.GetAddress(), nativeCoin)
+			require.True(t, nativeCoinOk)
+			externalCoinOk := app.ClpKeeper.HasBalance(ctx, clpAccount.GetAddress(), externalCoin)
+			require.True(t, externalCoinOk)
+
+			require.Equal(t, app.BankKeeper.GetBalance(ctx, clpAccount.GetAddress(), nativeAsset), nativeCoin)
+			require.Equal(t, app.BankKeeper.GetBalance(ctx, clpAccount.GetAddress(), tt.externalAsset), externalCoin)
+
+			signer := clptest.GenerateAddress(clptest.AddressKey1)
+			nativeCoin = sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("2000000000000000000000")))
+			externalCoin = sdk.NewCoin(tt.externalAsset, sdk.Int(sdk.NewUint(1000000000000000)))
+			err = sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(nativeCoin, externalCoin))
+			require.Nil(t, err)
+
+			nativeCoinOk = app.ClpKeeper.HasBalance(ctx, signer, nativeCoin)
+			require.True(t, nativeCoinOk)
+			externalCoinOk = app.ClpKeeper.HasBalance(ctx, signer, externalCoin)
+			require.True(t, externalCoinOk)
+
+			require.Equal(t, app.BankKeeper.GetBalance(ctx, signer, nativeAsset), nativeCoin)
+			require.Equal(t, app.BankKeeper.GetBalance(ctx, signer, tt.externalAsset), externalCoin)
+
+			msgOpen := types.MsgOpen{
+				Signer:           signer.String(),
+				CollateralAsset:  nativeAsset,
+				CollateralAmount: sdk.NewUintFromString("1000000000000000000000"),
+				BorrowAsset:      tt.externalAsset,
+				Position:         types.Position_SHORT,
+				Leverage:         sdk.NewDec(2),
+			}
+			msgClose := types.MsgClose{
+				Signer: signer.String(),
+				Id:     1,
+			}
+
+			marginKeeper.WhitelistAddress(ctx, msgOpen.Signer)
+
+			_, openError := msgServer.Open(sdk.WrapSDKContext(ctx), &msgOpen)
+			require.Nil(t, openError)
+
+			if tt.errString != nil {
+				require.EqualError(t, openError, tt.errString.Error())
+			} else if tt.err == nil {
+				require.NoError(t, openError)
+			} else {
+				require.ErrorIs(t, openError, tt.err)
+			}
+
+			require.Equal(t, sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("1000000000000000000000"))), app.BankKeeper.GetBalance(ctx, signer, nativeAsset))
+			require.Equal(t, sdk.NewCoin(tt.externalAsset, sdk.Int(sdk.NewUint(1000000000000000))), app.BankKeeper.GetBalance(ctx, signer, tt.externalAsset))
+
+			openExpectedMTP := types.MTP{
+				Id:                       1,
+				Address:                  signer.String(),
+				CollateralAsset:          nativeAsset,
+				CollateralAmount:         sdk.NewUintFromString("1000000000000000000000"),
+				Liabilities:              sdk.NewUintFromString("999990000099999000009"),
+				InterestPaidCollateral:   sdk.ZeroUint(),
+				InterestPaidCustody:      sdk.ZeroUint(),
+				InterestUnpaidCollateral: sdk.ZeroUint(),
+				CustodyAsset:             nativeAsset,
+				CustodyAmount:            sdk.NewUintFromString("1996980060398792024158"),
+				Leverage:                 sdk.NewDec(2),
+				MtpHealth:                sdk.MustNewDecFromStr("1.990969270849450293"),
+				Position:                 types.Position_SHORT,
+				LiabilitiesAsset:         tt.externalAsset,
+			}
+
+			openMTP, _ := marginKeeper.GetMTP(ctx, signer.String(), 1)
+
+			require.Equal(t, openExpectedMTP, openMTP)
+
+			openExpectedPool := clptypes.Pool{
+				ExternalAsset:                 &externalAsset,
+				NativeAssetBalance:            sdk.NewUintFromString("99999003019939601207975842"),
+				ExternalAssetBalance:          sdk.NewUintFromString("100000000000000000000000000"),
+				NativeCustody:                 sdk.NewUintFromString("1996980060398792024158"),
+				ExternalCustody:               sdk.ZeroUint(),
+				NativeLiabilities:             sdk.ZeroUint(),
+				ExternalLiabilities:           sdk.NewUintFromString("999990000099999000009"),
+				UnsettledExternalLiabilities:  sdk.ZeroUint(),
+				UnsettledNativeLiabilities:    sdk.ZeroUint(),
+				BlockInterestExternal:         sdk.ZeroUint(),
+				BlockInterestNative:           sdk.ZeroUint(),
+				PoolUnits:                     sdk.ZeroUint(),
+				Health:                        sdk.MustNewDecFromStr("0.999990000199996000"),
+				InterestRate:                  sdk.MustNewDecFromStr("0.100000000000000000"),
+				SwapPriceNative:               &SwapPriceNative,
+				SwapPriceExternal:             &SwapPriceExternal,
+				RewardPeriodNativeDistributed: sdk.ZeroUint(),
+			}
+
+			openPool, _ := marginKeeper.ClpKeeper().GetPool(ctx, tt.externalAsset)
+
+			require.Equal(t, openExpectedPool, openPool)
+
+			_, closeError := msgServer.Close(sdk.WrapSDKContext(ctx), &msgClose)
+			require.Nil(t, closeError)
+
+			// only the custody needed to buy back the liabilities is sold and the rest is returned as it is, selling
+			// all of it and swapping the excess back would return 1987976693119130251208
+			require.Equal(t, sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("1993971003137035937755"))), app.BankKeeper.GetBalance(ctx, signer, nativeAsset))
+			require.Equal(t, sdk.NewCoin(tt.externalAsset, sdk.Int(sdk.NewUint(1000000000000000))), app.BankKeeper.GetBalance(ctx, signer, tt.externalAsset))
+
+			closeExpectedPool := clptypes.Pool{
+				ExternalAsset:                 &externalAsset,
+				NativeAssetBalance:            sdk.NewUintFromString("100000006028996862964062245"),
+				ExternalAssetBalance:          sdk.NewUintFromString("100000000000000000000000000"),
+				NativeCustody:                 sdk.ZeroUint(),
+				ExternalCustody:               sdk.ZeroUint(),
+				NativeLiabilities:             sdk.ZeroUint(),
+				ExternalLiabilities:           sdk.ZeroUint(),
+				UnsettledExternalLiabilities:  sdk.ZeroUint(),
+				UnsettledNativeLiabilities:    sdk.ZeroUint(),
+				BlockInterestExternal:         sdk.ZeroUint(),
+				BlockInterestNative:           sdk.ZeroUint(),
+				PoolUnits:                     sdk.ZeroUint(),
+				Health:                        sdk.MustNewDecFromStr("0.999990000199996000"),
+				InterestRate:                  sdk.MustNewDecFromStr("0.100000000000000000"),
+				SwapPriceNative:               &SwapPriceNative,
+				SwapPriceExternal:             &SwapPriceExternal,
+				RewardPeriodNativeDistributed: sdk.ZeroUint(),
+			}
+
+			closePool, _ := marginKeeper.ClpKeeper().GetPool(ctx, tt.externalAsset)
+
+			require.Equal(t, closeExpectedPool, closePool)
+		})
+	}
+}
+
 func TestKeeper_OpenThenClose(t *testing.T) {
 	externalAsset := "xxx"
 	nativeAsset := clptypes.NativeSymbol
@@ -2134,3 +2392,360 @@ func TestKeeper_AddUpExistingMTP(t *testing.T) {
 	fmt.Println(openMTP)
 	require.Equal(t, openExpectedMTP, openMTP)
 }
+
+func setupOpenMTP(t *testing.T, position types.Position) (sdk.Context, *sifapp.SifchainApp, sdk.AccAddress, types.MsgServer) {
+	ctx, app := test.CreateTestAppMargin(false)
+	marginKeeper := app.MarginKeeper
+	externalAsset := clptypes.Asset{Symbol: "xxx"}
+	nativeAsset := clptypes.NativeSymbol
+
+	app.TokenRegistryKeeper.SetToken(ctx, &tokenregistrytypes.RegistryEntry{
+		Denom:       externalAsset.Symbol,
+		Decimals:    18,
+		Permissions: []tokenregistrytypes.Permission{tokenregistrytypes.Permission_CLP},
+	})
+
+	params := types.DefaultGenesis().Params
+	params.Pools = []string{externalAsset.Symbol}
+	params.LeverageMax = sdk.NewDec(2)
+	params.SafetyFactor = sdk.MustNewDecFromStr("1.05")
+	params.EpochLength = 0
+	params.RowanCollateralEnabled = true
+	marginKeeper.SetParams(ctx, params)
+
+	SwapPriceNative := sdk.ZeroDec()
+	SwapPriceExternal := sdk.ZeroDec()
+	pool := clptypes.Pool{
+		ExternalAsset:                 &externalAsset,
+		NativeAssetBalance:            sdk.NewUintFromString("100000000000000000000000000"),
+		ExternalAssetBalance:          sdk.NewUintFromString("100000000000000000000000000"),
+		UnsettledExternalLiabilities:  sdk.ZeroUint(),
+		UnsettledNativeLiabilities:    sdk.ZeroUint(),
+		BlockInterestExternal:         sdk.ZeroUint(),
+		BlockInterestNative:           sdk.ZeroUint(),
+		NativeCustody:                 sdk.ZeroUint(),
+		ExternalCustody:               sdk.ZeroUint(),
+		NativeLiabilities:             sdk.ZeroUint(),
+		ExternalLiabilities:           sdk.ZeroUint(),
+		PoolUnits:                     sdk.ZeroUint(),
+		Health:                        sdk.OneDec(),
+		InterestRate:                  sdk.NewDecWithPrec(1, 1),
+		SwapPriceNative:               &SwapPriceNative,
+		SwapPriceExternal:             &SwapPriceExternal,
+		RewardPeriodNativeDistributed: sdk.ZeroUint(),
+	}
+	// nolint:errcheck
+	marginKeeper.ClpKeeper().SetPool(ctx, &pool)
+
+	nativeCoin := sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("100000000000000000000000000")))
+	externalCoin := sdk.NewCoin(externalAsset.Symbol, sdk.Int(sdk.NewUintFromString("100000000000000000000000000")))
+	err := app.BankKeeper.MintCoins(ctx, clptypes.ModuleName, sdk.NewCoins(nativeCoin, externalCoin))
+	require.Nil(t, err)
+
+	signer := clptest.GenerateAddress(clptest.AddressKey1)
+	err = sifapp.AddCoinsToAccount(types.ModuleName, app.BankKeeper, ctx, signer, sdk.NewCoins(sdk.NewCoin(nativeAsset, sdk.Int(sdk.NewUintFromString("2000000000000000000000")))))
+	require.Nil(t, err)
+	marginKeeper.WhitelistAddress(ctx, signer.String())
+
+	msgServer := keeper.NewMsgServerImpl(marginKeeper)
+	_, err = msgServer.Open(sdk.WrapSDKContext(ctx), &types.MsgOpen{
+		Signer:           signer.String(),
+		CollateralAsset:  nativeAsset,
+		CollateralAmount: sdk.NewUintFromString("1000000000000000000000"),
+		BorrowAsset:      externalAsset.Symbol,
+		Position:         position,
+		Leverage:         sdk.NewDec(2),
+	})
+	require.NoError(t, err)
+
+	return ctx, app, signer, msgServer
+}
+
+func TestKeeper_PartialClose(t *testing.T) {
+	table := []struct {
+		name         string
+		position     types.Position
+		closeAmount  sdk.Uint
+		safetyFactor sdk.Dec
+		err          error
+	}{
+		{
+			name:        "partial close long position",
+			position:    types.Position_LONG,
+			closeAmount: sdk.NewUintFromString("500000000000000000000"),
+		},
+		{
+			name:        "partial close short position",
+			position:    types.Position_SHORT,
+			closeAmount: sdk.NewUintFromString("500000000000000000000"),
+		},
+		{
+			name:        "close amount above custody",
+			position:    types.Position_LONG,
+			closeAmount: sdk.NewUintFromString("100000000000000000000000"),
+			err:         types.ErrInvalidCloseSize,
+		},
+		{
+			name:         "long mtp already below safety factor keeping its health",
+			position:     types.Position_LONG,
+			closeAmount:  sdk.NewUint(1000000),
+			safetyFactor: sdk.NewDec(100),
+		},
+		{
+			name:         "short mtp already below safety factor keeping its health",
+			position:     types.Position_SHORT,
+			closeAmount:  sdk.NewUint(1000000),
+			safetyFactor: sdk.NewDec(100),
+		},
+		{
+			name:         "close lowering the health of an mtp already below safety factor",
+			position:     types.Position_LONG,
+			closeAmount:  sdk.NewUintFromString("500000000000000000000"),
+			safetyFactor: sdk.NewDec(100),
+			err:          types.ErrMTPUnhealthy,
+		},
+		{
+			name:         "close taking a healthy mtp below safety factor",
+			position:     types.Position_LONG,
+			closeAmount:  sdk.NewUintFromString("500000000000000000000"),
+			safetyFactor: sdk.MustNewDecFromStr("1.98801"),
+			err:          types.ErrMTPUnhealthy,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, msgServer := setupOpenMTP(t, tt.position)
+			marginKeeper := app.MarginKeeper
+			nativeAsset := clptypes.NativeSymbol
+
+			openMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			openPool, err := marginKeeper.ClpKeeper().GetPool(ctx, "xxx")
+			require.NoError(t, err)
+			openBalance := app.BankKeeper.GetBalance(ctx, signer, nativeAsset)
+			if !tt.safetyFactor.IsNil() {
+				params := marginKeeper.GetParams(ctx)
+				params.SafetyFactor = tt.safetyFactor
+				marginKeeper.SetParams(ctx, &params)
+			}
+
+			_, err = msgServer.PartialClose(sdk.WrapSDKContext(ctx), &types.MsgPartialClose{
+				Signer: signer.String(),
+				Id:     1,
+				Amount: tt.closeAmount,
+			})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+				require.NoError(t, err)
+				require.Equal(t, openMTP.CustodyAmount, mtp.CustodyAmount)
+				require.Equal(t, openBalance, app.BankKeeper.GetBalance(ctx, signer, nativeAsset))
+				return
+			}
+			require.NoError(t, err)
+
+			mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			require.Equal(t, openMTP.CustodyAmount.Sub(tt.closeAmount), mtp.CustodyAmount)
+			require.Equal(t, openMTP.Liabilities.Sub(openMTP.Liabilities.Mul(tt.closeAmount).Quo(openMTP.CustodyAmount)), mtp.Liabilities)
+			require.Equal(t, openMTP.CollateralAmount.Sub(openMTP.CollateralAmount.Mul(tt.closeAmount).Quo(openMTP.CustodyAmount)), mtp.CollateralAmount)
+			require.True(t, mtp.MtpHealth.IsPositive())
+			require.Equal(t, openMTP.Leverage.RoundInt64(), mtp.Leverage.RoundInt64())
+
+			pool, err := marginKeeper.ClpKeeper().GetPool(ctx, "xxx")
+			require.NoError(t, err)
+			if types.StringCompare(mtp.CustodyAsset, nativeAsset) {
+				require.Equal(t, openPool.NativeCustody.Sub(tt.closeAmount), pool.NativeCustody)
+			} else {
+				require.Equal(t, openPool.ExternalCustody.Sub(tt.closeAmount), pool.ExternalCustody)
+			}
+			if types.StringCompare(mtp.OwedAsset(), nativeAsset) {
+				require.Equal(t, mtp.Liabilities, pool.NativeLiabilities)
+			} else {
+				require.Equal(t, mtp.Liabilities, pool.ExternalLiabilities)
+			}
+
+			require.True(t, app.BankKeeper.GetBalance(ctx, signer, nativeAsset).Amount.GT(openBalance.Amount))
+
+			var found bool
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventPartialClose {
+					found = true
+				}
+			}
+			require.True(t, found)
+		})
+	}
+}
+
+func TestKeeper_AddWithdrawCollateral(t *testing.T) {
+	table := []struct {
+		name           string
+		position       types.Position
+		addAmount      sdk.Uint
+		withdrawAmount sdk.Uint
+		err            error
+	}{
+		{
+			name:           "long position",
+			position:       types.Position_LONG,
+			addAmount:      sdk.NewUintFromString("500000000000000000000"),
+			withdrawAmount: sdk.NewUintFromString("500000000000000000000"),
+		},
+		{
+			name:           "short position",
+			position:       types.Position_SHORT,
+			addAmount:      sdk.NewUintFromString("500000000000000000000"),
+			withdrawAmount: sdk.NewUintFromString("500000000000000000000"),
+		},
+		{
+			name:           "long withdrawal below safety factor",
+			position:       types.Position_LONG,
+			addAmount:      sdk.NewUintFromString("1"),
+			withdrawAmount: sdk.NewUintFromString("900000000000000000000"),
+			err:            types.ErrMTPUnhealthy,
+		},
+		{
+			name:           "short withdrawal below safety factor",
+			position:       types.Position_SHORT,
+			addAmount:      sdk.NewUintFromString("1"),
+			withdrawAmount: sdk.NewUintFromString("960000000000000000000"),
+			err:            types.ErrMTPUnhealthy,
+		},
+		{
+			name:           "withdrawal above collateral",
+			position:       types.Position_LONG,
+			addAmount:      sdk.NewUintFromString("1"),
+			withdrawAmount: sdk.NewUintFromString("2000000000000000000000"),
+			err:            types.ErrInvalidCollateralAmount,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, msgServer := setupOpenMTP(t, tt.position)
+			marginKeeper := app.MarginKeeper
+
+			openMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+
+			_, err = msgServer.AddCollateral(sdk.WrapSDKContext(ctx), &types.MsgAddCollateral{
+				Signer: signer.String(),
+				Id:     1,
+				Amount: tt.addAmount,
+			})
+			require.NoError(t, err)
+
+			addedMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			require.Equal(t, openMTP.CollateralAmount.Add(tt.addAmount), addedMTP.CollateralAmount)
+			require.True(t, addedMTP.MtpHealth.GTE(openMTP.MtpHealth))
+			require.True(t, addedMTP.Leverage.LTE(openMTP.Leverage))
+
+			_, err = msgServer.WithdrawCollateral(sdk.WrapSDKContext(ctx), &types.MsgWithdrawCollateral{
+				Signer: signer.String(),
+				Id:     1,
+				Amount: tt.withdrawAmount,
+			})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			require.Equal(t, addedMTP.CollateralAmount.Sub(tt.withdrawAmount), mtp.CollateralAmount)
+			require.True(t, mtp.MtpHealth.LT(addedMTP.MtpHealth))
+			require.True(t, mtp.MtpHealth.GT(marginKeeper.GetSafetyFactor(ctx)))
+
+			pool, err := marginKeeper.ClpKeeper().GetPool(ctx, "xxx")
+			require.NoError(t, err)
+			if types.StringCompare(mtp.OwedAsset(), clptypes.NativeSymbol) {
+				require.Equal(t, mtp.Liabilities, pool.NativeLiabilities)
+			} else {
+				require.Equal(t, mtp.Liabilities, pool.ExternalLiabilities)
+			}
+			if types.StringCompare(mtp.CustodyAsset, clptypes.NativeSymbol) {
+				require.Equal(t, mtp.CustodyAmount, pool.NativeCustody)
+			} else {
+				require.Equal(t, mtp.CustodyAmount, pool.ExternalCustody)
+			}
+		})
+	}
+}
+
+func TestKeeper_ReduceMTPWithTriggers(t *testing.T) {
+	table := []struct {
+		name   string
+		reduce func(ctx sdk.Context, msgServer types.MsgServer, signer sdk.AccAddress, amount sdk.Uint) error
+	}{
+		{
+			name: "withdraw collateral",
+			reduce: func(ctx sdk.Context, msgServer types.MsgServer, signer sdk.AccAddress, amount sdk.Uint) error {
+				_, err := msgServer.WithdrawCollateral(sdk.WrapSDKContext(ctx), &types.MsgWithdrawCollateral{Signer: signer.String(), Id: 1, Amount: amount})
+				return err
+			},
+		},
+		{
+			name: "partial close",
+			reduce: func(ctx sdk.Context, msgServer types.MsgServer, signer sdk.AccAddress, amount sdk.Uint) error {
+				_, err := msgServer.PartialClose(sdk.WrapSDKContext(ctx), &types.MsgPartialClose{Signer: signer.String(), Id: 1, Amount: amount})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, app, signer, msgServer := setupOpenMTP(t, types.Position_LONG)
+			marginKeeper := app.MarginKeeper
+
+			params := marginKeeper.GetParams(ctx)
+			params.MinTriggerCollateralValue = sdk.NewUintFromString("900000000000000000000")
+			marginKeeper.SetParams(ctx, &params)
+			stopLossPrice := sdk.MustNewDecFromStr("0.5")
+			_, err := msgServer.UpdateTriggers(sdk.WrapSDKContext(ctx), &types.MsgUpdateTriggers{
+				Signer:        signer.String(),
+				Id:            1,
+				StopLossPrice: &stopLossPrice,
+			})
+			require.NoError(t, err)
+			openMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+
+			// the mtp would keep its stop loss price with too little collateral for it
+			err = tt.reduce(ctx, msgServer, signer, sdk.NewUintFromString("200000000000000000000"))
+			require.ErrorIs(t, err, types.ErrTriggerPositionTooSmall)
+			mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+			require.NoError(t, err)
+			require.Equal(t, openMTP.CollateralAmount, mtp.CollateralAmount)
+
+			err = tt.reduce(ctx, msgServer, signer, sdk.NewUintFromString("50000000000000000000"))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestKeeper_AddCollateralPayingOffLiabilities(t *testing.T) {
+	ctx, app, signer, msgServer := setupOpenMTP(t, types.Position_LONG)
+	marginKeeper := app.MarginKeeper
+
+	openMTP, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+	require.NoError(t, err)
+
+	_, err = msgServer.AddCollateral(sdk.WrapSDKContext(ctx), &types.MsgAddCollateral{
+		Signer: signer.String(),
+		Id:     1,
+		Amount: openMTP.Liabilities,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidCollateralAmount)
+
+	mtp, err := marginKeeper.GetMTP(ctx, signer.String(), 1)
+	require.NoError(t, err)
+	require.Equal(t, openMTP, mtp)
+}
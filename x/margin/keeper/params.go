@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"errors"
+	"fmt"
 
+	clptypes "github.com/Sifchain/sifnode/x/clp/types"
 	"github.com/Sifchain/sifnode/x/margin/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -125,6 +127,22 @@ func (k Keeper) IsRowanCollateralEnabled(ctx sdk.Context) bool {
 	return k.GetParams(ctx).RowanCollateralEnabled
 }
 
+func (k Keeper) GetTwapWindow(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).TwapWindow
+}
+
+func (k Keeper) GetMaxTriggersPerBlock(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).MaxTriggersPerBlock
+}
+
+func (k Keeper) GetMinTriggerCollateralValue(ctx sdk.Context) sdk.Uint {
+	minValue := k.GetParams(ctx).MinTriggerCollateralValue
+	if types.IsNilUint(minValue) {
+		return sdk.ZeroUint()
+	}
+	return minValue
+}
+
 func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) {
 	err := ValidateParams(params)
 	if err != nil {
@@ -154,5 +172,9 @@ func ValidateParams(params *types.Params) error {
 		return sdkerrors.Wrap(errors.New("invalid value"), "leverage max must be >= 0")
 	}
 
+	if params.TwapWindow > clptypes.MaxTwapWindow {
+		return sdkerrors.Wrap(errors.New("invalid value"), fmt.Sprintf("twap window must be <= %d", clptypes.MaxTwapWindow))
+	}
+
 	return nil
 }
@@ -17,6 +17,10 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) { //nolint
 	cdc.RegisterConcrete(&MsgOpen{}, "margin/MsgOpen", nil)
 	cdc.RegisterConcrete(&MsgClose{}, "margin/MsgClose", nil)
+	cdc.RegisterConcrete(&MsgPartialClose{}, "margin/MsgPartialClose", nil)
+	cdc.RegisterConcrete(&MsgAddCollateral{}, "margin/MsgAddCollateral", nil)
+	cdc.RegisterConcrete(&MsgWithdrawCollateral{}, "margin/MsgWithdrawCollateral", nil)
+	cdc.RegisterConcrete(&MsgUpdateTriggers{}, "margin/MsgUpdateTriggers", nil)
 	cdc.RegisterConcrete(&MsgAdminClose{}, "margin/AdminClose", nil)
 	cdc.RegisterConcrete(&MsgAdminCloseAll{}, "margin/AdminCloseAll", nil)
 
@@ -27,6 +31,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgOpen{},
 		&MsgClose{},
+		&MsgPartialClose{},
+		&MsgAddCollateral{},
+		&MsgWithdrawCollateral{},
+		&MsgUpdateTriggers{},
 		&MsgAdminClose{},
 		&MsgAdminCloseAll{},
 	)
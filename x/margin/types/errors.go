@@ -16,4 +16,8 @@ var (
 	ErrCustodyTooHigh              = sdkerrors.Register(ModuleName, 11, "custody amount is higher than pool depth")
 	ErrMTPUnhealthy                = sdkerrors.Register(ModuleName, 12, "mtp health would be too low for safety factor")
 	ErrRowanAsCollateralNotAllowed = sdkerrors.Register(ModuleName, 13, "using rowan as collateral asset is not allowed")
+	ErrInvalidCloseSize            = sdkerrors.Register(ModuleName, 14, "close amount must be lower than custody amount")
+	ErrInvalidCollateralAmount     = sdkerrors.Register(ModuleName, 15, "collateral amount invalid for mtp")
+	ErrInvalidTriggerPrice         = sdkerrors.Register(ModuleName, 16, "invalid trigger price")
+	ErrTriggerPositionTooSmall     = sdkerrors.Register(ModuleName, 17, "mtp collateral too small for trigger prices")
 )
@@ -2,7 +2,12 @@ package types
 
 const EventOpen = "margin/mtp_open"
 const EventClose = "margin/mtp_close"
+const EventPartialClose = "margin/mtp_partial_close"
+const EventAddCollateral = "margin/mtp_add_collateral"
+const EventWithdrawCollateral = "margin/mtp_withdraw_collateral"
 const EventForceClose = "margin/mtp_force_close"
+const EventTriggerClose = "margin/mtp_trigger_close"
+const EventUpdateTriggers = "margin/mtp_update_triggers"
 const EventAdminClose = "margin/mtp_admin_close"
 const EventAdminCloseAll = "margin/mtp_admin_close_all"
 const EventInterestRateComputation = "margin/interest_rate_computation"
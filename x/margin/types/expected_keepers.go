@@ -22,11 +22,15 @@ type CLPKeeper interface {
 	SetPool(ctx sdk.Context, pool *clptypes.Pool) error
 
 	CLPCalcSwap(ctx sdk.Context, sentAmount sdk.Uint, to clptypes.Asset, pool clptypes.Pool, marginEnabled bool) (sdk.Uint, error)
+	CLPCalcSwapSentAmount(ctx sdk.Context, receivedAmount sdk.Uint, to clptypes.Asset, pool clptypes.Pool, marginEnabled bool) (sdk.Uint, error)
 
 	GetPmtpRateParams(ctx sdk.Context) clptypes.PmtpRateParams
+	GetAssetDecimals(ctx sdk.Context, asset clptypes.Asset) (uint8, error)
 
 	GetRemovalQueue(ctx sdk.Context, symbol string) clptypes.RemovalQueue
 
+	GetTwapForWindow(ctx sdk.Context, symbol string, window uint64) (sdk.Dec, sdk.Dec, error)
+
 	SingleExternalBalanceModuleAccountCheck(externalAsset string) sdk.Invariant
 }
 
@@ -50,6 +54,9 @@ type Keeper interface {
 	GetMTPs(ctx sdk.Context, pagination *query.PageRequest) ([]*MTP, *query.PageResponse, error)
 	GetMTPsForPool(ctx sdk.Context, asset string, pagination *query.PageRequest) ([]*MTP, *query.PageResponse, error)
 	GetMTPsForAddress(ctx sdk.Context, mtpAddress sdk.Address, pagination *query.PageRequest) ([]*MTP, *query.PageResponse, error)
+	GetMTPsWithTriggers(ctx sdk.Context, pagination *query.PageRequest) ([]*MTP, *query.PageResponse, error)
+	GetNextMTPsWithTriggers(ctx sdk.Context, limit int) []*MTP
+	ValidateTriggerPositionSize(ctx sdk.Context, mtp *MTP) error
 	DestroyMTP(ctx sdk.Context, mtpAddress string, id uint64) error
 
 	IsWhitelisted(ctx sdk.Context, address string) bool
@@ -73,12 +80,21 @@ type Keeper interface {
 	IsPoolClosed(ctx sdk.Context, asset string) bool
 	IsWhitelistingEnabled(ctx sdk.Context) bool
 	IsRowanCollateralEnabled(ctx sdk.Context) bool
+	GetTwapWindow(ctx sdk.Context) uint64
+	GetMaxTriggersPerBlock(ctx sdk.Context) uint64
+	GetMinTriggerCollateralValue(ctx sdk.Context) sdk.Uint
 
 	CLPSwap(ctx sdk.Context, sentAmount sdk.Uint, to string, pool clptypes.Pool) (sdk.Uint, error)
+	CLPValue(ctx sdk.Context, sentAmount sdk.Uint, to string, pool clptypes.Pool) (sdk.Uint, error)
+	CustodyValue(ctx sdk.Context, mtp MTP, pool clptypes.Pool) (sdk.Uint, error)
 	Borrow(ctx sdk.Context, collateralAsset string, collateralAmount sdk.Uint, custodyAmount sdk.Uint, mtp *MTP, pool *clptypes.Pool, eta sdk.Dec) error
 	TakeInCustody(ctx sdk.Context, mtp MTP, pool *clptypes.Pool) error
 	TakeOutCustody(ctx sdk.Context, mtp MTP, pool *clptypes.Pool) error
-	Repay(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, repayAmount sdk.Uint, takeFundPayment bool) error
+	SellCustody(ctx sdk.Context, mtp MTP, pool clptypes.Pool, custodyAmount sdk.Uint, owe sdk.Uint) (sdk.Uint, sdk.Uint, error)
+	Repay(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, repayAmount sdk.Uint, returnAmount sdk.Uint, takeFundPayment bool) error
+	PartialRepay(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, repayAmount sdk.Uint, returnAmount sdk.Uint, closeAmount sdk.Uint) error
+	AddCollateralToMTP(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, amount sdk.Uint) error
+	WithdrawCollateralFromMTP(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, amount sdk.Uint) error
 	InterestRateComputation(ctx sdk.Context, pool clptypes.Pool) (sdk.Dec, error)
 	CheckMinLiabilities(ctx sdk.Context, collateralAmount sdk.Uint, eta sdk.Dec, pool clptypes.Pool, custodyAsset string) error
 	HandleInterestPayment(ctx sdk.Context, interestPayment sdk.Uint, mtp *MTP, pool *clptypes.Pool) sdk.Uint
@@ -92,10 +108,14 @@ type Keeper interface {
 	GetSQBeginBlock(ctx sdk.Context, pool *clptypes.Pool) uint64
 	SetSQBeginBlock(ctx sdk.Context, pool *clptypes.Pool, height uint64)
 
-	ForceCloseLong(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, isAdminClose bool, takeFundPayment bool) (sdk.Uint, error)
+	ForceCloseMTP(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool, isAdminClose bool, takeFundPayment bool) (sdk.Uint, error)
+	TriggerClose(ctx sdk.Context, mtp *MTP, pool *clptypes.Pool) (sdk.Uint, error)
 
 	EmitAdminClose(ctx sdk.Context, mtp *MTP, repayAmount sdk.Uint, closer string)
 	EmitAdminCloseAll(ctx sdk.Context, takeMarginFund bool)
+	EmitPartialCloseEvent(ctx sdk.Context, mtp *MTP, closeAmount sdk.Uint, repayAmount sdk.Uint)
+	EmitTriggerClose(ctx sdk.Context, mtp *MTP, repayAmount sdk.Uint, trigger string, price sdk.Dec)
+	EmitUpdateTriggers(ctx sdk.Context, mtp *MTP)
 
 	GetSQFromQueue(ctx sdk.Context, pool clptypes.Pool) sdk.Dec
 	GetSafetyFactor(ctx sdk.Context) sdk.Dec
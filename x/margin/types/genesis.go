@@ -26,6 +26,8 @@ func DefaultGenesis() *GenesisState {
 			ClosedPools:                              []string{},
 			WhitelistingEnabled:                      false,
 			RowanCollateralEnabled:                   true,
+			MaxTriggersPerBlock:                      100,
+			MinTriggerCollateralValue:                sdk.NewUintFromString("10000000000000000000"),
 		},
 	}
 }
@@ -13,6 +13,9 @@ var (
 	OpenMTPCountPrefix = []byte{0x04}
 	WhitelistPrefix    = []byte{0x05}
 	SQBeginBlockPrefix = []byte{0x06}
+	MTPTriggerPrefix   = []byte{0x07}
+	// MTPTriggerCursorKey stores the last trigger index key checked, so the next block resumes after it
+	MTPTriggerCursorKey = []byte{0x08}
 )
 
 func GetMTPKey(address string, id uint64) []byte {
@@ -23,6 +26,11 @@ func GetMTPPrefixForAddress(address string) []byte {
 	return append(MTPPrefix, []byte(address)...)
 }
 
+// GetMTPTriggerKey returns the index key of an mtp with a stop loss or take profit price set
+func GetMTPTriggerKey(address string, id uint64) []byte {
+	return append(MTPTriggerPrefix, append([]byte(address), GetUint64Bytes(id)...)...)
+}
+
 // GetUint64Bytes returns the byte representation of the ID
 func GetUint64Bytes(ID uint64) []byte {
 	IDBz := make([]byte, 8)
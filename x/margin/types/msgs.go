@@ -12,6 +12,10 @@ import (
 var (
 	_ sdk.Msg = &MsgOpen{}
 	_ sdk.Msg = &MsgClose{}
+	_ sdk.Msg = &MsgPartialClose{}
+	_ sdk.Msg = &MsgAddCollateral{}
+	_ sdk.Msg = &MsgWithdrawCollateral{}
+	_ sdk.Msg = &MsgUpdateTriggers{}
 	_ sdk.Msg = &MsgForceClose{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgUpdatePools{}
@@ -23,6 +27,10 @@ var (
 
 	_ legacytx.LegacyMsg = &MsgOpen{}
 	_ legacytx.LegacyMsg = &MsgClose{}
+	_ legacytx.LegacyMsg = &MsgPartialClose{}
+	_ legacytx.LegacyMsg = &MsgAddCollateral{}
+	_ legacytx.LegacyMsg = &MsgWithdrawCollateral{}
+	_ legacytx.LegacyMsg = &MsgUpdateTriggers{}
 	_ legacytx.LegacyMsg = &MsgForceClose{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 	_ legacytx.LegacyMsg = &MsgUpdatePools{}
@@ -75,6 +83,10 @@ func (m MsgOpen) ValidateBasic() error {
 	if !Validate(m.BorrowAsset) {
 		return sdkerrors.Wrap(clptypes.ErrInValidAsset, m.BorrowAsset)
 	}
+	// a short sells the borrowed asset for the collateral asset, so they cannot be the same
+	if m.Position == Position_SHORT && StringCompare(m.CollateralAsset, m.BorrowAsset) {
+		return sdkerrors.Wrap(clptypes.ErrInValidAsset, "collateral asset and borrow asset must differ")
+	}
 
 	if m.CollateralAmount.IsZero() {
 		return sdkerrors.Wrap(clptypes.ErrInValidAmount, m.CollateralAmount.String())
@@ -88,6 +100,12 @@ func (m MsgOpen) ValidateBasic() error {
 	if !ok {
 		return sdkerrors.Wrap(ErrInvalidPosition, m.Position.String())
 	}
+	if err := ValidateTriggerPrice(m.StopLossPrice); err != nil {
+		return err
+	}
+	if err := ValidateTriggerPrice(m.TakeProfitPrice); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -131,6 +149,142 @@ func (m MsgClose) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+func (m MsgPartialClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPartialClose) Route() string {
+	return RouterKey
+}
+
+func (m MsgPartialClose) Type() string {
+	return "partial_close"
+}
+
+func (m MsgPartialClose) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "no id specified")
+	}
+	if IsNilUint(m.Amount) || m.Amount.IsZero() {
+		return sdkerrors.Wrap(clptypes.ErrInValidAmount, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgPartialClose) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgAddCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddCollateral) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddCollateral) Type() string {
+	return "add_collateral"
+}
+
+func (m MsgAddCollateral) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "no id specified")
+	}
+	if IsNilUint(m.Amount) || m.Amount.IsZero() {
+		return sdkerrors.Wrap(clptypes.ErrInValidAmount, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgAddCollateral) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgWithdrawCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawCollateral) Route() string {
+	return RouterKey
+}
+
+func (m MsgWithdrawCollateral) Type() string {
+	return "withdraw_collateral"
+}
+
+func (m MsgWithdrawCollateral) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "no id specified")
+	}
+	if IsNilUint(m.Amount) || m.Amount.IsZero() {
+		return sdkerrors.Wrap(clptypes.ErrInValidAmount, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgWithdrawCollateral) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m MsgUpdateTriggers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateTriggers) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateTriggers) Type() string {
+	return "update_triggers"
+}
+
+func (m MsgUpdateTriggers) ValidateBasic() error {
+	if len(m.Signer) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Signer)
+	}
+	if m.Id == 0 {
+		return sdkerrors.Wrap(ErrMTPDoesNotExist, "no id specified")
+	}
+	if err := ValidateTriggerPrice(m.StopLossPrice); err != nil {
+		return err
+	}
+
+	return ValidateTriggerPrice(m.TakeProfitPrice)
+}
+
+func (m MsgUpdateTriggers) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 func (m MsgForceClose) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
 }
@@ -89,6 +89,18 @@ func TestTypes_MsgOpenValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.Wrap(types.ErrInvalidPosition, types.Position_UNSPECIFIED.String()),
 		},
+		{
+			name: "short collateral asset same as borrow asset",
+			msgOpen: types.MsgOpen{
+				Signer:           "xxx",
+				CollateralAsset:  "rowan",
+				CollateralAmount: sdk.NewUint(100),
+				BorrowAsset:      "rowan",
+				Position:         types.Position_SHORT,
+				Leverage:         sdk.NewDec(2),
+			},
+			err: sdkerrors.Wrap(clptypes.ErrInValidAsset, "collateral asset and borrow asset must differ"),
+		},
 		{
 			name: "all valid",
 			msgOpen: types.MsgOpen{
@@ -259,6 +271,246 @@ func TestTypes_MsgCloseGetSigners(t *testing.T) {
 	}
 }
 
+func TestTypes_MsgPartialCloseValidateBasic(t *testing.T) {
+	validateBasicTests := []struct {
+		name            string
+		msgPartialClose types.MsgPartialClose
+		err             error
+	}{
+		{
+			name:            "no signer",
+			msgPartialClose: types.MsgPartialClose{},
+			err:             sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ""),
+		},
+		{
+			name: "id invalid",
+			msgPartialClose: types.MsgPartialClose{
+				Signer: "xxx",
+			},
+			err: sdkerrors.Wrap(types.ErrMTPDoesNotExist, "no id specified"),
+		},
+		{
+			name: "amount missing",
+			msgPartialClose: types.MsgPartialClose{
+				Signer: "xxx",
+				Id:     1,
+			},
+			err: clptypes.ErrInValidAmount,
+		},
+		{
+			name: "amount invalid",
+			msgPartialClose: types.MsgPartialClose{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.ZeroUint(),
+			},
+			err: sdkerrors.Wrap(clptypes.ErrInValidAmount, "0"),
+		},
+		{
+			name: "all valid",
+			msgPartialClose: types.MsgPartialClose{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.NewUint(100),
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range validateBasicTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msgPartialClose.ValidateBasic()
+
+			if tt.err == nil {
+				require.NoError(t, got)
+			} else {
+				require.ErrorIs(t, got, tt.err)
+			}
+		})
+	}
+}
+
+func TestTypes_MsgAddCollateralValidateBasic(t *testing.T) {
+	validateBasicTests := []struct {
+		name string
+		msg  types.MsgAddCollateral
+		err  error
+	}{
+		{
+			name: "no signer",
+			msg:  types.MsgAddCollateral{},
+			err:  sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ""),
+		},
+		{
+			name: "id invalid",
+			msg: types.MsgAddCollateral{
+				Signer: "xxx",
+			},
+			err: sdkerrors.Wrap(types.ErrMTPDoesNotExist, "no id specified"),
+		},
+		{
+			name: "amount missing",
+			msg: types.MsgAddCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.Uint{},
+			},
+			err: clptypes.ErrInValidAmount,
+		},
+		{
+			name: "amount invalid",
+			msg: types.MsgAddCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.ZeroUint(),
+			},
+			err: sdkerrors.Wrap(clptypes.ErrInValidAmount, "0"),
+		},
+		{
+			name: "all valid",
+			msg: types.MsgAddCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.NewUint(100),
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range validateBasicTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msg.ValidateBasic()
+
+			if tt.err == nil {
+				require.NoError(t, got)
+			} else {
+				require.ErrorIs(t, got, tt.err)
+			}
+		})
+	}
+}
+
+func TestTypes_MsgWithdrawCollateralValidateBasic(t *testing.T) {
+	validateBasicTests := []struct {
+		name string
+		msg  types.MsgWithdrawCollateral
+		err  error
+	}{
+		{
+			name: "no signer",
+			msg:  types.MsgWithdrawCollateral{},
+			err:  sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ""),
+		},
+		{
+			name: "id invalid",
+			msg: types.MsgWithdrawCollateral{
+				Signer: "xxx",
+			},
+			err: sdkerrors.Wrap(types.ErrMTPDoesNotExist, "no id specified"),
+		},
+		{
+			name: "amount missing",
+			msg: types.MsgWithdrawCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.Uint{},
+			},
+			err: clptypes.ErrInValidAmount,
+		},
+		{
+			name: "amount invalid",
+			msg: types.MsgWithdrawCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.ZeroUint(),
+			},
+			err: sdkerrors.Wrap(clptypes.ErrInValidAmount, "0"),
+		},
+		{
+			name: "all valid",
+			msg: types.MsgWithdrawCollateral{
+				Signer: "xxx",
+				Id:     1,
+				Amount: sdk.NewUint(100),
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range validateBasicTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msg.ValidateBasic()
+
+			if tt.err == nil {
+				require.NoError(t, got)
+			} else {
+				require.ErrorIs(t, got, tt.err)
+			}
+		})
+	}
+}
+
+func TestTypes_MsgUpdateTriggersValidateBasic(t *testing.T) {
+	price := sdk.OneDec()
+	negative := sdk.NewDec(-1)
+	validateBasicTests := []struct {
+		name string
+		msg  types.MsgUpdateTriggers
+		err  error
+	}{
+		{
+			name: "no signer",
+			msg:  types.MsgUpdateTriggers{},
+			err:  sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ""),
+		},
+		{
+			name: "id invalid",
+			msg: types.MsgUpdateTriggers{
+				Signer: "xxx",
+			},
+			err: sdkerrors.Wrap(types.ErrMTPDoesNotExist, "no id specified"),
+		},
+		{
+			name: "negative price",
+			msg: types.MsgUpdateTriggers{
+				Signer:        "xxx",
+				Id:            1,
+				StopLossPrice: &negative,
+			},
+			err: types.ErrInvalidTriggerPrice,
+		},
+		{
+			name: "clear triggers",
+			msg: types.MsgUpdateTriggers{
+				Signer: "xxx",
+				Id:     1,
+			},
+			err: nil,
+		},
+		{
+			name: "all valid",
+			msg: types.MsgUpdateTriggers{
+				Signer:          "xxx",
+				Id:              1,
+				TakeProfitPrice: &price,
+			},
+			err: nil,
+		},
+	}
+	for _, tt := range validateBasicTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.msg.ValidateBasic()
+
+			if tt.err == nil {
+				require.NoError(t, got)
+			} else {
+				require.ErrorIs(t, got, tt.err)
+			}
+		})
+	}
+}
+
 func TestTypes_MsgForceCloseValidateBasic(t *testing.T) {
 	validateBasicTests := []struct {
 		name          string
@@ -878,6 +878,102 @@ func (m *IsWhitelistedResponse) GetIsWhitelisted() bool {
 	return false
 }
 
+type TriggersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TriggersRequest) Reset()         { *m = TriggersRequest{} }
+func (m *TriggersRequest) String() string { return proto.CompactTextString(m) }
+func (*TriggersRequest) ProtoMessage()    {}
+func (*TriggersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{18}
+}
+func (m *TriggersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggersRequest.Merge(m, src)
+}
+func (m *TriggersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggersRequest proto.InternalMessageInfo
+
+func (m *TriggersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type TriggersResponse struct {
+	Mtps       []*MTP              `protobuf:"bytes,1,rep,name=mtps,proto3" json:"mtps,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TriggersResponse) Reset()         { *m = TriggersResponse{} }
+func (m *TriggersResponse) String() string { return proto.CompactTextString(m) }
+func (*TriggersResponse) ProtoMessage()    {}
+func (*TriggersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73c14070fed1f663, []int{19}
+}
+func (m *TriggersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggersResponse.Merge(m, src)
+}
+func (m *TriggersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggersResponse proto.InternalMessageInfo
+
+func (m *TriggersResponse) GetMtps() []*MTP {
+	if m != nil {
+		return m.Mtps
+	}
+	return nil
+}
+
+func (m *TriggersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MTPRequest)(nil), "sifnode.margin.v1.MTPRequest")
 	proto.RegisterType((*MTPResponse)(nil), "sifnode.margin.v1.MTPResponse")
@@ -897,71 +993,76 @@ func init() {
 	proto.RegisterType((*GetSQParamsResponse)(nil), "sifnode.margin.v1.GetSQParamsResponse")
 	proto.RegisterType((*IsWhitelistedRequest)(nil), "sifnode.margin.v1.IsWhitelistedRequest")
 	proto.RegisterType((*IsWhitelistedResponse)(nil), "sifnode.margin.v1.IsWhitelistedResponse")
+	proto.RegisterType((*TriggersRequest)(nil), "sifnode.margin.v1.TriggersRequest")
+	proto.RegisterType((*TriggersResponse)(nil), "sifnode.margin.v1.TriggersResponse")
 }
 
 func init() { proto.RegisterFile("sifnode/margin/v1/query.proto", fileDescriptor_73c14070fed1f663) }
 
 var fileDescriptor_73c14070fed1f663 = []byte{
-	// 930 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0x69, 0xc0, 0xcf, 0x8d, 0x69, 0x86, 0x10, 0xc2, 0x92, 0xb8, 0x61, 0x49, 0x5a,
-	0x63, 0xe1, 0xdd, 0x3a, 0x48, 0x41, 0x88, 0x03, 0x4a, 0x8a, 0x6a, 0xf5, 0x10, 0xc9, 0xdd, 0x54,
-	0x02, 0xf5, 0x40, 0xb4, 0xb6, 0xc7, 0x9b, 0x51, 0xbd, 0x3b, 0x5b, 0xcf, 0xd8, 0xc5, 0x44, 0x45,
-	0x88, 0x13, 0xa7, 0x8a, 0x1f, 0xff, 0x07, 0x7f, 0x00, 0x27, 0x8e, 0x1c, 0x2b, 0x71, 0xe1, 0x88,
-	0x12, 0xfe, 0x10, 0xb4, 0x33, 0xb3, 0xf6, 0xae, 0x3d, 0xb6, 0xab, 0x2a, 0x52, 0x6f, 0xbb, 0x6f,
-	0xbe, 0x79, 0xef, 0xfb, 0xde, 0xec, 0xfb, 0x66, 0x61, 0x9b, 0x91, 0x76, 0x48, 0x5b, 0xd8, 0x09,
-	0xbc, 0xae, 0x4f, 0x42, 0xa7, 0x5f, 0x75, 0x9e, 0xf4, 0x70, 0x77, 0x60, 0x47, 0x5d, 0xca, 0x29,
-	0x5a, 0x53, 0xcb, 0xb6, 0x5c, 0xb6, 0xfb, 0x55, 0x73, 0xdd, 0xa7, 0x3e, 0x15, 0xab, 0x4e, 0xfc,
-	0x24, 0x81, 0xe6, 0x96, 0x4f, 0xa9, 0xdf, 0xc1, 0x8e, 0x17, 0x11, 0xc7, 0x0b, 0x43, 0xca, 0x3d,
-	0x4e, 0x68, 0xc8, 0xd4, 0x6a, 0xb9, 0x49, 0x59, 0x40, 0x99, 0xd3, 0xf0, 0x18, 0x96, 0xf9, 0x9d,
-	0x7e, 0xb5, 0x81, 0xb9, 0x57, 0x75, 0x22, 0xcf, 0x27, 0xa1, 0x00, 0x2b, 0xac, 0x86, 0x11, 0x1f,
-	0x44, 0x58, 0xa5, 0xb2, 0x0e, 0x00, 0x8e, 0x1f, 0xd6, 0x5d, 0xfc, 0xa4, 0x87, 0x19, 0x47, 0x9b,
-	0xf0, 0x86, 0xd7, 0x6a, 0x75, 0x31, 0x63, 0x9b, 0xc6, 0x8e, 0x51, 0xca, 0xb9, 0xc9, 0x2b, 0x2a,
-	0xc0, 0x22, 0x69, 0x6d, 0x2e, 0xee, 0x18, 0xa5, 0x65, 0x77, 0x91, 0xb4, 0xac, 0x4f, 0x21, 0x2f,
-	0xf6, 0xb1, 0x88, 0x86, 0x0c, 0xa3, 0x12, 0x2c, 0x05, 0x3c, 0x12, 0x9b, 0xf2, 0xfb, 0x1b, 0xf6,
-	0x84, 0x4c, 0x3b, 0x06, 0xc7, 0x10, 0xeb, 0x7b, 0x30, 0xeb, 0x94, 0x11, 0x21, 0xe7, 0x1e, 0xed,
-	0x1e, 0xca, 0xfc, 0xf3, 0x09, 0xdc, 0x03, 0x18, 0x69, 0x13, 0x44, 0xf2, 0xfb, 0xb7, 0x6c, 0xd9,
-	0x08, 0x3b, 0x6e, 0x84, 0x2d, 0x1b, 0xad, 0x1a, 0x61, 0xd7, 0x3d, 0x1f, 0xab, 0xac, 0x6e, 0x6a,
-	0xa7, 0xf5, 0xab, 0x01, 0xef, 0x6b, 0x09, 0x28, 0x25, 0x65, 0x58, 0x0e, 0x78, 0x14, 0x97, 0x5f,
-	0x9a, 0x21, 0x45, 0x60, 0x50, 0x4d, 0xc3, 0xe9, 0xf6, 0x5c, 0x4e, 0xb2, 0x50, 0x86, 0x54, 0x1f,
-	0x36, 0x86, 0x9c, 0x8e, 0x06, 0x75, 0x4a, 0x3b, 0x49, 0x43, 0xd6, 0xe1, 0x9a, 0xc7, 0x18, 0xe6,
-	0xaa, 0x1d, 0xf2, 0xe5, 0xca, 0x9a, 0xf1, 0xdc, 0x80, 0x77, 0x27, 0x0a, 0xbf, 0xce, 0x46, 0x3c,
-	0x82, 0x1b, 0x43, 0x3e, 0x49, 0x0b, 0xb2, 0x62, 0x8d, 0x57, 0x16, 0xfb, 0x93, 0x01, 0x6b, 0xa9,
-	0xe4, 0xaf, 0x53, 0xe6, 0x5b, 0xb0, 0x5a, 0xf7, 0xba, 0x5e, 0x90, 0x68, 0xb4, 0xee, 0x42, 0x21,
-	0x09, 0x28, 0x5e, 0x55, 0x58, 0x89, 0x44, 0x44, 0x29, 0x7e, 0x4f, 0xc3, 0x4c, 0x6d, 0x51, 0xc0,
-	0x38, 0xeb, 0x09, 0xf7, 0x78, 0x6f, 0x98, 0xb5, 0x05, 0x85, 0x24, 0xa0, 0xb2, 0xee, 0x42, 0x81,
-	0x46, 0x38, 0x3c, 0x0d, 0x78, 0x74, 0xda, 0xa4, 0xbd, 0x50, 0x7e, 0x57, 0xcb, 0xee, 0xf5, 0x38,
-	0x7a, 0xcc, 0xa3, 0xbb, 0x71, 0x0c, 0x7d, 0x0c, 0xa8, 0x43, 0xda, 0x98, 0x93, 0x00, 0xa7, 0x90,
-	0x72, 0xf8, 0x6f, 0x24, 0x2b, 0x09, 0x3a, 0x3e, 0xb3, 0xaf, 0xce, 0x08, 0xc7, 0x1d, 0xc2, 0xf8,
-	0x55, 0x9f, 0xd9, 0x77, 0xb0, 0x96, 0xca, 0xad, 0x44, 0x6c, 0x41, 0xee, 0x69, 0x12, 0x14, 0xe7,
-	0x96, 0x73, 0x47, 0x81, 0xab, 0x3b, 0xa4, 0x12, 0xa0, 0x1a, 0xe6, 0x27, 0x0f, 0x32, 0x27, 0x85,
-	0x10, 0x2c, 0x47, 0x94, 0x76, 0xd4, 0x3c, 0x8a, 0x67, 0xeb, 0x00, 0xde, 0xce, 0x20, 0x15, 0xcf,
-	0x9b, 0x90, 0x6f, 0x60, 0x9f, 0x84, 0xa7, 0x8d, 0x0e, 0x6d, 0x3e, 0x16, 0x3b, 0x96, 0x5c, 0x10,
-	0xa1, 0xa3, 0x38, 0x62, 0xdd, 0x81, 0xf5, 0xfb, 0x6c, 0xa8, 0x0f, 0xb7, 0xe6, 0xba, 0xa0, 0xf5,
-	0x35, 0xbc, 0x33, 0xb6, 0x43, 0xd5, 0x9a, 0x6e, 0x9c, 0x7b, 0x50, 0x20, 0xec, 0xf4, 0xe9, 0x68,
-	0x8f, 0xe8, 0xc9, 0x9b, 0xee, 0x2a, 0x49, 0x27, 0xda, 0xff, 0x23, 0x07, 0xd7, 0x1e, 0xc4, 0x8d,
-	0x41, 0xf7, 0x61, 0xa5, 0x86, 0xf9, 0xf1, 0xc3, 0x3a, 0xda, 0x9e, 0x32, 0x0d, 0x92, 0xa6, 0x59,
-	0x9c, 0xb6, 0x2c, 0x39, 0x59, 0x0b, 0xe8, 0x17, 0x03, 0xae, 0xd7, 0x30, 0x1f, 0x4e, 0x1d, 0xfa,
-	0x50, 0xf7, 0x15, 0x8f, 0x0d, 0xbc, 0xb9, 0x3b, 0x1b, 0xa4, 0xb2, 0x1f, 0xfc, 0xf8, 0xf7, 0x7f,
-	0xbf, 0x2d, 0xde, 0x41, 0xb6, 0xc3, 0x48, 0xbb, 0x79, 0xe6, 0x91, 0x30, 0x75, 0xc5, 0x45, 0x09,
-	0xdc, 0x39, 0x4f, 0xdd, 0x88, 0x8f, 0xf1, 0xe0, 0x19, 0xfa, 0xd3, 0x80, 0x8d, 0x34, 0xa7, 0xd1,
-	0x1d, 0x80, 0x2a, 0xb3, 0x0a, 0x4f, 0x5c, 0x56, 0xa6, 0xfd, 0xb2, 0x70, 0xc5, 0xb8, 0x26, 0x18,
-	0x1f, 0xa2, 0x2f, 0x74, 0x8c, 0x63, 0x83, 0xa9, 0xb4, 0x69, 0xb7, 0xa2, 0xce, 0xcd, 0x39, 0x57,
-	0x0f, 0xcf, 0x26, 0x25, 0xfc, 0x6e, 0x88, 0x4f, 0x73, 0xcc, 0xb9, 0xd1, 0x47, 0xb3, 0xf8, 0x64,
-	0xae, 0x15, 0xb3, 0xfc, 0x32, 0x50, 0x45, 0xfb, 0x50, 0xd0, 0xfe, 0x1c, 0x7d, 0x36, 0x95, 0x76,
-	0x63, 0x50, 0x89, 0xe7, 0xc0, 0x39, 0x17, 0xb7, 0x93, 0x86, 0x30, 0x87, 0x5c, 0xcc, 0x57, 0x8c,
-	0x07, 0xda, 0x99, 0xee, 0x64, 0x8a, 0xdd, 0x07, 0x33, 0x10, 0x8a, 0x94, 0x25, 0x48, 0x6d, 0x21,
-	0x53, 0x7b, 0xfa, 0xb2, 0x90, 0xac, 0x2a, 0x1d, 0x50, 0x5b, 0x35, 0xe3, 0x96, 0xda, 0xaa, 0x59,
-	0xfb, 0x9c, 0x5d, 0x95, 0xc9, 0x42, 0xdf, 0x40, 0x3e, 0x65, 0x06, 0x68, 0x4f, 0x93, 0x75, 0xd2,
-	0x56, 0xcc, 0x5b, 0xf3, 0x60, 0xc3, 0x99, 0xfa, 0x41, 0xce, 0xd4, 0x70, 0x76, 0xb5, 0x33, 0x35,
-	0x6e, 0xc8, 0xda, 0x99, 0x9a, 0x70, 0x56, 0x6b, 0x4f, 0xe8, 0xbb, 0x89, 0xb6, 0x75, 0xfa, 0x46,
-	0x16, 0xfb, 0xdc, 0x80, 0xd5, 0x8c, 0x0d, 0xa1, 0xdb, 0x9a, 0xf4, 0x3a, 0x6b, 0x33, 0x4b, 0xf3,
-	0x81, 0x8a, 0x4b, 0x59, 0x70, 0xd9, 0x45, 0x96, 0x8e, 0x0b, 0x61, 0x95, 0x94, 0xa3, 0x1d, 0x7d,
-	0xf9, 0xd7, 0x45, 0xd1, 0x78, 0x71, 0x51, 0x34, 0xfe, 0xbd, 0x28, 0x1a, 0x3f, 0x5f, 0x16, 0x17,
-	0x5e, 0x5c, 0x16, 0x17, 0xfe, 0xb9, 0x2c, 0x2e, 0x3c, 0x2a, 0xfb, 0x84, 0x9f, 0xf5, 0x1a, 0x76,
-	0x93, 0x06, 0xce, 0x49, 0x92, 0x27, 0xf9, 0x25, 0xfe, 0x36, 0xc9, 0x28, 0xfe, 0x88, 0x1b, 0x2b,
-	0xe2, 0x97, 0xf8, 0x93, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xc0, 0x4e, 0xdd, 0xc5, 0x0b,
-	0x00, 0x00,
+	// 977 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x69, 0x20, 0xcf, 0x8d, 0x9b, 0x0c, 0x21, 0x84, 0x25, 0x71, 0xd3, 0x6d, 0xd2,
+	0x1a, 0x0b, 0xef, 0xd6, 0x41, 0x04, 0x21, 0x0e, 0x28, 0x29, 0xaa, 0xd5, 0x43, 0x24, 0xd7, 0x89,
+	0x04, 0xf4, 0x40, 0xb4, 0xb6, 0x27, 0x9b, 0x51, 0xed, 0x9d, 0xed, 0xce, 0x38, 0xc5, 0x44, 0x45,
+	0x88, 0x0b, 0x5c, 0xa8, 0xf8, 0xf1, 0x7f, 0xf0, 0x37, 0x70, 0x44, 0x9c, 0x22, 0x71, 0xe1, 0x88,
+	0x12, 0xfe, 0x10, 0xb4, 0x33, 0xb3, 0xf6, 0xda, 0x1e, 0xaf, 0x23, 0x64, 0x29, 0xb7, 0xdd, 0x99,
+	0x6f, 0xbe, 0xf7, 0x7d, 0x6f, 0xf6, 0xbd, 0xa7, 0x85, 0x75, 0x46, 0x8e, 0x7d, 0xda, 0xc0, 0x4e,
+	0xcb, 0x0d, 0x3d, 0xe2, 0x3b, 0xa7, 0x25, 0xe7, 0x79, 0x1b, 0x87, 0x1d, 0x3b, 0x08, 0x29, 0xa7,
+	0x68, 0x49, 0x6d, 0xdb, 0x72, 0xdb, 0x3e, 0x2d, 0x99, 0xcb, 0x1e, 0xf5, 0xa8, 0xd8, 0x75, 0xa2,
+	0x27, 0x09, 0x34, 0xd7, 0x3c, 0x4a, 0xbd, 0x26, 0x76, 0xdc, 0x80, 0x38, 0xae, 0xef, 0x53, 0xee,
+	0x72, 0x42, 0x7d, 0xa6, 0x76, 0x0b, 0x75, 0xca, 0x5a, 0x94, 0x39, 0x35, 0x97, 0x61, 0xc9, 0xef,
+	0x9c, 0x96, 0x6a, 0x98, 0xbb, 0x25, 0x27, 0x70, 0x3d, 0xe2, 0x0b, 0xb0, 0xc2, 0x6a, 0x14, 0xf1,
+	0x4e, 0x80, 0x15, 0x95, 0xb5, 0x03, 0xb0, 0x7f, 0x58, 0xa9, 0xe2, 0xe7, 0x6d, 0xcc, 0x38, 0x5a,
+	0x85, 0xd7, 0xdc, 0x46, 0x23, 0xc4, 0x8c, 0xad, 0x1a, 0x1b, 0x46, 0x7e, 0xbe, 0x1a, 0xbf, 0xa2,
+	0x2c, 0x4c, 0x93, 0xc6, 0xea, 0xf4, 0x86, 0x91, 0x9f, 0xad, 0x4e, 0x93, 0x86, 0xf5, 0x21, 0x64,
+	0xc4, 0x39, 0x16, 0x50, 0x9f, 0x61, 0x94, 0x87, 0x99, 0x16, 0x0f, 0xc4, 0xa1, 0xcc, 0xf6, 0x8a,
+	0x3d, 0x64, 0xd3, 0x8e, 0xc0, 0x11, 0xc4, 0xfa, 0x06, 0xcc, 0x0a, 0x65, 0x44, 0xd8, 0x79, 0x44,
+	0xc3, 0x5d, 0xc9, 0x3f, 0x5e, 0xc0, 0x23, 0x80, 0x9e, 0x37, 0x21, 0x24, 0xb3, 0x7d, 0xcf, 0x96,
+	0x89, 0xb0, 0xa3, 0x44, 0xd8, 0x32, 0xd1, 0x2a, 0x11, 0x76, 0xc5, 0xf5, 0xb0, 0x62, 0xad, 0x26,
+	0x4e, 0x5a, 0xbf, 0x18, 0xf0, 0x8e, 0x56, 0x80, 0x72, 0x52, 0x80, 0xd9, 0x16, 0x0f, 0xa2, 0xf0,
+	0x33, 0x29, 0x56, 0x04, 0x06, 0x95, 0x35, 0x9a, 0xee, 0x8f, 0xd5, 0x24, 0x03, 0xf5, 0x89, 0x3a,
+	0x85, 0x95, 0xae, 0xa6, 0xbd, 0x4e, 0x85, 0xd2, 0x66, 0x9c, 0x90, 0x65, 0xb8, 0xe1, 0x32, 0x86,
+	0xb9, 0x4a, 0x87, 0x7c, 0x99, 0x58, 0x32, 0x5e, 0x19, 0xf0, 0xd6, 0x50, 0xe0, 0xeb, 0x4c, 0xc4,
+	0x53, 0x58, 0xec, 0xea, 0x89, 0x53, 0xd0, 0x6f, 0xd6, 0xf8, 0xdf, 0x66, 0x7f, 0x30, 0x60, 0x29,
+	0x41, 0x7e, 0x9d, 0x36, 0x6f, 0xc1, 0x42, 0xc5, 0x0d, 0xdd, 0x56, 0xec, 0xd1, 0x7a, 0x08, 0xd9,
+	0x78, 0x41, 0xe9, 0x2a, 0xc1, 0x5c, 0x20, 0x56, 0x94, 0xe3, 0xb7, 0x35, 0xca, 0xd4, 0x11, 0x05,
+	0x8c, 0x58, 0x0f, 0xb8, 0xcb, 0xdb, 0x5d, 0xd6, 0x06, 0x64, 0xe3, 0x05, 0xc5, 0xba, 0x09, 0x59,
+	0x1a, 0x60, 0xff, 0xa8, 0xc5, 0x83, 0xa3, 0x3a, 0x6d, 0xfb, 0xf2, 0xbb, 0x9a, 0xad, 0xde, 0x8c,
+	0x56, 0xf7, 0x79, 0xf0, 0x30, 0x5a, 0x43, 0xef, 0x01, 0x6a, 0x92, 0x63, 0xcc, 0x49, 0x0b, 0x27,
+	0x90, 0xb2, 0xf8, 0x17, 0xe3, 0x9d, 0x18, 0x1d, 0xdd, 0xd9, 0x67, 0x27, 0x84, 0xe3, 0x26, 0x61,
+	0x7c, 0xd2, 0x77, 0xf6, 0x35, 0x2c, 0x25, 0xb8, 0x95, 0x89, 0x35, 0x98, 0x7f, 0x11, 0x2f, 0x8a,
+	0x7b, 0x9b, 0xaf, 0xf6, 0x16, 0x26, 0x77, 0x49, 0x79, 0x40, 0x65, 0xcc, 0x0f, 0x9e, 0xf4, 0xdd,
+	0x14, 0x42, 0x30, 0x1b, 0x50, 0xda, 0x54, 0xf5, 0x28, 0x9e, 0xad, 0x1d, 0x78, 0xa3, 0x0f, 0xa9,
+	0x74, 0xde, 0x86, 0x4c, 0x0d, 0x7b, 0xc4, 0x3f, 0xaa, 0x35, 0x69, 0xfd, 0x99, 0x38, 0x31, 0x53,
+	0x05, 0xb1, 0xb4, 0x17, 0xad, 0x58, 0x0f, 0x60, 0xf9, 0x31, 0xeb, 0xfa, 0xc3, 0x8d, 0xb1, 0x5d,
+	0xd0, 0xfa, 0x1c, 0xde, 0x1c, 0x38, 0xa1, 0x62, 0x8d, 0x6e, 0x9c, 0x5b, 0x90, 0x25, 0xec, 0xe8,
+	0x45, 0xef, 0x8c, 0xc8, 0xc9, 0xeb, 0xd5, 0x05, 0x92, 0x24, 0xb2, 0xbe, 0x80, 0x5b, 0x87, 0x21,
+	0xf1, 0x3c, 0x1c, 0x4e, 0xbc, 0xf0, 0xbe, 0x37, 0x60, 0xb1, 0xc7, 0x7d, 0x8d, 0x75, 0xb7, 0xfd,
+	0x27, 0xc0, 0x8d, 0x27, 0x11, 0x14, 0x3d, 0x86, 0xb9, 0x32, 0xe6, 0xfb, 0x87, 0x15, 0xb4, 0x3e,
+	0x22, 0xb4, 0x34, 0x62, 0xe6, 0x46, 0x6d, 0x4b, 0x7a, 0x6b, 0x0a, 0xfd, 0x6c, 0xc0, 0xcd, 0x32,
+	0xe6, 0xdd, 0xd6, 0x82, 0xee, 0xea, 0x4a, 0x75, 0xa0, 0xab, 0x99, 0x9b, 0xe9, 0x20, 0xc5, 0xbe,
+	0xf3, 0xdd, 0x5f, 0xff, 0xfe, 0x3a, 0xfd, 0x00, 0xd9, 0x0e, 0x23, 0xc7, 0xf5, 0x13, 0x97, 0xf8,
+	0x89, 0x39, 0x1e, 0xc4, 0x70, 0xe7, 0xac, 0xe7, 0xd1, 0x7e, 0x86, 0x3b, 0x2f, 0xd1, 0xef, 0x06,
+	0xac, 0x24, 0x35, 0xf5, 0x06, 0x1d, 0x2a, 0xa6, 0x05, 0x1e, 0x9a, 0xc8, 0xa6, 0x7d, 0x55, 0xb8,
+	0x52, 0x5c, 0x16, 0x8a, 0x77, 0xd1, 0x27, 0x3a, 0xc5, 0xd1, 0x6d, 0x16, 0x8f, 0x69, 0x58, 0x54,
+	0x1f, 0xa7, 0x73, 0xa6, 0x1e, 0x5e, 0x0e, 0x5b, 0xf8, 0xcd, 0x10, 0xf5, 0x37, 0x30, 0x9e, 0xd0,
+	0xbb, 0x69, 0x7a, 0xfa, 0x66, 0xa7, 0x59, 0xb8, 0x0a, 0x54, 0xc9, 0xde, 0x15, 0xb2, 0x3f, 0x46,
+	0x1f, 0x8d, 0x94, 0x5d, 0xeb, 0x14, 0xa3, 0x62, 0x77, 0xce, 0xc4, 0x08, 0xd6, 0x08, 0xe6, 0x30,
+	0x1f, 0xe9, 0x15, 0x3d, 0x00, 0x6d, 0x8c, 0x6e, 0xd7, 0x4a, 0xdd, 0x9d, 0x14, 0x84, 0x12, 0x65,
+	0x09, 0x51, 0x6b, 0xc8, 0xd4, 0xde, 0xbe, 0x0c, 0x24, 0xa3, 0xca, 0x36, 0xaf, 0x8d, 0xda, 0x37,
+	0x12, 0xcc, 0x3b, 0x29, 0x88, 0xab, 0x44, 0x65, 0x32, 0xd0, 0x97, 0x90, 0x49, 0x74, 0x3c, 0xb4,
+	0xa5, 0x61, 0x1d, 0xee, 0x9d, 0xe6, 0xbd, 0x71, 0xb0, 0x6e, 0x4d, 0x7d, 0x2b, 0x6b, 0xaa, 0xdb,
+	0xa0, 0xb4, 0x35, 0x35, 0x38, 0x75, 0xcc, 0xcd, 0x74, 0x90, 0x62, 0xdf, 0x12, 0xfe, 0x6e, 0xa3,
+	0x75, 0x9d, 0xbf, 0xde, 0x1c, 0x79, 0x65, 0xc0, 0x42, 0x5f, 0xaf, 0x45, 0xf7, 0x35, 0xf4, 0xba,
+	0xfe, 0x6d, 0xe6, 0xc7, 0x03, 0x95, 0x96, 0x82, 0xd0, 0xb2, 0x89, 0x2c, 0x9d, 0x16, 0xc2, 0x8a,
+	0x89, 0xb6, 0x8d, 0x7e, 0x34, 0x44, 0xd2, 0xe3, 0x4e, 0x8a, 0x2c, 0x4d, 0x94, 0x81, 0x16, 0x6e,
+	0xde, 0x4d, 0xc5, 0x28, 0x11, 0x1f, 0x08, 0x11, 0x0e, 0x2a, 0xea, 0x44, 0x70, 0x85, 0x1e, 0xfa,
+	0xde, 0xf7, 0x3e, 0xfd, 0xe3, 0x22, 0x67, 0x9c, 0x5f, 0xe4, 0x8c, 0x7f, 0x2e, 0x72, 0xc6, 0x4f,
+	0x97, 0xb9, 0xa9, 0xf3, 0xcb, 0xdc, 0xd4, 0xdf, 0x97, 0xb9, 0xa9, 0xa7, 0x05, 0x8f, 0xf0, 0x93,
+	0x76, 0xcd, 0xae, 0xd3, 0x96, 0x73, 0x10, 0x53, 0xc6, 0xff, 0x21, 0x5f, 0xc5, 0xe4, 0xe2, 0x37,
+	0xa4, 0x36, 0x27, 0xfe, 0x43, 0xde, 0xff, 0x6f, 0x00, 0xbe, 0x56, 0x46, 0x8e, 0x3a, 0x0d, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -985,6 +1086,7 @@ type QueryClient interface {
 	GetSQParams(ctx context.Context, in *GetSQParamsRequest, opts ...grpc.CallOption) (*GetSQParamsResponse, error)
 	GetWhitelist(ctx context.Context, in *WhitelistRequest, opts ...grpc.CallOption) (*WhitelistResponse, error)
 	IsWhitelisted(ctx context.Context, in *IsWhitelistedRequest, opts ...grpc.CallOption) (*IsWhitelistedResponse, error)
+	GetTriggers(ctx context.Context, in *TriggersRequest, opts ...grpc.CallOption) (*TriggersResponse, error)
 }
 
 type queryClient struct {
@@ -1076,6 +1178,15 @@ func (c *queryClient) IsWhitelisted(ctx context.Context, in *IsWhitelistedReques
 	return out, nil
 }
 
+func (c *queryClient) GetTriggers(ctx context.Context, in *TriggersRequest, opts ...grpc.CallOption) (*TriggersResponse, error) {
+	out := new(TriggersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Query/GetTriggers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	GetMTP(context.Context, *MTPRequest) (*MTPResponse, error)
@@ -1087,6 +1198,7 @@ type QueryServer interface {
 	GetSQParams(context.Context, *GetSQParamsRequest) (*GetSQParamsResponse, error)
 	GetWhitelist(context.Context, *WhitelistRequest) (*WhitelistResponse, error)
 	IsWhitelisted(context.Context, *IsWhitelistedRequest) (*IsWhitelistedResponse, error)
+	GetTriggers(context.Context, *TriggersRequest) (*TriggersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1120,6 +1232,9 @@ func (*UnimplementedQueryServer) GetWhitelist(ctx context.Context, req *Whitelis
 func (*UnimplementedQueryServer) IsWhitelisted(ctx context.Context, req *IsWhitelistedRequest) (*IsWhitelistedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsWhitelisted not implemented")
 }
+func (*UnimplementedQueryServer) GetTriggers(ctx context.Context, req *TriggersRequest) (*TriggersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTriggers not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1287,6 +1402,24 @@ func _Query_IsWhitelisted_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Query/GetTriggers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTriggers(ctx, req.(*TriggersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sifnode.margin.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1327,6 +1460,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsWhitelisted",
 			Handler:    _Query_IsWhitelisted_Handler,
 		},
+		{
+			MethodName: "GetTriggers",
+			Handler:    _Query_GetTriggers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sifnode/margin/v1/query.proto",
@@ -1989,6 +2126,90 @@ func (m *IsWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mtps) > 0 {
+		for iNdEx := len(m.Mtps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mtps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2265,6 +2486,38 @@ func (m *IsWhitelistedResponse) Size() (n int) {
 	return n
 }
 
+func (m *TriggersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TriggersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mtps) > 0 {
+		for _, e := range m.Mtps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3953,6 +4206,212 @@ func (m *IsWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TriggersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mtps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mtps = append(m.Mtps, &MTP{})
+			if err := m.Mtps[len(m.Mtps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
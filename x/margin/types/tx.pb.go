@@ -36,6 +36,8 @@ type MsgOpen struct {
 	BorrowAsset      string                                  `protobuf:"bytes,4,opt,name=borrow_asset,json=borrowAsset,proto3" json:"borrow_asset,omitempty"`
 	Position         Position                                `protobuf:"varint,5,opt,name=position,proto3,enum=sifnode.margin.v1.Position" json:"position,omitempty"`
 	Leverage         github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,6,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	StopLossPrice    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_loss_price,omitempty"`
+	TakeProfitPrice  *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_profit_price,omitempty"`
 }
 
 func (m *MsgOpen) Reset()         { *m = MsgOpen{} }
@@ -223,6 +225,365 @@ func (m *MsgCloseResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCloseResponse proto.InternalMessageInfo
 
+type MsgPartialClose struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// amount is the portion of the custody to close, expressed in the custody asset
+	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+}
+
+func (m *MsgPartialClose) Reset()         { *m = MsgPartialClose{} }
+func (m *MsgPartialClose) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialClose) ProtoMessage()    {}
+func (*MsgPartialClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{4}
+}
+func (m *MsgPartialClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialClose.Merge(m, src)
+}
+func (m *MsgPartialClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialClose proto.InternalMessageInfo
+
+func (m *MsgPartialClose) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgPartialClose) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgPartialCloseResponse struct {
+}
+
+func (m *MsgPartialCloseResponse) Reset()         { *m = MsgPartialCloseResponse{} }
+func (m *MsgPartialCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialCloseResponse) ProtoMessage()    {}
+func (*MsgPartialCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{5}
+}
+func (m *MsgPartialCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialCloseResponse.Merge(m, src)
+}
+func (m *MsgPartialCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialCloseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialCloseResponse proto.InternalMessageInfo
+
+type MsgAddCollateral struct {
+	Signer string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+}
+
+func (m *MsgAddCollateral) Reset()         { *m = MsgAddCollateral{} }
+func (m *MsgAddCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCollateral) ProtoMessage()    {}
+func (*MsgAddCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{6}
+}
+func (m *MsgAddCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCollateral.Merge(m, src)
+}
+func (m *MsgAddCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCollateral proto.InternalMessageInfo
+
+func (m *MsgAddCollateral) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddCollateral) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgAddCollateralResponse struct {
+}
+
+func (m *MsgAddCollateralResponse) Reset()         { *m = MsgAddCollateralResponse{} }
+func (m *MsgAddCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCollateralResponse) ProtoMessage()    {}
+func (*MsgAddCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{7}
+}
+func (m *MsgAddCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCollateralResponse.Merge(m, src)
+}
+func (m *MsgAddCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCollateralResponse proto.InternalMessageInfo
+
+type MsgWithdrawCollateral struct {
+	Signer string                                  `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64                                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
+}
+
+func (m *MsgWithdrawCollateral) Reset()         { *m = MsgWithdrawCollateral{} }
+func (m *MsgWithdrawCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCollateral) ProtoMessage()    {}
+func (*MsgWithdrawCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{8}
+}
+func (m *MsgWithdrawCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCollateral.Merge(m, src)
+}
+func (m *MsgWithdrawCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawCollateral proto.InternalMessageInfo
+
+func (m *MsgWithdrawCollateral) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgWithdrawCollateral) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgWithdrawCollateralResponse struct {
+}
+
+func (m *MsgWithdrawCollateralResponse) Reset()         { *m = MsgWithdrawCollateralResponse{} }
+func (m *MsgWithdrawCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawCollateralResponse) ProtoMessage()    {}
+func (*MsgWithdrawCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{9}
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawCollateralResponse.Merge(m, src)
+}
+func (m *MsgWithdrawCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawCollateralResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawCollateralResponse proto.InternalMessageInfo
+
+type MsgUpdateTriggers struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// a nil price clears the corresponding trigger
+	StopLossPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_loss_price,omitempty"`
+	TakeProfitPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_profit_price,omitempty"`
+}
+
+func (m *MsgUpdateTriggers) Reset()         { *m = MsgUpdateTriggers{} }
+func (m *MsgUpdateTriggers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTriggers) ProtoMessage()    {}
+func (*MsgUpdateTriggers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{10}
+}
+func (m *MsgUpdateTriggers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTriggers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTriggers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTriggers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTriggers.Merge(m, src)
+}
+func (m *MsgUpdateTriggers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTriggers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTriggers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTriggers proto.InternalMessageInfo
+
+func (m *MsgUpdateTriggers) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgUpdateTriggers) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgUpdateTriggersResponse struct {
+}
+
+func (m *MsgUpdateTriggersResponse) Reset()         { *m = MsgUpdateTriggersResponse{} }
+func (m *MsgUpdateTriggersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTriggersResponse) ProtoMessage()    {}
+func (*MsgUpdateTriggersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd3bc05d7e781ea, []int{11}
+}
+func (m *MsgUpdateTriggersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTriggersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTriggersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateTriggersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTriggersResponse.Merge(m, src)
+}
+func (m *MsgUpdateTriggersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTriggersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTriggersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTriggersResponse proto.InternalMessageInfo
+
 type MsgForceClose struct {
 	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	MtpAddress string `protobuf:"bytes,2,opt,name=mtp_address,json=mtpAddress,proto3" json:"mtp_address,omitempty"`
@@ -233,7 +594,7 @@ func (m *MsgForceClose) Reset()         { *m = MsgForceClose{} }
 func (m *MsgForceClose) String() string { return proto.CompactTextString(m) }
 func (*MsgForceClose) ProtoMessage()    {}
 func (*MsgForceClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{4}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{12}
 }
 func (m *MsgForceClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +651,7 @@ func (m *MsgForceCloseResponse) Reset()         { *m = MsgForceCloseResponse{} }
 func (m *MsgForceCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgForceCloseResponse) ProtoMessage()    {}
 func (*MsgForceCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{5}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{13}
 }
 func (m *MsgForceCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -328,7 +689,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{6}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{14}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +739,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{7}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{15}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,7 +778,7 @@ func (m *MsgUpdatePools) Reset()         { *m = MsgUpdatePools{} }
 func (m *MsgUpdatePools) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePools) ProtoMessage()    {}
 func (*MsgUpdatePools) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{8}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{16}
 }
 func (m *MsgUpdatePools) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +835,7 @@ func (m *MsgUpdatePoolsResponse) Reset()         { *m = MsgUpdatePoolsResponse{}
 func (m *MsgUpdatePoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdatePoolsResponse) ProtoMessage()    {}
 func (*MsgUpdatePoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{9}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{17}
 }
 func (m *MsgUpdatePoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +873,7 @@ func (m *MsgUpdateRowanCollateral) Reset()         { *m = MsgUpdateRowanCollater
 func (m *MsgUpdateRowanCollateral) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRowanCollateral) ProtoMessage()    {}
 func (*MsgUpdateRowanCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{10}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{18}
 }
 func (m *MsgUpdateRowanCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,7 +923,7 @@ func (m *MsgUpdateRowanCollateralResponse) Reset()         { *m = MsgUpdateRowan
 func (m *MsgUpdateRowanCollateralResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRowanCollateralResponse) ProtoMessage()    {}
 func (*MsgUpdateRowanCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{11}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{19}
 }
 func (m *MsgUpdateRowanCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -600,7 +961,7 @@ func (m *MsgWhitelist) Reset()         { *m = MsgWhitelist{} }
 func (m *MsgWhitelist) String() string { return proto.CompactTextString(m) }
 func (*MsgWhitelist) ProtoMessage()    {}
 func (*MsgWhitelist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{12}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{20}
 }
 func (m *MsgWhitelist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +1011,7 @@ func (m *MsgWhitelistResponse) Reset()         { *m = MsgWhitelistResponse{} }
 func (m *MsgWhitelistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWhitelistResponse) ProtoMessage()    {}
 func (*MsgWhitelistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{13}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{21}
 }
 func (m *MsgWhitelistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +1049,7 @@ func (m *MsgDewhitelist) Reset()         { *m = MsgDewhitelist{} }
 func (m *MsgDewhitelist) String() string { return proto.CompactTextString(m) }
 func (*MsgDewhitelist) ProtoMessage()    {}
 func (*MsgDewhitelist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{14}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{22}
 }
 func (m *MsgDewhitelist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -738,7 +1099,7 @@ func (m *MsgDewhitelistResponse) Reset()         { *m = MsgDewhitelistResponse{}
 func (m *MsgDewhitelistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDewhitelistResponse) ProtoMessage()    {}
 func (*MsgDewhitelistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{15}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{23}
 }
 func (m *MsgDewhitelistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,7 +1137,7 @@ func (m *MsgAdminCloseAll) Reset()         { *m = MsgAdminCloseAll{} }
 func (m *MsgAdminCloseAll) String() string { return proto.CompactTextString(m) }
 func (*MsgAdminCloseAll) ProtoMessage()    {}
 func (*MsgAdminCloseAll) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{16}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{24}
 }
 func (m *MsgAdminCloseAll) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -826,7 +1187,7 @@ func (m *MsgAdminCloseAllResponse) Reset()         { *m = MsgAdminCloseAllRespon
 func (m *MsgAdminCloseAllResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAdminCloseAllResponse) ProtoMessage()    {}
 func (*MsgAdminCloseAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{17}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{25}
 }
 func (m *MsgAdminCloseAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +1227,7 @@ func (m *MsgAdminClose) Reset()         { *m = MsgAdminClose{} }
 func (m *MsgAdminClose) String() string { return proto.CompactTextString(m) }
 func (*MsgAdminClose) ProtoMessage()    {}
 func (*MsgAdminClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{18}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{26}
 }
 func (m *MsgAdminClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1291,7 @@ func (m *MsgAdminCloseResponse) Reset()         { *m = MsgAdminCloseResponse{} }
 func (m *MsgAdminCloseResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAdminCloseResponse) ProtoMessage()    {}
 func (*MsgAdminCloseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd3bc05d7e781ea, []int{19}
+	return fileDescriptor_4dd3bc05d7e781ea, []int{27}
 }
 func (m *MsgAdminCloseResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,6 +1325,14 @@ func init() {
 	proto.RegisterType((*MsgOpenResponse)(nil), "sifnode.margin.v1.MsgOpenResponse")
 	proto.RegisterType((*MsgClose)(nil), "sifnode.margin.v1.MsgClose")
 	proto.RegisterType((*MsgCloseResponse)(nil), "sifnode.margin.v1.MsgCloseResponse")
+	proto.RegisterType((*MsgPartialClose)(nil), "sifnode.margin.v1.MsgPartialClose")
+	proto.RegisterType((*MsgPartialCloseResponse)(nil), "sifnode.margin.v1.MsgPartialCloseResponse")
+	proto.RegisterType((*MsgAddCollateral)(nil), "sifnode.margin.v1.MsgAddCollateral")
+	proto.RegisterType((*MsgAddCollateralResponse)(nil), "sifnode.margin.v1.MsgAddCollateralResponse")
+	proto.RegisterType((*MsgWithdrawCollateral)(nil), "sifnode.margin.v1.MsgWithdrawCollateral")
+	proto.RegisterType((*MsgWithdrawCollateralResponse)(nil), "sifnode.margin.v1.MsgWithdrawCollateralResponse")
+	proto.RegisterType((*MsgUpdateTriggers)(nil), "sifnode.margin.v1.MsgUpdateTriggers")
+	proto.RegisterType((*MsgUpdateTriggersResponse)(nil), "sifnode.margin.v1.MsgUpdateTriggersResponse")
 	proto.RegisterType((*MsgForceClose)(nil), "sifnode.margin.v1.MsgForceClose")
 	proto.RegisterType((*MsgForceCloseResponse)(nil), "sifnode.margin.v1.MsgForceCloseResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "sifnode.margin.v1.MsgUpdateParams")
@@ -985,60 +1354,73 @@ func init() {
 func init() { proto.RegisterFile("sifnode/margin/v1/tx.proto", fileDescriptor_4dd3bc05d7e781ea) }
 
 var fileDescriptor_4dd3bc05d7e781ea = []byte{
-	// 841 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x4e, 0xeb, 0x46,
-	0x14, 0xce, 0x1f, 0x21, 0x9c, 0x40, 0x00, 0x17, 0x82, 0x31, 0x6a, 0x12, 0x8c, 0x54, 0x02, 0xa8,
-	0xb1, 0x08, 0x8b, 0x76, 0x1b, 0xa0, 0xa8, 0x6a, 0x15, 0x15, 0xb9, 0x45, 0xb4, 0x88, 0x2a, 0x75,
-	0xec, 0xc1, 0x58, 0xd8, 0x1e, 0xcb, 0xe3, 0x10, 0xaa, 0xee, 0xfa, 0x04, 0x7d, 0x93, 0xbe, 0x06,
-	0x4b, 0xd4, 0x55, 0x75, 0x17, 0xe8, 0x0a, 0x5e, 0xe4, 0xca, 0x63, 0xc7, 0x9e, 0x80, 0x9d, 0x70,
-	0x25, 0x56, 0x90, 0x73, 0xbe, 0xf9, 0xbe, 0x6f, 0x8e, 0xcf, 0x39, 0x1a, 0x10, 0x88, 0x71, 0x65,
-	0x63, 0x0d, 0x49, 0x96, 0xe2, 0xea, 0x86, 0x2d, 0xdd, 0xee, 0x4b, 0xde, 0x5d, 0xcb, 0x71, 0xb1,
-	0x87, 0xb9, 0xe5, 0x30, 0xd7, 0x0a, 0x72, 0xad, 0xdb, 0x7d, 0x61, 0x45, 0xc7, 0x3a, 0xa6, 0x59,
-	0xc9, 0xff, 0x2f, 0x00, 0x0a, 0x5f, 0x26, 0x90, 0xfc, 0xe9, 0x20, 0x12, 0xa4, 0xc5, 0xff, 0x72,
-	0x30, 0xdb, 0x25, 0xfa, 0x4f, 0x0e, 0xb2, 0xb9, 0x2a, 0x14, 0x89, 0xa1, 0xdb, 0xc8, 0xe5, 0xb3,
-	0x8d, 0x6c, 0x73, 0x4e, 0x0e, 0x7f, 0x71, 0x3b, 0xb0, 0xa4, 0x62, 0xd3, 0x54, 0x3c, 0xe4, 0x2a,
-	0x66, 0x4f, 0x21, 0x04, 0x79, 0x7c, 0x8e, 0x22, 0x16, 0xe3, 0x78, 0xc7, 0x0f, 0x73, 0x97, 0xb0,
-	0xcc, 0x42, 0x2d, 0x3c, 0xb0, 0x3d, 0x3e, 0xef, 0x63, 0x0f, 0xa5, 0xfb, 0xc7, 0x7a, 0xe6, 0xc3,
-	0x63, 0x7d, 0x5b, 0x37, 0xbc, 0xeb, 0x41, 0xbf, 0xa5, 0x62, 0x4b, 0x52, 0x31, 0xb1, 0x30, 0x09,
-	0xff, 0x7c, 0x4d, 0xb4, 0x9b, 0xd0, 0xdb, 0x99, 0x61, 0x7b, 0x32, 0x23, 0xda, 0xa1, 0x44, 0xdc,
-	0x26, 0xcc, 0xf7, 0xb1, 0xeb, 0xe2, 0x61, 0x68, 0xa2, 0x40, 0x4d, 0x94, 0x83, 0x58, 0x60, 0xe0,
-	0x1b, 0x28, 0x39, 0x98, 0x18, 0x9e, 0x81, 0x6d, 0x7e, 0xa6, 0x91, 0x6d, 0x56, 0xda, 0x1b, 0xad,
-	0x57, 0xa5, 0x6a, 0x9d, 0x86, 0x10, 0x39, 0x02, 0x73, 0x3f, 0x40, 0xc9, 0x44, 0xb7, 0xc8, 0x55,
-	0x74, 0xc4, 0x17, 0xa9, 0xe1, 0x56, 0x68, 0xf8, 0xab, 0x37, 0x18, 0x3e, 0x46, 0xaa, 0x1c, 0x9d,
-	0x17, 0x97, 0x61, 0x31, 0xac, 0xa9, 0x8c, 0x88, 0x83, 0x6d, 0x82, 0xc4, 0x36, 0x94, 0xba, 0x44,
-	0x3f, 0x32, 0x31, 0x41, 0xa9, 0x75, 0xae, 0x40, 0xce, 0xd0, 0x68, 0x65, 0x0b, 0x72, 0xce, 0xd0,
-	0x44, 0x0e, 0x96, 0x46, 0x67, 0x22, 0x9e, 0x5f, 0x61, 0xa1, 0x4b, 0xf4, 0x13, 0xec, 0xaa, 0x68,
-	0x32, 0x59, 0x1d, 0xca, 0x96, 0xe7, 0xf4, 0x14, 0x4d, 0x73, 0x11, 0x21, 0xe1, 0xf7, 0x02, 0xcb,
-	0x73, 0x3a, 0x41, 0x24, 0x54, 0xcb, 0x47, 0x6a, 0x6b, 0xb0, 0x3a, 0xc6, 0x1c, 0x49, 0x5e, 0xd2,
-	0xdb, 0x9c, 0x39, 0x9a, 0xe2, 0xa1, 0x53, 0xc5, 0x55, 0x2c, 0x92, 0x2a, 0xba, 0x0f, 0x45, 0x87,
-	0x22, 0xa8, 0x5e, 0xb9, 0xbd, 0x9e, 0x54, 0x7b, 0x0a, 0x90, 0x43, 0xa0, 0xb8, 0x0e, 0x6b, 0x2f,
-	0xd8, 0x23, 0x61, 0x05, 0x2a, 0x71, 0x0a, 0x63, 0x33, 0x5d, 0x77, 0x05, 0x66, 0x1c, 0x1f, 0xc0,
-	0xe7, 0x1a, 0xf9, 0xe6, 0x9c, 0x1c, 0xfc, 0xf0, 0xdb, 0x45, 0xf5, 0x6f, 0xa2, 0xf5, 0x82, 0x64,
-	0x9e, 0x26, 0xcb, 0x41, 0x8c, 0x12, 0x8a, 0x3c, 0x54, 0xc7, 0x25, 0x22, 0x71, 0x13, 0xf8, 0x28,
-	0x23, 0xe3, 0xa1, 0x62, 0x1f, 0x45, 0xdd, 0x98, 0x6a, 0xe3, 0x5b, 0xe0, 0x5d, 0x1f, 0xda, 0x63,
-	0x66, 0x00, 0xd9, 0x4a, 0xdf, 0x44, 0xc1, 0x67, 0x2d, 0xc9, 0x55, 0x77, 0x9c, 0xea, 0xbb, 0x20,
-	0x2b, 0x8a, 0xd0, 0x48, 0x53, 0x8b, 0x1c, 0x9d, 0xc3, 0x7c, 0x97, 0xe8, 0xe7, 0xd7, 0x86, 0x87,
-	0x4c, 0x83, 0x78, 0xa9, 0x2e, 0x24, 0xf8, 0x62, 0x38, 0x02, 0x21, 0xed, 0x45, 0x07, 0x70, 0x4c,
-	0x2a, 0xec, 0x04, 0xb1, 0x0a, 0x2b, 0x2c, 0x71, 0x24, 0xf8, 0x1b, 0xad, 0xff, 0x31, 0x1a, 0xbe,
-	0xbf, 0x64, 0x50, 0x77, 0x86, 0x3a, 0x12, 0xfd, 0x85, 0x36, 0x7d, 0x47, 0xb3, 0x0c, 0x9b, 0xb6,
-	0x61, 0xc7, 0x4c, 0xaf, 0x77, 0x13, 0x96, 0x3c, 0xe5, 0x06, 0xf5, 0x82, 0xe6, 0xea, 0x5d, 0x0d,
-	0xec, 0x51, 0x9d, 0x2b, 0x7e, 0xbc, 0x4b, 0xc3, 0x27, 0x03, 0x5b, 0x13, 0x05, 0xfa, 0x35, 0xc7,
-	0x58, 0x23, 0xc5, 0xbf, 0xb3, 0x74, 0xa6, 0xe2, 0xe4, 0xbb, 0xcd, 0x54, 0xa2, 0xc1, 0x42, 0xa2,
-	0xc1, 0x60, 0xfa, 0x62, 0x0f, 0x23, 0x77, 0xed, 0x7f, 0x67, 0x21, 0xdf, 0x25, 0x3a, 0xf7, 0x3d,
-	0x14, 0xe8, 0x92, 0x16, 0x12, 0x46, 0x2a, 0x5c, 0x36, 0x82, 0x98, 0x9e, 0x8b, 0x6e, 0x9b, 0xe1,
-	0x7e, 0x84, 0x99, 0xe0, 0x9a, 0x1b, 0xc9, 0x70, 0x9a, 0x14, 0xb6, 0x26, 0x24, 0x19, 0xb2, 0x0b,
-	0x00, 0x66, 0x19, 0x35, 0x92, 0x0f, 0xc5, 0x08, 0xa1, 0x39, 0x0d, 0xc1, 0x70, 0xff, 0x01, 0xf3,
-	0x63, 0x5b, 0x27, 0xe5, 0x7a, 0x2c, 0x46, 0xd8, 0x9d, 0x8e, 0x61, 0x14, 0x7e, 0x87, 0x32, 0xbb,
-	0x5e, 0x36, 0x27, 0x1e, 0xf6, 0x21, 0xc2, 0xce, 0x54, 0x08, 0x43, 0xff, 0x17, 0xac, 0x26, 0x2f,
-	0x90, 0xbd, 0x49, 0x2c, 0x2f, 0xc0, 0xc2, 0xc1, 0x67, 0x80, 0x19, 0xf1, 0x73, 0x98, 0x8b, 0x77,
-	0x45, 0x3d, 0x99, 0x23, 0x02, 0x08, 0xdb, 0x53, 0x00, 0xe3, 0x45, 0x63, 0x77, 0x42, 0x4a, 0xd1,
-	0x18, 0x48, 0x5a, 0xd1, 0x92, 0xc6, 0x9f, 0x76, 0x14, 0x33, 0x8a, 0x29, 0x1d, 0x15, 0x23, 0xd2,
-	0x3a, 0xea, 0xf5, 0x28, 0x89, 0x19, 0x4e, 0x85, 0x85, 0xf1, 0xcd, 0xb2, 0x35, 0xed, 0x70, 0xc7,
-	0x34, 0x85, 0xbd, 0x37, 0x80, 0x62, 0x91, 0xc3, 0xe3, 0xfb, 0xa7, 0x5a, 0xf6, 0xe1, 0xa9, 0x96,
-	0xfd, 0xf8, 0x54, 0xcb, 0xfe, 0xf3, 0x5c, 0xcb, 0x3c, 0x3c, 0xd7, 0x32, 0xff, 0x3f, 0xd7, 0x32,
-	0x17, 0xbb, 0xcc, 0x4b, 0xe2, 0x67, 0xe3, 0x4a, 0xbd, 0x56, 0x0c, 0x5b, 0x1a, 0xbd, 0xcf, 0xee,
-	0x46, 0x2f, 0x34, 0xfa, 0xa2, 0xe8, 0x17, 0xe9, 0xfb, 0xec, 0xe0, 0x53, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x42, 0x95, 0xda, 0xcf, 0x05, 0x0a, 0x00, 0x00,
+	// 1047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x5f, 0x6f, 0xdb, 0xd4,
+	0x1b, 0x8e, 0x9b, 0x34, 0x4b, 0xdf, 0xb4, 0x49, 0xe3, 0x5f, 0xdb, 0xb9, 0xae, 0x96, 0x64, 0xde,
+	0x4f, 0x2c, 0xed, 0x20, 0xa1, 0xdd, 0x05, 0xdc, 0x66, 0x2b, 0x03, 0x01, 0x11, 0x91, 0xd9, 0x28,
+	0x54, 0x43, 0xc1, 0x8d, 0x4f, 0x5c, 0x6b, 0x8e, 0x8f, 0x75, 0x8e, 0xdb, 0x0c, 0x71, 0x07, 0x12,
+	0xe2, 0x92, 0x8f, 0xb5, 0xcb, 0x89, 0x2b, 0xc4, 0xc5, 0x84, 0xda, 0x0f, 0xc0, 0x57, 0x40, 0x3e,
+	0x76, 0x4e, 0x8e, 0x53, 0x3b, 0x49, 0xa1, 0xc0, 0xd5, 0x96, 0xf3, 0x3e, 0xe7, 0x79, 0x1e, 0xbf,
+	0x7d, 0xff, 0xd8, 0xa0, 0x52, 0x7b, 0xe0, 0x62, 0x13, 0xb5, 0x86, 0x06, 0xb1, 0x6c, 0xb7, 0x75,
+	0xbe, 0xdf, 0xf2, 0x5f, 0x36, 0x3d, 0x82, 0x7d, 0x2c, 0x57, 0xa2, 0x58, 0x33, 0x8c, 0x35, 0xcf,
+	0xf7, 0xd5, 0x0d, 0x0b, 0x5b, 0x98, 0x45, 0x5b, 0xc1, 0xff, 0x42, 0xa0, 0x7a, 0x27, 0x81, 0xe4,
+	0x5b, 0x0f, 0xd1, 0x30, 0xac, 0xfd, 0x98, 0x83, 0x5b, 0x1d, 0x6a, 0x7d, 0xe6, 0x21, 0x57, 0xde,
+	0x82, 0x3c, 0xb5, 0x2d, 0x17, 0x11, 0x45, 0xaa, 0x4b, 0x8d, 0x15, 0x3d, 0xfa, 0x25, 0xef, 0xc2,
+	0x7a, 0x1f, 0x3b, 0x8e, 0xe1, 0x23, 0x62, 0x38, 0x3d, 0x83, 0x52, 0xe4, 0x2b, 0x4b, 0x0c, 0x51,
+	0x9e, 0x9c, 0xb7, 0x83, 0x63, 0xf9, 0x39, 0x54, 0x44, 0xe8, 0x10, 0x9f, 0xb9, 0xbe, 0x92, 0x0d,
+	0xb0, 0x8f, 0x5a, 0xaf, 0xde, 0xd4, 0x32, 0xbf, 0xbd, 0xa9, 0xdd, 0xb7, 0x6c, 0xff, 0xf4, 0xec,
+	0xa4, 0xd9, 0xc7, 0xc3, 0x56, 0x1f, 0xd3, 0x21, 0xa6, 0xd1, 0x3f, 0xef, 0x50, 0xf3, 0x45, 0xe4,
+	0xed, 0x99, 0xed, 0xfa, 0xba, 0x20, 0xda, 0x66, 0x44, 0xf2, 0x5d, 0x58, 0x3d, 0xc1, 0x84, 0xe0,
+	0x51, 0x64, 0x22, 0xc7, 0x4c, 0x14, 0xc3, 0xb3, 0xd0, 0xc0, 0x7b, 0x50, 0xf0, 0x30, 0xb5, 0x7d,
+	0x1b, 0xbb, 0xca, 0x72, 0x5d, 0x6a, 0x94, 0x0e, 0x76, 0x9a, 0x57, 0x52, 0xd5, 0xec, 0x46, 0x10,
+	0x9d, 0x83, 0xe5, 0x8f, 0xa1, 0xe0, 0xa0, 0x73, 0x44, 0x0c, 0x0b, 0x29, 0x79, 0x66, 0xb8, 0x19,
+	0x19, 0x7e, 0x6b, 0x01, 0xc3, 0x87, 0xa8, 0xaf, 0xf3, 0xfb, 0xf2, 0x17, 0x50, 0xa6, 0x3e, 0xf6,
+	0x7a, 0x0e, 0xa6, 0xb4, 0xe7, 0x11, 0xbb, 0x8f, 0x94, 0x5b, 0x9c, 0x52, 0xba, 0x06, 0xe5, 0x5a,
+	0x40, 0xf3, 0x29, 0xa6, 0xb4, 0x1b, 0x90, 0xc8, 0xc7, 0x50, 0xf1, 0x8d, 0x17, 0xa8, 0xe7, 0x11,
+	0x3c, 0xb0, 0xfd, 0x88, 0xb9, 0xf0, 0x97, 0x98, 0xcb, 0x01, 0x51, 0x97, 0xf1, 0x30, 0x6e, 0xad,
+	0x02, 0xe5, 0xa8, 0x0e, 0x74, 0x44, 0x3d, 0xec, 0x52, 0xa4, 0x1d, 0x40, 0xa1, 0x43, 0xad, 0xc7,
+	0x0e, 0xa6, 0x28, 0xb5, 0x36, 0x4a, 0xb0, 0x64, 0x9b, 0xac, 0x1a, 0x72, 0xfa, 0x92, 0x6d, 0x6a,
+	0x32, 0xac, 0x8f, 0xef, 0x70, 0x9e, 0xef, 0x25, 0xc6, 0xdd, 0x35, 0x88, 0x6f, 0x1b, 0xce, 0xb5,
+	0xf8, 0xe4, 0x0f, 0x21, 0xff, 0xf7, 0xaa, 0x28, 0xba, 0xae, 0x6d, 0xc3, 0xed, 0x29, 0x0f, 0xdc,
+	0xdf, 0x0f, 0x12, 0x33, 0xdd, 0x36, 0xcd, 0xc7, 0xbc, 0xe2, 0xfe, 0x7d, 0x83, 0x2a, 0x28, 0xd3,
+	0x26, 0xb8, 0xc3, 0x9f, 0x24, 0xd8, 0xec, 0x50, 0xeb, 0xc8, 0xf6, 0x4f, 0x4d, 0x62, 0x8c, 0xfe,
+	0x4b, 0x9b, 0x35, 0xb8, 0x93, 0xe8, 0x84, 0x7b, 0xfd, 0x43, 0x82, 0x4a, 0x87, 0x5a, 0xcf, 0x3c,
+	0xd3, 0xf0, 0xd1, 0x53, 0x62, 0x5b, 0x16, 0x22, 0x74, 0x61, 0x9f, 0x09, 0xad, 0x93, 0xfd, 0xc7,
+	0x5a, 0x27, 0x77, 0x33, 0xad, 0xb3, 0x03, 0xdb, 0x57, 0x1e, 0x98, 0xa7, 0xe3, 0x4b, 0x58, 0xeb,
+	0x50, 0xeb, 0x09, 0x26, 0x7d, 0x34, 0xbb, 0xf2, 0x6b, 0x50, 0x1c, 0xfa, 0x5e, 0xcf, 0x30, 0x4d,
+	0x82, 0x28, 0x8d, 0x06, 0x2c, 0x0c, 0x7d, 0xaf, 0x1d, 0x9e, 0x44, 0xa9, 0xca, 0xf2, 0x56, 0xbb,
+	0x0d, 0x9b, 0x31, 0x66, 0x2e, 0xf9, 0x1c, 0xca, 0xdc, 0x4f, 0xd7, 0x20, 0xc6, 0x30, 0x3d, 0xfd,
+	0xfb, 0x90, 0xf7, 0x18, 0x82, 0xe9, 0x15, 0x0f, 0xb6, 0x93, 0x86, 0x25, 0x03, 0xe8, 0x11, 0x30,
+	0x6a, 0x24, 0x91, 0x9d, 0x0b, 0x1b, 0x50, 0x9a, 0x84, 0x30, 0x76, 0xd2, 0x75, 0x37, 0x60, 0xd9,
+	0x0b, 0x00, 0xca, 0x52, 0x3d, 0xdb, 0x58, 0xd1, 0xc3, 0x1f, 0xc1, 0x7c, 0xef, 0x07, 0x4f, 0x62,
+	0xf6, 0xc2, 0x60, 0x96, 0x05, 0x8b, 0xe1, 0x19, 0x23, 0xd4, 0x14, 0xd8, 0x8a, 0x4b, 0x70, 0x71,
+	0x07, 0x14, 0x1e, 0xd1, 0xf1, 0xc8, 0x70, 0x17, 0xe8, 0x92, 0xf7, 0x41, 0x21, 0x01, 0xb4, 0x27,
+	0x2c, 0x2d, 0xe4, 0x1a, 0x27, 0x0e, 0x0a, 0x6b, 0xb2, 0xa0, 0x6f, 0x91, 0x38, 0xd5, 0x07, 0x61,
+	0x54, 0xd3, 0xa0, 0x9e, 0xa6, 0xc6, 0x1d, 0x1d, 0xc1, 0x6a, 0xd0, 0x2a, 0xa7, 0xb6, 0x8f, 0x1c,
+	0x9b, 0xfa, 0xa9, 0x2e, 0x5a, 0xf0, 0xbf, 0xd1, 0x18, 0x84, 0xcc, 0xa9, 0x0a, 0x90, 0x85, 0x50,
+	0x54, 0x09, 0xda, 0x16, 0x6c, 0x88, 0xc4, 0x5c, 0xf0, 0x2b, 0x96, 0xff, 0x43, 0x34, 0xba, 0x79,
+	0xc9, 0x30, 0xef, 0x02, 0x35, 0x17, 0x7d, 0x1a, 0x0d, 0xcf, 0xa1, 0xed, 0xb2, 0x32, 0x6c, 0x3b,
+	0xe9, 0xf9, 0x6e, 0xc0, 0x3a, 0xeb, 0xc2, 0xb0, 0xb8, 0x7a, 0x83, 0x33, 0x77, 0x9c, 0xe7, 0x52,
+	0x70, 0xde, 0x61, 0xc7, 0x4f, 0xce, 0x5c, 0x93, 0x4f, 0x43, 0x81, 0x55, 0xdc, 0x27, 0x6b, 0xb1,
+	0xe0, 0x8d, 0xf5, 0x54, 0xa2, 0xc1, 0x5c, 0xa2, 0xc1, 0xb0, 0xfb, 0x26, 0x1e, 0xc6, 0xee, 0x0e,
+	0x7e, 0x01, 0xc8, 0x76, 0xa8, 0x25, 0x7f, 0x04, 0x39, 0xf6, 0x56, 0xa5, 0x26, 0xb4, 0x54, 0xb4,
+	0x69, 0x55, 0x2d, 0x3d, 0xc6, 0x9f, 0x36, 0x23, 0x7f, 0x02, 0xcb, 0xe1, 0x63, 0xee, 0x24, 0xc3,
+	0x59, 0x50, 0xbd, 0x37, 0x23, 0x28, 0x90, 0x7d, 0x03, 0xab, 0xb1, 0x45, 0x9c, 0x62, 0x41, 0xc4,
+	0xa8, 0x7b, 0xf3, 0x31, 0x82, 0x42, 0x1f, 0xd6, 0xe2, 0xab, 0x34, 0xc5, 0x59, 0x0c, 0xa4, 0x3e,
+	0x58, 0x00, 0x24, 0x88, 0x10, 0x90, 0x13, 0xb6, 0x61, 0x23, 0x99, 0xe4, 0x2a, 0x52, 0x7d, 0x77,
+	0x51, 0xa4, 0xa0, 0x39, 0x80, 0xd2, 0xd4, 0x56, 0xfb, 0x7f, 0x32, 0x4b, 0x1c, 0xa5, 0xbe, 0xbd,
+	0x08, 0x4a, 0xd0, 0x39, 0x06, 0x10, 0xf6, 0x45, 0x3d, 0xf9, 0xf6, 0x04, 0xa1, 0x36, 0xe6, 0x21,
+	0xe2, 0x7f, 0xfe, 0xd8, 0x62, 0xd0, 0x66, 0x79, 0x0b, 0x31, 0xea, 0xde, 0x7c, 0x8c, 0xa0, 0xf0,
+	0x35, 0x14, 0xc5, 0x0d, 0x70, 0x77, 0xe6, 0xe5, 0x00, 0xa2, 0xee, 0xce, 0x85, 0x08, 0xf4, 0xdf,
+	0xc1, 0x66, 0xf2, 0x8c, 0x7f, 0x30, 0x8b, 0x65, 0x0a, 0xac, 0x3e, 0xbc, 0x06, 0x58, 0x10, 0x3f,
+	0x82, 0x95, 0xc9, 0x38, 0xaf, 0xa5, 0x94, 0xd0, 0x18, 0xa0, 0xde, 0x9f, 0x03, 0x88, 0x27, 0x4d,
+	0x1c, 0xdb, 0x29, 0x49, 0x13, 0x20, 0xea, 0xee, 0x5c, 0x48, 0xbc, 0xa2, 0x84, 0x69, 0x59, 0x4f,
+	0x6b, 0xb5, 0x31, 0x42, 0x6d, 0xcc, 0x43, 0x4c, 0xb7, 0xbb, 0x38, 0xfc, 0xef, 0xcd, 0xbb, 0xdc,
+	0x76, 0x66, 0xb4, 0x7b, 0xd2, 0xc0, 0xcf, 0x3c, 0x3a, 0x7c, 0x75, 0x51, 0x95, 0x5e, 0x5f, 0x54,
+	0xa5, 0xdf, 0x2f, 0xaa, 0xd2, 0xcf, 0x97, 0xd5, 0xcc, 0xeb, 0xcb, 0x6a, 0xe6, 0xd7, 0xcb, 0x6a,
+	0xe6, 0x78, 0x4f, 0x78, 0x6b, 0xfb, 0xdc, 0x1e, 0xf4, 0x4f, 0x0d, 0xdb, 0x6d, 0x8d, 0xbf, 0x79,
+	0x5f, 0x8e, 0xbf, 0x7a, 0xd9, 0xdb, 0xdb, 0x49, 0x9e, 0x7d, 0xf3, 0x3e, 0xfc, 0x73, 0x00, 0xcc,
+	0x5f, 0x2d, 0x1f, 0x59, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1055,6 +1437,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	Open(ctx context.Context, in *MsgOpen, opts ...grpc.CallOption) (*MsgOpenResponse, error)
 	Close(ctx context.Context, in *MsgClose, opts ...grpc.CallOption) (*MsgCloseResponse, error)
+	PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error)
+	AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*MsgAddCollateralResponse, error)
+	WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error)
+	UpdateTriggers(ctx context.Context, in *MsgUpdateTriggers, opts ...grpc.CallOption) (*MsgUpdateTriggersResponse, error)
 	ForceClose(ctx context.Context, in *MsgForceClose, opts ...grpc.CallOption) (*MsgForceCloseResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdatePools(ctx context.Context, in *MsgUpdatePools, opts ...grpc.CallOption) (*MsgUpdatePoolsResponse, error)
@@ -1091,16 +1477,52 @@ func (c *msgClient) Close(ctx context.Context, in *MsgClose, opts ...grpc.CallOp
 	return out, nil
 }
 
-func (c *msgClient) ForceClose(ctx context.Context, in *MsgForceClose, opts ...grpc.CallOption) (*MsgForceCloseResponse, error) {
-	out := new(MsgForceCloseResponse)
-	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/ForceClose", in, out, opts...)
+func (c *msgClient) PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error) {
+	out := new(MsgPartialCloseResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/PartialClose", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+func (c *msgClient) AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*MsgAddCollateralResponse, error) {
+	out := new(MsgAddCollateralResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/AddCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawCollateral(ctx context.Context, in *MsgWithdrawCollateral, opts ...grpc.CallOption) (*MsgWithdrawCollateralResponse, error) {
+	out := new(MsgWithdrawCollateralResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/WithdrawCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTriggers(ctx context.Context, in *MsgUpdateTriggers, opts ...grpc.CallOption) (*MsgUpdateTriggersResponse, error) {
+	out := new(MsgUpdateTriggersResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/UpdateTriggers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForceClose(ctx context.Context, in *MsgForceClose, opts ...grpc.CallOption) (*MsgForceCloseResponse, error) {
+	out := new(MsgForceCloseResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/ForceClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/sifnode.margin.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
@@ -1167,6 +1589,10 @@ func (c *msgClient) AdminCloseAll(ctx context.Context, in *MsgAdminCloseAll, opt
 type MsgServer interface {
 	Open(context.Context, *MsgOpen) (*MsgOpenResponse, error)
 	Close(context.Context, *MsgClose) (*MsgCloseResponse, error)
+	PartialClose(context.Context, *MsgPartialClose) (*MsgPartialCloseResponse, error)
+	AddCollateral(context.Context, *MsgAddCollateral) (*MsgAddCollateralResponse, error)
+	WithdrawCollateral(context.Context, *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error)
+	UpdateTriggers(context.Context, *MsgUpdateTriggers) (*MsgUpdateTriggersResponse, error)
 	ForceClose(context.Context, *MsgForceClose) (*MsgForceCloseResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	UpdatePools(context.Context, *MsgUpdatePools) (*MsgUpdatePoolsResponse, error)
@@ -1187,6 +1613,18 @@ func (*UnimplementedMsgServer) Open(ctx context.Context, req *MsgOpen) (*MsgOpen
 func (*UnimplementedMsgServer) Close(ctx context.Context, req *MsgClose) (*MsgCloseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
 }
+func (*UnimplementedMsgServer) PartialClose(ctx context.Context, req *MsgPartialClose) (*MsgPartialCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialClose not implemented")
+}
+func (*UnimplementedMsgServer) AddCollateral(ctx context.Context, req *MsgAddCollateral) (*MsgAddCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollateral not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawCollateral(ctx context.Context, req *MsgWithdrawCollateral) (*MsgWithdrawCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCollateral not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTriggers(ctx context.Context, req *MsgUpdateTriggers) (*MsgUpdateTriggersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTriggers not implemented")
+}
 func (*UnimplementedMsgServer) ForceClose(ctx context.Context, req *MsgForceClose) (*MsgForceCloseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceClose not implemented")
 }
@@ -1252,6 +1690,78 @@ func _Msg_Close_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_PartialClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartialClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Msg/PartialClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialClose(ctx, req.(*MsgPartialClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Msg/AddCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCollateral(ctx, req.(*MsgAddCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Msg/WithdrawCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawCollateral(ctx, req.(*MsgWithdrawCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTriggers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.margin.v1.Msg/UpdateTriggers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTriggers(ctx, req.(*MsgUpdateTriggers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_ForceClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgForceClose)
 	if err := dec(in); err != nil {
@@ -1408,6 +1918,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Close",
 			Handler:    _Msg_Close_Handler,
 		},
+		{
+			MethodName: "PartialClose",
+			Handler:    _Msg_PartialClose_Handler,
+		},
+		{
+			MethodName: "AddCollateral",
+			Handler:    _Msg_AddCollateral_Handler,
+		},
+		{
+			MethodName: "WithdrawCollateral",
+			Handler:    _Msg_WithdrawCollateral_Handler,
+		},
+		{
+			MethodName: "UpdateTriggers",
+			Handler:    _Msg_UpdateTriggers_Handler,
+		},
 		{
 			MethodName: "ForceClose",
 			Handler:    _Msg_ForceClose_Handler,
@@ -1465,6 +1991,30 @@ func (m *MsgOpen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TakeProfitPrice != nil {
+		{
+			size := m.TakeProfitPrice.Size()
+			i -= size
+			if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StopLossPrice != nil {
+		{
+			size := m.StopLossPrice.Size()
+			i -= size
+			if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size := m.Leverage.Size()
 		i -= size
@@ -1595,7 +2145,7 @@ func (m *MsgCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgForceClose) Marshal() (dAtA []byte, err error) {
+func (m *MsgPartialClose) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1605,27 +2155,30 @@ func (m *MsgForceClose) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgForceClose) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPartialClose) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgForceClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPartialClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Id != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.MtpAddress) > 0 {
-		i -= len(m.MtpAddress)
-		copy(dAtA[i:], m.MtpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MtpAddress)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -1637,7 +2190,7 @@ func (m *MsgForceClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgForceCloseResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPartialCloseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1647,12 +2200,12 @@ func (m *MsgForceCloseResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgForceCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPartialCloseResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgForceCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPartialCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1660,7 +2213,7 @@ func (m *MsgForceCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1670,27 +2223,30 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -1702,7 +2258,7 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1712,12 +2268,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1725,7 +2281,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePools) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1735,33 +2291,30 @@ func (m *MsgUpdatePools) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdatePools) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdatePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClosedPools) > 0 {
-		for iNdEx := len(m.ClosedPools) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ClosedPools[iNdEx])
-			copy(dAtA[i:], m.ClosedPools[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ClosedPools[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Pools[iNdEx])
-			copy(dAtA[i:], m.Pools[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Pools[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -1773,7 +2326,7 @@ func (m *MsgUpdatePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePoolsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1783,12 +2336,12 @@ func (m *MsgUpdatePoolsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdatePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdatePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1796,7 +2349,7 @@ func (m *MsgUpdatePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRowanCollateral) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateTriggers) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1806,24 +2359,43 @@ func (m *MsgUpdateRowanCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRowanCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateTriggers) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRowanCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateTriggers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RowanCollateralEnabled {
+	if m.TakeProfitPrice != nil {
+		{
+			size := m.TakeProfitPrice.Size()
+			i -= size
+			if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		if m.RowanCollateralEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x22
+	}
+	if m.StopLossPrice != nil {
+		{
+			size := m.StopLossPrice.Size()
+			i -= size
+			if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
 		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
@@ -1836,7 +2408,7 @@ func (m *MsgUpdateRowanCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRowanCollateralResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateTriggersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1846,12 +2418,12 @@ func (m *MsgUpdateRowanCollateralResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRowanCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateTriggersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRowanCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateTriggersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1859,7 +2431,7 @@ func (m *MsgUpdateRowanCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelist) Marshal() (dAtA []byte, err error) {
+func (m *MsgForceClose) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1869,20 +2441,25 @@ func (m *MsgWhitelist) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWhitelist) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForceClose) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForceClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WhitelistedAddress) > 0 {
-		i -= len(m.WhitelistedAddress)
-		copy(dAtA[i:], m.WhitelistedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedAddress)))
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MtpAddress) > 0 {
+		i -= len(m.MtpAddress)
+		copy(dAtA[i:], m.MtpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MtpAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1896,7 +2473,7 @@ func (m *MsgWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelistResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgForceCloseResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1906,12 +2483,12 @@ func (m *MsgWhitelistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgForceCloseResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgForceCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1919,7 +2496,7 @@ func (m *MsgWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDewhitelist) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1929,26 +2506,31 @@ func (m *MsgDewhitelist) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDewhitelist) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDewhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WhitelistedAddress) > 0 {
-		i -= len(m.WhitelistedAddress)
-		copy(dAtA[i:], m.WhitelistedAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
@@ -1956,7 +2538,7 @@ func (m *MsgDewhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDewhitelistResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1966,12 +2548,12 @@ func (m *MsgDewhitelistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDewhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDewhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1979,7 +2561,7 @@ func (m *MsgDewhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminCloseAll) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdatePools) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1989,25 +2571,33 @@ func (m *MsgAdminCloseAll) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminCloseAll) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdatePools) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminCloseAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdatePools) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TakeMarginFund {
-		i--
-		if m.TakeMarginFund {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.ClosedPools) > 0 {
+		for iNdEx := len(m.ClosedPools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ClosedPools[iNdEx])
+			copy(dAtA[i:], m.ClosedPools[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ClosedPools[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pools[iNdEx])
+			copy(dAtA[i:], m.Pools[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Pools[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -2019,7 +2609,7 @@ func (m *MsgAdminCloseAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminCloseAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdatePoolsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2029,12 +2619,12 @@ func (m *MsgAdminCloseAllResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminCloseAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdatePoolsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminCloseAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdatePoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2042,7 +2632,7 @@ func (m *MsgAdminCloseAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminClose) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateRowanCollateral) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2052,37 +2642,25 @@ func (m *MsgAdminClose) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminClose) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateRowanCollateral) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateRowanCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TakeMarginFund {
+	if m.RowanCollateralEnabled {
 		i--
-		if m.TakeMarginFund {
+		if m.RowanCollateralEnabled {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.MtpAddress) > 0 {
-		i -= len(m.MtpAddress)
-		copy(dAtA[i:], m.MtpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MtpAddress)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Signer) > 0 {
 		i -= len(m.Signer)
@@ -2094,7 +2672,7 @@ func (m *MsgAdminClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAdminCloseResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateRowanCollateralResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2104,12 +2682,12 @@ func (m *MsgAdminCloseResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAdminCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateRowanCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAdminCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateRowanCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2117,135 +2695,276 @@ func (m *MsgAdminCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgWhitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgOpen) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgWhitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CollateralAsset)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.CollateralAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.BorrowAsset)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WhitelistedAddress) > 0 {
+		i -= len(m.WhitelistedAddress)
+		copy(dAtA[i:], m.WhitelistedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Position != 0 {
-		n += 1 + sovTx(uint64(m.Position))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Leverage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgOpenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClose) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
-	}
-	return n
+func (m *MsgWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCloseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgForceClose) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MtpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+func (m *MsgDewhitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgForceCloseResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgDewhitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDewhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WhitelistedAddress) > 0 {
+		i -= len(m.WhitelistedAddress)
+		copy(dAtA[i:], m.WhitelistedAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDewhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDewhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDewhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePools) Size() (n int) {
+func (m *MsgAdminCloseAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminCloseAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminCloseAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakeMarginFund {
+		i--
+		if m.TakeMarginFund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminCloseAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminCloseAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminCloseAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TakeMarginFund {
+		i--
+		if m.TakeMarginFund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MtpAddress) > 0 {
+		i -= len(m.MtpAddress)
+		copy(dAtA[i:], m.MtpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MtpAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAdminCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAdminCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAdminCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgOpen) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2255,22 +2974,33 @@ func (m *MsgUpdatePools) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Pools) > 0 {
-		for _, s := range m.Pools {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.CollateralAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.ClosedPools) > 0 {
-		for _, s := range m.ClosedPools {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = m.CollateralAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BorrowAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTx(uint64(m.Position))
+	}
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StopLossPrice != nil {
+		l = m.StopLossPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TakeProfitPrice != nil {
+		l = m.TakeProfitPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgUpdatePoolsResponse) Size() (n int) {
+func (m *MsgOpenResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2279,7 +3009,7 @@ func (m *MsgUpdatePoolsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateRowanCollateral) Size() (n int) {
+func (m *MsgClose) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2289,13 +3019,13 @@ func (m *MsgUpdateRowanCollateral) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.RowanCollateralEnabled {
-		n += 2
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
 	}
 	return n
 }
 
-func (m *MsgUpdateRowanCollateralResponse) Size() (n int) {
+func (m *MsgCloseResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2304,7 +3034,7 @@ func (m *MsgUpdateRowanCollateralResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgWhitelist) Size() (n int) {
+func (m *MsgPartialClose) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2314,14 +3044,15 @@ func (m *MsgWhitelist) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.WhitelistedAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
 	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgWhitelistResponse) Size() (n int) {
+func (m *MsgPartialCloseResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2330,7 +3061,7 @@ func (m *MsgWhitelistResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDewhitelist) Size() (n int) {
+func (m *MsgAddCollateral) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2340,14 +3071,42 @@ func (m *MsgDewhitelist) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.WhitelistedAddress)
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgDewhitelistResponse) Size() (n int) {
+func (m *MsgWithdrawCollateralResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2356,7 +3115,7 @@ func (m *MsgDewhitelistResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgAdminCloseAll) Size() (n int) {
+func (m *MsgUpdateTriggers) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2366,13 +3125,21 @@ func (m *MsgAdminCloseAll) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.TakeMarginFund {
-		n += 2
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.StopLossPrice != nil {
+		l = m.StopLossPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TakeProfitPrice != nil {
+		l = m.TakeProfitPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAdminCloseAllResponse) Size() (n int) {
+func (m *MsgUpdateTriggersResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2381,7 +3148,7 @@ func (m *MsgAdminCloseAllResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgAdminClose) Size() (n int) {
+func (m *MsgForceClose) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2398,28 +3165,910 @@ func (m *MsgAdminClose) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovTx(uint64(m.Id))
 	}
-	if m.TakeMarginFund {
-		n += 2
+	return n
+}
+
+func (m *MsgForceCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePools) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Pools) > 0 {
+		for _, s := range m.Pools {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ClosedPools) > 0 {
+		for _, s := range m.ClosedPools {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRowanCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RowanCollateralEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgUpdateRowanCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WhitelistedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDewhitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WhitelistedAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDewhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdminCloseAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TakeMarginFund {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAdminCloseAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAdminClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MtpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.TakeMarginFund {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgAdminCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgOpen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= Position(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StopLossPrice = &v
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakeProfitPrice = &v
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgAdminCloseResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgOpen) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2442,10 +4091,10 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2481,10 +4130,10 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2494,27 +4143,14 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralAsset = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2542,13 +4178,113 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowAsset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2576,13 +4312,13 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowAsset = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Position = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2592,14 +4328,14 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Position |= Position(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2627,7 +4363,7 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2652,7 +4388,7 @@ func (m *MsgOpen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,10 +4411,10 @@ func (m *MsgOpenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2702,7 +4438,7 @@ func (m *MsgOpenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClose) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTriggers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2725,10 +4461,10 @@ func (m *MsgClose) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClose: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTriggers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClose: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTriggers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2782,6 +4518,78 @@ func (m *MsgClose) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StopLossPrice = &v
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakeProfitPrice = &v
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2803,7 +4611,7 @@ func (m *MsgClose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTriggersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2826,10 +4634,10 @@ func (m *MsgCloseResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTriggersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTriggersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
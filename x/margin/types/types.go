@@ -18,7 +18,17 @@ const (
 	RouterKey = ModuleName
 )
 
+const (
+	TriggerStopLoss   = "stop_loss"
+	TriggerTakeProfit = "take_profit"
+)
+
 func NewMTP(signer string, collateralAsset string, borrowAsset string, position Position, leverage sdk.Dec) *MTP {
+	custodyAsset, liabilitiesAsset := borrowAsset, ""
+	if position == Position_SHORT {
+		// short positions sell the borrowed asset and hold the proceeds in the collateral asset
+		custodyAsset, liabilitiesAsset = collateralAsset, borrowAsset
+	}
 	return &MTP{
 		Address:                  signer,
 		CollateralAsset:          collateralAsset,
@@ -27,11 +37,12 @@ func NewMTP(signer string, collateralAsset string, borrowAsset string, position
 		InterestPaidCollateral:   sdk.ZeroUint(),
 		InterestPaidCustody:      sdk.ZeroUint(),
 		InterestUnpaidCollateral: sdk.ZeroUint(),
-		CustodyAsset:             borrowAsset,
+		CustodyAsset:             custodyAsset,
 		CustodyAmount:            sdk.ZeroUint(),
 		Leverage:                 leverage,
 		MtpHealth:                sdk.ZeroDec(),
 		Position:                 position,
+		LiabilitiesAsset:         liabilitiesAsset,
 	}
 }
 
@@ -48,7 +59,85 @@ func (mtp MTP) Validate() error {
 	if mtp.Id == 0 {
 		return sdkerrors.Wrap(ErrMTPInvalid, "no id specified")
 	}
+	if mtp.Position == Position_SHORT && mtp.LiabilitiesAsset == "" {
+		return sdkerrors.Wrap(ErrMTPInvalid, "no liabilities asset specified")
+	}
+
+	return nil
+}
+
+// IsNilUint returns true if u was never set, sdk.Uint has no IsNil and panics on most methods when nil.
+func IsNilUint(u sdk.Uint) bool {
+	return u == sdk.Uint{}
+}
+
+// OwedAsset returns the asset the liabilities and interest of the MTP are denominated in.
+func (mtp MTP) OwedAsset() string {
+	if mtp.Position == Position_SHORT {
+		return mtp.LiabilitiesAsset
+	}
+	return mtp.CollateralAsset
+}
 
+// ExternalAsset returns the symbol of the pool the MTP borrows from.
+func (mtp MTP) ExternalAsset() string {
+	if !StringCompare(mtp.CollateralAsset, GetSettlementAsset()) {
+		return mtp.CollateralAsset
+	}
+	if mtp.Position == Position_SHORT {
+		return mtp.LiabilitiesAsset
+	}
+	return mtp.CustodyAsset
+}
+
+// HasTriggers returns true when a stop loss or take profit price is set on the MTP.
+func (mtp MTP) HasTriggers() bool {
+	return mtp.StopLossPrice != nil || mtp.TakeProfitPrice != nil
+}
+
+// gainsOnPriceIncrease returns true when the MTP holds the external asset in custody
+// and so profits from a rising external asset price.
+func (mtp MTP) gainsOnPriceIncrease() bool {
+	return !StringCompare(mtp.CustodyAsset, GetSettlementAsset())
+}
+
+func (mtp MTP) ValidateTriggers() error {
+	if err := ValidateTriggerPrice(mtp.StopLossPrice); err != nil {
+		return err
+	}
+	if err := ValidateTriggerPrice(mtp.TakeProfitPrice); err != nil {
+		return err
+	}
+	if mtp.StopLossPrice == nil || mtp.TakeProfitPrice == nil {
+		return nil
+	}
+	if mtp.gainsOnPriceIncrease() && mtp.StopLossPrice.GTE(*mtp.TakeProfitPrice) {
+		return sdkerrors.Wrap(ErrInvalidTriggerPrice, "stop loss price must be below take profit price")
+	}
+	if !mtp.gainsOnPriceIncrease() && mtp.StopLossPrice.LTE(*mtp.TakeProfitPrice) {
+		return sdkerrors.Wrap(ErrInvalidTriggerPrice, "stop loss price must be above take profit price")
+	}
+
+	return nil
+}
+
+// ReachedTrigger returns the trigger hit by the external asset spot price, or an
+// empty string when neither the stop loss nor the take profit is reached.
+func (mtp MTP) ReachedTrigger(price sdk.Dec) string {
+	gains := mtp.gainsOnPriceIncrease()
+	if mtp.StopLossPrice != nil && ((gains && price.LTE(*mtp.StopLossPrice)) || (!gains && price.GTE(*mtp.StopLossPrice))) {
+		return TriggerStopLoss
+	}
+	if mtp.TakeProfitPrice != nil && ((gains && price.GTE(*mtp.TakeProfitPrice)) || (!gains && price.LTE(*mtp.TakeProfitPrice))) {
+		return TriggerTakeProfit
+	}
+	return ""
+}
+
+func ValidateTriggerPrice(price *sdk.Dec) error {
+	if price != nil && (price.IsNil() || !price.IsPositive()) {
+		return sdkerrors.Wrap(ErrInvalidTriggerPrice, "trigger price must be positive")
+	}
 	return nil
 }
 
@@ -118,6 +118,15 @@ type Params struct {
 	IncrementalInterestPaymentEnabled        bool                                   `protobuf:"varint,20,opt,name=incremental_interest_payment_enabled,json=incrementalInterestPaymentEnabled,proto3" json:"incremental_interest_payment_enabled,omitempty"`
 	WhitelistingEnabled                      bool                                   `protobuf:"varint,21,opt,name=whitelisting_enabled,json=whitelistingEnabled,proto3" json:"whitelisting_enabled,omitempty"`
 	RowanCollateralEnabled                   bool                                   `protobuf:"varint,22,opt,name=rowan_collateral_enabled,json=rowanCollateralEnabled,proto3" json:"rowan_collateral_enabled,omitempty"`
+	// number of blocks to average pool prices over when calculating position
+	// health, the pool's swap output is used when zero
+	TwapWindow uint64 `protobuf:"varint,23,opt,name=twap_window,json=twapWindow,proto3" json:"twap_window,omitempty"`
+	// most mtps whose stop loss and take profit prices are checked in one
+	// block, trigger prices are not checked when zero
+	MaxTriggersPerBlock uint64 `protobuf:"varint,24,opt,name=max_triggers_per_block,json=maxTriggersPerBlock,proto3" json:"max_triggers_per_block,omitempty"`
+	// least rowan the collateral of an mtp must be worth to set a stop loss or
+	// take profit price on it
+	MinTriggerCollateralValue github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,25,opt,name=min_trigger_collateral_value,json=minTriggerCollateralValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_trigger_collateral_value"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -216,6 +225,20 @@ func (m *Params) GetRowanCollateralEnabled() bool {
 	return false
 }
 
+func (m *Params) GetTwapWindow() uint64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTriggersPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTriggersPerBlock
+	}
+	return 0
+}
+
 type MTP struct {
 	Address                  string                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	CollateralAsset          string                                  `protobuf:"bytes,2,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset,omitempty"`
@@ -230,6 +253,13 @@ type MTP struct {
 	MtpHealth                github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,11,opt,name=mtp_health,json=mtpHealth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mtp_health"`
 	Position                 Position                                `protobuf:"varint,12,opt,name=position,proto3,enum=sifnode.margin.v1.Position" json:"position,omitempty"`
 	Id                       uint64                                  `protobuf:"varint,13,opt,name=id,proto3" json:"id,omitempty"`
+	// liabilities_asset is the asset owed by a SHORT position, LONG positions
+	// owe the collateral asset and leave it empty.
+	LiabilitiesAsset string `protobuf:"bytes,14,opt,name=liabilities_asset,json=liabilitiesAsset,proto3" json:"liabilities_asset,omitempty"`
+	// stop_loss_price and take_profit_price are spot prices of the pool external
+	// asset at which the position is closed automatically, unset when nil.
+	StopLossPrice   *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=stop_loss_price,json=stopLossPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stop_loss_price,omitempty"`
+	TakeProfitPrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=take_profit_price,json=takeProfitPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_profit_price,omitempty"`
 }
 
 func (m *MTP) Reset()         { *m = MTP{} }
@@ -300,6 +330,13 @@ func (m *MTP) GetId() uint64 {
 	return 0
 }
 
+func (m *MTP) GetLiabilitiesAsset() string {
+	if m != nil {
+		return m.LiabilitiesAsset
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("sifnode.margin.v1.Position", Position_name, Position_value)
 	proto.RegisterType((*GenesisState)(nil), "sifnode.margin.v1.GenesisState")
@@ -310,69 +347,78 @@ func init() {
 func init() { proto.RegisterFile("sifnode/margin/v1/types.proto", fileDescriptor_b3994728d56e8650) }
 
 var fileDescriptor_b3994728d56e8650 = []byte{
-	// 985 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcd, 0x72, 0xdb, 0x36,
-	0x10, 0xc7, 0x4d, 0x7f, 0x4a, 0x2b, 0xd9, 0x96, 0x60, 0xd9, 0x65, 0x93, 0xa9, 0xa2, 0xb8, 0x5f,
-	0x4a, 0x26, 0x95, 0xea, 0xf4, 0x90, 0x5e, 0x1d, 0x7f, 0xc5, 0x9d, 0x38, 0x92, 0x29, 0xbb, 0xd3,
-	0xc9, 0x64, 0xca, 0x81, 0x49, 0x48, 0xc2, 0x94, 0x04, 0x68, 0x02, 0x72, 0xe4, 0x17, 0xe8, 0xb1,
-	0xd3, 0x7b, 0x5f, 0x28, 0xc7, 0x1c, 0x3b, 0x3d, 0x64, 0x3a, 0xf6, 0x8b, 0x74, 0x08, 0x82, 0x14,
-	0x6d, 0x37, 0x69, 0xcd, 0xf6, 0x24, 0x71, 0x77, 0xf1, 0xfb, 0xef, 0x02, 0x8b, 0x59, 0xc0, 0x27,
-	0x82, 0xf6, 0x19, 0x77, 0x49, 0xdb, 0xc7, 0xe1, 0x80, 0xb2, 0xf6, 0xd9, 0x46, 0x5b, 0x9e, 0x07,
-	0x44, 0xb4, 0x82, 0x90, 0x4b, 0x8e, 0xaa, 0xda, 0xdd, 0x8a, 0xdd, 0xad, 0xb3, 0x8d, 0x3b, 0xb5,
-	0x01, 0x1f, 0x70, 0xe5, 0x6d, 0x47, 0xff, 0xe2, 0xc0, 0xf5, 0x4d, 0x28, 0xef, 0x11, 0x46, 0x04,
-	0x15, 0x3d, 0x89, 0x25, 0x41, 0x1b, 0x30, 0x1f, 0xe0, 0x10, 0xfb, 0xc2, 0x34, 0x1a, 0x46, 0xb3,
-	0xf4, 0xf8, 0xe3, 0xd6, 0x0d, 0x52, 0xab, 0xab, 0x02, 0x2c, 0x1d, 0xb8, 0xfe, 0xf3, 0x22, 0xcc,
-	0xc7, 0x26, 0x74, 0x08, 0x65, 0x8f, 0x9c, 0x91, 0x10, 0x0f, 0x88, 0xed, 0xe3, 0xb1, 0x62, 0x14,
-	0x9f, 0xb6, 0xde, 0xbc, 0xbb, 0x37, 0xf5, 0xc7, 0xbb, 0x7b, 0x5f, 0x0c, 0xa8, 0x1c, 0x8e, 0x4e,
-	0x5a, 0x0e, 0xf7, 0xdb, 0x0e, 0x17, 0x3e, 0x17, 0xfa, 0xe7, 0x2b, 0xe1, 0xfe, 0xa4, 0xd3, 0xdf,
-	0x26, 0x8e, 0x55, 0x4a, 0x18, 0x07, 0x78, 0x8c, 0x5e, 0x42, 0x95, 0x32, 0x49, 0x42, 0x22, 0xa4,
-	0x1d, 0x62, 0x19, 0x73, 0xa7, 0x73, 0x71, 0x97, 0x13, 0x90, 0x85, 0xe5, 0x7b, 0xd8, 0x94, 0x99,
-	0x33, 0xff, 0x03, 0x9b, 0x32, 0xe4, 0xc2, 0xda, 0x55, 0x36, 0x65, 0x4e, 0x48, 0xb0, 0x20, 0xe6,
-	0x6c, 0x2e, 0x81, 0x5a, 0x56, 0x60, 0x5f, 0xb3, 0x6e, 0xaa, 0xb8, 0x44, 0xab, 0xcc, 0xfd, 0x77,
-	0x95, 0x6d, 0xcd, 0x42, 0xaf, 0x00, 0x0d, 0x09, 0xf6, 0xe4, 0xd0, 0x1e, 0x60, 0xca, 0xec, 0x3e,
-	0x76, 0x24, 0x0f, 0xcd, 0xf9, 0x5c, 0x0a, 0x95, 0x98, 0xb4, 0x87, 0x29, 0xdb, 0x55, 0x1c, 0x74,
-	0x1f, 0xca, 0x24, 0xe0, 0xce, 0xd0, 0xf6, 0x08, 0x1b, 0xc8, 0xa1, 0xb9, 0xd0, 0x30, 0x9a, 0x33,
-	0x56, 0x49, 0xd9, 0x9e, 0x2b, 0x13, 0xaa, 0xc1, 0x5c, 0xc0, 0xb9, 0x27, 0xcc, 0x42, 0x63, 0xa6,
-	0x59, 0xb4, 0xe2, 0x0f, 0xd4, 0x87, 0x8f, 0x42, 0xe2, 0xf3, 0x33, 0xec, 0xd9, 0xa7, 0x23, 0x32,
-	0x22, 0xb6, 0x1c, 0x86, 0x44, 0x0c, 0xb9, 0xe7, 0x9a, 0x90, 0x2b, 0xb7, 0x55, 0x8d, 0x3b, 0x8c,
-	0x68, 0x47, 0x09, 0x0c, 0x3d, 0x02, 0xe4, 0xe3, 0xb1, 0xcd, 0x03, 0xc2, 0xec, 0x80, 0x0b, 0x2a,
-	0x29, 0x67, 0xc2, 0x2c, 0x35, 0x8c, 0xe6, 0xac, 0x55, 0xf1, 0xf1, 0xb8, 0x13, 0x10, 0xd6, 0x4d,
-	0xec, 0xe8, 0x47, 0x58, 0x89, 0xd2, 0x8b, 0xc3, 0x27, 0x19, 0x95, 0x73, 0x65, 0x54, 0x8d, 0x50,
-	0x11, 0x7f, 0x92, 0x8d, 0x0f, 0x77, 0xfb, 0x3c, 0x74, 0x88, 0xed, 0x78, 0x5c, 0x10, 0xbb, 0x3f,
-	0x62, 0xae, 0x1d, 0x90, 0xd0, 0x21, 0x4c, 0xe2, 0x01, 0x31, 0x17, 0x73, 0xe9, 0x98, 0x0a, 0xb9,
-	0x15, 0x11, 0x77, 0x47, 0xcc, 0xed, 0xa6, 0x3c, 0xf4, 0x04, 0xcc, 0x1b, 0x72, 0xd8, 0x75, 0x43,
-	0x22, 0x84, 0xb9, 0x14, 0x69, 0x59, 0xab, 0x57, 0xd7, 0x6e, 0xc6, 0x4e, 0xf4, 0x8b, 0x01, 0x8f,
-	0x54, 0xcf, 0xfb, 0x11, 0xc9, 0xb3, 0xd3, 0x3e, 0x0d, 0xf0, 0x79, 0x64, 0xba, 0x91, 0xf9, 0x72,
-	0xae, 0xcc, 0x9b, 0x19, 0x8d, 0x7d, 0x2d, 0xd1, 0x8d, 0x15, 0xae, 0x55, 0xf2, 0x03, 0x3c, 0xf8,
-	0xe7, 0x7c, 0x92, 0xd2, 0x2a, 0xaa, 0xb4, 0xcf, 0x3f, 0x0c, 0x4f, 0x4a, 0xed, 0x40, 0x49, 0x9c,
-	0xda, 0x3e, 0x77, 0x69, 0x9f, 0x92, 0xd0, 0xac, 0xe6, 0x2a, 0x04, 0xc4, 0xe9, 0x81, 0x26, 0xa0,
-	0x1e, 0x2c, 0x0a, 0xdc, 0x27, 0xf2, 0x3c, 0xb9, 0x6b, 0x28, 0x17, 0xb2, 0x1c, 0x43, 0x26, 0xf7,
-	0x4c, 0x9d, 0xa1, 0x6b, 0xc7, 0x77, 0x69, 0x45, 0xdd, 0xa5, 0x52, 0x6c, 0xeb, 0xaa, 0x1b, 0xd5,
-	0x81, 0xcf, 0x3e, 0xb8, 0x45, 0x84, 0xe1, 0x13, 0x8f, 0xb8, 0x66, 0xad, 0x61, 0x34, 0x0b, 0xd6,
-	0xfd, 0xf7, 0xef, 0xce, 0x4e, 0x1c, 0x88, 0x36, 0xa0, 0xf6, 0x7a, 0x48, 0x25, 0xf1, 0xa8, 0x90,
-	0x94, 0x0d, 0x52, 0xc0, 0xaa, 0x02, 0xac, 0x64, 0x7d, 0xc9, 0x92, 0x6f, 0xc1, 0x0c, 0xf9, 0x6b,
-	0xcc, 0x6c, 0x87, 0x7b, 0x1e, 0x96, 0x24, 0xc4, 0x5e, 0xba, 0x6c, 0x4d, 0x2d, 0x5b, 0x53, 0xfe,
-	0xad, 0xd4, 0xad, 0x57, 0xae, 0xff, 0xb6, 0x00, 0x33, 0x07, 0x47, 0x5d, 0x64, 0xc2, 0x42, 0x72,
-	0x8c, 0x6a, 0x00, 0x59, 0xc9, 0x27, 0x7a, 0x00, 0x95, 0x0c, 0x15, 0x0b, 0x41, 0x64, 0x3c, 0x4b,
-	0xac, 0xe5, 0x89, 0x7d, 0x33, 0x32, 0xa3, 0x57, 0x50, 0xcd, 0x86, 0xfa, 0x7c, 0xc4, 0xa4, 0x9e,
-	0x0d, 0x6d, 0x7d, 0x0c, 0x5f, 0xfe, 0x8b, 0x63, 0x38, 0xa6, 0x4c, 0x5a, 0x19, 0xd1, 0x4d, 0x05,
-	0x42, 0x87, 0x50, 0xf2, 0x28, 0x3e, 0xa1, 0x1e, 0x95, 0x94, 0x08, 0x3d, 0x12, 0x6e, 0xcd, 0xcd,
-	0x32, 0x10, 0x05, 0x33, 0x73, 0x5e, 0xd4, 0xcd, 0xec, 0x9f, 0x1e, 0x06, 0xb7, 0xe6, 0xa7, 0xb3,
-	0xa5, 0x8b, 0xa9, 0x3b, 0xd9, 0x6f, 0xe4, 0xc0, 0xea, 0x35, 0xa9, 0x91, 0x90, 0xdc, 0x3d, 0xd7,
-	0x23, 0xe1, 0xd6, 0x3a, 0x2b, 0x57, 0x74, 0x62, 0x16, 0xf2, 0xe1, 0x4e, 0x2a, 0x32, 0x62, 0xd7,
-	0x2b, 0x5a, 0xc8, 0xa7, 0x94, 0x6e, 0xd1, 0xb1, 0x22, 0x66, 0x6a, 0xfa, 0x14, 0x16, 0x75, 0x15,
-	0xba, 0x2f, 0x0a, 0xaa, 0x2f, 0xca, 0xda, 0x18, 0x37, 0xc5, 0xf7, 0xb0, 0x94, 0x06, 0xc5, 0x1d,
-	0x51, 0xcc, 0x97, 0x47, 0xa2, 0xa5, 0xdb, 0xe1, 0x3b, 0x28, 0x24, 0x6f, 0x9e, 0x9c, 0xa3, 0x2b,
-	0x5d, 0x8f, 0x0e, 0x00, 0x7c, 0x19, 0xd8, 0xf1, 0x98, 0x55, 0x53, 0xea, 0xf6, 0xb4, 0xa2, 0x2f,
-	0x83, 0x67, 0x0a, 0x80, 0x9e, 0x40, 0x21, 0x99, 0x79, 0x6a, 0x86, 0x2d, 0x3d, 0xbe, 0xfb, 0x77,
-	0x4f, 0x42, 0x1d, 0x62, 0xa5, 0xc1, 0x68, 0x09, 0xa6, 0xa9, 0xab, 0xc6, 0xd1, 0xac, 0x35, 0x4d,
-	0xdd, 0x87, 0x5f, 0x43, 0x21, 0x89, 0x42, 0xcb, 0x50, 0x3a, 0x7e, 0xd1, 0xeb, 0xee, 0x6c, 0xed,
-	0xef, 0xee, 0xef, 0x6c, 0x57, 0xa6, 0x50, 0x01, 0x66, 0x9f, 0x77, 0x5e, 0xec, 0x55, 0x0c, 0x54,
-	0x84, 0xb9, 0xde, 0xb3, 0x8e, 0x75, 0x54, 0x99, 0x7e, 0xba, 0xfd, 0xe6, 0xa2, 0x6e, 0xbc, 0xbd,
-	0xa8, 0x1b, 0x7f, 0x5e, 0xd4, 0x8d, 0x5f, 0x2f, 0xeb, 0x53, 0x6f, 0x2f, 0xeb, 0x53, 0xbf, 0x5f,
-	0xd6, 0xa7, 0x5e, 0x3e, 0xcc, 0xd4, 0xd1, 0xa3, 0x7d, 0x67, 0x88, 0x29, 0x6b, 0x27, 0x2f, 0xe2,
-	0x71, 0xf2, 0x26, 0x56, 0xf5, 0x9c, 0xcc, 0xab, 0x87, 0xee, 0x37, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x06, 0x6f, 0xf0, 0x49, 0x32, 0x0b, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x5d, 0x53, 0xdb, 0x46,
+	0x17, 0xc7, 0x31, 0xaf, 0xe6, 0xd8, 0x60, 0x7b, 0x79, 0xc9, 0x92, 0x3c, 0x8f, 0x21, 0xf4, 0xcd,
+	0x49, 0x53, 0xbb, 0x24, 0x17, 0xe9, 0x2d, 0xef, 0xa1, 0x03, 0xc1, 0x08, 0x48, 0x3b, 0x99, 0x4c,
+	0x77, 0x16, 0x69, 0x6d, 0xef, 0x20, 0xed, 0x0a, 0xed, 0x1a, 0xcc, 0x97, 0xe8, 0xf4, 0x63, 0xe5,
+	0xaa, 0x93, 0xcb, 0x4e, 0x2f, 0x32, 0x1d, 0xf8, 0x1a, 0xbd, 0xe8, 0x68, 0x25, 0xd9, 0x02, 0x9a,
+	0xb4, 0x55, 0x7b, 0x05, 0x3e, 0xe7, 0xec, 0xef, 0x7f, 0xce, 0xd1, 0xd9, 0x39, 0x12, 0xfc, 0x5f,
+	0xf1, 0x96, 0x90, 0x0e, 0x6b, 0x78, 0x34, 0x68, 0x73, 0xd1, 0x38, 0x5f, 0x69, 0xe8, 0x4b, 0x9f,
+	0xa9, 0xba, 0x1f, 0x48, 0x2d, 0x51, 0x25, 0x76, 0xd7, 0x23, 0x77, 0xfd, 0x7c, 0xe5, 0xfe, 0x6c,
+	0x5b, 0xb6, 0xa5, 0xf1, 0x36, 0xc2, 0xff, 0xa2, 0xc0, 0xe5, 0x55, 0x28, 0x6e, 0x33, 0xc1, 0x14,
+	0x57, 0x87, 0x9a, 0x6a, 0x86, 0x56, 0x60, 0xdc, 0xa7, 0x01, 0xf5, 0x14, 0xce, 0x2d, 0xe5, 0x6a,
+	0x85, 0xa7, 0x0b, 0xf5, 0x3b, 0xa4, 0x7a, 0xd3, 0x04, 0x58, 0x71, 0xe0, 0xf2, 0xcf, 0xd3, 0x30,
+	0x1e, 0x99, 0xd0, 0x01, 0x14, 0x5d, 0x76, 0xce, 0x02, 0xda, 0x66, 0xc4, 0xa3, 0x3d, 0xc3, 0x98,
+	0x5c, 0xab, 0xbf, 0x7d, 0xbf, 0x38, 0xf4, 0xeb, 0xfb, 0xc5, 0xcf, 0xdb, 0x5c, 0x77, 0xba, 0x27,
+	0x75, 0x5b, 0x7a, 0x0d, 0x5b, 0x2a, 0x4f, 0xaa, 0xf8, 0xcf, 0x57, 0xca, 0x39, 0x8d, 0xd3, 0xdf,
+	0x60, 0xb6, 0x55, 0x48, 0x18, 0x7b, 0xb4, 0x87, 0x5e, 0x43, 0x85, 0x0b, 0xcd, 0x02, 0xa6, 0x34,
+	0x09, 0xa8, 0x8e, 0xb8, 0xc3, 0x99, 0xb8, 0xa5, 0x04, 0x64, 0x51, 0xfd, 0x01, 0x36, 0x17, 0x78,
+	0xe4, 0x3f, 0x60, 0x73, 0x81, 0x1c, 0x98, 0xbf, 0xc9, 0xe6, 0xc2, 0x0e, 0x18, 0x55, 0x0c, 0x8f,
+	0x66, 0x12, 0x98, 0x4d, 0x0b, 0xec, 0xc4, 0xac, 0xbb, 0x2a, 0x0e, 0x8b, 0x55, 0xc6, 0xfe, 0xbd,
+	0xca, 0x46, 0xcc, 0x42, 0x6f, 0x00, 0x75, 0x18, 0x75, 0x75, 0x87, 0xb4, 0x29, 0x17, 0xa4, 0x45,
+	0x6d, 0x2d, 0x03, 0x3c, 0x9e, 0x49, 0xa1, 0x1c, 0x91, 0xb6, 0x29, 0x17, 0x5b, 0x86, 0x83, 0x1e,
+	0x42, 0x91, 0xf9, 0xd2, 0xee, 0x10, 0x97, 0x89, 0xb6, 0xee, 0xe0, 0x89, 0xa5, 0x5c, 0x6d, 0xc4,
+	0x2a, 0x18, 0xdb, 0xae, 0x31, 0xa1, 0x59, 0x18, 0xf3, 0xa5, 0x74, 0x15, 0xce, 0x2f, 0x8d, 0xd4,
+	0x26, 0xad, 0xe8, 0x07, 0x6a, 0xc1, 0xbd, 0x80, 0x79, 0xf2, 0x9c, 0xba, 0xe4, 0xac, 0xcb, 0xba,
+	0x8c, 0xe8, 0x4e, 0xc0, 0x54, 0x47, 0xba, 0x0e, 0x86, 0x4c, 0xb9, 0xcd, 0xc5, 0xb8, 0x83, 0x90,
+	0x76, 0x94, 0xc0, 0xd0, 0x13, 0x40, 0x1e, 0xed, 0x11, 0xe9, 0x33, 0x41, 0x7c, 0xa9, 0xb8, 0xe6,
+	0x52, 0x28, 0x5c, 0x58, 0xca, 0xd5, 0x46, 0xad, 0xb2, 0x47, 0x7b, 0xfb, 0x3e, 0x13, 0xcd, 0xc4,
+	0x8e, 0x7e, 0x80, 0x99, 0x30, 0xbd, 0x28, 0x7c, 0x90, 0x51, 0x31, 0x53, 0x46, 0x95, 0x10, 0x15,
+	0xf2, 0x07, 0xd9, 0x78, 0xf0, 0xa0, 0x25, 0x03, 0x9b, 0x11, 0xdb, 0x95, 0x8a, 0x91, 0x56, 0x57,
+	0x38, 0xc4, 0x67, 0x81, 0xcd, 0x84, 0xa6, 0x6d, 0x86, 0xa7, 0x32, 0xe9, 0x60, 0x83, 0x5c, 0x0f,
+	0x89, 0x5b, 0x5d, 0xe1, 0x34, 0xfb, 0x3c, 0xf4, 0x1c, 0xf0, 0x1d, 0x39, 0xea, 0x38, 0x01, 0x53,
+	0x0a, 0x4f, 0x87, 0x5a, 0xd6, 0xdc, 0xcd, 0xb3, 0xab, 0x91, 0x13, 0xfd, 0x98, 0x83, 0x27, 0x66,
+	0xe6, 0xbd, 0x90, 0xe4, 0x92, 0xfe, 0x9c, 0xfa, 0xf4, 0x32, 0x34, 0xdd, 0xc9, 0xbc, 0x94, 0x29,
+	0xf3, 0x5a, 0x4a, 0x63, 0x27, 0x96, 0x68, 0x46, 0x0a, 0xb7, 0x2a, 0xf9, 0x1e, 0x1e, 0xfd, 0x75,
+	0x3e, 0x49, 0x69, 0x65, 0x53, 0xda, 0x67, 0x1f, 0x87, 0x27, 0xa5, 0xee, 0x43, 0x41, 0x9d, 0x11,
+	0x4f, 0x3a, 0xbc, 0xc5, 0x59, 0x80, 0x2b, 0x99, 0x0a, 0x01, 0x75, 0xb6, 0x17, 0x13, 0xd0, 0x21,
+	0x4c, 0x29, 0xda, 0x62, 0xfa, 0x32, 0xb9, 0x6b, 0x28, 0x13, 0xb2, 0x18, 0x41, 0x06, 0xf7, 0xcc,
+	0x3c, 0x43, 0x87, 0x44, 0x77, 0x69, 0xc6, 0xdc, 0xa5, 0x42, 0x64, 0x6b, 0x9a, 0x1b, 0xb5, 0x0f,
+	0x9f, 0x7e, 0xb4, 0x45, 0x4c, 0xd0, 0x13, 0x97, 0x39, 0x78, 0x76, 0x29, 0x57, 0xcb, 0x5b, 0x0f,
+	0x3f, 0xdc, 0x9d, 0xcd, 0x28, 0x10, 0xad, 0xc0, 0xec, 0x45, 0x87, 0x6b, 0xe6, 0x72, 0xa5, 0xb9,
+	0x68, 0xf7, 0x01, 0x73, 0x06, 0x30, 0x93, 0xf6, 0x25, 0x47, 0xbe, 0x01, 0x1c, 0xc8, 0x0b, 0x2a,
+	0x88, 0x2d, 0x5d, 0x97, 0x6a, 0x16, 0x50, 0xb7, 0x7f, 0x6c, 0xde, 0x1c, 0x9b, 0x37, 0xfe, 0xf5,
+	0xbe, 0x3b, 0x39, 0xb9, 0x08, 0x05, 0x7d, 0x41, 0x7d, 0x72, 0xc1, 0x85, 0x23, 0x2f, 0xf0, 0x3d,
+	0x73, 0x41, 0x21, 0x34, 0x7d, 0x67, 0x2c, 0xe8, 0x19, 0xcc, 0x87, 0x17, 0x59, 0x07, 0xbc, 0xdd,
+	0x66, 0x81, 0x0a, 0x87, 0x8f, 0x9c, 0xb8, 0xd2, 0x3e, 0xc5, 0xd8, 0xc4, 0xce, 0x78, 0xb4, 0x77,
+	0x14, 0x3b, 0x9b, 0x2c, 0x58, 0x0b, 0x5d, 0xc8, 0x87, 0xff, 0x79, 0x5c, 0x24, 0x87, 0xd2, 0x59,
+	0x9d, 0x53, 0xb7, 0xcb, 0xf0, 0x82, 0x79, 0x34, 0x8d, 0xf8, 0xd1, 0x7c, 0xf1, 0x37, 0x1e, 0xcd,
+	0x31, 0x17, 0xda, 0x5a, 0xf0, 0xb8, 0x88, 0xb5, 0x06, 0x95, 0xbc, 0x0a, 0x89, 0xcb, 0xbf, 0xe7,
+	0x61, 0x64, 0xef, 0xa8, 0x89, 0x30, 0x4c, 0x24, 0xe3, 0x68, 0x16, 0xa9, 0x95, 0xfc, 0x44, 0x8f,
+	0xa0, 0x9c, 0xca, 0x83, 0x2a, 0xc5, 0x74, 0xb4, 0x13, 0xad, 0xd2, 0xc0, 0xbe, 0x1a, 0x9a, 0xd1,
+	0x1b, 0xa8, 0xa4, 0x43, 0x3d, 0xd9, 0x15, 0x1a, 0x8f, 0x64, 0xcb, 0x39, 0x25, 0xba, 0x6a, 0x40,
+	0xe8, 0x00, 0x0a, 0x2e, 0xa7, 0x27, 0xdc, 0xe5, 0x9a, 0x33, 0x85, 0x47, 0xb3, 0x71, 0xd3, 0x0c,
+	0xc4, 0x01, 0xa7, 0xe6, 0x8e, 0x3b, 0xa9, 0x8e, 0xe3, 0xb1, 0x6c, 0xfc, 0xfe, 0x8e, 0x6c, 0x52,
+	0xee, 0x0c, 0xba, 0x8d, 0x6c, 0x98, 0xbb, 0x25, 0xd5, 0x55, 0x5a, 0x3a, 0x97, 0x78, 0x3c, 0x9b,
+	0xce, 0xcc, 0x0d, 0x9d, 0x88, 0x85, 0x3c, 0xb8, 0xdf, 0x17, 0xe9, 0x8a, 0xdb, 0x15, 0x4d, 0x64,
+	0x53, 0xea, 0xb7, 0xe8, 0x58, 0xf8, 0x37, 0x6b, 0xfa, 0x04, 0xa6, 0xe2, 0x2a, 0xe2, 0xb9, 0xc8,
+	0x9b, 0xb9, 0x28, 0xc6, 0xc6, 0x68, 0x28, 0x5e, 0xc1, 0x74, 0x3f, 0x28, 0x9a, 0x88, 0xc9, 0x6c,
+	0x79, 0x24, 0x5a, 0xf1, 0x38, 0x7c, 0x0b, 0xf9, 0xe4, 0xdd, 0x2d, 0xe3, 0x0a, 0xee, 0x9f, 0x47,
+	0x7b, 0x00, 0x9e, 0xf6, 0x49, 0xf4, 0xba, 0x80, 0x0b, 0x99, 0x68, 0x93, 0x9e, 0xf6, 0x5f, 0x18,
+	0x00, 0x7a, 0x0e, 0xf9, 0x64, 0x77, 0x9b, 0x5d, 0x3c, 0xfd, 0xf4, 0xc1, 0x9f, 0xbd, 0xda, 0xc6,
+	0x21, 0x56, 0x3f, 0x18, 0x4d, 0xc3, 0x30, 0x77, 0xcc, 0x5a, 0x1d, 0xb5, 0x86, 0xb9, 0x83, 0xbe,
+	0x84, 0x4a, 0x6a, 0x5c, 0xe3, 0x26, 0x47, 0x9b, 0xb0, 0x9c, 0x72, 0x24, 0x8d, 0x2e, 0x29, 0x2d,
+	0x7d, 0xe2, 0x4a, 0xa5, 0x88, 0x1f, 0x70, 0x3b, 0xbd, 0xe6, 0x72, 0xff, 0xa0, 0x92, 0xa9, 0x10,
+	0xb3, 0x2b, 0x95, 0x6a, 0x86, 0x90, 0xf0, 0xcd, 0x55, 0xd3, 0x53, 0x46, 0xfc, 0x40, 0xb6, 0xb8,
+	0x8e, 0xc9, 0xe5, 0x4c, 0xe4, 0x52, 0x08, 0x6a, 0x1a, 0x8e, 0x61, 0x3f, 0xfe, 0x1a, 0xf2, 0x49,
+	0x1b, 0x50, 0x09, 0x0a, 0xc7, 0x2f, 0x0f, 0x9b, 0x9b, 0xeb, 0x3b, 0x5b, 0x3b, 0x9b, 0x1b, 0xe5,
+	0x21, 0x94, 0x87, 0xd1, 0xdd, 0xfd, 0x97, 0xdb, 0xe5, 0x1c, 0x9a, 0x84, 0xb1, 0xc3, 0x17, 0xfb,
+	0xd6, 0x51, 0x79, 0x78, 0x6d, 0xe3, 0xed, 0x55, 0x35, 0xf7, 0xee, 0xaa, 0x9a, 0xfb, 0xed, 0xaa,
+	0x9a, 0xfb, 0xe9, 0xba, 0x3a, 0xf4, 0xee, 0xba, 0x3a, 0xf4, 0xcb, 0x75, 0x75, 0xe8, 0xf5, 0xe3,
+	0x54, 0x12, 0x87, 0xbc, 0x65, 0x77, 0x28, 0x17, 0x8d, 0xe4, 0xd3, 0xa5, 0x97, 0x7c, 0xbc, 0x98,
+	0x64, 0x4e, 0xc6, 0xcd, 0x17, 0xc9, 0xb3, 0x3f, 0x06, 0x00, 0x34, 0x1b, 0x5e, 0xd0, 0xdb, 0x0c,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -430,6 +476,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MinTriggerCollateralValue.Size()
+		i -= size
+		if _, err := m.MinTriggerCollateralValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.MaxTriggersPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxTriggersPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.TwapWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TwapWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
 	if m.RowanCollateralEnabled {
 		i--
 		if m.RowanCollateralEnabled {
@@ -659,6 +731,39 @@ func (m *MTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TakeProfitPrice != nil {
+		{
+			size := m.TakeProfitPrice.Size()
+			i -= size
+			if _, err := m.TakeProfitPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.StopLossPrice != nil {
+		{
+			size := m.StopLossPrice.Size()
+			i -= size
+			if _, err := m.StopLossPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.LiabilitiesAsset) > 0 {
+		i -= len(m.LiabilitiesAsset)
+		copy(dAtA[i:], m.LiabilitiesAsset)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LiabilitiesAsset)))
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.Id != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
 		i--
@@ -862,6 +967,14 @@ func (m *Params) Size() (n int) {
 	if m.RowanCollateralEnabled {
 		n += 3
 	}
+	if m.TwapWindow != 0 {
+		n += 2 + sovTypes(uint64(m.TwapWindow))
+	}
+	if m.MaxTriggersPerBlock != 0 {
+		n += 2 + sovTypes(uint64(m.MaxTriggersPerBlock))
+	}
+	l = m.MinTriggerCollateralValue.Size()
+	n += 2 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -905,6 +1018,18 @@ func (m *MTP) Size() (n int) {
 	if m.Id != 0 {
 		n += 1 + sovTypes(uint64(m.Id))
 	}
+	l = len(m.LiabilitiesAsset)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StopLossPrice != nil {
+		l = m.StopLossPrice.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TakeProfitPrice != nil {
+		l = m.TakeProfitPrice.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1663,6 +1788,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RowanCollateralEnabled = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
+			}
+			m.TwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTriggersPerBlock", wireType)
+			}
+			m.MaxTriggersPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTriggersPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTriggerCollateralValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinTriggerCollateralValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2119,6 +2316,110 @@ func (m *MTP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiabilitiesAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiabilitiesAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopLossPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.StopLossPrice = &v
+			if err := m.StopLossPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeProfitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TakeProfitPrice = &v
+			if err := m.TakeProfitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
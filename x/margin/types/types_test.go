@@ -19,6 +19,62 @@ func TestTypes_NewMTP(t *testing.T) {
 	require.Equal(t, got.Leverage, sdk.OneDec())
 }
 
+func TestTypes_NewMTPShort(t *testing.T) {
+	got := types.NewMTP("signer", "rowan", "xxx", types.Position_SHORT, sdk.OneDec())
+
+	require.Equal(t, got.CollateralAsset, "rowan")
+	require.Equal(t, got.CustodyAsset, "rowan")
+	require.Equal(t, got.LiabilitiesAsset, "xxx")
+	require.Equal(t, got.Position, types.Position_SHORT)
+	require.Equal(t, got.OwedAsset(), "xxx")
+	require.Equal(t, got.ExternalAsset(), "xxx")
+}
+
+func TestTypes_MtpAssets(t *testing.T) {
+	long := types.NewMTP("signer", "rowan", "xxx", types.Position_LONG, sdk.OneDec())
+	require.Equal(t, long.OwedAsset(), "rowan")
+	require.Equal(t, long.ExternalAsset(), "xxx")
+
+	long = types.NewMTP("signer", "xxx", "rowan", types.Position_LONG, sdk.OneDec())
+	require.Equal(t, long.OwedAsset(), "xxx")
+	require.Equal(t, long.ExternalAsset(), "xxx")
+
+	short := types.NewMTP("signer", "xxx", "rowan", types.Position_SHORT, sdk.OneDec())
+	require.Equal(t, short.OwedAsset(), "rowan")
+	require.Equal(t, short.ExternalAsset(), "xxx")
+}
+
+func TestTypes_MtpTriggers(t *testing.T) {
+	low, high := sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("2")
+
+	// custody in the external asset profits from a rising price
+	long := types.NewMTP("signer", "rowan", "xxx", types.Position_LONG, sdk.OneDec())
+	require.False(t, long.HasTriggers())
+	require.NoError(t, long.ValidateTriggers())
+	long.StopLossPrice, long.TakeProfitPrice = &low, &high
+	require.True(t, long.HasTriggers())
+	require.NoError(t, long.ValidateTriggers())
+	require.Equal(t, "", long.ReachedTrigger(sdk.OneDec()))
+	require.Equal(t, types.TriggerStopLoss, long.ReachedTrigger(low))
+	require.Equal(t, types.TriggerTakeProfit, long.ReachedTrigger(sdk.NewDec(3)))
+	long.StopLossPrice, long.TakeProfitPrice = &high, &low
+	require.ErrorIs(t, long.ValidateTriggers(), types.ErrInvalidTriggerPrice)
+
+	// custody in rowan profits from a falling external asset price
+	short := types.NewMTP("signer", "rowan", "xxx", types.Position_SHORT, sdk.OneDec())
+	short.StopLossPrice, short.TakeProfitPrice = &high, &low
+	require.NoError(t, short.ValidateTriggers())
+	require.Equal(t, "", short.ReachedTrigger(sdk.OneDec()))
+	require.Equal(t, types.TriggerStopLoss, short.ReachedTrigger(sdk.NewDec(3)))
+	require.Equal(t, types.TriggerTakeProfit, short.ReachedTrigger(low))
+	short.StopLossPrice, short.TakeProfitPrice = &low, &high
+	require.ErrorIs(t, short.ValidateTriggers(), types.ErrInvalidTriggerPrice)
+
+	zero := sdk.ZeroDec()
+	short.StopLossPrice, short.TakeProfitPrice = &zero, nil
+	require.ErrorIs(t, short.ValidateTriggers(), types.ErrInvalidTriggerPrice)
+}
+
 func TestTypes_MtpValidate(t *testing.T) {
 	validateTests := []struct {
 		name      string
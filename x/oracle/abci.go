@@ -0,0 +1,23 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/keeper"
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+// EndBlocker deletes expired pending prophecies, prunes old finalized prophecies and
+// old tombstones
+func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) []abci.ValidatorUpdate {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	keeper.ExpireProphecies(ctx)
+	keeper.PruneProphecies(ctx)
+	keeper.PruneProphecyTombstones(ctx)
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,164 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+const flagStatus = "status"
+
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		GetCmdQueryProphecies(),
+		GetCmdQueryProphecy(),
+		GetCmdQueryWhiteList(),
+		GetCmdQueryAdminAccount(),
+		GetCmdQueryProphecyLifetime(),
+	)
+	return cmd
+}
+
+func GetCmdQueryProphecies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecies",
+		Short: "query prophecies, optionally filtered by status",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			statusFlag, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			status := types.StatusText_STATUS_TEXT_UNSPECIFIED
+			if statusFlag != "" {
+				value, ok := types.StatusText_value["STATUS_TEXT_"+strings.ToUpper(statusFlag)]
+				if !ok {
+					return fmt.Errorf("invalid status %s, expected pending, success or failed", statusFlag)
+				}
+				status = types.StatusText(value)
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Prophecies(context.Background(), &types.QueryPropheciesRequest{
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(flagStatus, "", "only return prophecies with this status: pending, success or failed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prophecies")
+	return cmd
+}
+
+func GetCmdQueryProphecy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecy [id]",
+		Short: "query a prophecy and the claim made by each validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Prophecy(context.Background(), &types.QueryProphecyRequest{Id: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryWhiteList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist",
+		Short: "query the whitelisted validators and their voting power",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.WhiteList(context.Background(), &types.QueryWhiteListRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryAdminAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "query the oracle admin account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AdminAccount(context.Background(), &types.QueryAdminAccountRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryProphecyLifetime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prophecy-lifetime",
+		Short: "query when pending prophecies expire and finalized prophecies are pruned",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ProphecyLifetime(context.Background(), &types.QueryProphecyLifetimeRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -37,7 +37,27 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 	}
 
 	for _, dbProphecy := range data.Prophecies {
-		keeper.SetDBProphecy(ctx, *dbProphecy)
+		migrated, err := dbProphecy.MigrateLegacyClaims()
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetDBProphecy(ctx, migrated)
+	}
+
+	if !data.ConsensusNeeded.IsNil() {
+		if err := types.ValidateConsensusNeeded(data.ConsensusNeeded); err != nil {
+			panic(err)
+		}
+		keeper.SetConsensusNeeded(ctx, data.ConsensusNeeded)
+	}
+
+	if data.ProphecyLifetime != nil {
+		keeper.SetProphecyLifetime(ctx, *data.ProphecyLifetime)
+	}
+
+	// the exported tombstones are kept for TombstoneRetentionBlocks from the genesis height
+	for _, id := range data.PrunedProphecyIds {
+		keeper.SetProphecyTombstone(ctx, id)
 	}
 
 	return []abci.ValidatorUpdate{}
@@ -59,14 +79,22 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		}
 		dbProphecies[i] = &dbProphecy
 	}
+	lifetime := keeper.GetProphecyLifetime(ctx)
 	return &types.GenesisState{
-		AddressWhitelist: wl,
-		AdminAddress:     adminAcc.String(),
-		Prophecies:       dbProphecies,
+		AddressWhitelist:  wl,
+		AdminAddress:      adminAcc.String(),
+		Prophecies:        dbProphecies,
+		ConsensusNeeded:   keeper.GetConsensusNeeded(ctx),
+		ProphecyLifetime:  &lifetime,
+		PrunedProphecyIds: keeper.GetProphecyTombstones(ctx),
 	}
 }
 
 // ValidateGenesis validates the oracle genesis parameters
-func ValidateGenesis(_ *types.GenesisState) error {
-	return nil
+func ValidateGenesis(data *types.GenesisState) error {
+	// genesis files written before the consensus needed was in state leave it unset
+	if data.ConsensusNeeded.IsNil() {
+		return nil
+	}
+	return types.ValidateConsensusNeeded(data.ConsensusNeeded)
 }
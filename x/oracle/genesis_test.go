@@ -41,6 +41,10 @@ func TestInitGenesis(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, p, &serialised)
 			}
+
+			require.Equal(t, tc.genesis.ConsensusNeeded.String(), keeper.GetConsensusNeeded(ctx).String())
+			require.Equal(t, *tc.genesis.ProphecyLifetime, keeper.GetProphecyLifetime(ctx))
+			require.Equal(t, tc.genesis.PrunedProphecyIds, keeper.GetProphecyTombstones(ctx))
 		})
 	}
 }
@@ -67,6 +71,10 @@ func TestExportGenesis(t *testing.T) {
 			for i, p := range tc.genesis.Prophecies {
 				require.Equal(t, p, prophecies[i])
 			}
+
+			require.Equal(t, tc.genesis.ConsensusNeeded.String(), genesis.ConsensusNeeded.String())
+			require.Equal(t, tc.genesis.ProphecyLifetime, genesis.ProphecyLifetime)
+			require.Equal(t, tc.genesis.PrunedProphecyIds, genesis.PrunedProphecyIds)
 		})
 	}
 }
@@ -130,11 +138,8 @@ func testGenesisData(t *testing.T) ([]testCase, []types.Prophecy) {
 				Text:       types.StatusText_STATUS_TEXT_PENDING,
 				FinalClaim: "abc",
 			},
-			ClaimValidators: map[string][]sdk.ValAddress{
-				"123": valAddrs,
-			},
-			ValidatorClaims: map[string]string{
-				"321": "4321",
+			Claims: []types.ProphecyClaim{
+				{ValidatorAddress: "321", Claim: "4321"},
 			},
 		}
 		prophecies = append(prophecies, prophecy)
@@ -155,9 +160,12 @@ func testGenesisData(t *testing.T) ([]testCase, []types.Prophecy) {
 		{
 			name: "Prophecy",
 			genesis: types.GenesisState{
-				AddressWhitelist: whitelist,
-				AdminAddress:     addrs[0].String(),
-				Prophecies:       dbProphecies,
+				AddressWhitelist:  whitelist,
+				AdminAddress:      addrs[0].String(),
+				Prophecies:        dbProphecies,
+				ConsensusNeeded:   sdk.NewDecWithPrec(8, 1),
+				ProphecyLifetime:  &types.ProphecyLifetime{ExpiryBlocks: 10, RetentionBlocks: 20},
+				PrunedProphecyIds: []string{"pruned0", "pruned1"},
 			},
 		},
 	}, prophecies
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func (k Keeper) SetConsensusNeeded(ctx sdk.Context, consensusNeeded sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ConsensusNeededPrefix
+	store.Set(key, k.cdc.MustMarshal(&sdk.DecProto{Dec: consensusNeeded}))
+}
+
+// GetConsensusNeeded returns the minimum proportion of whitelisted validator power
+// needed to sign a claim for a prophecy to succeed, or the default if it was never set
+func (k Keeper) GetConsensusNeeded(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ConsensusNeededPrefix
+	bz := store.Get(key)
+	if bz == nil {
+		return types.DefaultConsensusNeeded
+	}
+	consensusNeeded := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &consensusNeeded)
+	return consensusNeeded.Dec
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+type Querier struct {
+	Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func (q Querier) Prophecies(c context.Context, req *types.QueryPropheciesRequest) (*types.QueryPropheciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.ProphecyPrefix)
+	var prophecies []types.ProphecyInfo
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var dbProphecy types.DBProphecy
+		if err := q.cdc.Unmarshal(value, &dbProphecy); err != nil {
+			return false, err
+		}
+		if req.Status != types.StatusText_STATUS_TEXT_UNSPECIFIED && dbProphecy.Status.Text != req.Status {
+			return false, nil
+		}
+		if accumulate {
+			prophecy, err := dbProphecy.DeserializeFromDB()
+			if err != nil {
+				return false, err
+			}
+			prophecies = append(prophecies, q.getProphecyInfo(ctx, prophecy))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPropheciesResponse{Prophecies: prophecies, Pagination: pageRes}, nil
+}
+
+func (q Querier) Prophecy(c context.Context, req *types.QueryProphecyRequest) (*types.QueryProphecyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	prophecy, found := q.GetProphecy(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrProphecyNotFound, req.Id)
+	}
+	return &types.QueryProphecyResponse{Prophecy: q.getProphecyInfo(ctx, prophecy)}, nil
+}
+
+func (q Querier) WhiteList(c context.Context, _ *types.QueryWhiteListRequest) (*types.QueryWhiteListResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	whiteList := q.GetOracleWhiteList(ctx)
+	validators := make([]types.WhiteListValidator, len(whiteList))
+	totalPower := int64(0)
+	for i, address := range whiteList {
+		power := q.getValidatorPower(ctx, address)
+		validators[i] = types.WhiteListValidator{ValidatorAddress: address.String(), Power: power}
+		totalPower += power
+	}
+	return &types.QueryWhiteListResponse{Validators: validators, TotalPower: totalPower}, nil
+}
+
+func (q Querier) AdminAccount(c context.Context, _ *types.QueryAdminAccountRequest) (*types.QueryAdminAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAdminAccountResponse{AdminAccount: q.GetAdminAccount(ctx).String()}, nil
+}
+
+func (q Querier) ProphecyLifetime(c context.Context, _ *types.QueryProphecyLifetimeRequest) (*types.QueryProphecyLifetimeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryProphecyLifetimeResponse{ProphecyLifetime: q.GetProphecyLifetime(ctx)}, nil
+}
+
+// getProphecyInfo returns the prophecy with its claims sorted by validator address
+func (k Keeper) getProphecyInfo(ctx sdk.Context, prophecy types.Prophecy) types.ProphecyInfo {
+	validatorClaims := make([]types.ValidatorClaim, 0, len(prophecy.Claims))
+	for _, claim := range prophecy.Claims {
+		power := int64(0)
+		if valAddr, err := sdk.ValAddressFromBech32(claim.ValidatorAddress); err == nil {
+			power = k.getValidatorPower(ctx, valAddr)
+		}
+		validatorClaims = append(validatorClaims, types.ValidatorClaim{
+			ValidatorAddress: claim.ValidatorAddress,
+			Claim:            claim.Claim,
+			Power:            power,
+		})
+	}
+	return types.ProphecyInfo{
+		Id:              prophecy.ID,
+		Status:          prophecy.Status,
+		ValidatorClaims: validatorClaims,
+		CreationHeight:  prophecy.CreationHeight,
+	}
+}
+
+// getValidatorPower returns the consensus power of a validator, or zero if it is not bonded
+func (k Keeper) getValidatorPower(ctx sdk.Context, validatorAddress sdk.ValAddress) int64 {
+	validator, found := k.stakeKeeper.GetValidator(ctx, validatorAddress)
+	if !found || !validator.IsBonded() {
+		return 0
+	}
+	return validator.GetConsensusPower(sdk.DefaultPowerReduction)
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/oracle/keeper"
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestQuerier_Prophecies(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.6, []int64{3, 3, 4}, "")
+	querier := keeper.Querier{Keeper: oracleKeeper}
+
+	// TestID succeeds with 60% of the power while AlternateTestID stays pending
+	for _, validator := range validatorAddresses[:2] {
+		_, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validator.String(), TestString))
+		require.NoError(t, err)
+	}
+	_, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, validatorAddresses[2].String(), TestString))
+	require.NoError(t, err)
+
+	res, err := querier.Prophecies(sdk.WrapSDKContext(ctx), &types.QueryPropheciesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Prophecies, 2)
+
+	res, err = querier.Prophecies(sdk.WrapSDKContext(ctx), &types.QueryPropheciesRequest{Status: types.StatusText_STATUS_TEXT_PENDING})
+	require.NoError(t, err)
+	require.Len(t, res.Prophecies, 1)
+	require.Equal(t, AlternateTestID, res.Prophecies[0].Id)
+
+	res, err = querier.Prophecies(sdk.WrapSDKContext(ctx), &types.QueryPropheciesRequest{
+		Status:     types.StatusText_STATUS_TEXT_SUCCESS,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Prophecies, 1)
+	require.Equal(t, TestID, res.Prophecies[0].Id)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+
+	_, err = querier.Prophecies(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestQuerier_Prophecy(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	querier := keeper.Querier{Keeper: oracleKeeper}
+	_, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[1].String(), AlternateTestString))
+	require.NoError(t, err)
+
+	res, err := querier.Prophecy(sdk.WrapSDKContext(ctx), &types.QueryProphecyRequest{Id: TestID})
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_SUCCESS, res.Prophecy.Status.Text)
+	require.Len(t, res.Prophecy.ValidatorClaims, 2)
+	claims := map[string]types.ValidatorClaim{}
+	for _, claim := range res.Prophecy.ValidatorClaims {
+		claims[claim.ValidatorAddress] = claim
+	}
+	require.Equal(t, TestString, claims[validatorAddresses[0].String()].Claim)
+	require.Equal(t, int64(3), claims[validatorAddresses[0].String()].Power)
+	require.Equal(t, AlternateTestString, claims[validatorAddresses[1].String()].Claim)
+	require.Equal(t, int64(7), claims[validatorAddresses[1].String()].Power)
+
+	_, err = querier.Prophecy(sdk.WrapSDKContext(ctx), &types.QueryProphecyRequest{Id: AlternateTestID})
+	require.ErrorIs(t, err, types.ErrProphecyNotFound)
+}
+
+func TestQuerier_WhiteListAndAdminAccount(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	querier := keeper.Querier{Keeper: oracleKeeper}
+
+	res, err := querier.WhiteList(sdk.WrapSDKContext(ctx), &types.QueryWhiteListRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, len(validatorAddresses))
+	require.Equal(t, int64(10), res.TotalPower)
+
+	admin := sdk.AccAddress(validatorAddresses[0])
+	oracleKeeper.SetAdminAccount(ctx, admin)
+	adminRes, err := querier.AdminAccount(sdk.WrapSDKContext(ctx), &types.QueryAdminAccountRequest{})
+	require.NoError(t, err)
+	require.Equal(t, admin.String(), adminRes.AdminAccount)
+}
@@ -16,22 +16,16 @@ type Keeper struct {
 	cdc         codec.BinaryCodec // The wire codec for binary encoding/decoding.
 	storeKey    sdk.StoreKey      // Unexposed key to access store from sdk.Context
 	stakeKeeper types.StakingKeeper
-	// TODO: use this as param instead
-	consensusNeeded float64 // The minimum % of stake needed to sign claims in order for consensus to occur
 }
 
 // NewKeeper creates new instances of the oracle Keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, storeKey sdk.StoreKey, stakeKeeper types.StakingKeeper, consensusNeeded float64,
+	cdc codec.BinaryCodec, storeKey sdk.StoreKey, stakeKeeper types.StakingKeeper,
 ) Keeper {
-	if consensusNeeded <= 0 || consensusNeeded > 1 {
-		panic(types.ErrMinimumConsensusNeededInvalid.Error())
-	}
 	return Keeper{
-		cdc:             cdc,
-		storeKey:        storeKey,
-		stakeKeeper:     stakeKeeper,
-		consensusNeeded: consensusNeeded,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		stakeKeeper: stakeKeeper,
 	}
 }
 
@@ -57,13 +51,10 @@ func (k Keeper) GetProphecies(ctx sdk.Context) []types.Prophecy {
 
 // GetProphecy gets the entire prophecy data struct for a given id
 func (k Keeper) GetProphecy(ctx sdk.Context, id string) (types.Prophecy, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte(fmt.Sprintf("%s_%s", types.ProphecyPrefix, id)))
-	if bz == nil {
+	dbProphecy, found := k.GetDBProphecy(ctx, id)
+	if !found {
 		return types.Prophecy{}, false
 	}
-	var dbProphecy types.DBProphecy
-	k.cdc.MustUnmarshal(bz, &dbProphecy)
 	deSerializedProphecy, err := dbProphecy.DeserializeFromDB()
 	if err != nil {
 		return types.Prophecy{}, false
@@ -81,9 +72,48 @@ func (k Keeper) SetProphecy(ctx sdk.Context, prophecy types.Prophecy) error {
 	return nil
 }
 
+func (k Keeper) GetDBProphecy(ctx sdk.Context, id string) (types.DBProphecy, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetProphecyKey(id))
+	if bz == nil {
+		return types.DBProphecy{}, false
+	}
+	var dbProphecy types.DBProphecy
+	k.cdc.MustUnmarshal(bz, &dbProphecy)
+	return dbProphecy, true
+}
+
+// SetDBProphecy saves a prophecy and indexes it as pending or finalized by its creation height
 func (k Keeper) SetDBProphecy(ctx sdk.Context, prophecy types.DBProphecy) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(fmt.Sprintf("%s_%s", types.ProphecyPrefix, prophecy.Id)), k.cdc.MustMarshal(&prophecy))
+	store.Set(types.GetProphecyKey(prophecy.Id), k.cdc.MustMarshal(&prophecy))
+	pendingKey := types.GetProphecyIndexKey(types.PendingProphecyPrefix, prophecy.CreationHeight, prophecy.Id)
+	finalizedKey := types.GetProphecyIndexKey(types.FinalizedProphecyPrefix, prophecy.CreationHeight, prophecy.Id)
+	if prophecy.Status.Text == types.StatusText_STATUS_TEXT_PENDING {
+		store.Set(pendingKey, []byte{})
+		store.Delete(finalizedKey)
+	} else {
+		store.Delete(pendingKey)
+		store.Set(finalizedKey, []byte{})
+	}
+}
+
+// DeleteDBProphecy deletes a prophecy and its index entries
+func (k Keeper) DeleteDBProphecy(ctx sdk.Context, prophecy types.DBProphecy) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetProphecyKey(prophecy.Id))
+	store.Delete(types.GetProphecyIndexKey(types.PendingProphecyPrefix, prophecy.CreationHeight, prophecy.Id))
+	store.Delete(types.GetProphecyIndexKey(types.FinalizedProphecyPrefix, prophecy.CreationHeight, prophecy.Id))
+}
+
+// DeleteProphecy deletes a prophecy and its index entries
+func (k Keeper) DeleteProphecy(ctx sdk.Context, prophecy types.Prophecy) error {
+	dbProphecy, err := prophecy.SerializeForDB()
+	if err != nil {
+		return err
+	}
+	k.DeleteDBProphecy(ctx, dbProphecy)
+	return nil
 }
 
 func (k Keeper) EnsureAddressIsInWhitelist(ctx sdk.Context, validatorAddress string) error {
@@ -126,7 +156,17 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, claim types.Claim) (types.Status,
 	}
 	prophecy, found := k.GetProphecy(ctx, claim.Id)
 	if !found {
-		prophecy = types.NewProphecy(claim.Id)
+		// a successful prophecy that was pruned must not be claimed again
+		if k.HasProphecyTombstone(ctx, claim.Id) {
+			return types.Status{}, types.ErrProphecyFinalized
+		}
+		prophecy = types.NewProphecy(claim.Id, ctx.BlockHeight())
+	} else if k.isExpiredProphecy(ctx, prophecy) {
+		// an expired prophecy is started over so that its claim can be resubmitted
+		if err := k.DeleteProphecy(ctx, prophecy); err != nil {
+			return types.Status{}, err
+		}
+		prophecy = types.NewProphecy(claim.Id, ctx.BlockHeight())
 	}
 	switch prophecy.Status.Text {
 	case types.StatusText_STATUS_TEXT_PENDING:
@@ -134,7 +174,7 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, claim types.Claim) (types.Status,
 	default:
 		return types.Status{}, types.ErrProphecyFinalized
 	}
-	if prophecy.ValidatorClaims[claim.ValidatorAddress] != "" {
+	if _, found := prophecy.GetClaim(claim.ValidatorAddress); found {
 		return types.Status{}, types.ErrDuplicateMessage
 	}
 	prophecy.AddClaim(valAddr, claim.Content)
@@ -179,14 +219,18 @@ func (k Keeper) ProcessUpdateWhiteListValidator(ctx sdk.Context, cosmosSender sd
 // left to push it over the threshold required for consensus.
 func (k Keeper) processCompletion(ctx sdk.Context, prophecy types.Prophecy) types.Prophecy {
 	highestClaim, highestClaimPower, totalClaimsPower, totalPower := prophecy.FindHighestClaim(ctx, k.stakeKeeper, k.GetOracleWhiteList(ctx))
-	highestConsensusRatio := float64(highestClaimPower) / float64(totalPower)
+	if totalPower <= 0 {
+		return prophecy
+	}
+	consensusNeeded := k.GetConsensusNeeded(ctx)
+	highestConsensusRatio := sdk.NewDec(highestClaimPower).QuoInt64(totalPower)
 	remainingPossibleClaimPower := totalPower - totalClaimsPower
 	highestPossibleClaimPower := highestClaimPower + remainingPossibleClaimPower
-	highestPossibleConsensusRatio := float64(highestPossibleClaimPower) / float64(totalPower)
-	if highestConsensusRatio >= k.consensusNeeded {
+	highestPossibleConsensusRatio := sdk.NewDec(highestPossibleClaimPower).QuoInt64(totalPower)
+	if highestConsensusRatio.GTE(consensusNeeded) {
 		prophecy.Status.Text = types.StatusText_STATUS_TEXT_SUCCESS
 		prophecy.Status.FinalClaim = highestClaim
-	} else if highestPossibleConsensusRatio < k.consensusNeeded {
+	} else if highestPossibleConsensusRatio.LT(consensusNeeded) {
 		prophecy.Status.Text = types.StatusText_STATUS_TEXT_FAILED
 	}
 	return prophecy
@@ -200,5 +244,5 @@ func (k Keeper) Exists(ctx sdk.Context, key []byte) bool {
 
 func (k Keeper) GetProphecyIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return store.Iterator(types.ProphecyPrefix, nil)
+	return sdk.KVStorePrefixIterator(store, types.ProphecyPrefix)
 }
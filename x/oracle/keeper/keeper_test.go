@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Sifchain/sifnode/app"
@@ -45,18 +46,33 @@ func TestCreateGetProphecy(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, prophecy.ID, TestID)
 	require.Equal(t, prophecy.Status.Text, types.StatusText_STATUS_TEXT_PENDING)
-	require.Equal(t, prophecy.ClaimValidators[TestString][0], validator1Pow3)
-	require.Equal(t, prophecy.ValidatorClaims[validator1Pow3.String()], TestString)
+	claim, found := prophecy.GetClaim(validator1Pow3.String())
+	require.True(t, found)
+	require.Equal(t, claim, TestString)
 }
 
 func TestBadConsensusForOracle(t *testing.T) {
-	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("The code did not panic")
-		}
-	}()
-	_, _, _, _, _, _, _ = test.CreateTestKeepers(t, 0, []int64{10}, "")
-	_, _, _, _, _, _, _ = test.CreateTestKeepers(t, 1.2, []int64{10}, "")
+	require.ErrorIs(t, types.ValidateConsensusNeeded(sdk.ZeroDec()), types.ErrMinimumConsensusNeededInvalid)
+	require.ErrorIs(t, types.ValidateConsensusNeeded(sdk.NewDecWithPrec(12, 1)), types.ErrMinimumConsensusNeededInvalid)
+	require.ErrorIs(t, types.ValidateConsensusNeeded(sdk.Dec{}), types.ErrMinimumConsensusNeededInvalid)
+	require.NoError(t, types.ValidateConsensusNeeded(sdk.OneDec()))
+}
+
+func TestConsensusNeededUpdate(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.6, []int64{3, 3, 4}, "")
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), oracleKeeper.GetConsensusNeeded(ctx))
+	oracleKeeper.SetConsensusNeeded(ctx, sdk.NewDecWithPrec(7, 1))
+
+	// 60% of the power no longer reaches consensus
+	for _, validator := range validatorAddresses[:2] {
+		status, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validator.String(), TestString))
+		require.NoError(t, err)
+		require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, status.Text)
+	}
+	status, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[2].String(), TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_SUCCESS, status.Text)
+	require.Equal(t, TestString, status.FinalClaim)
 }
 
 func TestBadMsgs(t *testing.T) {
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateToVer2 sets the creation height of existing prophecies to the upgrade height
+// and indexes them so that they can expire and be pruned
+func (m Migrator) MigrateToVer2(ctx sdk.Context) error {
+	prophecies, err := m.getDBProphecies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, prophecy := range prophecies {
+		prophecy.CreationHeight = ctx.BlockHeight()
+		m.keeper.SetDBProphecy(ctx, prophecy)
+	}
+	return nil
+}
+
+// MigrateToVer3 moves the json encoded claim maps of existing prophecies into sorted claims
+func (m Migrator) MigrateToVer3(ctx sdk.Context) error {
+	prophecies, err := m.getDBProphecies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, prophecy := range prophecies {
+		migrated, err := prophecy.MigrateLegacyClaims()
+		if err != nil {
+			return err
+		}
+		m.keeper.SetDBProphecy(ctx, migrated)
+	}
+	return nil
+}
+
+func (m Migrator) getDBProphecies(ctx sdk.Context) ([]types.DBProphecy, error) {
+	var prophecies []types.DBProphecy
+	iterator := m.keeper.GetProphecyIterator(ctx)
+	for ; iterator.Valid(); iterator.Next() {
+		var prophecy types.DBProphecy
+		m.keeper.cdc.MustUnmarshal(iterator.Value(), &prophecy)
+		prophecies = append(prophecies, prophecy)
+	}
+	if err := iterator.Close(); err != nil {
+		return nil, err
+	}
+	return prophecies, nil
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func (k Keeper) SetProphecyLifetime(ctx sdk.Context, lifetime types.ProphecyLifetime) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ProphecyLifetimePrefix
+	store.Set(key, k.cdc.MustMarshal(&lifetime))
+}
+
+// GetProphecyLifetime returns when pending prophecies expire and finalized prophecies
+// are pruned, or the default if it was never set
+func (k Keeper) GetProphecyLifetime(ctx sdk.Context) types.ProphecyLifetime {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ProphecyLifetimePrefix
+	bz := store.Get(key)
+	if bz == nil {
+		return types.DefaultProphecyLifetime
+	}
+	lifetime := types.ProphecyLifetime{}
+	k.cdc.MustUnmarshal(bz, &lifetime)
+	return lifetime
+}
+
+// ExpireProphecies marks the prophecies that are still pending ExpiryBlocks after
+// they were created as failed, so that relayers can resubmit their claims. At most
+// MaxExpiredPropheciesPerBlock are expired per block, the rest in the next blocks.
+func (k Keeper) ExpireProphecies(ctx sdk.Context) {
+	expiryBlocks := k.GetProphecyLifetime(ctx).ExpiryBlocks
+	height := ctx.BlockHeight()
+	if expiryBlocks == 0 || height <= 0 || uint64(height) <= expiryBlocks {
+		return
+	}
+	for _, id := range k.getIndexedProphecyIDs(ctx, types.PendingProphecyPrefix, height-int64(expiryBlocks), types.MaxExpiredPropheciesPerBlock) {
+		prophecy, found := k.GetDBProphecy(ctx, id)
+		if !found {
+			continue
+		}
+		prophecy.Status.Text = types.StatusText_STATUS_TEXT_FAILED
+		k.SetDBProphecy(ctx, prophecy)
+		k.Logger(ctx).Info("sifnode oracle prophecy expired.", "prophecyID", id)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeProphecyExpired,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProphecyID, id),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(prophecy.CreationHeight, 10)),
+		))
+	}
+}
+
+// PruneProphecies deletes the finalized prophecies created RetentionBlocks or more
+// blocks ago, leaving a tombstone for the successful ones. At most
+// MaxPrunedPropheciesPerBlock are pruned per block, the rest in the next blocks.
+func (k Keeper) PruneProphecies(ctx sdk.Context) {
+	retentionBlocks := k.GetProphecyLifetime(ctx).RetentionBlocks
+	height := ctx.BlockHeight()
+	if retentionBlocks == 0 || height <= 0 || uint64(height) <= retentionBlocks {
+		return
+	}
+	for _, id := range k.getIndexedProphecyIDs(ctx, types.FinalizedProphecyPrefix, height-int64(retentionBlocks), types.MaxPrunedPropheciesPerBlock) {
+		prophecy, found := k.GetDBProphecy(ctx, id)
+		if !found {
+			continue
+		}
+		if prophecy.Status.Text == types.StatusText_STATUS_TEXT_SUCCESS {
+			k.SetProphecyTombstone(ctx, id)
+		}
+		k.DeleteDBProphecy(ctx, prophecy)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeProphecyPruned,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyProphecyID, id),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(prophecy.CreationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, prophecy.Status.Text.String()),
+		))
+	}
+}
+
+// isExpiredProphecy returns whether a prophecy failed and was created ExpiryBlocks or
+// more blocks ago, in which case its claim may be resubmitted
+func (k Keeper) isExpiredProphecy(ctx sdk.Context, prophecy types.Prophecy) bool {
+	expiryBlocks := k.GetProphecyLifetime(ctx).ExpiryBlocks
+	return expiryBlocks != 0 &&
+		prophecy.Status.Text == types.StatusText_STATUS_TEXT_FAILED &&
+		prophecy.CreationHeight <= ctx.BlockHeight()-int64(expiryBlocks)
+}
+
+// getIndexedProphecyIDs returns the ids of at most limit prophecies in an index
+// created at or before maxCreationHeight, oldest first
+func (k Keeper) getIndexedProphecyIDs(ctx sdk.Context, indexPrefix []byte, maxCreationHeight int64, limit int) []string {
+	store := ctx.KVStore(k.storeKey)
+	// the height is 8 bytes after the index prefix
+	idStart := len(indexPrefix) + 8
+	iterator := store.Iterator(indexPrefix, types.GetProphecyIndexHeightKey(indexPrefix, maxCreationHeight+1))
+	defer iterator.Close()
+	var ids []string
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, string(iterator.Key()[idStart:]))
+	}
+	return ids
+}
+
+// SetProphecyTombstone records that a successful prophecy was pruned at the current
+// height, the tombstone is kept for TombstoneRetentionBlocks
+func (k Keeper) SetProphecyTombstone(ctx sdk.Context, id string) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetProphecyTombstoneKey(id)); bz != nil {
+		store.Delete(types.GetProphecyIndexKey(types.TombstoneHeightPrefix, int64(sdk.BigEndianToUint64(bz)), id))
+	}
+	height := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))
+	store.Set(types.GetProphecyTombstoneKey(id), height)
+	store.Set(types.GetProphecyIndexKey(types.TombstoneHeightPrefix, ctx.BlockHeight(), id), []byte{})
+}
+
+// PruneProphecyTombstones deletes the tombstones set TombstoneRetentionBlocks or
+// more blocks ago. At most MaxPrunedPropheciesPerBlock are deleted per block, the
+// rest in the next blocks.
+func (k Keeper) PruneProphecyTombstones(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	if height <= types.TombstoneRetentionBlocks {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	pruneHeight := height - types.TombstoneRetentionBlocks
+	for _, id := range k.getIndexedProphecyIDs(ctx, types.TombstoneHeightPrefix, pruneHeight, types.MaxPrunedPropheciesPerBlock) {
+		bz := store.Get(types.GetProphecyTombstoneKey(id))
+		if bz != nil {
+			store.Delete(types.GetProphecyTombstoneKey(id))
+			store.Delete(types.GetProphecyIndexKey(types.TombstoneHeightPrefix, int64(sdk.BigEndianToUint64(bz)), id))
+		}
+	}
+}
+
+// HasProphecyTombstone returns whether a successful prophecy with the id was pruned
+func (k Keeper) HasProphecyTombstone(ctx sdk.Context, id string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetProphecyTombstoneKey(id))
+}
+
+// GetProphecyTombstones returns the ids of all pruned successful prophecies
+func (k Keeper) GetProphecyTombstones(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProphecyTombstonePrefix)
+	defer iterator.Close()
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, string(iterator.Key()[len(types.ProphecyTombstonePrefix):]))
+	}
+	return ids
+}
@@ -0,0 +1,201 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/ethbridge/test"
+	"github.com/Sifchain/sifnode/x/oracle/keeper"
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+func TestExpireAndPruneProphecies(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	require.Equal(t, types.DefaultProphecyLifetime, oracleKeeper.GetProphecyLifetime(ctx))
+	oracleKeeper.SetProphecyLifetime(ctx, types.ProphecyLifetime{ExpiryBlocks: 10, RetentionBlocks: 20})
+
+	// TestID stays pending while AlternateTestID succeeds
+	ctx = ctx.WithBlockHeight(1)
+	_, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(2)
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, validatorAddresses[1].String(), TestString))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	oracleKeeper.ExpireProphecies(ctx)
+	prophecy, found := oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, prophecy.Status.Text)
+	require.Equal(t, int64(1), prophecy.CreationHeight)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	oracleKeeper.ExpireProphecies(ctx)
+	prophecy, found = oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, types.StatusText_STATUS_TEXT_FAILED, prophecy.Status.Text)
+	require.Equal(t, types.EventTypeProphecyExpired, ctx.EventManager().Events()[0].Type)
+
+	// the expired claim can be resubmitted and starts a new prophecy
+	status, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, status.Text)
+	prophecy, found = oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, int64(11), prophecy.CreationHeight)
+	require.Len(t, prophecy.Claims, 1)
+	require.Len(t, oracleKeeper.GetProphecies(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(21)
+	oracleKeeper.PruneProphecies(ctx)
+	_, found = oracleKeeper.GetProphecy(ctx, AlternateTestID)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(22)
+	oracleKeeper.PruneProphecies(ctx)
+	_, found = oracleKeeper.GetProphecy(ctx, AlternateTestID)
+	require.False(t, found)
+	require.Len(t, oracleKeeper.GetProphecies(ctx), 1)
+
+	// the pruned successful prophecy cannot be claimed again
+	require.Equal(t, []string{AlternateTestID}, oracleKeeper.GetProphecyTombstones(ctx))
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(AlternateTestID, validatorAddresses[1].String(), TestString))
+	require.ErrorIs(t, err, types.ErrProphecyFinalized)
+	_, found = oracleKeeper.GetProphecy(ctx, AlternateTestID)
+	require.False(t, found)
+}
+
+func TestPruneProphecyTombstones(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	ctx = ctx.WithBlockHeight(1)
+	oracleKeeper.SetProphecyTombstone(ctx, TestID)
+	oracleKeeper.SetProphecyTombstone(ctx, AlternateTestID)
+	// setting a tombstone again restarts its retention
+	ctx = ctx.WithBlockHeight(2)
+	oracleKeeper.SetProphecyTombstone(ctx, AlternateTestID)
+
+	ctx = ctx.WithBlockHeight(types.TombstoneRetentionBlocks)
+	oracleKeeper.PruneProphecyTombstones(ctx)
+	require.Equal(t, []string{AlternateTestID, TestID}, oracleKeeper.GetProphecyTombstones(ctx))
+
+	ctx = ctx.WithBlockHeight(1 + types.TombstoneRetentionBlocks)
+	oracleKeeper.PruneProphecyTombstones(ctx)
+	require.Equal(t, []string{AlternateTestID}, oracleKeeper.GetProphecyTombstones(ctx))
+	require.False(t, oracleKeeper.HasProphecyTombstone(ctx, TestID))
+	// the claim starts a new prophecy once its tombstone is deleted
+	status, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+	require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, status.Text)
+
+	ctx = ctx.WithBlockHeight(2 + types.TombstoneRetentionBlocks)
+	oracleKeeper.PruneProphecyTombstones(ctx)
+	require.Empty(t, oracleKeeper.GetProphecyTombstones(ctx))
+}
+
+func TestProphecyLifetimeDisabled(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	oracleKeeper.SetProphecyLifetime(ctx, types.ProphecyLifetime{})
+	ctx = ctx.WithBlockHeight(1)
+	_, err := oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(1000000)
+	oracleKeeper.ExpireProphecies(ctx)
+	oracleKeeper.PruneProphecies(ctx)
+	prophecy, found := oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, types.StatusText_STATUS_TEXT_PENDING, prophecy.Status.Text)
+}
+
+func TestMigrator_MigrateToVer2(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	oracleKeeper.SetProphecyLifetime(ctx, types.ProphecyLifetime{ExpiryBlocks: 10, RetentionBlocks: 20})
+	prophecy, err := types.NewProphecy(TestID, 0).SerializeForDB()
+	require.NoError(t, err)
+	oracleKeeper.SetDBProphecy(ctx, prophecy)
+
+	ctx = ctx.WithBlockHeight(100)
+	require.NoError(t, keeper.NewMigrator(oracleKeeper).MigrateToVer2(ctx))
+	migrated, found := oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, int64(100), migrated.CreationHeight)
+
+	ctx = ctx.WithBlockHeight(110)
+	oracleKeeper.ExpireProphecies(ctx)
+	migrated, found = oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Equal(t, types.StatusText_STATUS_TEXT_FAILED, migrated.Status.Text)
+}
+
+func TestExpireAndPrunePropheciesPerBlockLimit(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, _ := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	oracleKeeper.SetProphecyLifetime(ctx, types.ProphecyLifetime{ExpiryBlocks: 10, RetentionBlocks: 20})
+	count := types.MaxExpiredPropheciesPerBlock + 1
+	for i := 0; i < count; i++ {
+		prophecy, err := types.NewProphecy(fmt.Sprintf("%s%d", TestID, i), 1).SerializeForDB()
+		require.NoError(t, err)
+		oracleKeeper.SetDBProphecy(ctx, prophecy)
+	}
+	countStatus := func(text types.StatusText) int {
+		n := 0
+		for _, prophecy := range oracleKeeper.GetProphecies(ctx) {
+			if prophecy.Status.Text == text {
+				n++
+			}
+		}
+		return n
+	}
+
+	ctx = ctx.WithBlockHeight(11)
+	oracleKeeper.ExpireProphecies(ctx)
+	require.Equal(t, types.MaxExpiredPropheciesPerBlock, countStatus(types.StatusText_STATUS_TEXT_FAILED))
+	ctx = ctx.WithBlockHeight(12)
+	oracleKeeper.ExpireProphecies(ctx)
+	require.Equal(t, count, countStatus(types.StatusText_STATUS_TEXT_FAILED))
+
+	ctx = ctx.WithBlockHeight(21)
+	oracleKeeper.PruneProphecies(ctx)
+	require.Len(t, oracleKeeper.GetProphecies(ctx), count-types.MaxPrunedPropheciesPerBlock)
+	ctx = ctx.WithBlockHeight(22)
+	oracleKeeper.PruneProphecies(ctx)
+	require.Empty(t, oracleKeeper.GetProphecies(ctx))
+}
+
+func TestMigrator_MigrateToVer3(t *testing.T) {
+	ctx, _, _, _, oracleKeeper, _, validatorAddresses := test.CreateTestKeepers(t, 0.7, []int64{3, 7}, "")
+	validatorClaims, err := json.Marshal(map[string]string{
+		validatorAddresses[1].String(): TestString,
+		validatorAddresses[0].String(): TestString,
+	})
+	require.NoError(t, err)
+	claimValidators, err := json.Marshal(map[string][]sdk.ValAddress{TestString: validatorAddresses})
+	require.NoError(t, err)
+	oracleKeeper.SetDBProphecy(ctx, types.DBProphecy{
+		Id:              TestID,
+		Status:          types.NewStatus(types.StatusText_STATUS_TEXT_PENDING, ""),
+		ClaimValidators: claimValidators,
+		ValidatorClaims: validatorClaims,
+	})
+	_, found := oracleKeeper.GetProphecy(ctx, TestID)
+	require.False(t, found)
+
+	require.NoError(t, keeper.NewMigrator(oracleKeeper).MigrateToVer3(ctx))
+	dbProphecy, found := oracleKeeper.GetDBProphecy(ctx, TestID)
+	require.True(t, found)
+	require.False(t, dbProphecy.HasLegacyClaims())
+	migrated, found := oracleKeeper.GetProphecy(ctx, TestID)
+	require.True(t, found)
+	require.Len(t, migrated.Claims, 2)
+	require.True(t, migrated.Claims[0].ValidatorAddress < migrated.Claims[1].ValidatorAddress)
+	for _, claim := range migrated.Claims {
+		require.Equal(t, TestString, claim.Claim)
+	}
+
+	// claims keep being processed against the migrated prophecy
+	_, err = oracleKeeper.ProcessClaim(ctx, types.NewClaim(TestID, validatorAddresses[0].String(), TestString))
+	require.ErrorIs(t, err, types.ErrDuplicateMessage)
+}
@@ -37,5 +37,7 @@ func Migrate(genesis v039oracle.GenesisState) *types.GenesisState {
 		AddressWhitelist: addressWhiteList,
 		AdminAddress:     genesis.AdminAddress.String(),
 		Prophecies:       prophecies,
+		ConsensusNeeded:  types.DefaultConsensusNeeded,
+		ProphecyLifetime: &types.DefaultProphecyLifetime,
 	}
 }
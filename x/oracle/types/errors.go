@@ -21,4 +21,5 @@ var (
 	ErrValidatorNotInWhiteList = sdkerrors.Register(ModuleName, 10, "validator must be in whitelist")
 	ErrNotAdminAccount         = sdkerrors.Register(ModuleName, 11, "Not an admin account")
 	ErrInvalidOperationType    = sdkerrors.Register(ModuleName, 12, "invalid operation type for validator whitelist")
+	ErrInvalidProphecyLifetime = sdkerrors.Register(ModuleName, 13, "prophecy expiry and retention blocks must be > 0")
 )
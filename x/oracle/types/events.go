@@ -0,0 +1,13 @@
+package types
+
+// Oracle module event types
+var (
+	EventTypeProphecyExpired = "prophecy_expired"
+	EventTypeProphecyPruned  = "prophecy_pruned"
+
+	AttributeKeyProphecyID     = "prophecy_id"
+	AttributeKeyCreationHeight = "creation_height"
+	AttributeKeyStatus         = "status"
+
+	AttributeValueCategory = ModuleName
+)
@@ -17,6 +17,8 @@ func DefaultGenesisState() *GenesisState {
 		AddressWhitelist: []string{},
 		AdminAddress:     "",
 		Prophecies:       []*DBProphecy{},
+		ConsensusNeeded:  DefaultConsensusNeeded,
+		ProphecyLifetime: &DefaultProphecyLifetime,
 	}
 }
 
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the oracle module
 	ModuleName = "oracle"
@@ -18,4 +24,36 @@ var (
 	WhiteListValidatorPrefix = []byte{0x00}
 	AdminAccountPrefix       = []byte{0x01}
 	ProphecyPrefix           = []byte{0x02}
+	ConsensusNeededPrefix    = []byte{0x03}
+	ProphecyLifetimePrefix   = []byte{0x04}
+	PendingProphecyPrefix    = []byte{0x05}
+	FinalizedProphecyPrefix  = []byte{0x06}
+	ProphecyTombstonePrefix  = []byte{0x07}
+	TombstoneHeightPrefix    = []byte{0x08}
 )
+
+// GetProphecyKey returns the key a prophecy is stored under
+func GetProphecyKey(id string) []byte {
+	return []byte(fmt.Sprintf("%s_%s", ProphecyPrefix, id))
+}
+
+// GetProphecyTombstoneKey returns the key marking that a successful prophecy was pruned
+func GetProphecyTombstoneKey(id string) []byte {
+	return append(append([]byte{}, ProphecyTombstonePrefix...), []byte(id)...)
+}
+
+// GetProphecyIndexKey returns the key of a prophecy in the pending or finalized
+// prophecy index, which are ordered by creation height, or in the tombstone index,
+// which is ordered by pruning height
+func GetProphecyIndexKey(indexPrefix []byte, creationHeight int64, id string) []byte {
+	return append(GetProphecyIndexHeightKey(indexPrefix, creationHeight), []byte(id)...)
+}
+
+// GetProphecyIndexHeightKey returns the start of the index keys of prophecies
+// created at a height
+func GetProphecyIndexHeightKey(indexPrefix []byte, creationHeight int64) []byte {
+	if creationHeight < 0 {
+		creationHeight = 0
+	}
+	return append(append([]byte{}, indexPrefix...), sdk.Uint64ToBigEndian(uint64(creationHeight))...)
+}
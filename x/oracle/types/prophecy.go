@@ -3,79 +3,149 @@ package types
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // DefaultConsensusNeeded defines the default consensus value required for a
 // prophecy to be finalized
-const DefaultConsensusNeeded float64 = 0.7
+var DefaultConsensusNeeded = sdk.NewDecWithPrec(7, 1)
+
+// DefaultProphecyLifetime expires prophecies still pending after a week and prunes
+// finalized prophecies after two weeks, assuming 6 second blocks
+var DefaultProphecyLifetime = ProphecyLifetime{
+	ExpiryBlocks:    100800,
+	RetentionBlocks: 201600,
+}
+
+// MaxExpiredPropheciesPerBlock and MaxPrunedPropheciesPerBlock bound the work the
+// EndBlocker does when many prophecies reach their expiry or retention height at once
+const (
+	MaxExpiredPropheciesPerBlock = 100
+	MaxPrunedPropheciesPerBlock  = 100
+)
+
+// TombstoneRetentionBlocks is how long the tombstone of a pruned successful prophecy
+// is kept, a year of 6 second blocks. A claim is only finalized again if most
+// validators resubmit it, and relayers resubmit old claims only when they resume
+// from the last block they processed after downtime or when an operator replays a
+// block range, neither of which reaches a year back. Tombstones older than that are
+// deleted so that they do not grow the store without bound.
+const TombstoneRetentionBlocks = 5256000
+
+// ValidateConsensusNeeded checks that the consensus needed is a proportion in (0, 1]
+func ValidateConsensusNeeded(consensusNeeded sdk.Dec) error {
+	if consensusNeeded.IsNil() || !consensusNeeded.IsPositive() || consensusNeeded.GT(sdk.OneDec()) {
+		return ErrMinimumConsensusNeededInvalid
+	}
+	return nil
+}
+
+// ValidateProphecyLifetime checks that a prophecy lifetime set by an admin neither
+// disables expiry nor pruning
+func ValidateProphecyLifetime(lifetime ProphecyLifetime) error {
+	if lifetime.ExpiryBlocks == 0 || lifetime.RetentionBlocks == 0 {
+		return ErrInvalidProphecyLifetime
+	}
+	return nil
+}
 
 // Prophecy is a struct that contains all the metadata of an oracle ritual.
-// Claims are indexed by the claim's validator bech32 address and by the claim's json value to allow
-// for constant lookup times for any validation/verifiation checks of duplicate claims
-// Each transaction, pending potential results are also calculated, stored and indexed by their byte result
-// to allow discovery of consensus on any the result in constant time without having to sort or run
-// through the list of claims to find the one with highest consensus
+// Claims are kept sorted by validator address so that consensus is always computed
+// by iterating them in the same order
 type Prophecy struct {
 	ID     string `json:"id"`
 	Status Status `json:"status"`
-	//WARNING: Mappings are nondeterministic in Amino,
-	// an so iterating over them could result in consensus failure. New code should not iterate over the below 2 mappings.
-	//This is a mapping from a claim to the list of validators that made that claim.
-	ClaimValidators map[string][]sdk.ValAddress `json:"claim_validators"`
-	//This is a mapping from a validator bech32 address to their claim
-	ValidatorClaims map[string]string `json:"validator_claims"`
+	// Claims holds the claim made by each validator sorted by validator bech32 address
+	Claims []ProphecyClaim `json:"claims"`
+	// CreationHeight is the height of the block the first claim was made in
+	CreationHeight int64 `json:"creation_height"`
 }
 
 // SerializeForDB serializes a prophecy into a DBProphecy
-// TODO: Using gob here may mean that different tendermint clients in different languages may serialize/store
-// prophecies in their db in different ways -
-// check with @codereviewer if this is ok or if it introduces a risk of creating forks.
-// Or maybe using a slower json serializer or Amino:JSON would be ok
 func (prophecy Prophecy) SerializeForDB() (DBProphecy, error) {
-	claimValidators, err := json.Marshal(prophecy.ClaimValidators)
-	if err != nil {
-		return DBProphecy{}, err
-	}
-	validatorClaims, err := json.Marshal(prophecy.ValidatorClaims)
-	if err != nil {
-		return DBProphecy{}, err
-	}
+	claims := make([]ProphecyClaim, len(prophecy.Claims))
+	copy(claims, prophecy.Claims)
 	return DBProphecy{
-		Id:              prophecy.ID,
-		Status:          prophecy.Status,
-		ClaimValidators: claimValidators,
-		ValidatorClaims: validatorClaims,
+		Id:             prophecy.ID,
+		Status:         prophecy.Status,
+		Claims:         claims,
+		CreationHeight: prophecy.CreationHeight,
 	}, nil
 }
 
 // DeserializeFromDB deserializes a DBProphecy into a prophecy
 func (dbProphecy DBProphecy) DeserializeFromDB() (Prophecy, error) {
-	var claimValidators map[string][]sdk.ValAddress
-	if err := json.Unmarshal(dbProphecy.ClaimValidators, &claimValidators); err != nil {
-		return Prophecy{}, err
-	}
-	var validatorClaims map[string]string
-	if err := json.Unmarshal(dbProphecy.ValidatorClaims, &validatorClaims); err != nil {
-		return Prophecy{}, err
+	if dbProphecy.HasLegacyClaims() {
+		return Prophecy{}, sdkerrors.Wrap(ErrInternalDB, "prophecy has not been migrated to claims")
 	}
+	claims := make([]ProphecyClaim, len(dbProphecy.Claims))
+	copy(claims, dbProphecy.Claims)
 	return Prophecy{
-		ID:              dbProphecy.Id,
-		Status:          dbProphecy.Status,
-		ClaimValidators: claimValidators,
-		ValidatorClaims: validatorClaims,
+		ID:             dbProphecy.Id,
+		Status:         dbProphecy.Status,
+		Claims:         claims,
+		CreationHeight: dbProphecy.CreationHeight,
 	}, nil
 }
 
+// HasLegacyClaims returns true if the prophecy still holds json encoded claim maps
+func (dbProphecy DBProphecy) HasLegacyClaims() bool {
+	return len(dbProphecy.ClaimValidators) > 0 || len(dbProphecy.ValidatorClaims) > 0 //nolint:staticcheck
+}
+
+// MigrateLegacyClaims moves the json encoded claim maps of a prophecy into sorted claims
+func (dbProphecy DBProphecy) MigrateLegacyClaims() (DBProphecy, error) {
+	if !dbProphecy.HasLegacyClaims() {
+		return dbProphecy, nil
+	}
+	var validatorClaims map[string]string
+	if err := json.Unmarshal(dbProphecy.ValidatorClaims, &validatorClaims); err != nil { //nolint:staticcheck
+		return DBProphecy{}, sdkerrors.Wrap(ErrInternalDB, err.Error())
+	}
+	prophecy := Prophecy{}
+	for validatorAddress, claim := range validatorClaims {
+		prophecy.setClaim(validatorAddress, claim)
+	}
+	dbProphecy.Claims = prophecy.Claims
+	dbProphecy.ClaimValidators = nil //nolint:staticcheck
+	dbProphecy.ValidatorClaims = nil //nolint:staticcheck
+	return dbProphecy, nil
+}
+
 // AddClaim adds a given claim to this prophecy
-func (prophecy Prophecy) AddClaim(validator sdk.ValAddress, claim string) {
-	claimValidators := prophecy.ClaimValidators[claim]
-	prophecy.ClaimValidators[claim] = append(claimValidators, validator)
-	validatorBech32 := validator.String()
-	prophecy.ValidatorClaims[validatorBech32] = claim
+func (prophecy *Prophecy) AddClaim(validator sdk.ValAddress, claim string) {
+	prophecy.setClaim(validator.String(), claim)
+}
+
+// GetClaim returns the claim a validator made on this prophecy
+func (prophecy Prophecy) GetClaim(validatorAddress string) (string, bool) {
+	i := prophecy.searchClaims(validatorAddress)
+	if i < len(prophecy.Claims) && prophecy.Claims[i].ValidatorAddress == validatorAddress {
+		return prophecy.Claims[i].Claim, true
+	}
+	return "", false
+}
+
+// setClaim inserts or replaces the claim of a validator keeping the claims sorted
+func (prophecy *Prophecy) setClaim(validatorAddress string, claim string) {
+	i := prophecy.searchClaims(validatorAddress)
+	if i < len(prophecy.Claims) && prophecy.Claims[i].ValidatorAddress == validatorAddress {
+		prophecy.Claims[i].Claim = claim
+		return
+	}
+	prophecy.Claims = append(prophecy.Claims, ProphecyClaim{})
+	copy(prophecy.Claims[i+1:], prophecy.Claims[i:])
+	prophecy.Claims[i] = ProphecyClaim{ValidatorAddress: validatorAddress, Claim: claim}
+}
+
+func (prophecy Prophecy) searchClaims(validatorAddress string) int {
+	return sort.Search(len(prophecy.Claims), func(i int) bool {
+		return prophecy.Claims[i].ValidatorAddress >= validatorAddress
+	})
 }
 
 func inWhiteList(validator staking.Validator, whiteListValidatorAddresses []sdk.ValAddress) bool {
@@ -91,9 +161,7 @@ func inWhiteList(validator staking.Validator, whiteListValidatorAddresses []sdk.
 // all claims and returns the highest claim, power for that claim, total power claimed on the prophecy overall.
 // and the total power of all whitelist validators.
 func (prophecy Prophecy) FindHighestClaim(ctx sdk.Context, stakeKeeper StakingKeeper, whiteListValidatorAddresses []sdk.ValAddress) (string, int64, int64, int64) {
-	fmt.Println("sifnode oracle prophecy FindHighestClaim")
 	validators := stakeKeeper.GetBondedValidatorsByPower(ctx)
-	fmt.Printf("sifnode oracle prophecy FindHighestClaim validators length is %d\n", len(validators))
 	// Compute the total power of white list validators
 	totalPower := int64(0)
 	for _, validator := range validators {
@@ -101,29 +169,34 @@ func (prophecy Prophecy) FindHighestClaim(ctx sdk.Context, stakeKeeper StakingKe
 			totalPower += validator.GetConsensusPower(sdk.DefaultPowerReduction)
 		}
 	}
-	fmt.Printf("sifnode oracle prophecy FindHighestClaim totalPower is %d\n", totalPower)
 	//Index the validators by address for looking when scanning through claims
 	validatorsByAddress := make(map[string]staking.Validator)
 	for _, validator := range validators {
 		validatorsByAddress[validator.OperatorAddress] = validator
 	}
+	// Sum the power behind each claim iterating the claims in validator address order
+	claimPowers := make(map[string]int64)
+	var claims []string
+	for _, prophecyClaim := range prophecy.Claims {
+		if _, ok := claimPowers[prophecyClaim.Claim]; !ok {
+			claims = append(claims, prophecyClaim.Claim)
+			claimPowers[prophecyClaim.Claim] = 0
+		}
+		validator, found := validatorsByAddress[prophecyClaim.ValidatorAddress]
+		if found {
+			// Note: If claim validator is not found in the current validator set, we assume it is no longer
+			// an active validator and so can silently ignore it's claim and no longer count it towards total power.
+			claimPowers[prophecyClaim.Claim] += validator.GetConsensusPower(sdk.DefaultPowerReduction)
+		}
+	}
+	// Ties between claims go to the lowest claim so the result does not depend on claim order
+	sort.Strings(claims)
 	totalClaimsPower := int64(0)
 	highestClaimPower := int64(-1)
 	highestClaim := ""
-	for claim, validatorAddrs := range prophecy.ClaimValidators {
-		claimPower := int64(0)
-		for _, validatorAddr := range validatorAddrs {
-			fmt.Printf("sifnode oracle prophecy FindHighestClaim validator is %s\n", validatorAddr.String())
-			validator, found := validatorsByAddress[validatorAddr.String()]
-			if found {
-				// Note: If claim validator is not found in the current validator set, we assume it is no longer
-				// an active validator and so can silently ignore it's claim and no longer count it towards total power.
-				claimPower += validator.GetConsensusPower(sdk.DefaultPowerReduction)
-			}
-		}
+	for _, claim := range claims {
+		claimPower := claimPowers[claim]
 		totalClaimsPower += claimPower
-		fmt.Printf("sifnode oracle prophecy FindHighestClaim totalClaimsPower is %d\n", totalClaimsPower)
-		fmt.Printf("sifnode oracle prophecy FindHighestClaim claimPower is %d\n", claimPower)
 		if claimPower > highestClaimPower {
 			highestClaimPower = claimPower
 			highestClaim = claim
@@ -133,12 +206,12 @@ func (prophecy Prophecy) FindHighestClaim(ctx sdk.Context, stakeKeeper StakingKe
 }
 
 // NewProphecy returns a new Prophecy, initialized in pending status with an initial claim
-func NewProphecy(id string) Prophecy {
+func NewProphecy(id string, creationHeight int64) Prophecy {
 	return Prophecy{
-		ID:              id,
-		Status:          NewStatus(StatusText_STATUS_TEXT_PENDING, ""),
-		ClaimValidators: make(map[string][]sdk.ValAddress),
-		ValidatorClaims: make(map[string]string),
+		ID:             id,
+		Status:         NewStatus(StatusText_STATUS_TEXT_PENDING, ""),
+		Claims:         []ProphecyClaim{},
+		CreationHeight: creationHeight,
 	}
 }
 
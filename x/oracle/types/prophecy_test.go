@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sifchain/sifnode/x/oracle/types"
+)
+
+type stakingKeeperStub struct {
+	types.StakingKeeper
+	validators []staking.Validator
+}
+
+func (s stakingKeeperStub) GetBondedValidatorsByPower(_ sdk.Context) []staking.Validator {
+	return s.validators
+}
+
+func newValidator(addr sdk.ValAddress, power int64) staking.Validator {
+	return staking.Validator{
+		OperatorAddress: addr.String(),
+		Status:          staking.Bonded,
+		Tokens:          sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction),
+	}
+}
+
+func TestProphecy_AddClaimKeepsClaimsSorted(t *testing.T) {
+	valA := sdk.ValAddress("validator_a_________")
+	valB := sdk.ValAddress("validator_b_________")
+	valC := sdk.ValAddress("validator_c_________")
+	prophecy := types.NewProphecy("id", 1)
+	prophecy.AddClaim(valC, "c")
+	prophecy.AddClaim(valA, "a")
+	prophecy.AddClaim(valB, "b")
+	require.Len(t, prophecy.Claims, 3)
+	require.True(t, sort.SliceIsSorted(prophecy.Claims, func(i, j int) bool {
+		return prophecy.Claims[i].ValidatorAddress < prophecy.Claims[j].ValidatorAddress
+	}))
+	prophecy.AddClaim(valB, "b")
+	require.Len(t, prophecy.Claims, 3)
+	claim, found := prophecy.GetClaim(valB.String())
+	require.True(t, found)
+	require.Equal(t, "b", claim)
+	_, found = prophecy.GetClaim(sdk.ValAddress("validator_d_________").String())
+	require.False(t, found)
+}
+
+func TestProphecy_FindHighestClaimIsDeterministic(t *testing.T) {
+	valA := sdk.ValAddress("validator_a_________")
+	valB := sdk.ValAddress("validator_b_________")
+	valC := sdk.ValAddress("validator_c_________")
+	stakingKeeper := stakingKeeperStub{validators: []staking.Validator{
+		newValidator(valA, 5),
+		newValidator(valB, 5),
+		newValidator(valC, 2),
+	}}
+	whiteList := []sdk.ValAddress{valA, valB, valC}
+
+	first := types.NewProphecy("id", 1)
+	first.AddClaim(valA, "x")
+	first.AddClaim(valB, "y")
+	second := types.NewProphecy("id", 1)
+	second.AddClaim(valB, "y")
+	second.AddClaim(valA, "x")
+	require.Equal(t, first.Claims, second.Claims)
+
+	for _, prophecy := range []types.Prophecy{first, second} {
+		highestClaim, highestClaimPower, totalClaimsPower, totalPower := prophecy.FindHighestClaim(sdk.Context{}, stakingKeeper, whiteList)
+		require.Equal(t, "x", highestClaim)
+		require.Equal(t, int64(5), highestClaimPower)
+		require.Equal(t, int64(10), totalClaimsPower)
+		require.Equal(t, int64(12), totalPower)
+	}
+}
+
+func TestDBProphecy_MigrateLegacyClaims(t *testing.T) {
+	valA := sdk.ValAddress("validator_a_________")
+	valB := sdk.ValAddress("validator_b_________")
+	claimValidators, err := json.Marshal(map[string][]sdk.ValAddress{"x": {valA, valB}})
+	require.NoError(t, err)
+	validatorClaims, err := json.Marshal(map[string]string{valB.String(): "x", valA.String(): "x"})
+	require.NoError(t, err)
+	legacy := types.DBProphecy{
+		Id:              "id",
+		ClaimValidators: claimValidators,
+		ValidatorClaims: validatorClaims,
+	}
+	_, err = legacy.DeserializeFromDB()
+	require.Error(t, err)
+
+	migrated, err := legacy.MigrateLegacyClaims()
+	require.NoError(t, err)
+	require.False(t, migrated.HasLegacyClaims())
+	require.Len(t, migrated.Claims, 2)
+	require.True(t, migrated.Claims[0].ValidatorAddress < migrated.Claims[1].ValidatorAddress)
+	for _, claim := range migrated.Claims {
+		require.Equal(t, "x", claim.Claim)
+	}
+	prophecy, err := migrated.DeserializeFromDB()
+	require.NoError(t, err)
+	require.Equal(t, migrated.Claims, prophecy.Claims)
+}
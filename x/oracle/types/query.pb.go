@@ -0,0 +1,2931 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sifnode/oracle/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorClaim is the claim made by a validator on a prophecy along with the
+// validator's current voting power
+type ValidatorClaim struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Claim            string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
+	Power            int64  `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorClaim) Reset()         { *m = ValidatorClaim{} }
+func (m *ValidatorClaim) String() string { return proto.CompactTextString(m) }
+func (*ValidatorClaim) ProtoMessage()    {}
+func (*ValidatorClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{0}
+}
+func (m *ValidatorClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorClaim.Merge(m, src)
+}
+func (m *ValidatorClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorClaim proto.InternalMessageInfo
+
+func (m *ValidatorClaim) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorClaim) GetClaim() string {
+	if m != nil {
+		return m.Claim
+	}
+	return ""
+}
+
+func (m *ValidatorClaim) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ProphecyInfo is a prophecy with its claims sorted by validator address
+type ProphecyInfo struct {
+	Id              string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          Status           `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	ValidatorClaims []ValidatorClaim `protobuf:"bytes,3,rep,name=validator_claims,json=validatorClaims,proto3" json:"validator_claims"`
+	CreationHeight  int64            `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+}
+
+func (m *ProphecyInfo) Reset()         { *m = ProphecyInfo{} }
+func (m *ProphecyInfo) String() string { return proto.CompactTextString(m) }
+func (*ProphecyInfo) ProtoMessage()    {}
+func (*ProphecyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{1}
+}
+func (m *ProphecyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProphecyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProphecyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProphecyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProphecyInfo.Merge(m, src)
+}
+func (m *ProphecyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProphecyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProphecyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProphecyInfo proto.InternalMessageInfo
+
+func (m *ProphecyInfo) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProphecyInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status{}
+}
+
+func (m *ProphecyInfo) GetValidatorClaims() []ValidatorClaim {
+	if m != nil {
+		return m.ValidatorClaims
+	}
+	return nil
+}
+
+func (m *ProphecyInfo) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// QueryPropheciesRequest returns prophecies of every status when status is
+// STATUS_TEXT_UNSPECIFIED
+type QueryPropheciesRequest struct {
+	Status     StatusText         `protobuf:"varint,1,opt,name=status,proto3,enum=sifnode.oracle.v1.StatusText" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPropheciesRequest) Reset()         { *m = QueryPropheciesRequest{} }
+func (m *QueryPropheciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPropheciesRequest) ProtoMessage()    {}
+func (*QueryPropheciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{2}
+}
+func (m *QueryPropheciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPropheciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPropheciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPropheciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPropheciesRequest.Merge(m, src)
+}
+func (m *QueryPropheciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPropheciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPropheciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPropheciesRequest proto.InternalMessageInfo
+
+func (m *QueryPropheciesRequest) GetStatus() StatusText {
+	if m != nil {
+		return m.Status
+	}
+	return StatusText_STATUS_TEXT_UNSPECIFIED
+}
+
+func (m *QueryPropheciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPropheciesResponse struct {
+	Prophecies []ProphecyInfo      `protobuf:"bytes,1,rep,name=prophecies,proto3" json:"prophecies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPropheciesResponse) Reset()         { *m = QueryPropheciesResponse{} }
+func (m *QueryPropheciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPropheciesResponse) ProtoMessage()    {}
+func (*QueryPropheciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{3}
+}
+func (m *QueryPropheciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPropheciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPropheciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPropheciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPropheciesResponse.Merge(m, src)
+}
+func (m *QueryPropheciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPropheciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPropheciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPropheciesResponse proto.InternalMessageInfo
+
+func (m *QueryPropheciesResponse) GetProphecies() []ProphecyInfo {
+	if m != nil {
+		return m.Prophecies
+	}
+	return nil
+}
+
+func (m *QueryPropheciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProphecyRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryProphecyRequest) Reset()         { *m = QueryProphecyRequest{} }
+func (m *QueryProphecyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyRequest) ProtoMessage()    {}
+func (*QueryProphecyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{4}
+}
+func (m *QueryProphecyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyRequest.Merge(m, src)
+}
+func (m *QueryProphecyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyRequest proto.InternalMessageInfo
+
+func (m *QueryProphecyRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type QueryProphecyResponse struct {
+	Prophecy ProphecyInfo `protobuf:"bytes,1,opt,name=prophecy,proto3" json:"prophecy"`
+}
+
+func (m *QueryProphecyResponse) Reset()         { *m = QueryProphecyResponse{} }
+func (m *QueryProphecyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyResponse) ProtoMessage()    {}
+func (*QueryProphecyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{5}
+}
+func (m *QueryProphecyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyResponse.Merge(m, src)
+}
+func (m *QueryProphecyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyResponse proto.InternalMessageInfo
+
+func (m *QueryProphecyResponse) GetProphecy() ProphecyInfo {
+	if m != nil {
+		return m.Prophecy
+	}
+	return ProphecyInfo{}
+}
+
+// WhiteListValidator is a whitelisted validator and its voting power, which is
+// zero when the validator is not bonded
+type WhiteListValidator struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Power            int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *WhiteListValidator) Reset()         { *m = WhiteListValidator{} }
+func (m *WhiteListValidator) String() string { return proto.CompactTextString(m) }
+func (*WhiteListValidator) ProtoMessage()    {}
+func (*WhiteListValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{6}
+}
+func (m *WhiteListValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhiteListValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhiteListValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhiteListValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhiteListValidator.Merge(m, src)
+}
+func (m *WhiteListValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhiteListValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhiteListValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhiteListValidator proto.InternalMessageInfo
+
+func (m *WhiteListValidator) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *WhiteListValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type QueryWhiteListRequest struct {
+}
+
+func (m *QueryWhiteListRequest) Reset()         { *m = QueryWhiteListRequest{} }
+func (m *QueryWhiteListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhiteListRequest) ProtoMessage()    {}
+func (*QueryWhiteListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{7}
+}
+func (m *QueryWhiteListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhiteListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhiteListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhiteListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhiteListRequest.Merge(m, src)
+}
+func (m *QueryWhiteListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhiteListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhiteListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhiteListRequest proto.InternalMessageInfo
+
+type QueryWhiteListResponse struct {
+	Validators []WhiteListValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	TotalPower int64                `protobuf:"varint,2,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *QueryWhiteListResponse) Reset()         { *m = QueryWhiteListResponse{} }
+func (m *QueryWhiteListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhiteListResponse) ProtoMessage()    {}
+func (*QueryWhiteListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{8}
+}
+func (m *QueryWhiteListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhiteListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhiteListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhiteListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhiteListResponse.Merge(m, src)
+}
+func (m *QueryWhiteListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhiteListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhiteListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhiteListResponse proto.InternalMessageInfo
+
+func (m *QueryWhiteListResponse) GetValidators() []WhiteListValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *QueryWhiteListResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+type QueryAdminAccountRequest struct {
+}
+
+func (m *QueryAdminAccountRequest) Reset()         { *m = QueryAdminAccountRequest{} }
+func (m *QueryAdminAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminAccountRequest) ProtoMessage()    {}
+func (*QueryAdminAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{9}
+}
+func (m *QueryAdminAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminAccountRequest.Merge(m, src)
+}
+func (m *QueryAdminAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminAccountRequest proto.InternalMessageInfo
+
+type QueryAdminAccountResponse struct {
+	AdminAccount string `protobuf:"bytes,1,opt,name=admin_account,json=adminAccount,proto3" json:"admin_account,omitempty"`
+}
+
+func (m *QueryAdminAccountResponse) Reset()         { *m = QueryAdminAccountResponse{} }
+func (m *QueryAdminAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAdminAccountResponse) ProtoMessage()    {}
+func (*QueryAdminAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{10}
+}
+func (m *QueryAdminAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAdminAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAdminAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAdminAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAdminAccountResponse.Merge(m, src)
+}
+func (m *QueryAdminAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAdminAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAdminAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAdminAccountResponse proto.InternalMessageInfo
+
+func (m *QueryAdminAccountResponse) GetAdminAccount() string {
+	if m != nil {
+		return m.AdminAccount
+	}
+	return ""
+}
+
+type QueryProphecyLifetimeRequest struct {
+}
+
+func (m *QueryProphecyLifetimeRequest) Reset()         { *m = QueryProphecyLifetimeRequest{} }
+func (m *QueryProphecyLifetimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyLifetimeRequest) ProtoMessage()    {}
+func (*QueryProphecyLifetimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{11}
+}
+func (m *QueryProphecyLifetimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyLifetimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyLifetimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyLifetimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyLifetimeRequest.Merge(m, src)
+}
+func (m *QueryProphecyLifetimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyLifetimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyLifetimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyLifetimeRequest proto.InternalMessageInfo
+
+type QueryProphecyLifetimeResponse struct {
+	ProphecyLifetime ProphecyLifetime `protobuf:"bytes,1,opt,name=prophecy_lifetime,json=prophecyLifetime,proto3" json:"prophecy_lifetime"`
+}
+
+func (m *QueryProphecyLifetimeResponse) Reset()         { *m = QueryProphecyLifetimeResponse{} }
+func (m *QueryProphecyLifetimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProphecyLifetimeResponse) ProtoMessage()    {}
+func (*QueryProphecyLifetimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05283fd272c042b0, []int{12}
+}
+func (m *QueryProphecyLifetimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProphecyLifetimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProphecyLifetimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProphecyLifetimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProphecyLifetimeResponse.Merge(m, src)
+}
+func (m *QueryProphecyLifetimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProphecyLifetimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProphecyLifetimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProphecyLifetimeResponse proto.InternalMessageInfo
+
+func (m *QueryProphecyLifetimeResponse) GetProphecyLifetime() ProphecyLifetime {
+	if m != nil {
+		return m.ProphecyLifetime
+	}
+	return ProphecyLifetime{}
+}
+
+func init() {
+	proto.RegisterType((*ValidatorClaim)(nil), "sifnode.oracle.v1.ValidatorClaim")
+	proto.RegisterType((*ProphecyInfo)(nil), "sifnode.oracle.v1.ProphecyInfo")
+	proto.RegisterType((*QueryPropheciesRequest)(nil), "sifnode.oracle.v1.QueryPropheciesRequest")
+	proto.RegisterType((*QueryPropheciesResponse)(nil), "sifnode.oracle.v1.QueryPropheciesResponse")
+	proto.RegisterType((*QueryProphecyRequest)(nil), "sifnode.oracle.v1.QueryProphecyRequest")
+	proto.RegisterType((*QueryProphecyResponse)(nil), "sifnode.oracle.v1.QueryProphecyResponse")
+	proto.RegisterType((*WhiteListValidator)(nil), "sifnode.oracle.v1.WhiteListValidator")
+	proto.RegisterType((*QueryWhiteListRequest)(nil), "sifnode.oracle.v1.QueryWhiteListRequest")
+	proto.RegisterType((*QueryWhiteListResponse)(nil), "sifnode.oracle.v1.QueryWhiteListResponse")
+	proto.RegisterType((*QueryAdminAccountRequest)(nil), "sifnode.oracle.v1.QueryAdminAccountRequest")
+	proto.RegisterType((*QueryAdminAccountResponse)(nil), "sifnode.oracle.v1.QueryAdminAccountResponse")
+	proto.RegisterType((*QueryProphecyLifetimeRequest)(nil), "sifnode.oracle.v1.QueryProphecyLifetimeRequest")
+	proto.RegisterType((*QueryProphecyLifetimeResponse)(nil), "sifnode.oracle.v1.QueryProphecyLifetimeResponse")
+}
+
+func init() { proto.RegisterFile("sifnode/oracle/v1/query.proto", fileDescriptor_05283fd272c042b0) }
+
+var fileDescriptor_05283fd272c042b0 = []byte{
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x4e, 0x53, 0xb5, 0x2f, 0xc1, 0x4d, 0x46, 0x81, 0x3a, 0x4b, 0xbd, 0x76, 0x37,
+	0x72, 0xec, 0x26, 0xb0, 0x8b, 0x8d, 0x10, 0x57, 0x5c, 0x7e, 0x8b, 0x1e, 0x82, 0x8b, 0x5a, 0xa9,
+	0x17, 0x6b, 0xbc, 0x3b, 0x5e, 0x8f, 0x64, 0xef, 0x6c, 0x77, 0xc6, 0x4e, 0x2d, 0xc4, 0x85, 0x03,
+	0x48, 0xc0, 0x01, 0x89, 0x03, 0xff, 0x00, 0x17, 0xfe, 0x93, 0x1e, 0x2b, 0xc1, 0x81, 0x13, 0x42,
+	0x0e, 0x7f, 0x08, 0xda, 0xd9, 0xd9, 0xf5, 0xda, 0x59, 0xc7, 0xe6, 0x16, 0xbf, 0xf7, 0xe6, 0xcd,
+	0xe7, 0xbd, 0xef, 0x77, 0xa2, 0x85, 0x32, 0xa7, 0x7d, 0x9f, 0xb9, 0xc4, 0x66, 0x21, 0x76, 0x86,
+	0xc4, 0x9e, 0x34, 0xed, 0xe7, 0x63, 0x12, 0x4e, 0xad, 0x20, 0x64, 0x82, 0xa1, 0x03, 0x95, 0xb6,
+	0xe2, 0xb4, 0x35, 0x69, 0xea, 0x87, 0x1e, 0xf3, 0x98, 0xcc, 0xda, 0xd1, 0x5f, 0x71, 0xa1, 0x7e,
+	0xcf, 0x63, 0xcc, 0x1b, 0x12, 0x1b, 0x07, 0xd4, 0xc6, 0xbe, 0xcf, 0x04, 0x16, 0x94, 0xf9, 0x5c,
+	0x65, 0x4f, 0x1d, 0xc6, 0x47, 0x8c, 0xdb, 0x3d, 0xcc, 0x49, 0xdc, 0xdf, 0x9e, 0x34, 0x7b, 0x44,
+	0xe0, 0xa6, 0x1d, 0x60, 0x8f, 0xfa, 0xb2, 0x58, 0xd5, 0xe6, 0x10, 0x89, 0x69, 0x40, 0x54, 0x2b,
+	0x93, 0x42, 0xf1, 0x09, 0x1e, 0x52, 0x17, 0x0b, 0x16, 0x7e, 0x38, 0xc4, 0x74, 0x84, 0xce, 0xe0,
+	0x60, 0x92, 0x44, 0xba, 0xd8, 0x75, 0x43, 0xc2, 0x79, 0x49, 0xab, 0x6a, 0x8d, 0xdb, 0x9d, 0xfd,
+	0x34, 0xd1, 0x8e, 0xe3, 0xe8, 0x10, 0x76, 0x9c, 0xe8, 0x54, 0xa9, 0x20, 0x0b, 0xe2, 0x1f, 0x51,
+	0x34, 0x60, 0x17, 0x24, 0x2c, 0x6d, 0x57, 0xb5, 0xc6, 0x76, 0x27, 0xfe, 0x61, 0xfe, 0xa9, 0xc1,
+	0xde, 0x79, 0xc8, 0x82, 0x01, 0x71, 0xa6, 0x9f, 0xfb, 0x7d, 0x86, 0x8a, 0x50, 0xa0, 0xae, 0x6a,
+	0x5d, 0xa0, 0x2e, 0x7a, 0x1f, 0x6e, 0x72, 0x81, 0xc5, 0x98, 0xcb, 0x6e, 0xbb, 0xad, 0x23, 0xeb,
+	0xca, 0xba, 0xac, 0xc7, 0xb2, 0xe0, 0xe1, 0x8d, 0x97, 0x7f, 0x57, 0xb6, 0x3a, 0xaa, 0x1c, 0x75,
+	0x60, 0x4e, 0xd6, 0x95, 0x08, 0xbc, 0xb4, 0x5d, 0xdd, 0x6e, 0xec, 0xb6, 0xee, 0xe7, 0xb4, 0x58,
+	0x9c, 0x57, 0xb5, 0xba, 0x33, 0x59, 0x88, 0x72, 0x54, 0x87, 0x3b, 0x4e, 0x48, 0xe4, 0x26, 0xbb,
+	0x03, 0x42, 0xbd, 0x81, 0x28, 0xdd, 0x90, 0xd3, 0x14, 0x93, 0xf0, 0x67, 0x32, 0x6a, 0xfe, 0xaa,
+	0xc1, 0x1b, 0x5f, 0x46, 0x1a, 0xa8, 0xd9, 0x28, 0xe1, 0x1d, 0xf2, 0x7c, 0x4c, 0xb8, 0x40, 0xef,
+	0xa5, 0x03, 0x45, 0x43, 0x16, 0x5b, 0xe5, 0x95, 0x03, 0x7d, 0x45, 0x5e, 0x88, 0x74, 0x9c, 0x4f,
+	0x00, 0xe6, 0x32, 0xaa, 0x5d, 0x9c, 0x58, 0xb1, 0xe6, 0x56, 0xa4, 0xb9, 0x15, 0x7b, 0x4a, 0x69,
+	0x6e, 0x9d, 0x63, 0x8f, 0xa8, 0x2b, 0x3b, 0x99, 0x93, 0xe6, 0xef, 0x1a, 0xdc, 0xbd, 0x42, 0xc6,
+	0x03, 0xe6, 0x73, 0x82, 0x3e, 0x06, 0x08, 0xd2, 0x68, 0x49, 0x93, 0xcb, 0xaa, 0xe4, 0xe0, 0x65,
+	0x05, 0x53, 0xab, 0xca, 0x1c, 0x44, 0x9f, 0xe6, 0xa0, 0xd6, 0xd7, 0xa2, 0xc6, 0x0c, 0x0b, 0xac,
+	0x27, 0x70, 0x98, 0x45, 0x9d, 0x26, 0x2b, 0x5c, 0xf2, 0x88, 0xf9, 0x0c, 0x5e, 0x5f, 0xaa, 0x53,
+	0x03, 0xb5, 0xe1, 0x96, 0xe2, 0x9a, 0xca, 0xf2, 0x8d, 0xc7, 0x49, 0x8f, 0x99, 0x4f, 0x01, 0x3d,
+	0x1d, 0x50, 0x41, 0x1e, 0x51, 0x2e, 0x52, 0x93, 0xfc, 0xef, 0xf7, 0x10, 0x3b, 0xbf, 0x90, 0x75,
+	0xfe, 0x5d, 0x05, 0x9d, 0x76, 0x57, 0xd3, 0x99, 0xdf, 0x25, 0xde, 0xc9, 0x64, 0xd4, 0x3c, 0x5f,
+	0x00, 0xa4, 0xdd, 0x13, 0x81, 0x6a, 0x39, 0x13, 0x5d, 0x25, 0x4e, 0x64, 0x9a, 0x1f, 0x47, 0x15,
+	0xd8, 0x15, 0x4c, 0xe0, 0x61, 0x37, 0x0b, 0x07, 0x32, 0x74, 0x2e, 0x09, 0x75, 0x28, 0x49, 0x8e,
+	0xb6, 0x3b, 0xa2, 0x7e, 0xdb, 0x71, 0xd8, 0xd8, 0x4f, 0x21, 0x3f, 0x80, 0xa3, 0x9c, 0x9c, 0xc2,
+	0x3c, 0x86, 0xd7, 0x70, 0x14, 0xef, 0xe2, 0x38, 0xa1, 0x36, 0xb3, 0x87, 0x33, 0xc5, 0xa6, 0x01,
+	0xf7, 0x16, 0x44, 0x7b, 0x44, 0xfb, 0x44, 0xd0, 0x51, 0x62, 0x5a, 0xf3, 0x02, 0xca, 0x2b, 0xf2,
+	0xea, 0x96, 0x27, 0x70, 0x90, 0xa8, 0xd4, 0x1d, 0xaa, 0xa4, 0x52, 0xf9, 0xf8, 0x1a, 0x95, 0x93,
+	0x3e, 0x6a, 0x23, 0xfb, 0xc1, 0x52, 0xbc, 0x35, 0xdb, 0x81, 0x1d, 0x79, 0x33, 0xfa, 0x51, 0x03,
+	0x98, 0x3f, 0x13, 0xf4, 0x20, 0xa7, 0x6b, 0xfe, 0x23, 0xd7, 0x4f, 0x37, 0x29, 0x8d, 0xe7, 0x30,
+	0x4f, 0xbe, 0xfd, 0xe3, 0xdf, 0x5f, 0x0a, 0x55, 0x64, 0xd8, 0x9c, 0xf6, 0x9d, 0x01, 0xa6, 0x7e,
+	0xe6, 0xdf, 0x72, 0xe6, 0x59, 0xfd, 0xa0, 0xc1, 0xad, 0x64, 0x08, 0x54, 0x5f, 0x73, 0x41, 0xf2,
+	0x56, 0xf4, 0xc6, 0xfa, 0x42, 0xc5, 0x71, 0x26, 0x39, 0x6a, 0xe8, 0xf8, 0x7a, 0x0e, 0xfb, 0x6b,
+	0xea, 0x7e, 0x83, 0xbe, 0xd7, 0xe0, 0x76, 0xea, 0x32, 0xb4, 0xf2, 0x92, 0x65, 0x73, 0xeb, 0x0f,
+	0x36, 0xa8, 0x54, 0x3c, 0x35, 0xc9, 0x53, 0x41, 0xe5, 0x3c, 0x9e, 0x8b, 0xa8, 0x7c, 0x18, 0xdd,
+	0xfd, 0x93, 0x06, 0x7b, 0x59, 0x17, 0xa2, 0xb3, 0x55, 0x57, 0xe4, 0xf8, 0x58, 0x7f, 0x6b, 0xb3,
+	0x62, 0x85, 0x74, 0x5f, 0x22, 0xbd, 0x89, 0x8e, 0xf2, 0x90, 0xa4, 0xbb, 0xd1, 0x6f, 0x1a, 0xec,
+	0x2f, 0x5b, 0x0d, 0xd9, 0xeb, 0x44, 0x58, 0x32, 0xbf, 0xfe, 0xce, 0xe6, 0x07, 0x14, 0xda, 0xdb,
+	0x12, 0xad, 0x8e, 0x6a, 0xd7, 0xa8, 0x37, 0x7f, 0x27, 0x0f, 0x3f, 0x7a, 0x39, 0x33, 0xb4, 0x57,
+	0x33, 0x43, 0xfb, 0x67, 0x66, 0x68, 0x3f, 0x5f, 0x1a, 0x5b, 0xaf, 0x2e, 0x8d, 0xad, 0xbf, 0x2e,
+	0x8d, 0xad, 0x67, 0xa7, 0x1e, 0x15, 0x83, 0x71, 0xcf, 0x72, 0xd8, 0xc8, 0x7e, 0x9c, 0xb4, 0x4a,
+	0xbe, 0x17, 0x5e, 0x24, 0x4d, 0xe5, 0xe7, 0x42, 0xef, 0xa6, 0xfc, 0x5e, 0x78, 0xf7, 0xbf, 0x01,
+	0x00, 0x46, 0xc6, 0xf7, 0xaf, 0xe2, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Prophecies queries prophecies, optionally filtered by status
+	Prophecies(ctx context.Context, in *QueryPropheciesRequest, opts ...grpc.CallOption) (*QueryPropheciesResponse, error)
+	// Prophecy queries a prophecy and the claim made by each validator
+	Prophecy(ctx context.Context, in *QueryProphecyRequest, opts ...grpc.CallOption) (*QueryProphecyResponse, error)
+	// WhiteList queries the whitelisted validators and their voting power
+	WhiteList(ctx context.Context, in *QueryWhiteListRequest, opts ...grpc.CallOption) (*QueryWhiteListResponse, error)
+	// AdminAccount queries the oracle admin account
+	AdminAccount(ctx context.Context, in *QueryAdminAccountRequest, opts ...grpc.CallOption) (*QueryAdminAccountResponse, error)
+	// ProphecyLifetime queries when pending prophecies expire and finalized
+	// prophecies are pruned
+	ProphecyLifetime(ctx context.Context, in *QueryProphecyLifetimeRequest, opts ...grpc.CallOption) (*QueryProphecyLifetimeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Prophecies(ctx context.Context, in *QueryPropheciesRequest, opts ...grpc.CallOption) (*QueryPropheciesResponse, error) {
+	out := new(QueryPropheciesResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/Prophecies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Prophecy(ctx context.Context, in *QueryProphecyRequest, opts ...grpc.CallOption) (*QueryProphecyResponse, error) {
+	out := new(QueryProphecyResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/Prophecy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhiteList(ctx context.Context, in *QueryWhiteListRequest, opts ...grpc.CallOption) (*QueryWhiteListResponse, error) {
+	out := new(QueryWhiteListResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/WhiteList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AdminAccount(ctx context.Context, in *QueryAdminAccountRequest, opts ...grpc.CallOption) (*QueryAdminAccountResponse, error) {
+	out := new(QueryAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/AdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProphecyLifetime(ctx context.Context, in *QueryProphecyLifetimeRequest, opts ...grpc.CallOption) (*QueryProphecyLifetimeResponse, error) {
+	out := new(QueryProphecyLifetimeResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.oracle.v1.Query/ProphecyLifetime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Prophecies queries prophecies, optionally filtered by status
+	Prophecies(context.Context, *QueryPropheciesRequest) (*QueryPropheciesResponse, error)
+	// Prophecy queries a prophecy and the claim made by each validator
+	Prophecy(context.Context, *QueryProphecyRequest) (*QueryProphecyResponse, error)
+	// WhiteList queries the whitelisted validators and their voting power
+	WhiteList(context.Context, *QueryWhiteListRequest) (*QueryWhiteListResponse, error)
+	// AdminAccount queries the oracle admin account
+	AdminAccount(context.Context, *QueryAdminAccountRequest) (*QueryAdminAccountResponse, error)
+	// ProphecyLifetime queries when pending prophecies expire and finalized
+	// prophecies are pruned
+	ProphecyLifetime(context.Context, *QueryProphecyLifetimeRequest) (*QueryProphecyLifetimeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Prophecies(ctx context.Context, req *QueryPropheciesRequest) (*QueryPropheciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prophecies not implemented")
+}
+func (*UnimplementedQueryServer) Prophecy(ctx context.Context, req *QueryProphecyRequest) (*QueryProphecyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prophecy not implemented")
+}
+func (*UnimplementedQueryServer) WhiteList(ctx context.Context, req *QueryWhiteListRequest) (*QueryWhiteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhiteList not implemented")
+}
+func (*UnimplementedQueryServer) AdminAccount(ctx context.Context, req *QueryAdminAccountRequest) (*QueryAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminAccount not implemented")
+}
+func (*UnimplementedQueryServer) ProphecyLifetime(ctx context.Context, req *QueryProphecyLifetimeRequest) (*QueryProphecyLifetimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProphecyLifetime not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Prophecies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPropheciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prophecies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/Prophecies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prophecies(ctx, req.(*QueryPropheciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Prophecy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProphecyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Prophecy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/Prophecy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prophecy(ctx, req.(*QueryProphecyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhiteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhiteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhiteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/WhiteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhiteList(ctx, req.(*QueryWhiteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdminAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/AdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AdminAccount(ctx, req.(*QueryAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProphecyLifetime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProphecyLifetimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProphecyLifetime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.oracle.v1.Query/ProphecyLifetime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProphecyLifetime(ctx, req.(*QueryProphecyLifetimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sifnode.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Prophecies",
+			Handler:    _Query_Prophecies_Handler,
+		},
+		{
+			MethodName: "Prophecy",
+			Handler:    _Query_Prophecy_Handler,
+		},
+		{
+			MethodName: "WhiteList",
+			Handler:    _Query_WhiteList_Handler,
+		},
+		{
+			MethodName: "AdminAccount",
+			Handler:    _Query_AdminAccount_Handler,
+		},
+		{
+			MethodName: "ProphecyLifetime",
+			Handler:    _Query_ProphecyLifetime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sifnode/oracle/v1/query.proto",
+}
+
+func (m *ValidatorClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Claim) > 0 {
+		i -= len(m.Claim)
+		copy(dAtA[i:], m.Claim)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Claim)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProphecyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProphecyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProphecyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorClaims) > 0 {
+		for iNdEx := len(m.ValidatorClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPropheciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPropheciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPropheciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPropheciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPropheciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPropheciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Prophecies) > 0 {
+		for iNdEx := len(m.Prophecies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prophecies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Prophecy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WhiteListValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhiteListValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhiteListValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhiteListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhiteListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhiteListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhiteListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhiteListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhiteListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyLifetimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyLifetimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyLifetimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProphecyLifetimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProphecyLifetimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProphecyLifetimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProphecyLifetime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Claim)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ProphecyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorClaims) > 0 {
+		for _, e := range m.ValidatorClaims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *QueryPropheciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPropheciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prophecies) > 0 {
+		for _, e := range m.Prophecies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProphecyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProphecyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Prophecy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *WhiteListValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryWhiteListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWhiteListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	return n
+}
+
+func (m *QueryAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProphecyLifetimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProphecyLifetimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProphecyLifetime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProphecyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProphecyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProphecyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorClaims = append(m.ValidatorClaims, ValidatorClaim{})
+			if err := m.ValidatorClaims[len(m.ValidatorClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPropheciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPropheciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPropheciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StatusText(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPropheciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPropheciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPropheciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prophecies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prophecies = append(m.Prophecies, ProphecyInfo{})
+			if err := m.Prophecies[len(m.Prophecies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prophecy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Prophecy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhiteListValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhiteListValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhiteListValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhiteListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhiteListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhiteListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhiteListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhiteListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhiteListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, WhiteListValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAdminAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAdminAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyLifetimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyLifetimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyLifetimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProphecyLifetimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProphecyLifetimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProphecyLifetimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProphecyLifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
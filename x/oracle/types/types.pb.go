@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -60,9 +61,12 @@ func (StatusText) EnumDescriptor() ([]byte, []int) {
 }
 
 type GenesisState struct {
-	AddressWhitelist []string      `protobuf:"bytes,1,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
-	AdminAddress     string        `protobuf:"bytes,2,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
-	Prophecies       []*DBProphecy `protobuf:"bytes,3,rep,name=prophecies,proto3" json:"prophecies,omitempty"`
+	AddressWhitelist  []string                               `protobuf:"bytes,1,rep,name=address_whitelist,json=addressWhitelist,proto3" json:"address_whitelist,omitempty"`
+	AdminAddress      string                                 `protobuf:"bytes,2,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
+	Prophecies        []*DBProphecy                          `protobuf:"bytes,3,rep,name=prophecies,proto3" json:"prophecies,omitempty"`
+	ConsensusNeeded   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=consensus_needed,json=consensusNeeded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"consensus_needed"`
+	ProphecyLifetime  *ProphecyLifetime                      `protobuf:"bytes,5,opt,name=prophecy_lifetime,json=prophecyLifetime,proto3" json:"prophecy_lifetime,omitempty"`
+	PrunedProphecyIds []string                               `protobuf:"bytes,6,rep,name=pruned_prophecy_ids,json=prunedProphecyIds,proto3" json:"pruned_prophecy_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +123,78 @@ func (m *GenesisState) GetProphecies() []*DBProphecy {
 	return nil
 }
 
+func (m *GenesisState) GetProphecyLifetime() *ProphecyLifetime {
+	if m != nil {
+		return m.ProphecyLifetime
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPrunedProphecyIds() []string {
+	if m != nil {
+		return m.PrunedProphecyIds
+	}
+	return nil
+}
+
+// ProphecyLifetime sets how many blocks after its creation a pending prophecy
+// is expired and marked failed, after which its claim can be resubmitted to
+// start a new prophecy, and how many blocks after its creation a finalized
+// prophecy is pruned. Pruned successful prophecies leave a tombstone behind so
+// they cannot be claimed again until the tombstone is deleted a year later.
+// Zero disables either.
+type ProphecyLifetime struct {
+	ExpiryBlocks    uint64 `protobuf:"varint,1,opt,name=expiry_blocks,json=expiryBlocks,proto3" json:"expiry_blocks,omitempty"`
+	RetentionBlocks uint64 `protobuf:"varint,2,opt,name=retention_blocks,json=retentionBlocks,proto3" json:"retention_blocks,omitempty"`
+}
+
+func (m *ProphecyLifetime) Reset()         { *m = ProphecyLifetime{} }
+func (m *ProphecyLifetime) String() string { return proto.CompactTextString(m) }
+func (*ProphecyLifetime) ProtoMessage()    {}
+func (*ProphecyLifetime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1b931484f4203, []int{1}
+}
+func (m *ProphecyLifetime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProphecyLifetime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProphecyLifetime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProphecyLifetime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProphecyLifetime.Merge(m, src)
+}
+func (m *ProphecyLifetime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProphecyLifetime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProphecyLifetime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProphecyLifetime proto.InternalMessageInfo
+
+func (m *ProphecyLifetime) GetExpiryBlocks() uint64 {
+	if m != nil {
+		return m.ExpiryBlocks
+	}
+	return 0
+}
+
+func (m *ProphecyLifetime) GetRetentionBlocks() uint64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
 // Claim contains an arbitrary claim with arbitrary content made by a given
 // validator
 type Claim struct {
@@ -131,7 +207,7 @@ func (m *Claim) Reset()         { *m = Claim{} }
 func (m *Claim) String() string { return proto.CompactTextString(m) }
 func (*Claim) ProtoMessage()    {}
 func (*Claim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{1}
+	return fileDescriptor_dac1b931484f4203, []int{2}
 }
 func (m *Claim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,20 +258,24 @@ func (m *Claim) GetContent() string {
 }
 
 // DBProphecy is what the prophecy becomes when being saved to the database.
-//  Tendermint/Amino does not support maps so we must serialize those variables
-//  into bytes.
 type DBProphecy struct {
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status          Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
-	ClaimValidators []byte `protobuf:"bytes,3,opt,name=claim_validators,json=claimValidators,proto3" json:"claim_validators,omitempty"`
-	ValidatorClaims []byte `protobuf:"bytes,4,opt,name=validator_claims,json=validatorClaims,proto3" json:"validator_claims,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status Status `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// claim_validators and validator_claims are the json encoded claim maps
+	// prophecies were stored with before consensus version 3. They are only
+	// read to migrate prophecies to claims.
+	ClaimValidators []byte `protobuf:"bytes,3,opt,name=claim_validators,json=claimValidators,proto3" json:"claim_validators,omitempty"` // Deprecated: Do not use.
+	ValidatorClaims []byte `protobuf:"bytes,4,opt,name=validator_claims,json=validatorClaims,proto3" json:"validator_claims,omitempty"` // Deprecated: Do not use.
+	CreationHeight  int64  `protobuf:"varint,5,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// claims holds the claim made by each validator sorted by validator address
+	Claims []ProphecyClaim `protobuf:"bytes,6,rep,name=claims,proto3" json:"claims"`
 }
 
 func (m *DBProphecy) Reset()         { *m = DBProphecy{} }
 func (m *DBProphecy) String() string { return proto.CompactTextString(m) }
 func (*DBProphecy) ProtoMessage()    {}
 func (*DBProphecy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{2}
+	return fileDescriptor_dac1b931484f4203, []int{3}
 }
 func (m *DBProphecy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,6 +318,7 @@ func (m *DBProphecy) GetStatus() Status {
 	return Status{}
 }
 
+// Deprecated: Do not use.
 func (m *DBProphecy) GetClaimValidators() []byte {
 	if m != nil {
 		return m.ClaimValidators
@@ -245,6 +326,7 @@ func (m *DBProphecy) GetClaimValidators() []byte {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *DBProphecy) GetValidatorClaims() []byte {
 	if m != nil {
 		return m.ValidatorClaims
@@ -252,6 +334,73 @@ func (m *DBProphecy) GetValidatorClaims() []byte {
 	return nil
 }
 
+func (m *DBProphecy) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *DBProphecy) GetClaims() []ProphecyClaim {
+	if m != nil {
+		return m.Claims
+	}
+	return nil
+}
+
+// ProphecyClaim is the claim a validator made on a prophecy
+type ProphecyClaim struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Claim            string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
+}
+
+func (m *ProphecyClaim) Reset()         { *m = ProphecyClaim{} }
+func (m *ProphecyClaim) String() string { return proto.CompactTextString(m) }
+func (*ProphecyClaim) ProtoMessage()    {}
+func (*ProphecyClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1b931484f4203, []int{4}
+}
+func (m *ProphecyClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProphecyClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProphecyClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProphecyClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProphecyClaim.Merge(m, src)
+}
+func (m *ProphecyClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProphecyClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProphecyClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProphecyClaim proto.InternalMessageInfo
+
+func (m *ProphecyClaim) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ProphecyClaim) GetClaim() string {
+	if m != nil {
+		return m.Claim
+	}
+	return ""
+}
+
 // Status is a struct that contains the status of a given prophecy
 type Status struct {
 	Text       StatusText `protobuf:"varint,1,opt,name=text,proto3,enum=sifnode.oracle.v1.StatusText" json:"text,omitempty"`
@@ -262,7 +411,7 @@ func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dac1b931484f4203, []int{3}
+	return fileDescriptor_dac1b931484f4203, []int{5}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,46 +457,61 @@ func (m *Status) GetFinalClaim() string {
 func init() {
 	proto.RegisterEnum("sifnode.oracle.v1.StatusText", StatusText_name, StatusText_value)
 	proto.RegisterType((*GenesisState)(nil), "sifnode.oracle.v1.GenesisState")
+	proto.RegisterType((*ProphecyLifetime)(nil), "sifnode.oracle.v1.ProphecyLifetime")
 	proto.RegisterType((*Claim)(nil), "sifnode.oracle.v1.Claim")
 	proto.RegisterType((*DBProphecy)(nil), "sifnode.oracle.v1.DBProphecy")
+	proto.RegisterType((*ProphecyClaim)(nil), "sifnode.oracle.v1.ProphecyClaim")
 	proto.RegisterType((*Status)(nil), "sifnode.oracle.v1.Status")
 }
 
 func init() { proto.RegisterFile("sifnode/oracle/v1/types.proto", fileDescriptor_dac1b931484f4203) }
 
 var fileDescriptor_dac1b931484f4203 = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6e, 0xda, 0x40,
-	0x18, 0x85, 0x31, 0x50, 0xaa, 0xfc, 0xd0, 0xd4, 0x99, 0x56, 0x8d, 0xdb, 0x2a, 0x0e, 0xa2, 0x1b,
-	0x9a, 0x4a, 0xb6, 0x48, 0x17, 0x5d, 0x75, 0x01, 0xd8, 0x89, 0x90, 0x2a, 0x84, 0x6c, 0xd3, 0x56,
-	0x55, 0x55, 0x6b, 0x62, 0x0f, 0x30, 0x92, 0xf1, 0x20, 0xcf, 0x84, 0x92, 0x5b, 0xf4, 0x06, 0x3d,
-	0x40, 0x2f, 0x92, 0x65, 0x96, 0x5d, 0x55, 0x15, 0x5c, 0x24, 0x62, 0x6c, 0x03, 0x4a, 0xb2, 0xb3,
-	0xdf, 0xf7, 0x66, 0xde, 0x9b, 0x5f, 0x3f, 0x1c, 0x71, 0x3a, 0x8a, 0x59, 0x48, 0x4c, 0x96, 0xe0,
-	0x20, 0x22, 0xe6, 0xbc, 0x65, 0x8a, 0xab, 0x19, 0xe1, 0xc6, 0x2c, 0x61, 0x82, 0xa1, 0x83, 0x0c,
-	0x1b, 0x29, 0x36, 0xe6, 0xad, 0x57, 0xcf, 0xc7, 0x6c, 0xcc, 0x24, 0x35, 0xd7, 0x5f, 0xa9, 0xb1,
-	0xf1, 0x5b, 0x81, 0xda, 0x39, 0x89, 0x09, 0xa7, 0xdc, 0x15, 0x58, 0x10, 0xf4, 0x0e, 0x0e, 0x70,
-	0x18, 0x26, 0x84, 0x73, 0xff, 0xe7, 0x84, 0x0a, 0x12, 0x51, 0x2e, 0x34, 0xa5, 0x5e, 0x6a, 0xee,
-	0x39, 0x6a, 0x06, 0xbe, 0xe4, 0x3a, 0x7a, 0x03, 0x4f, 0x70, 0x38, 0xa5, 0xb1, 0x9f, 0x11, 0xad,
-	0x58, 0x57, 0x9a, 0x7b, 0x4e, 0x4d, 0x8a, 0xed, 0x54, 0x43, 0x1f, 0x01, 0x66, 0x09, 0x9b, 0x4d,
-	0x48, 0x40, 0x09, 0xd7, 0x4a, 0xf5, 0x52, 0xb3, 0x7a, 0x7a, 0x64, 0xdc, 0x2b, 0x68, 0x58, 0x9d,
-	0x41, 0x6a, 0xbb, 0x72, 0x76, 0x0e, 0x34, 0x7e, 0xc0, 0xa3, 0x6e, 0x84, 0xe9, 0x14, 0xed, 0x43,
-	0x91, 0x86, 0x9a, 0x22, 0x13, 0x8a, 0x34, 0x5c, 0x37, 0x9d, 0xe3, 0x88, 0x86, 0x58, 0xb0, 0xe4,
-	0x4e, 0x01, 0x75, 0x03, 0xf2, 0x12, 0x1a, 0x3c, 0x0e, 0x58, 0x2c, 0x48, 0x2c, 0xb4, 0x92, 0xb4,
-	0xe4, 0xbf, 0x8d, 0x3f, 0x0a, 0xc0, 0x36, 0xfa, 0x5e, 0xca, 0x07, 0xa8, 0x70, 0x81, 0xc5, 0x65,
-	0x7a, 0x75, 0xf5, 0xf4, 0xe5, 0x03, 0xcd, 0x5d, 0x69, 0xe8, 0x94, 0xaf, 0xff, 0x1d, 0x17, 0x9c,
-	0xcc, 0x8e, 0xde, 0x82, 0x1a, 0xac, 0x7b, 0xfb, 0x9b, 0x2e, 0x5c, 0x46, 0xd7, 0x9c, 0xa7, 0x52,
-	0xff, 0xbc, 0x91, 0xd7, 0xd6, 0xed, 0x4b, 0x24, 0xe4, 0x5a, 0x39, 0xb5, 0x6e, 0x74, 0x39, 0x03,
-	0xde, 0xf8, 0x0e, 0x95, 0x34, 0x0d, 0xb5, 0xa0, 0x2c, 0xc8, 0x42, 0xc8, 0xaa, 0xfb, 0x0f, 0x0e,
-	0x34, 0x35, 0x7a, 0x64, 0x21, 0x1c, 0x69, 0x45, 0xc7, 0x50, 0x1d, 0xd1, 0x18, 0x47, 0x69, 0x46,
-	0x36, 0x2b, 0x90, 0x92, 0xbc, 0xfe, 0x84, 0x03, 0x6c, 0x0f, 0xa1, 0xd7, 0x70, 0xe8, 0x7a, 0x6d,
-	0x6f, 0xe8, 0xfa, 0x9e, 0xfd, 0xd5, 0xf3, 0x87, 0x7d, 0x77, 0x60, 0x77, 0x7b, 0x67, 0x3d, 0xdb,
-	0x52, 0x0b, 0xe8, 0x10, 0x9e, 0xed, 0xc2, 0x81, 0xdd, 0xb7, 0x7a, 0xfd, 0x73, 0x55, 0xb9, 0x0b,
-	0xdc, 0x61, 0xb7, 0x6b, 0xbb, 0xae, 0x5a, 0x44, 0x2f, 0x00, 0xed, 0x82, 0xb3, 0x76, 0xef, 0x93,
-	0x6d, 0xa9, 0xa5, 0x8e, 0x75, 0xbd, 0xd4, 0x95, 0x9b, 0xa5, 0xae, 0xfc, 0x5f, 0xea, 0xca, 0xaf,
-	0x95, 0x5e, 0xb8, 0x59, 0xe9, 0x85, 0xbf, 0x2b, 0xbd, 0xf0, 0xed, 0x64, 0x4c, 0xc5, 0xe4, 0xf2,
-	0xc2, 0x08, 0xd8, 0xd4, 0x74, 0xe9, 0x28, 0x98, 0x60, 0x1a, 0x9b, 0xf9, 0xe2, 0x2f, 0xf2, 0xd5,
-	0x97, 0x7b, 0x7f, 0x51, 0x91, 0xfb, 0xfc, 0xfe, 0x36, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x8f, 0x95,
-	0x2e, 0x19, 0x03, 0x00, 0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0x86, 0xf0, 0x29, 0x17, 0x12, 0xcc, 0x24, 0xfa, 0xe2, 0xef, 0xab, 0x42, 0x10, 0x91,
+	0x5a, 0x9a, 0x2a, 0x46, 0x49, 0x17, 0x5d, 0xb5, 0x52, 0xf8, 0x49, 0x8a, 0x14, 0x21, 0x64, 0x93,
+	0xfe, 0xa9, 0xaa, 0x65, 0xec, 0x01, 0x46, 0x01, 0x0f, 0xf2, 0x0c, 0x29, 0xbc, 0x45, 0x1f, 0xa0,
+	0x0f, 0x94, 0x65, 0x96, 0x55, 0x17, 0x51, 0x95, 0xbc, 0x46, 0x17, 0x95, 0xc7, 0x3f, 0x50, 0x42,
+	0xbb, 0x02, 0x9f, 0x73, 0xee, 0xb9, 0xd7, 0xe7, 0x7a, 0x06, 0x76, 0x19, 0xe9, 0xb9, 0xd4, 0xc1,
+	0x15, 0xea, 0x59, 0xf6, 0x10, 0x57, 0xae, 0x8e, 0x2a, 0x7c, 0x36, 0xc6, 0x4c, 0x1b, 0x7b, 0x94,
+	0x53, 0x94, 0x0f, 0x69, 0x2d, 0xa0, 0xb5, 0xab, 0xa3, 0xff, 0xb7, 0xfb, 0xb4, 0x4f, 0x05, 0x5b,
+	0xf1, 0xff, 0x05, 0xc2, 0xd2, 0x4f, 0x19, 0xb2, 0x67, 0xd8, 0xc5, 0x8c, 0x30, 0x83, 0x5b, 0x1c,
+	0xa3, 0x67, 0x90, 0xb7, 0x1c, 0xc7, 0xc3, 0x8c, 0x99, 0x9f, 0x07, 0x84, 0xe3, 0x21, 0x61, 0x5c,
+	0x95, 0x8a, 0xc9, 0xf2, 0xba, 0xae, 0x84, 0xc4, 0xdb, 0x08, 0x47, 0xfb, 0xb0, 0x61, 0x39, 0x23,
+	0xe2, 0x9a, 0x21, 0xa3, 0xca, 0x45, 0xa9, 0xbc, 0xae, 0x67, 0x05, 0x78, 0x12, 0x60, 0xe8, 0x25,
+	0xc0, 0xd8, 0xa3, 0xe3, 0x01, 0xb6, 0x09, 0x66, 0x6a, 0xb2, 0x98, 0x2c, 0x67, 0x8e, 0x77, 0xb5,
+	0x07, 0x03, 0x6a, 0xf5, 0x6a, 0x3b, 0x90, 0xcd, 0xf4, 0x85, 0x02, 0xf4, 0x1e, 0x14, 0x9b, 0xba,
+	0x0c, 0xbb, 0x6c, 0xc2, 0x4c, 0x17, 0x63, 0x07, 0x3b, 0x6a, 0xca, 0x6f, 0x53, 0xd5, 0xae, 0x6f,
+	0xf7, 0x12, 0xdf, 0x6f, 0xf7, 0x1e, 0xf7, 0x09, 0x1f, 0x4c, 0xba, 0x9a, 0x4d, 0x47, 0x15, 0x9b,
+	0xb2, 0x11, 0x65, 0xe1, 0xcf, 0x21, 0x73, 0x2e, 0xc3, 0x58, 0xea, 0xd8, 0xd6, 0x73, 0xb1, 0x4f,
+	0x4b, 0xd8, 0xa0, 0x36, 0xe4, 0xc3, 0x46, 0x33, 0x73, 0x48, 0x7a, 0x98, 0x93, 0x11, 0x56, 0xd7,
+	0x8a, 0x52, 0x39, 0x73, 0xbc, 0xbf, 0x62, 0xc0, 0x68, 0xbc, 0xf3, 0x50, 0xaa, 0x2b, 0xe3, 0x25,
+	0x04, 0x69, 0xb0, 0x35, 0xf6, 0x26, 0x2e, 0x76, 0xcc, 0xd8, 0x98, 0x38, 0x4c, 0x4d, 0x8b, 0xfc,
+	0xf2, 0x01, 0x15, 0xd9, 0x34, 0x1d, 0x56, 0xea, 0x82, 0xb2, 0xec, 0xea, 0x87, 0x8a, 0xa7, 0x63,
+	0xe2, 0xcd, 0xcc, 0xee, 0x90, 0xda, 0x97, 0x4c, 0x95, 0x8a, 0x52, 0x39, 0xa5, 0x67, 0x03, 0xb0,
+	0x2a, 0x30, 0xf4, 0x14, 0x14, 0x0f, 0x73, 0xec, 0x72, 0x42, 0xdd, 0x48, 0x27, 0x0b, 0x5d, 0x2e,
+	0xc6, 0x03, 0x69, 0xe9, 0x13, 0xac, 0xd5, 0x86, 0x16, 0x19, 0xa1, 0x4d, 0x90, 0x89, 0x23, 0xdc,
+	0xd6, 0x75, 0x99, 0x38, 0xfe, 0xaa, 0xaf, 0xac, 0x21, 0x71, 0x2c, 0x4e, 0xbd, 0xa5, 0x0d, 0x2a,
+	0x31, 0x11, 0x6d, 0x51, 0x85, 0x7f, 0x6c, 0xea, 0xfa, 0xce, 0x6a, 0x52, 0x48, 0xa2, 0xc7, 0xd2,
+	0x57, 0x19, 0x60, 0xbe, 0xbb, 0x07, 0x5d, 0x5e, 0x40, 0x9a, 0x71, 0x8b, 0x4f, 0x02, 0xeb, 0xcc,
+	0xf1, 0x7f, 0x2b, 0x92, 0x35, 0x84, 0xa0, 0x9a, 0xf2, 0x17, 0xaa, 0x87, 0x72, 0x74, 0x08, 0x8a,
+	0xed, 0xcf, 0x6d, 0xc6, 0xb3, 0x30, 0xd1, 0x3a, 0x5b, 0x95, 0x55, 0x49, 0xcf, 0x09, 0xee, 0x4d,
+	0x4c, 0xf9, 0xf2, 0xf9, 0xdb, 0x08, 0x92, 0xa9, 0xa9, 0xb9, 0x3c, 0xe6, 0x44, 0x16, 0x0c, 0x3d,
+	0x81, 0x9c, 0xed, 0x61, 0x4b, 0xe4, 0x37, 0xc0, 0xa4, 0x3f, 0xe0, 0x62, 0xf3, 0x49, 0x7d, 0x33,
+	0x82, 0x5f, 0x0b, 0x14, 0xbd, 0x82, 0x74, 0xe8, 0x96, 0x16, 0x9f, 0x6e, 0xf1, 0x2f, 0x5f, 0x86,
+	0xf0, 0x8e, 0x5e, 0x23, 0xa8, 0x2a, 0xe9, 0xb0, 0xf1, 0x1b, 0xbd, 0x3a, 0x76, 0xe9, 0x0f, 0xb1,
+	0x6f, 0xc3, 0x9a, 0xf0, 0x09, 0xf7, 0x12, 0x3c, 0x94, 0x3e, 0x42, 0x3a, 0x88, 0x0c, 0x1d, 0x41,
+	0x8a, 0xe3, 0x29, 0x17, 0xf5, 0x9b, 0x2b, 0x8f, 0x55, 0x20, 0xec, 0xe0, 0x29, 0xd7, 0x85, 0x14,
+	0xed, 0x41, 0xa6, 0x47, 0x5c, 0x6b, 0x68, 0x2e, 0x1a, 0x83, 0x80, 0xc4, 0x80, 0x07, 0x0c, 0x60,
+	0x5e, 0x84, 0x1e, 0xc1, 0x8e, 0xd1, 0x39, 0xe9, 0x5c, 0x18, 0x66, 0xa7, 0xf1, 0xae, 0x63, 0x5e,
+	0xb4, 0x8c, 0x76, 0xa3, 0xd6, 0x3c, 0x6d, 0x36, 0xea, 0x4a, 0x02, 0xed, 0xc0, 0xd6, 0x22, 0xd9,
+	0x6e, 0xb4, 0xea, 0xcd, 0xd6, 0x99, 0x22, 0x2d, 0x13, 0xc6, 0x45, 0xad, 0xd6, 0x30, 0x0c, 0x45,
+	0x46, 0xff, 0x02, 0x5a, 0x24, 0x4e, 0x4f, 0x9a, 0xe7, 0x8d, 0xba, 0x92, 0xac, 0xd6, 0xaf, 0xef,
+	0x0a, 0xd2, 0xcd, 0x5d, 0x41, 0xfa, 0x71, 0x57, 0x90, 0xbe, 0xdc, 0x17, 0x12, 0x37, 0xf7, 0x85,
+	0xc4, 0xb7, 0xfb, 0x42, 0xe2, 0xc3, 0xc1, 0xc2, 0xf1, 0x36, 0x48, 0xcf, 0x1e, 0x58, 0xc4, 0xad,
+	0x44, 0xd7, 0xdf, 0x34, 0xba, 0x00, 0xc5, 0x31, 0xef, 0xa6, 0xc5, 0xad, 0xf6, 0xfc, 0xd7, 0x00,
+	0xc7, 0x46, 0x20, 0xb8, 0x1f, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -370,6 +534,37 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrunedProphecyIds) > 0 {
+		for iNdEx := len(m.PrunedProphecyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrunedProphecyIds[iNdEx])
+			copy(dAtA[i:], m.PrunedProphecyIds[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.PrunedProphecyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ProphecyLifetime != nil {
+		{
+			size, err := m.ProphecyLifetime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ConsensusNeeded.Size()
+		i -= size
+		if _, err := m.ConsensusNeeded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Prophecies) > 0 {
 		for iNdEx := len(m.Prophecies) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -403,6 +598,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProphecyLifetime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProphecyLifetime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProphecyLifetime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetentionBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExpiryBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Claim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -467,6 +695,25 @@ func (m *DBProphecy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CreationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.ValidatorClaims) > 0 {
 		i -= len(m.ValidatorClaims)
 		copy(dAtA[i:], m.ValidatorClaims)
@@ -501,6 +748,43 @@ func (m *DBProphecy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProphecyClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProphecyClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProphecyClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claim) > 0 {
+		i -= len(m.Claim)
+		copy(dAtA[i:], m.Claim)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Claim)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -569,6 +853,33 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	l = m.ConsensusNeeded.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ProphecyLifetime != nil {
+		l = m.ProphecyLifetime.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.PrunedProphecyIds) > 0 {
+		for _, s := range m.PrunedProphecyIds {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProphecyLifetime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiryBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryBlocks))
+	}
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.RetentionBlocks))
+	}
 	return n
 }
 
@@ -613,6 +924,32 @@ func (m *DBProphecy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.CreationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CreationHeight))
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProphecyClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Claim)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -765,38 +1102,228 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusNeeded", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Claim) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusNeeded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProphecyLifetime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProphecyLifetime == nil {
+				m.ProphecyLifetime = &ProphecyLifetime{}
+			}
+			if err := m.ProphecyLifetime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedProphecyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedProphecyIds = append(m.PrunedProphecyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProphecyLifetime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProphecyLifetime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProphecyLifetime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlocks", wireType)
+			}
+			m.ExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
+			}
+			m.RetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Claim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1094,6 +1621,173 @@ func (m *DBProphecy) Unmarshal(dAtA []byte) error {
 				m.ValidatorClaims = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ProphecyClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProphecyClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProphecyClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProphecyClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		GetCmdQueryEntries(),
+		GetCmdQueryEntry(),
 		GetCmdGenerateEntry(),
 		GetCmdAddEntry(),
 		GetCmdAddAllEntries(),
@@ -35,22 +36,57 @@ func GetQueryCmd() *cobra.Command {
 func GetCmdQueryEntries() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "entries",
-		Short: "query the complete token registry",
+		Short: "query the complete token registry, or a page of it if pagination flags are set",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+			req := &types.QueryEntriesRequest{}
+			// only paginate when asked to so the default output is still the whole registry
+			if cmd.Flags().Changed(flags.FlagLimit) || cmd.Flags().Changed(flags.FlagOffset) ||
+				cmd.Flags().Changed(flags.FlagPage) || cmd.Flags().Changed(flags.FlagPageKey) {
+				req.Pagination, err = client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+			}
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.Entries(context.Background(), &types.QueryEntriesRequest{})
+			res, err := queryClient.Entries(context.Background(), req)
 			if err != nil {
 				return err
 			}
+			if req.Pagination != nil {
+				return clientCtx.PrintProto(res)
+			}
 			return clientCtx.PrintBytes(clientCtx.Codec.MustMarshalJSON(res.Registry))
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "entries")
+	return cmd
+}
+
+func GetCmdQueryEntry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry [denom]",
+		Short: "query the token registry entry of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Entry(context.Background(), &types.QueryEntryRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res.Entry)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
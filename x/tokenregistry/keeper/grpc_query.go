@@ -5,6 +5,8 @@ import (
 
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Querier struct {
@@ -15,10 +17,29 @@ func NewQueryServer(k types.Keeper) types.QueryServer {
 	return Querier{k}
 }
 
-func (q Querier) Entries(c context.Context, _ *types.QueryEntriesRequest) (*types.QueryEntriesResponse, error) {
+func (q Querier) Entries(c context.Context, req *types.QueryEntriesRequest) (*types.QueryEntriesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	wl := q.GetRegistry(ctx)
-	return &types.QueryEntriesResponse{Registry: &wl}, nil
+	if req == nil || req.Pagination == nil {
+		wl := q.GetRegistry(ctx)
+		return &types.QueryEntriesResponse{Registry: &wl}, nil
+	}
+	wl, pageRes, err := q.GetRegistryPaginated(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryEntriesResponse{Registry: &wl, Pagination: pageRes}, nil
+}
+
+func (q Querier) Entry(c context.Context, req *types.QueryEntryRequest) (*types.QueryEntryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	entry, err := q.GetRegistryEntry(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryEntryResponse{Entry: entry}, nil
 }
 
 var _ types.QueryServer = Querier{}
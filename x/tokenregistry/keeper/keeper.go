@@ -3,8 +3,10 @@ package keeper
 import (
 	adminkeeper "github.com/Sifchain/sifnode/x/admin/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
 )
@@ -57,45 +59,75 @@ func (k keeper) GetEntry(wl types.Registry, denom string) (*types.RegistryEntry,
 	return nil, errors.Wrap(errors.ErrKeyNotFound, "registry entry not found")
 }
 
-func (k keeper) SetToken(ctx sdk.Context, entry *types.RegistryEntry) {
-	wl := k.GetRegistry(ctx)
-	for i := range wl.Entries {
-		if wl.Entries[i] != nil && types.StringCompare(wl.Entries[i].Denom, entry.Denom) {
-			wl.Entries[i] = entry
-			k.SetRegistry(ctx, wl)
-			return
-		}
+func (k keeper) GetRegistryEntry(ctx sdk.Context, denom string) (*types.RegistryEntry, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRegistryEntryKey(denom))
+	if bz == nil {
+		return nil, errors.Wrap(errors.ErrKeyNotFound, "registry entry not found")
 	}
-	wl.Entries = append(wl.Entries, entry)
-	k.SetRegistry(ctx, wl)
+	var entry types.RegistryEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return &entry, nil
+}
+
+func (k keeper) SetToken(ctx sdk.Context, entry *types.RegistryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRegistryEntryKey(entry.Denom), k.cdc.MustMarshal(entry))
 }
 
 func (k keeper) RemoveToken(ctx sdk.Context, denom string) {
-	registry := k.GetRegistry(ctx)
-	updated := make([]*types.RegistryEntry, 0)
-	for _, t := range registry.Entries {
-		if t != nil && !types.StringCompare(t.Denom, denom) {
-			updated = append(updated, t)
-		}
-	}
-	k.SetRegistry(ctx, types.Registry{
-		Entries: updated,
-	})
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRegistryEntryKey(denom))
 }
 
+// SetRegistry replaces all registry entries with the entries of wl
 func (k keeper) SetRegistry(ctx sdk.Context, wl types.Registry) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&wl)
-	store.Set(types.WhitelistStorePrefix, bz)
+	iterator := sdk.KVStorePrefixIterator(store, types.RegistryEntryPrefix)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, entry := range wl.Entries {
+		if entry != nil {
+			k.SetToken(ctx, entry)
+		}
+	}
 }
 
+// GetRegistry returns all registry entries ordered by denom
 func (k keeper) GetRegistry(ctx sdk.Context) types.Registry {
 	var whitelist types.Registry
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.WhitelistStorePrefix)
-	if len(bz) == 0 {
-		return types.Registry{}
+	iterator := sdk.KVStorePrefixIterator(store, types.RegistryEntryPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.RegistryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		whitelist.Entries = append(whitelist.Entries, &entry)
 	}
-	k.cdc.MustUnmarshal(bz, &whitelist)
 	return whitelist
 }
+
+func (k keeper) GetRegistryPaginated(ctx sdk.Context, pagination *query.PageRequest) (types.Registry, *query.PageResponse, error) {
+	var whitelist types.Registry
+	store := ctx.KVStore(k.storeKey)
+	entryStore := prefix.NewStore(store, types.RegistryEntryPrefix)
+	pageRes, err := query.Paginate(entryStore, pagination, func(key []byte, value []byte) error {
+		var entry types.RegistryEntry
+		err := k.cdc.Unmarshal(value, &entry)
+		if err != nil {
+			return err
+		}
+		whitelist.Entries = append(whitelist.Entries, &entry)
+		return nil
+	})
+	if err != nil {
+		return types.Registry{}, nil, err
+	}
+	return whitelist, pageRes, nil
+}
@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/Sifchain/sifnode/x/tokenregistry/keeper"
 	"github.com/Sifchain/sifnode/x/tokenregistry/test"
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestKeeper_CheckDenomPermissions(t *testing.T) {
@@ -37,3 +39,50 @@ func TestKeeper_CheckDenomPermissions(t *testing.T) {
 	assert.False(t, app.TokenRegistryKeeper.CheckEntryPermissions(entry2, []types.Permission{types.Permission_IBCEXPORT, types.Permission_IBCIMPORT}))
 	assert.True(t, app.TokenRegistryKeeper.CheckEntryPermissions(entry, []types.Permission{}))
 }
+
+func TestKeeper_RegistryEntries(t *testing.T) {
+	app, ctx, _ := test.CreateTestApp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{Denom: "rowan", Decimals: 18})
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{Denom: "ceth", Decimals: 18})
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{Denom: "ceth", Decimals: 10})
+
+	entry, err := app.TokenRegistryKeeper.GetRegistryEntry(ctx, "ceth")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), entry.Decimals)
+	_, err = app.TokenRegistryKeeper.GetRegistryEntry(ctx, "cusdc")
+	require.Error(t, err)
+
+	registry := app.TokenRegistryKeeper.GetRegistry(ctx)
+	require.Len(t, registry.Entries, 2)
+	require.Equal(t, "ceth", registry.Entries[0].Denom)
+	require.Equal(t, "rowan", registry.Entries[1].Denom)
+
+	app.TokenRegistryKeeper.RemoveToken(ctx, "ceth")
+	_, err = app.TokenRegistryKeeper.GetRegistryEntry(ctx, "ceth")
+	require.Error(t, err)
+
+	app.TokenRegistryKeeper.SetRegistry(ctx, types.Registry{Entries: []*types.RegistryEntry{{Denom: "cusdc", Decimals: 6}}})
+	registry = app.TokenRegistryKeeper.GetRegistry(ctx)
+	require.Len(t, registry.Entries, 1)
+	require.Equal(t, "cusdc", registry.Entries[0].Denom)
+}
+
+func TestMigrator_MigrateToVer5(t *testing.T) {
+	app, ctx, _ := test.CreateTestApp(false)
+	legacy := types.Registry{Entries: []*types.RegistryEntry{
+		{Denom: "rowan", Decimals: 18},
+		{Denom: "ceth", Decimals: 18},
+	}}
+	bz, err := legacy.Marshal()
+	require.NoError(t, err)
+	store := ctx.KVStore(app.TokenRegistryKeeper.StoreKey())
+	store.Set(types.WhitelistStorePrefix, bz)
+
+	err = keeper.NewMigrator(app.TokenRegistryKeeper).MigrateToVer5(ctx)
+	require.NoError(t, err)
+	require.False(t, store.Has(types.WhitelistStorePrefix))
+	for _, denom := range []string{"rowan", "ceth"} {
+		_, err = app.TokenRegistryKeeper.GetRegistryEntry(ctx, denom)
+		require.NoError(t, err)
+	}
+}
@@ -28,3 +28,24 @@ func (m Migrator) MigrateToVer4(ctx sdk.Context) error {
 
 	return nil
 }
+
+// MigrateToVer5 moves the registry from a single store value to one key per denom
+func (m Migrator) MigrateToVer5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.StoreKey())
+	bz := store.Get(tkrtypes.WhitelistStorePrefix)
+	if bz == nil {
+		return nil
+	}
+	var registry tkrtypes.Registry
+	err := registry.Unmarshal(bz)
+	if err != nil {
+		return err
+	}
+	for _, entry := range registry.Entries {
+		if entry != nil {
+			m.SetToken(ctx, entry)
+		}
+	}
+	store.Delete(tkrtypes.WhitelistStorePrefix)
+	return nil
+}
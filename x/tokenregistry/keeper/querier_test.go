@@ -6,8 +6,12 @@ import (
 	"github.com/Sifchain/sifnode/x/tokenregistry/keeper"
 	"github.com/Sifchain/sifnode/x/tokenregistry/test"
 	"github.com/Sifchain/sifnode/x/tokenregistry/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestQueryEntries(t *testing.T) {
@@ -27,3 +31,42 @@ func TestQueryEntries(t *testing.T) {
 	require.Len(t, res.Registry.Entries, 1)
 	require.Equal(t, &expectedRegistry, res.Registry)
 }
+
+func TestQuerier_EntriesPaginated(t *testing.T) {
+	app, ctx, _ := test.CreateTestApp(false)
+	for _, denom := range []string{"ceth", "cusdc", "rowan"} {
+		app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{Denom: denom, Decimals: 18})
+	}
+	querier := keeper.NewQueryServer(app.TokenRegistryKeeper)
+
+	res, err := querier.Entries(sdk.WrapSDKContext(ctx), &types.QueryEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Registry.Entries, 3)
+	require.Nil(t, res.Pagination)
+
+	res, err = querier.Entries(sdk.WrapSDKContext(ctx), &types.QueryEntriesRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.Registry.Entries, 2)
+	require.Equal(t, "ceth", res.Registry.Entries[0].Denom)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = querier.Entries(sdk.WrapSDKContext(ctx), &types.QueryEntriesRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Registry.Entries, 1)
+	require.Equal(t, "rowan", res.Registry.Entries[0].Denom)
+}
+
+func TestQuerier_Entry(t *testing.T) {
+	app, ctx, _ := test.CreateTestApp(false)
+	app.TokenRegistryKeeper.SetToken(ctx, &types.RegistryEntry{Denom: "rowan", Decimals: 18})
+	querier := keeper.NewQueryServer(app.TokenRegistryKeeper)
+
+	res, err := querier.Entry(sdk.WrapSDKContext(ctx), &types.QueryEntryRequest{Denom: "rowan"})
+	require.NoError(t, err)
+	require.Equal(t, int64(18), res.Entry.Decimals)
+
+	_, err = querier.Entry(sdk.WrapSDKContext(ctx), &types.QueryEntryRequest{Denom: "ceth"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = querier.Entry(sdk.WrapSDKContext(ctx), nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
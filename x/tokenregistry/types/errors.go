@@ -7,4 +7,6 @@ var (
 	ErrPermissionDenied      = sdkerrors.Register(ModuleName, 2, "permission denied for denom")
 	ErrNotAllowedToSellAsset = sdkerrors.Register(ModuleName, 3, "Unable to swap, not allowed to sell selected asset")
 	ErrNotAllowedToBuyAsset  = sdkerrors.Register(ModuleName, 4, "Unable to swap, not allowed to buy selected asset")
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 5, "transfer amount exceeds the transfer limit of the denom")
+	ErrInvalidTransferLimit  = sdkerrors.Register(ModuleName, 6, "transfer limit must be empty or a non negative integer")
 )
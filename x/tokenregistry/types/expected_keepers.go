@@ -3,6 +3,7 @@ package types
 import (
 	adminkeeper "github.com/Sifchain/sifnode/x/admin/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -11,10 +12,12 @@ type Keeper interface {
 	GetAdminKeeper() adminkeeper.Keeper
 	CheckEntryPermissions(entry *RegistryEntry, permissions []Permission) bool
 	GetEntry(registry Registry, denom string) (*RegistryEntry, error)
+	GetRegistryEntry(ctx sdk.Context, denom string) (*RegistryEntry, error)
 	SetToken(ctx sdk.Context, entry *RegistryEntry)
 	RemoveToken(ctx sdk.Context, denom string)
 	InitGenesis(ctx sdk.Context, state GenesisState) []abci.ValidatorUpdate
 	ExportGenesis(ctx sdk.Context) *GenesisState
 	GetRegistry(ctx sdk.Context) Registry
+	GetRegistryPaginated(ctx sdk.Context, pagination *query.PageRequest) (Registry, *query.PageResponse, error)
 	SetRegistry(ctx sdk.Context, registry Registry)
 }
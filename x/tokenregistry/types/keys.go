@@ -1,3 +1,12 @@
 package types
 
-var WhitelistStorePrefix = []byte{0x01}
+var (
+	// WhitelistStorePrefix held the whole registry as a single value before version 5
+	WhitelistStorePrefix = []byte{0x01}
+	RegistryEntryPrefix  = []byte{0x03} // key for storing registry entries by denom
+)
+
+// GetRegistryEntryKey generates a key to store a registry entry
+func GetRegistryEntryKey(denom string) []byte {
+	return append(RegistryEntryPrefix, []byte(denom)...)
+}
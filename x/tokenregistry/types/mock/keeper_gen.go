@@ -9,6 +9,7 @@ import (
 
 	types "github.com/Sifchain/sifnode/x/tokenregistry/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	gomock "github.com/golang/mock/gomock"
 	types1 "github.com/tendermint/tendermint/abci/types"
 )
@@ -93,6 +94,37 @@ func (mr *MockKeeperMockRecorder) GetRegistry(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockKeeper)(nil).GetRegistry), ctx)
 }
 
+// GetRegistryEntry mocks base method.
+func (m *MockKeeper) GetRegistryEntry(ctx types0.Context, denom string) (*types.RegistryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryEntry", ctx, denom)
+	ret0, _ := ret[0].(*types.RegistryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistryEntry indicates an expected call of GetRegistryEntry.
+func (mr *MockKeeperMockRecorder) GetRegistryEntry(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryEntry", reflect.TypeOf((*MockKeeper)(nil).GetRegistryEntry), ctx, denom)
+}
+
+// GetRegistryPaginated mocks base method.
+func (m *MockKeeper) GetRegistryPaginated(ctx types0.Context, pagination *query.PageRequest) (types.Registry, *query.PageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistryPaginated", ctx, pagination)
+	ret0, _ := ret[0].(types.Registry)
+	ret1, _ := ret[1].(*query.PageResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRegistryPaginated indicates an expected call of GetRegistryPaginated.
+func (mr *MockKeeperMockRecorder) GetRegistryPaginated(ctx, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryPaginated", reflect.TypeOf((*MockKeeper)(nil).GetRegistryPaginated), ctx, pagination)
+}
+
 // InitGenesis mocks base method.
 func (m *MockKeeper) InitGenesis(ctx types0.Context, state types.GenesisState) []types1.ValidatorUpdate {
 	m.ctrl.T.Helper()
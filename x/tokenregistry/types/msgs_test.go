@@ -48,6 +48,18 @@ func TestMsgUpdateWhitelist_ValidateBasic(t *testing.T) {
 			},
 			assertion: assert.Error,
 		},
+		{
+			name: "Invalid Transfer Limit",
+			msg: types.MsgRegister{
+				From: admin.String(),
+				Entry: &types.RegistryEntry{
+					Denom:         "TestDenom",
+					Decimals:      18,
+					TransferLimit: "-10",
+				},
+			},
+			assertion: assert.Error,
+		},
 		{
 			name: "Empty from",
 			msg: types.MsgRegister{
@@ -78,3 +90,25 @@ func TestMsgUpdateWhitelist_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistryEntry_CheckTransferLimit(t *testing.T) {
+	tests := []struct {
+		name          string
+		transferLimit string
+		amount        sdk.Int
+		err           error
+	}{
+		{name: "empty limit", transferLimit: "", amount: sdk.NewInt(1000)},
+		{name: "zero limit", transferLimit: "0", amount: sdk.NewInt(1000)},
+		{name: "at limit", transferLimit: "100", amount: sdk.NewInt(100)},
+		{name: "over limit", transferLimit: "100", amount: sdk.NewInt(101), err: types.ErrTransferLimitExceeded},
+		{name: "invalid limit", transferLimit: "abc", amount: sdk.NewInt(1), err: types.ErrInvalidTransferLimit},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			entry := types.RegistryEntry{Denom: "ceth", TransferLimit: tt.transferLimit}
+			assert.ErrorIs(t, entry.CheckTransferLimit(tt.amount), tt.err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -30,7 +31,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type QueryEntriesResponse struct {
-	Registry *Registry `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	Registry   *Registry           `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesResponse) Reset()         { *m = QueryEntriesResponse{} }
@@ -73,7 +75,16 @@ func (m *QueryEntriesResponse) GetRegistry() *Registry {
 	return nil
 }
 
+func (m *QueryEntriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The complete registry is returned when pagination is not set
 type QueryEntriesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryEntriesRequest) Reset()         { *m = QueryEntriesRequest{} }
@@ -109,9 +120,106 @@ func (m *QueryEntriesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEntriesRequest proto.InternalMessageInfo
 
+func (m *QueryEntriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryEntryRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryEntryRequest) Reset()         { *m = QueryEntryRequest{} }
+func (m *QueryEntryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryRequest) ProtoMessage()    {}
+func (*QueryEntryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c311bc06126a6f47, []int{2}
+}
+func (m *QueryEntryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEntryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryRequest.Merge(m, src)
+}
+func (m *QueryEntryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntryRequest proto.InternalMessageInfo
+
+func (m *QueryEntryRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryEntryResponse struct {
+	Entry *RegistryEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (m *QueryEntryResponse) Reset()         { *m = QueryEntryResponse{} }
+func (m *QueryEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntryResponse) ProtoMessage()    {}
+func (*QueryEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c311bc06126a6f47, []int{3}
+}
+func (m *QueryEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntryResponse.Merge(m, src)
+}
+func (m *QueryEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEntryResponse proto.InternalMessageInfo
+
+func (m *QueryEntryResponse) GetEntry() *RegistryEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEntriesResponse)(nil), "sifnode.tokenregistry.v1.QueryEntriesResponse")
 	proto.RegisterType((*QueryEntriesRequest)(nil), "sifnode.tokenregistry.v1.QueryEntriesRequest")
+	proto.RegisterType((*QueryEntryRequest)(nil), "sifnode.tokenregistry.v1.QueryEntryRequest")
+	proto.RegisterType((*QueryEntryResponse)(nil), "sifnode.tokenregistry.v1.QueryEntryResponse")
 }
 
 func init() {
@@ -119,26 +227,34 @@ func init() {
 }
 
 var fileDescriptor_c311bc06126a6f47 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xce, 0x4c, 0xcb,
-	0xcb, 0x4f, 0x49, 0xd5, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x2b, 0x4a, 0x4d, 0xcf, 0x2c, 0x2e, 0x29,
-	0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x80, 0xaa, 0xd2, 0x43, 0x51, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea,
-	0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43,
-	0x65, 0x71, 0xdb, 0x59, 0x52, 0x59, 0x90, 0x0a, 0x55, 0xa5, 0x14, 0xc6, 0x25, 0x12, 0x08, 0x72,
-	0x82, 0x6b, 0x5e, 0x49, 0x51, 0x66, 0x6a, 0x71, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa,
-	0x90, 0x1d, 0x17, 0x07, 0x4c, 0x8b, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x92, 0x1e, 0x2e,
-	0xe7, 0xe9, 0x05, 0x41, 0xd9, 0x41, 0x70, 0x3d, 0x4a, 0xa2, 0x5c, 0xc2, 0xa8, 0xe6, 0x16, 0x96,
-	0xa6, 0x16, 0x97, 0x18, 0x2d, 0x66, 0xe4, 0x62, 0x05, 0x8b, 0x0b, 0xcd, 0x64, 0xe4, 0x62, 0x87,
-	0x4a, 0x0a, 0xe9, 0xe2, 0x36, 0x1a, 0x8b, 0x21, 0x52, 0x7a, 0xc4, 0x2a, 0x87, 0xf8, 0x45, 0x49,
-	0xbf, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x9a, 0x42, 0xea, 0xfa, 0xc5, 0x99, 0x69, 0xc9, 0x19, 0x89,
-	0x99, 0x79, 0x18, 0x61, 0x92, 0x94, 0x5a, 0x92, 0x68, 0xa8, 0x9f, 0x0a, 0xd1, 0xe8, 0xe4, 0x7d,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc1, 0x30, 0xc3, 0x60, 0x01, 0x5d, 0x81, 0x66, 0x2c, 0x38,
-	0x9c, 0x93, 0xd8, 0xc0, 0x01, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x06, 0x74, 0x5a, 0x30,
-	0x04, 0x02, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xbf, 0x8e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0x4a, 0xe1, 0x8f, 0x99, 0x30, 0x1d, 0xaa, 0x08, 0x45, 0x28, 0x02, 0xc2, 0x01,
+	0x67, 0x2b, 0xc7, 0x0c, 0x03, 0x12, 0x30, 0xb0, 0x40, 0x6e, 0x43, 0x62, 0x70, 0x7a, 0xbf, 0xf3,
+	0x59, 0x50, 0x3b, 0x17, 0xbb, 0x15, 0x59, 0x79, 0x02, 0x10, 0x13, 0x13, 0x8f, 0xc1, 0x2b, 0x30,
+	0x9e, 0xc4, 0xc2, 0x88, 0x5a, 0x1e, 0x04, 0xd5, 0x76, 0xae, 0x0d, 0x5c, 0xd5, 0x6c, 0x8e, 0xf2,
+	0xfd, 0x7d, 0xfc, 0xf1, 0x57, 0x36, 0xbe, 0x6d, 0xe4, 0xb1, 0xd2, 0x47, 0xc0, 0xac, 0x7e, 0x07,
+	0xaa, 0x06, 0x21, 0x8d, 0xad, 0x1b, 0x36, 0xcf, 0xd9, 0xe9, 0x0c, 0xea, 0x86, 0x56, 0xb5, 0xb6,
+	0x9a, 0x8c, 0x43, 0x8a, 0x76, 0x52, 0x74, 0x9e, 0xc7, 0x23, 0xa1, 0x85, 0x76, 0x21, 0xb6, 0x5a,
+	0xf9, 0x7c, 0x7c, 0x53, 0x68, 0x2d, 0xde, 0x03, 0xe3, 0x95, 0x64, 0x5c, 0x29, 0x6d, 0xb9, 0x95,
+	0x5a, 0x99, 0xf0, 0xf7, 0xfe, 0x44, 0x9b, 0xa9, 0x36, 0xac, 0xe4, 0x06, 0xfc, 0x36, 0x6c, 0x9e,
+	0x97, 0x60, 0x79, 0xce, 0x2a, 0x2e, 0xa4, 0x72, 0xe1, 0x90, 0xdd, 0xee, 0x67, 0x9b, 0x0a, 0x02,
+	0x31, 0xfd, 0x86, 0xf0, 0xe8, 0xf5, 0x0a, 0xf4, 0x4c, 0xd9, 0x5a, 0x82, 0x29, 0xc0, 0x54, 0x5a,
+	0x19, 0x20, 0x4f, 0xf0, 0x95, 0x76, 0x66, 0x8c, 0x6e, 0xa1, 0x7b, 0xd7, 0x0e, 0x52, 0xba, 0xed,
+	0x2c, 0xb4, 0x08, 0xeb, 0xe2, 0x7c, 0x86, 0xbc, 0xc0, 0x78, 0xad, 0x34, 0x1e, 0x3a, 0x42, 0x46,
+	0xbd, 0x3f, 0x5d, 0xf9, 0x53, 0x5f, 0x53, 0xf0, 0xa7, 0xaf, 0xb8, 0x80, 0x76, 0xf3, 0x62, 0x63,
+	0x34, 0x7d, 0x8b, 0x6f, 0x74, 0x05, 0x4f, 0x67, 0x60, 0x2c, 0x79, 0xde, 0xe1, 0x7b, 0xc3, 0xbb,
+	0x3b, 0xf9, 0x6e, 0xb6, 0x83, 0xdf, 0xc3, 0xd7, 0xcf, 0xf1, 0x4d, 0x0b, 0x1f, 0xe1, 0xe8, 0x08,
+	0x94, 0x9e, 0x3a, 0xee, 0xd5, 0xc2, 0x7f, 0xa4, 0x87, 0x98, 0x6c, 0x46, 0x43, 0x51, 0x8f, 0x71,
+	0x04, 0x6a, 0xdd, 0x52, 0xb6, 0xbb, 0x25, 0x3f, 0xef, 0xa7, 0x0e, 0xbe, 0x0f, 0x71, 0xe4, 0xa8,
+	0xe4, 0x2b, 0xc2, 0x97, 0xc3, 0x21, 0xc9, 0xfe, 0x76, 0xca, 0x05, 0x65, 0xc4, 0xb4, 0x6f, 0xdc,
+	0x3b, 0xa7, 0xec, 0xe3, 0xcf, 0x3f, 0x5f, 0x86, 0x7b, 0x24, 0x63, 0x46, 0x1e, 0x4f, 0x4e, 0xb8,
+	0x54, 0xff, 0xdd, 0x12, 0x7f, 0xab, 0x20, 0xf8, 0x7c, 0x46, 0x38, 0x72, 0xda, 0xe4, 0x41, 0x8f,
+	0xad, 0xda, 0x1e, 0xe3, 0x87, 0xfd, 0xc2, 0xc1, 0x6a, 0xdf, 0x59, 0x65, 0xe4, 0x4e, 0x1f, 0xab,
+	0xe6, 0xe9, 0xcb, 0x1f, 0x8b, 0x04, 0x9d, 0x2d, 0x12, 0xf4, 0x7b, 0x91, 0xa0, 0x4f, 0xcb, 0x64,
+	0x70, 0xb6, 0x4c, 0x06, 0xbf, 0x96, 0xc9, 0xe0, 0x4d, 0x2e, 0xa4, 0x3d, 0x99, 0x95, 0x74, 0xa2,
+	0xa7, 0xec, 0xb0, 0x45, 0xb5, 0xcf, 0xe1, 0xc3, 0x3f, 0x50, 0xf7, 0x1a, 0xca, 0x4b, 0xee, 0x39,
+	0x3c, 0xfa, 0x3b, 0x00, 0x74, 0xdd, 0x65, 0xda, 0xd6, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -154,6 +270,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Entries(ctx context.Context, in *QueryEntriesRequest, opts ...grpc.CallOption) (*QueryEntriesResponse, error)
+	Entry(ctx context.Context, in *QueryEntryRequest, opts ...grpc.CallOption) (*QueryEntryResponse, error)
 }
 
 type queryClient struct {
@@ -173,9 +290,19 @@ func (c *queryClient) Entries(ctx context.Context, in *QueryEntriesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Entry(ctx context.Context, in *QueryEntryRequest, opts ...grpc.CallOption) (*QueryEntryResponse, error) {
+	out := new(QueryEntryResponse)
+	err := c.cc.Invoke(ctx, "/sifnode.tokenregistry.v1.Query/Entry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Entries(context.Context, *QueryEntriesRequest) (*QueryEntriesResponse, error)
+	Entry(context.Context, *QueryEntryRequest) (*QueryEntryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -185,6 +312,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Entries(ctx context.Context, req *QueryEntriesRequest) (*QueryEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Entries not implemented")
 }
+func (*UnimplementedQueryServer) Entry(ctx context.Context, req *QueryEntryRequest) (*QueryEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Entry not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -208,6 +338,24 @@ func _Query_Entries_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Entry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Entry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sifnode.tokenregistry.v1.Query/Entry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Entry(ctx, req.(*QueryEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "sifnode.tokenregistry.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -216,6 +364,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Entries",
 			Handler:    _Query_Entries_Handler,
 		},
+		{
+			MethodName: "Entry",
+			Handler:    _Query_Entry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sifnode/tokenregistry/v1/query.proto",
@@ -241,6 +393,18 @@ func (m *QueryEntriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Registry != nil {
 		{
 			size, err := m.Registry.MarshalToSizedBuffer(dAtA[:i])
@@ -276,6 +440,83 @@ func (m *QueryEntriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -300,6 +541,10 @@ func (m *QueryEntriesResponse) Size() (n int) {
 		l = m.Registry.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -309,6 +554,36 @@ func (m *QueryEntriesRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -383,6 +658,42 @@ func (m *QueryEntriesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -433,6 +744,210 @@ func (m *QueryEntriesRequest) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: QueryEntriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &RegistryEntry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 const (
 	// ModuleName is the name of the whitelist module
 	ModuleName = "tokenregistry"
@@ -30,3 +35,31 @@ func GetPermissionFromString(s string) Permission {
 func StringCompare(a, b string) bool {
 	return a == b
 }
+
+// ValidateTransferLimit checks that a transfer limit is empty or a non negative integer
+func ValidateTransferLimit(transferLimit string) error {
+	if transferLimit == "" {
+		return nil
+	}
+	limit, ok := sdk.NewIntFromString(transferLimit)
+	if !ok || limit.IsNegative() {
+		return sdkerrors.Wrapf(ErrInvalidTransferLimit, "%q", transferLimit)
+	}
+	return nil
+}
+
+// CheckTransferLimit returns ErrTransferLimitExceeded if a single transfer of amount goes over the
+// transfer limit of the entry. An empty or zero transfer limit leaves transfers unlimited
+func (r *RegistryEntry) CheckTransferLimit(amount sdk.Int) error {
+	if err := ValidateTransferLimit(r.TransferLimit); err != nil {
+		return err
+	}
+	if r.TransferLimit == "" {
+		return nil
+	}
+	limit, _ := sdk.NewIntFromString(r.TransferLimit)
+	if limit.IsPositive() && amount.GT(limit) {
+		return sdkerrors.Wrapf(ErrTransferLimitExceeded, "%s%s is over the transfer limit of %s%s", amount, r.Denom, limit, r.Denom)
+	}
+	return nil
+}
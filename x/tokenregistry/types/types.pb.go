@@ -149,19 +149,21 @@ func (m *Registry) GetEntries() []*RegistryEntry {
 }
 
 type RegistryEntry struct {
-	Decimals                 int64        `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	Denom                    string       `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	BaseDenom                string       `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	Path                     string       `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
-	IbcChannelId             string       `protobuf:"bytes,6,opt,name=ibc_channel_id,json=ibcChannelId,proto3" json:"ibc_channel_id,omitempty"`
-	IbcCounterpartyChannelId string       `protobuf:"bytes,7,opt,name=ibc_counterparty_channel_id,json=ibcCounterpartyChannelId,proto3" json:"ibc_counterparty_channel_id,omitempty"`
-	DisplayName              string       `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	DisplaySymbol            string       `protobuf:"bytes,9,opt,name=display_symbol,json=displaySymbol,proto3" json:"display_symbol,omitempty"`
-	Network                  string       `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
-	Address                  string       `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
-	ExternalSymbol           string       `protobuf:"bytes,12,opt,name=external_symbol,json=externalSymbol,proto3" json:"external_symbol,omitempty"`
-	TransferLimit            string       `protobuf:"bytes,13,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
-	Permissions              []Permission `protobuf:"varint,15,rep,packed,name=permissions,proto3,enum=sifnode.tokenregistry.v1.Permission" json:"permissions,omitempty"`
+	Decimals                 int64  `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Denom                    string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	BaseDenom                string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	Path                     string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	IbcChannelId             string `protobuf:"bytes,6,opt,name=ibc_channel_id,json=ibcChannelId,proto3" json:"ibc_channel_id,omitempty"`
+	IbcCounterpartyChannelId string `protobuf:"bytes,7,opt,name=ibc_counterparty_channel_id,json=ibcCounterpartyChannelId,proto3" json:"ibc_counterparty_channel_id,omitempty"`
+	DisplayName              string `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	DisplaySymbol            string `protobuf:"bytes,9,opt,name=display_symbol,json=displaySymbol,proto3" json:"display_symbol,omitempty"`
+	Network                  string `protobuf:"bytes,10,opt,name=network,proto3" json:"network,omitempty"`
+	Address                  string `protobuf:"bytes,11,opt,name=address,proto3" json:"address,omitempty"`
+	ExternalSymbol           string `protobuf:"bytes,12,opt,name=external_symbol,json=externalSymbol,proto3" json:"external_symbol,omitempty"`
+	// The maximum amount of a single IBC or bridge transfer of this token.
+	// Empty or zero leaves transfers unlimited.
+	TransferLimit string       `protobuf:"bytes,13,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
+	Permissions   []Permission `protobuf:"varint,15,rep,packed,name=permissions,proto3,enum=sifnode.tokenregistry.v1.Permission" json:"permissions,omitempty"`
 	// The name of denomination unit of this token that is the smallest unit
 	// stored. IBC imports of this RegistryEntry convert and store funds as
 	// unit_denom. Several different denom units of a token may be imported into
@@ -339,7 +341,7 @@ func init() {
 }
 
 var fileDescriptor_d08afdaf425e66ea = []byte{
-	// 602 bytes of a gzipped FileDescriptorProto
+	// 599 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x4f, 0x4f, 0x13, 0x41,
 	0x18, 0xc6, 0xbb, 0xb4, 0xa5, 0xed, 0xdb, 0x3f, 0xac, 0x13, 0x42, 0x46, 0x8c, 0x4d, 0x6d, 0x20,
 	0x34, 0x1e, 0xda, 0x80, 0x5e, 0x3c, 0x68, 0x42, 0x4b, 0x31, 0xab, 0x05, 0x9b, 0x56, 0x12, 0xf5,
@@ -376,8 +378,8 @@ var fileDescriptor_d08afdaf425e66ea = []byte{
 	0x8c, 0xfb, 0x83, 0x81, 0x9d, 0xef, 0x7e, 0xfc, 0x75, 0x55, 0xb7, 0x2e, 0xaf, 0xea, 0xd6, 0x9f,
 	0xab, 0xba, 0xf5, 0xf3, 0xba, 0x9e, 0xb9, 0xbc, 0xae, 0x67, 0x7e, 0x5f, 0xd7, 0x33, 0xdf, 0xb6,
 	0x67, 0x4c, 0x9f, 0x9c, 0xb9, 0xed, 0xa9, 0x08, 0x3a, 0x63, 0x76, 0x6c, 0xae, 0xdf, 0x49, 0xdf,
-	0x84, 0x8b, 0x7b, 0xaf, 0x82, 0x79, 0x12, 0xdc, 0x65, 0xf3, 0x8f, 0xbf, 0xfa, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x3c, 0x80, 0xb9, 0xb6, 0x3b, 0x04, 0x00, 0x00,
+	0x84, 0x8b, 0x7b, 0xaf, 0x82, 0x79, 0x12, 0xdc, 0x65, 0xf3, 0x8f, 0xbf, 0xfa, 0x3b, 0x00, 0x3c,
+	0x80, 0xb9, 0xb6, 0x3b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {